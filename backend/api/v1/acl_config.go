@@ -27,6 +27,7 @@ var ownerAndDBAMethods = map[string]bool{
 	v1pb.RoleService_DeleteRole_FullMethodName:                 true,
 	v1pb.ActuatorService_UpdateActuatorInfo_FullMethodName:     true,
 	v1pb.ActuatorService_ListDebugLog_FullMethodName:           true,
+	v1pb.ActuatorService_GetDriverPoolStats_FullMethodName:     true,
 }
 
 var projectOwnerMethods = map[string]bool{
@@ -58,34 +59,40 @@ var methodPermissionMap = map[string]iam.Permission{
 	v1pb.InstanceService_UpdateDataSource_FullMethodName:  iam.PermissionInstancesUpdate,
 	v1pb.InstanceService_SyncSlowQueries_FullMethodName:   iam.PermissionInstancesSync,
 
-	v1pb.DatabaseService_GetDatabase_FullMethodName:            iam.PermissionDatabasesGet,
-	v1pb.DatabaseService_SearchDatabases_FullMethodName:        iam.PermissionDatabasesGet,
-	v1pb.DatabaseService_ListDatabases_FullMethodName:          iam.PermissionDatabasesList,
-	v1pb.DatabaseService_UpdateDatabase_FullMethodName:         iam.PermissionDatabasesUpdate,
-	v1pb.DatabaseService_BatchUpdateDatabases_FullMethodName:   iam.PermissionDatabasesUpdate,
-	v1pb.DatabaseService_SyncDatabase_FullMethodName:           iam.PermissionDatabasesSync,
-	v1pb.DatabaseService_GetDatabaseMetadata_FullMethodName:    iam.PermissionDatabasesGetSchema,
-	v1pb.DatabaseService_UpdateDatabaseMetadata_FullMethodName: iam.PermissionDatabasesUpdate,
-	v1pb.DatabaseService_GetDatabaseSchema_FullMethodName:      iam.PermissionDatabasesGetSchema,
-	v1pb.DatabaseService_DiffSchema_FullMethodName:             iam.PermissionChangeHistoriesGet,
-	v1pb.DatabaseService_GetBackupSetting_FullMethodName:       iam.PermissionDatabasesGetBackupSetting,
-	v1pb.DatabaseService_UpdateBackupSetting_FullMethodName:    iam.PermissionDatabasesUpdateBackupSetting,
-	v1pb.DatabaseService_CreateBackup_FullMethodName:           iam.PermissionBackupsCreate,
-	v1pb.DatabaseService_ListBackups_FullMethodName:            iam.PermissionBackupsList,
-	v1pb.DatabaseService_ListSlowQueries_FullMethodName:        iam.PermissionSlowQueriesList,
-	v1pb.DatabaseService_ListSecrets_FullMethodName:            iam.PermissionDatabaseSecretsList,
-	v1pb.DatabaseService_UpdateSecret_FullMethodName:           iam.PermissionDatabaseSecretsUpdate,
-	v1pb.DatabaseService_DeleteSecret_FullMethodName:           iam.PermissionDatabaseSecretsDelete,
-	v1pb.DatabaseService_AdviseIndex_FullMethodName:            iam.PermissionDatabasesAdviseIndex,
-	v1pb.DatabaseService_ListChangeHistories_FullMethodName:    iam.PermissionChangeHistoriesList,
-	v1pb.DatabaseService_GetChangeHistory_FullMethodName:       iam.PermissionChangeHistoriesGet,
-	v1pb.EnvironmentService_CreateEnvironment_FullMethodName:   iam.PermissionEnvironmentsCreate,
-	v1pb.EnvironmentService_UpdateEnvironment_FullMethodName:   iam.PermissionEnvironmentsUpdate,
-	v1pb.EnvironmentService_DeleteEnvironment_FullMethodName:   iam.PermissionEnvironmentsDelete,
-	v1pb.EnvironmentService_UndeleteEnvironment_FullMethodName: iam.PermissionEnvironmentsUndelete,
-	v1pb.EnvironmentService_GetEnvironment_FullMethodName:      iam.PermissionEnvironmentsGet,
-	v1pb.EnvironmentService_ListEnvironments_FullMethodName:    iam.PermissionEnvironmentsList,
-	v1pb.EnvironmentService_UpdateBackupSetting_FullMethodName: iam.PermissionEnvironmentsUpdate,
+	v1pb.DatabaseService_GetDatabase_FullMethodName:                 iam.PermissionDatabasesGet,
+	v1pb.DatabaseService_SearchDatabases_FullMethodName:             iam.PermissionDatabasesGet,
+	v1pb.DatabaseService_ListDatabases_FullMethodName:               iam.PermissionDatabasesList,
+	v1pb.DatabaseService_UpdateDatabase_FullMethodName:              iam.PermissionDatabasesUpdate,
+	v1pb.DatabaseService_BatchUpdateDatabases_FullMethodName:        iam.PermissionDatabasesUpdate,
+	v1pb.DatabaseService_SyncDatabase_FullMethodName:                iam.PermissionDatabasesSync,
+	v1pb.DatabaseService_GetDatabaseMetadata_FullMethodName:         iam.PermissionDatabasesGetSchema,
+	v1pb.DatabaseService_UpdateDatabaseMetadata_FullMethodName:      iam.PermissionDatabasesUpdate,
+	v1pb.DatabaseService_GetDatabaseSchema_FullMethodName:           iam.PermissionDatabasesGetSchema,
+	v1pb.DatabaseService_DiffSchema_FullMethodName:                  iam.PermissionChangeHistoriesGet,
+	v1pb.DatabaseService_GetBackupSetting_FullMethodName:            iam.PermissionDatabasesGetBackupSetting,
+	v1pb.DatabaseService_UpdateBackupSetting_FullMethodName:         iam.PermissionDatabasesUpdateBackupSetting,
+	v1pb.DatabaseService_CreateBackup_FullMethodName:                iam.PermissionBackupsCreate,
+	v1pb.DatabaseService_ListBackups_FullMethodName:                 iam.PermissionBackupsList,
+	v1pb.DatabaseService_ListSlowQueries_FullMethodName:             iam.PermissionSlowQueriesList,
+	v1pb.DatabaseService_ListTopQueries_FullMethodName:              iam.PermissionSlowQueriesList,
+	v1pb.DatabaseService_ListSecrets_FullMethodName:                 iam.PermissionDatabaseSecretsList,
+	v1pb.DatabaseService_UpdateSecret_FullMethodName:                iam.PermissionDatabaseSecretsUpdate,
+	v1pb.DatabaseService_DeleteSecret_FullMethodName:                iam.PermissionDatabaseSecretsDelete,
+	v1pb.DatabaseService_AdviseIndex_FullMethodName:                 iam.PermissionDatabasesAdviseIndex,
+	v1pb.DatabaseService_ListChangeHistories_FullMethodName:         iam.PermissionChangeHistoriesList,
+	v1pb.DatabaseService_GetChangeHistory_FullMethodName:            iam.PermissionChangeHistoriesGet,
+	v1pb.DatabaseService_DiscoverSensitiveData_FullMethodName:       iam.PermissionDatabasesUpdate,
+	v1pb.DatabaseService_ListSensitiveDataProposals_FullMethodName:  iam.PermissionDatabasesGet,
+	v1pb.DatabaseService_AcceptSensitiveDataProposal_FullMethodName: iam.PermissionDatabasesUpdate, // bb.policies.create or bb.policies.update is checked in the method.
+	v1pb.DatabaseService_RejectSensitiveDataProposal_FullMethodName: iam.PermissionDatabasesUpdate, // bb.policies.update is checked in the method.
+	v1pb.DatabaseService_ReconcileSchemaDrift_FullMethodName:        iam.PermissionIssuesCreate,
+	v1pb.EnvironmentService_CreateEnvironment_FullMethodName:        iam.PermissionEnvironmentsCreate,
+	v1pb.EnvironmentService_UpdateEnvironment_FullMethodName:        iam.PermissionEnvironmentsUpdate,
+	v1pb.EnvironmentService_DeleteEnvironment_FullMethodName:        iam.PermissionEnvironmentsDelete,
+	v1pb.EnvironmentService_UndeleteEnvironment_FullMethodName:      iam.PermissionEnvironmentsUndelete,
+	v1pb.EnvironmentService_GetEnvironment_FullMethodName:           iam.PermissionEnvironmentsGet,
+	v1pb.EnvironmentService_ListEnvironments_FullMethodName:         iam.PermissionEnvironmentsList,
+	v1pb.EnvironmentService_UpdateBackupSetting_FullMethodName:      iam.PermissionEnvironmentsUpdate,
 
 	// XXX: issues.action needs respective plans.action and rollouts.action permissions if the issue type is change database.
 	v1pb.IssueService_CreateIssue_FullMethodName:             iam.PermissionIssuesCreate,
@@ -55,6 +55,7 @@ func isSkippedMethod(fullMethod string) bool {
 		v1pb.ActuatorService_UpdateActuatorInfo_FullMethodName,
 		v1pb.ActuatorService_DeleteCache_FullMethodName,
 		v1pb.ActuatorService_ListDebugLog_FullMethodName,
+		v1pb.ActuatorService_GetDriverPoolStats_FullMethodName,
 		v1pb.AnomalyService_SearchAnomalies_FullMethodName,
 		v1pb.AuthService_GetUser_FullMethodName,
 		v1pb.AuthService_ListUsers_FullMethodName,
@@ -70,6 +71,9 @@ func isSkippedMethod(fullMethod string) bool {
 		v1pb.LoggingService_GetLog_FullMethodName,
 		v1pb.LoggingService_ExportLogs_FullMethodName,
 		v1pb.SQLService_Query_FullMethodName,
+		v1pb.SQLService_ListQuerySessions_FullMethodName,
+		v1pb.SQLService_GetQuerySession_FullMethodName,
+		v1pb.SQLService_CancelQuerySession_FullMethodName,
 		v1pb.SQLService_Export_FullMethodName,
 		v1pb.SQLService_DifferPreview_FullMethodName,
 		v1pb.SQLService_Check_FullMethodName,
@@ -98,7 +102,15 @@ func isSkippedMethod(fullMethod string) bool {
 		v1pb.WorksheetService_SearchWorksheets_FullMethodName,
 		v1pb.WorksheetService_UpdateWorksheet_FullMethodName,
 		v1pb.WorksheetService_UpdateWorksheetOrganizer_FullMethodName,
-		v1pb.WorksheetService_DeleteWorksheet_FullMethodName:
+		v1pb.WorksheetService_DeleteWorksheet_FullMethodName,
+		v1pb.WorksheetService_ListWorksheetRevisions_FullMethodName,
+		v1pb.WorksheetService_GetWorksheetRevision_FullMethodName,
+		v1pb.WorksheetService_DiffWorksheetRevisions_FullMethodName,
+		v1pb.WorksheetService_RestoreWorksheetRevision_FullMethodName,
+		v1pb.WorksheetService_CreateWorksheetComment_FullMethodName,
+		v1pb.WorksheetService_ListWorksheetComments_FullMethodName,
+		v1pb.WorksheetService_UpdateWorksheetComment_FullMethodName,
+		v1pb.WorksheetService_DeleteWorksheetComment_FullMethodName:
 		return true
 	// handled in the method because we need to consider branch.Creator.
 	case
@@ -272,11 +284,17 @@ func (in *ACLInterceptor) doIAMPermissionCheck(ctx context.Context, fullMethod s
 		v1pb.DatabaseService_CreateBackup_FullMethodName,
 		v1pb.DatabaseService_ListBackups_FullMethodName,
 		v1pb.DatabaseService_ListSecrets_FullMethodName,
+		v1pb.DatabaseService_ListTopQueries_FullMethodName,
 		v1pb.DatabaseService_UpdateSecret_FullMethodName,
 		v1pb.DatabaseService_DeleteSecret_FullMethodName,
 		v1pb.DatabaseService_AdviseIndex_FullMethodName,
 		v1pb.DatabaseService_ListChangeHistories_FullMethodName,
-		v1pb.DatabaseService_GetChangeHistory_FullMethodName:
+		v1pb.DatabaseService_GetChangeHistory_FullMethodName,
+		v1pb.DatabaseService_DiscoverSensitiveData_FullMethodName,
+		v1pb.DatabaseService_ListSensitiveDataProposals_FullMethodName,
+		v1pb.DatabaseService_AcceptSensitiveDataProposal_FullMethodName,
+		v1pb.DatabaseService_RejectSensitiveDataProposal_FullMethodName,
+		v1pb.DatabaseService_ReconcileSchemaDrift_FullMethodName:
 
 		projectIDsGetter = in.getProjectIDsForDatabaseService
 	case
@@ -669,6 +687,8 @@ func (in *ACLInterceptor) getProjectIDsForDatabaseService(ctx context.Context, r
 		databaseNames = append(databaseNames, r.GetParent())
 	case *v1pb.ListSecretsRequest:
 		databaseNames = append(databaseNames, r.GetParent())
+	case *v1pb.ListTopQueriesRequest:
+		databaseNames = append(databaseNames, r.GetParent())
 	case *v1pb.UpdateSecretRequest:
 		instance, database, _, err := common.GetInstanceDatabaseIDSecretName(r.GetSecret().GetName())
 		if err != nil {
@@ -683,6 +703,24 @@ func (in *ACLInterceptor) getProjectIDsForDatabaseService(ctx context.Context, r
 		databaseNames = append(databaseNames, common.FormatDatabase(instance, database))
 	case *v1pb.ListChangeHistoriesRequest:
 		databaseNames = append(databaseNames, r.GetParent())
+	case *v1pb.DiscoverSensitiveDataRequest:
+		databaseNames = append(databaseNames, r.GetName())
+	case *v1pb.ReconcileSchemaDriftRequest:
+		databaseNames = append(databaseNames, r.GetName())
+	case *v1pb.ListSensitiveDataProposalsRequest:
+		databaseNames = append(databaseNames, r.GetParent())
+	case *v1pb.AcceptSensitiveDataProposalRequest:
+		instance, database, _, err := common.GetInstanceDatabaseIDSensitiveDataProposal(r.GetName())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get databaseName from %q", r.GetName())
+		}
+		databaseNames = append(databaseNames, common.FormatDatabase(instance, database))
+	case *v1pb.RejectSensitiveDataProposalRequest:
+		instance, database, _, err := common.GetInstanceDatabaseIDSensitiveDataProposal(r.GetName())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get databaseName from %q", r.GetName())
+		}
+		databaseNames = append(databaseNames, common.FormatDatabase(instance, database))
 	case *v1pb.GetChangeHistoryRequest:
 		instance, database, _, err := common.GetInstanceDatabaseIDChangeHistory(r.GetName())
 		if err != nil {
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"time"
 
@@ -13,8 +14,10 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/backend/api/auth"
+	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
@@ -28,15 +31,17 @@ type ActuatorService struct {
 	profile         *config.Profile
 	errorRecordRing *api.ErrorRecordRing
 	licenseService  enterprise.LicenseService
+	dbFactory       *dbfactory.DBFactory
 }
 
 // NewActuatorService creates a new ActuatorService.
-func NewActuatorService(store *store.Store, profile *config.Profile, errorRecordRing *api.ErrorRecordRing, licenseService enterprise.LicenseService) *ActuatorService {
+func NewActuatorService(store *store.Store, profile *config.Profile, errorRecordRing *api.ErrorRecordRing, licenseService enterprise.LicenseService, dbFactory *dbfactory.DBFactory) *ActuatorService {
 	return &ActuatorService{
 		store:           store,
 		profile:         profile,
 		errorRecordRing: errorRecordRing,
 		licenseService:  licenseService,
+		dbFactory:       dbFactory,
 	}
 }
 
@@ -105,6 +110,31 @@ func (s *ActuatorService) GetResourcePackage(ctx context.Context, _ *v1pb.GetRes
 	}, nil
 }
 
+// GetDriverPoolStats gets the statistics of the database driver pool.
+func (s *ActuatorService) GetDriverPoolStats(_ context.Context, _ *v1pb.GetDriverPoolStatsRequest) (*v1pb.DriverPoolStats, error) {
+	stats := s.dbFactory.GetPoolStats()
+	response := &v1pb.DriverPoolStats{
+		OpenCount:            int32(stats.OpenCount),
+		InUseCount:           int32(stats.InUseCount),
+		IdleCount:            int32(stats.IdleCount),
+		MaxPerInstance:       int32(stats.MaxPerInstance),
+		HitCount:             stats.HitCount,
+		MissCount:            stats.MissCount,
+		WaitCount:            stats.WaitCount,
+		IdleClosedCount:      stats.IdleClosedCount,
+		UnhealthyClosedCount: stats.UnhealthyClosedCount,
+		InvalidatedCount:     stats.InvalidatedCount,
+	}
+	for _, instanceStats := range stats.Instances {
+		response.Instances = append(response.Instances, &v1pb.DriverPoolStats_InstanceStats{
+			Instance:   fmt.Sprintf("%s%s", common.InstanceNamePrefix, instanceStats.InstanceID),
+			OpenCount:  int32(instanceStats.OpenCount),
+			InUseCount: int32(instanceStats.InUseCount),
+		})
+	}
+	return response, nil
+}
+
 func (s *ActuatorService) getServerInfo(ctx context.Context) (*v1pb.ActuatorInfo, error) {
 	count, err := s.store.CountUsers(ctx, api.EndUser)
 	if err != nil {
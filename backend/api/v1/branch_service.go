@@ -419,16 +419,28 @@ func (s *BranchService) MergeBranch(ctx context.Context, request *v1pb.MergeBran
 	// Maybe we can support auto-merging in the future.
 
 	// The first crazy night in 2024.
-	adHead, err := tryMerge(headBranch.Base.Metadata, headBranch.Head.Metadata, baseBranch.Head.Metadata)
+	resolvedHead, resolvedTheirs, err := applyMergeConflictResolutions(headBranch.Head.Metadata, baseBranch.Head.Metadata, request.Resolutions)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid resolutions, error: %v", err)
+	}
+	adHead, conflicts, err := mergeSchemaMetadata(headBranch.Base.Metadata, resolvedHead, resolvedTheirs)
 	if err != nil {
 		slog.Info("cannot merge branches", log.BBError(err))
 		return nil, status.Errorf(codes.Aborted, "cannot merge branches without conflict, error: %v", err)
 	}
+	if len(conflicts) > 0 {
+		st, err := status.New(codes.Aborted, fmt.Sprintf("cannot merge branches without conflict, found %d conflicts", len(conflicts))).WithDetails(&v1pb.MergeConflicts{Conflicts: conflicts})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to build merge conflicts, error: %v", err)
+		}
+		return nil, st.Err()
+	}
 	mergedMetadata, err := tryMerge(baseBranch.Head.Metadata, adHead, baseBranch.Head.Metadata)
 	if err != nil {
 		slog.Info("cannot merge branches", log.BBError(err))
 		return nil, status.Errorf(codes.Aborted, "cannot merge branches without conflict, error: %v", err)
 	}
+	mergeViewsAndFunctions(baseBranch.Head.Metadata, adHead, baseBranch.Head.Metadata, mergedMetadata)
 	if mergedMetadata == nil {
 		return nil, status.Errorf(codes.Internal, "merged metadata should not be nil if there is no error while merging (%+v, %+v, %+v)", headBranch.Base.Metadata, headBranch.Head.Metadata, baseBranch.Head.Metadata)
 	}
@@ -537,9 +549,16 @@ func (s *BranchService) RebaseBranch(ctx context.Context, request *v1pb.RebaseBr
 		}
 		newHeadConfig = baseBranch.Head.GetDatabaseConfig()
 	} else {
-		newHeadMetadata, err = tryMerge(baseBranch.Base.Metadata, baseBranch.Head.Metadata, filteredNewBaseMetadata)
+		resolvedHead, resolvedTheirs, err := applyMergeConflictResolutions(baseBranch.Head.Metadata, filteredNewBaseMetadata, request.Resolutions)
 		if err != nil {
-			slog.Info("cannot rebase branches", log.BBError(err))
+			return nil, status.Errorf(codes.InvalidArgument, "invalid resolutions, error: %v", err)
+		}
+		var conflicts []*v1pb.MergeConflict
+		newHeadMetadata, conflicts, err = mergeSchemaMetadata(baseBranch.Base.Metadata, resolvedHead, resolvedTheirs)
+		if err != nil || len(conflicts) > 0 {
+			if err != nil {
+				slog.Info("cannot rebase branches", log.BBError(err))
+			}
 			conflictSchema, err := diff3.Merge(
 				strings.NewReader(newBaseSchema),
 				bytes.NewReader(baseBranch.BaseSchema),
@@ -559,7 +578,7 @@ func (s *BranchService) RebaseBranch(ctx context.Context, request *v1pb.RebaseBr
 				sb = append(sb, []byte("\n")...)
 			}
 			conflictSchemaString := string(sb)
-			return &v1pb.RebaseBranchResponse{Result: &v1pb.RebaseBranchResponse_ConflictSchema{ConflictSchema: conflictSchemaString}}, nil
+			return &v1pb.RebaseBranchResponse{Result: &v1pb.RebaseBranchResponse_ConflictSchema{ConflictSchema: conflictSchemaString}, Conflicts: conflicts}, nil
 		}
 		if newHeadMetadata == nil {
 			return nil, status.Errorf(codes.Internal, "merged metadata should not be nil if there is no error while merging (%+v, %+v, %+v)", baseBranch.Base.Metadata, baseBranch.Head.Metadata, filteredNewBaseMetadata)
@@ -992,6 +1011,19 @@ func filterDatabaseMetadata(metadata *storepb.DatabaseSchemaMetadata) *storepb.D
 			}
 			filteredSchema.Tables = append(filteredSchema.Tables, filteredTable)
 		}
+		for _, view := range schema.Views {
+			filteredSchema.Views = append(filteredSchema.Views, &storepb.ViewMetadata{
+				Name:       view.Name,
+				Definition: view.Definition,
+				Comment:    view.Comment,
+			})
+		}
+		for _, function := range schema.Functions {
+			filteredSchema.Functions = append(filteredSchema.Functions, &storepb.FunctionMetadata{
+				Name:       function.Name,
+				Definition: function.Definition,
+			})
+		}
 		filteredDatabase.Schemas = append(filteredDatabase.Schemas, filteredSchema)
 	}
 
@@ -345,6 +345,10 @@ func convertToEngine(engine storepb.Engine) v1pb.Engine {
 		return v1pb.Engine_STARROCKS
 	case storepb.Engine_DORIS:
 		return v1pb.Engine_DORIS
+	case storepb.Engine_COCKROACHDB:
+		return v1pb.Engine_COCKROACHDB
+	case storepb.Engine_DUCKDB:
+		return v1pb.Engine_DUCKDB
 	}
 	return v1pb.Engine_ENGINE_UNSPECIFIED
 }
@@ -389,6 +393,10 @@ func convertEngine(engine v1pb.Engine) storepb.Engine {
 		return storepb.Engine_STARROCKS
 	case v1pb.Engine_DORIS:
 		return storepb.Engine_DORIS
+	case v1pb.Engine_COCKROACHDB:
+		return storepb.Engine_COCKROACHDB
+	case v1pb.Engine_DUCKDB:
+		return storepb.Engine_DUCKDB
 	}
 	return storepb.Engine_ENGINE_UNSPECIFIED
 }
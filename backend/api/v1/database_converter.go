@@ -115,6 +115,31 @@ func convertStoreDatabaseMetadata(metadata *storepb.DatabaseSchemaMetadata, conf
 			}
 			s.Streams = append(s.Streams, v1Stream)
 		}
+		for _, keyPattern := range schema.KeyPatterns {
+			if keyPattern == nil {
+				continue
+			}
+			v1KeyPattern := &v1pb.KeyPatternMetadata{
+				Pattern: keyPattern.GetPattern(),
+			}
+			if requestView == v1pb.DatabaseMetadataView_DATABASE_METADATA_VIEW_FULL {
+				v1KeyPattern.Types = keyPattern.GetTypes()
+				v1KeyPattern.SampleCount = keyPattern.GetSampleCount()
+				v1KeyPattern.KeyCount = keyPattern.GetKeyCount()
+				v1KeyPattern.MemoryUsage = keyPattern.GetMemoryUsage()
+				v1KeyPattern.ExampleKey = keyPattern.GetExampleKey()
+				if distribution := keyPattern.GetTtlDistribution(); distribution != nil {
+					v1KeyPattern.TtlDistribution = &v1pb.KeyPatternMetadata_TTLDistribution{
+						NoExpiry:   distribution.GetNoExpiry(),
+						WithinHour: distribution.GetWithinHour(),
+						WithinDay:  distribution.GetWithinDay(),
+						WithinWeek: distribution.GetWithinWeek(),
+						OverWeek:   distribution.GetOverWeek(),
+					}
+				}
+			}
+			s.KeyPatterns = append(s.KeyPatterns, v1KeyPattern)
+		}
 		m.Schemas = append(m.Schemas, s)
 	}
 	for _, extension := range metadata.GetExtensions() {
@@ -317,9 +342,10 @@ func convertStoreTableConfig(table *storepb.TableConfig) *v1pb.TableConfig {
 
 func convertStoreColumnConfig(column *storepb.ColumnConfig) *v1pb.ColumnConfig {
 	return &v1pb.ColumnConfig{
-		Name:           column.GetName(),
-		SemanticTypeId: column.GetSemanticTypeId(),
-		Labels:         column.GetLabels(),
+		Name:             column.GetName(),
+		SemanticTypeId:   column.GetSemanticTypeId(),
+		Labels:           column.GetLabels(),
+		ClassificationId: column.GetClassificationId(),
 	}
 }
 
@@ -427,6 +453,29 @@ func convertV1DatabaseMetadata(metadata *v1pb.DatabaseMetadata) (*storepb.Databa
 			}
 			s.Streams = append(s.Streams, storeStream)
 		}
+		for _, keyPattern := range schema.KeyPatterns {
+			if keyPattern == nil {
+				continue
+			}
+			storeKeyPattern := &storepb.KeyPatternMetadata{
+				Pattern:     keyPattern.GetPattern(),
+				Types:       keyPattern.GetTypes(),
+				SampleCount: keyPattern.GetSampleCount(),
+				KeyCount:    keyPattern.GetKeyCount(),
+				MemoryUsage: keyPattern.GetMemoryUsage(),
+				ExampleKey:  keyPattern.GetExampleKey(),
+			}
+			if distribution := keyPattern.GetTtlDistribution(); distribution != nil {
+				storeKeyPattern.TtlDistribution = &storepb.KeyPatternMetadata_TTLDistribution{
+					NoExpiry:   distribution.GetNoExpiry(),
+					WithinHour: distribution.GetWithinHour(),
+					WithinDay:  distribution.GetWithinDay(),
+					WithinWeek: distribution.GetWithinWeek(),
+					OverWeek:   distribution.GetOverWeek(),
+				}
+			}
+			s.KeyPatterns = append(s.KeyPatterns, storeKeyPattern)
+		}
 		m.Schemas = append(m.Schemas, s)
 	}
 	for _, extension := range metadata.GetExtensions() {
@@ -551,9 +600,10 @@ func convertV1DatabaseConfig(databaseConfig *v1pb.DatabaseConfig) *storepb.Datab
 					continue
 				}
 				t.ColumnConfigs = append(t.ColumnConfigs, &storepb.ColumnConfig{
-					Name:           column.GetName(),
-					SemanticTypeId: column.GetSemanticTypeId(),
-					Labels:         column.GetLabels(),
+					Name:             column.GetName(),
+					SemanticTypeId:   column.GetSemanticTypeId(),
+					Labels:           column.GetLabels(),
+					ClassificationId: column.GetClassificationId(),
 				})
 			}
 			s.TableConfigs = append(s.TableConfigs, t)
@@ -578,8 +628,9 @@ func convertV1TableConfig(table *v1pb.TableConfig) *storepb.TableConfig {
 
 func convertV1ColumnConfig(column *v1pb.ColumnConfig) *storepb.ColumnConfig {
 	return &storepb.ColumnConfig{
-		Name:           column.GetName(),
-		SemanticTypeId: column.GetSemanticTypeId(),
-		Labels:         column.GetLabels(),
+		Name:             column.GetName(),
+		SemanticTypeId:   column.GetSemanticTypeId(),
+		Labels:           column.GetLabels(),
+		ClassificationId: column.GetClassificationId(),
 	}
 }
@@ -72,10 +72,12 @@ type DatabaseService struct {
 	licenseService enterprise.LicenseService
 	profile        *config.Profile
 	iamManager     *iam.Manager
+	rolloutService *RolloutService
+	issueService   *IssueService
 }
 
 // NewDatabaseService creates a new DatabaseService.
-func NewDatabaseService(store *store.Store, br *backuprun.Runner, schemaSyncer *schemasync.Syncer, licenseService enterprise.LicenseService, profile *config.Profile, iamManager *iam.Manager) *DatabaseService {
+func NewDatabaseService(store *store.Store, br *backuprun.Runner, schemaSyncer *schemasync.Syncer, licenseService enterprise.LicenseService, profile *config.Profile, iamManager *iam.Manager, rolloutService *RolloutService, issueService *IssueService) *DatabaseService {
 	return &DatabaseService{
 		store:          store,
 		backupRunner:   br,
@@ -83,6 +85,8 @@ func NewDatabaseService(store *store.Store, br *backuprun.Runner, schemaSyncer *
 		licenseService: licenseService,
 		profile:        profile,
 		iamManager:     iamManager,
+		rolloutService: rolloutService,
+		issueService:   issueService,
 	}
 }
 
@@ -1325,7 +1329,7 @@ func (s *DatabaseService) getTargetSchema(ctx context.Context, request *v1pb.Dif
 
 func (s *DatabaseService) getParserEngine(ctx context.Context, request *v1pb.DiffSchemaRequest) (storepb.Engine, error) {
 	var instanceID string
-	var engine storepb.Engine
+	engine := storepb.Engine_ENGINE_UNSPECIFIED
 
 	if strings.Contains(request.Name, common.ChangeHistoryPrefix) {
 		insID, _, _, err := common.GetInstanceDatabaseIDChangeHistory(request.Name)
@@ -1349,20 +1353,25 @@ func (s *DatabaseService) getParserEngine(ctx context.Context, request *v1pb.Dif
 		return engine, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
 	}
 
-	switch instance.Engine {
+	return getSchemaDiffEngine(instance.Engine)
+}
+
+// getSchemaDiffEngine returns the parser engine used to diff the schemas of the instance engine.
+func getSchemaDiffEngine(instanceEngine storepb.Engine) (storepb.Engine, error) {
+	switch instanceEngine {
 	case storepb.Engine_POSTGRES:
-		engine = storepb.Engine_POSTGRES
+		return storepb.Engine_POSTGRES, nil
 	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
-		engine = storepb.Engine_MYSQL
+		return storepb.Engine_MYSQL, nil
 	case storepb.Engine_TIDB:
-		engine = storepb.Engine_TIDB
+		return storepb.Engine_TIDB, nil
 	case storepb.Engine_ORACLE, storepb.Engine_DM, storepb.Engine_OCEANBASE_ORACLE:
-		engine = storepb.Engine_ORACLE
+		return storepb.Engine_ORACLE, nil
+	case storepb.Engine_MSSQL:
+		return storepb.Engine_MSSQL, nil
 	default:
-		return engine, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid engine type %v", instance.Engine))
+		return storepb.Engine_ENGINE_UNSPECIFIED, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid engine type %v", instanceEngine))
 	}
-
-	return engine, nil
 }
 
 func convertToChangeHistories(h []*store.InstanceChangeHistoryMessage) ([]*v1pb.ChangeHistory, error) {
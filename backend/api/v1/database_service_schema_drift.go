@@ -0,0 +1,224 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// reconcileSchemaDriftPermissions are the permissions required to reconcile the schema drift.
+// The ACL interceptor only checks bb.issues.create, but the reconciliation also creates a plan and a rollout on behalf of the user.
+var reconcileSchemaDriftPermissions = []iam.Permission{
+	iam.PermissionPlansCreate,
+	iam.PermissionRolloutsCreate,
+	iam.PermissionIssuesCreate,
+}
+
+// ReconcileSchemaDrift creates an issue to reconcile the schema drift of a database.
+// The REVERT strategy migrates the live schema back to the schema of the latest change history,
+// and the ADOPT_BASELINE strategy establishes the live schema as the new baseline.
+func (s *DatabaseService) ReconcileSchemaDrift(ctx context.Context, request *v1pb.ReconcileSchemaDriftRequest) (*v1pb.Issue, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	if err := s.licenseService.IsFeatureEnabledForInstance(api.FeatureSchemaDrift, instance); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	if database.ProjectID == api.DefaultProjectID {
+		return nil, status.Errorf(codes.FailedPrecondition, "database %q must be transferred out of the default project before reconciling the schema drift", databaseName)
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", database.ProjectID)
+	}
+	if err := s.checkReconcileSchemaDriftPermission(ctx, project.ResourceID); err != nil {
+		return nil, err
+	}
+
+	// The drift is the difference between the schema of the latest change history and the live schema, the same as the schema drift anomaly.
+	limit := 1
+	changeHistories, err := s.store.ListInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+		InstanceID: &instance.UID,
+		DatabaseID: &database.UID,
+		ShowFull:   true,
+		Limit:      &limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list change histories, error: %v", err)
+	}
+	if len(changeHistories) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "database %q has no change history", databaseName)
+	}
+	expectedSchema := changeHistories[0].Schema
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if dbSchema == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "database schema %q not found", databaseName)
+	}
+	actualSchema := string(dbSchema.GetSchema())
+	if expectedSchema == actualSchema {
+		return nil, status.Errorf(codes.FailedPrecondition, "database %q has no schema drift", databaseName)
+	}
+
+	var title, description string
+	changeDatabaseConfig := &v1pb.Plan_ChangeDatabaseConfig{
+		Target: common.FormatDatabase(database.InstanceID, database.DatabaseName),
+	}
+	switch request.Strategy {
+	case v1pb.ReconcileSchemaDriftRequest_REVERT:
+		engine, err := getSchemaDiffEngine(instance.Engine)
+		if err != nil {
+			return nil, err
+		}
+		diff, err := base.SchemaDiff(engine, base.DiffContext{
+			IgnoreCaseSensitive: false,
+			StrictMode:          engine != storepb.Engine_ORACLE,
+		}, actualSchema, expectedSchema)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to compute diff between the live schema and the schema of the latest change history, error: %v", err)
+		}
+		if diff == "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "database %q has no schema drift to revert", databaseName)
+		}
+		sheet, err := s.store.CreateSheet(ctx, &store.SheetMessage{
+			CreatorID:   principalID,
+			ProjectUID:  project.UID,
+			DatabaseUID: &database.UID,
+			Title:       fmt.Sprintf("Revert schema drift for database %q", database.DatabaseName),
+			Statement:   diff,
+			Visibility:  store.ProjectSheet,
+			Source:      store.SheetFromBytebaseArtifact,
+			Type:        store.SheetForSQL,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create sheet, error: %v", err)
+		}
+		changeDatabaseConfig.Type = v1pb.Plan_ChangeDatabaseConfig_MIGRATE
+		changeDatabaseConfig.Sheet = fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, project.ResourceID, common.SheetIDPrefix, sheet.UID)
+		title = fmt.Sprintf("[%s] Revert schema drift", database.DatabaseName)
+		description = fmt.Sprintf("Revert the schema drift of database %q to the schema of version %q.", database.DatabaseName, changeHistories[0].Version.Version)
+	case v1pb.ReconcileSchemaDriftRequest_ADOPT_BASELINE:
+		changeDatabaseConfig.Type = v1pb.Plan_ChangeDatabaseConfig_BASELINE
+		title = fmt.Sprintf("[%s] Adopt schema drift as baseline", database.DatabaseName)
+		description = fmt.Sprintf("Establish the live schema of database %q as the new baseline, replacing the schema of version %q.", database.DatabaseName, changeHistories[0].Version.Version)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported strategy %q", request.Strategy)
+	}
+
+	parent := fmt.Sprintf("%s%s", common.ProjectNamePrefix, project.ResourceID)
+	plan, err := s.rolloutService.CreatePlan(ctx, &v1pb.CreatePlanRequest{
+		Parent: parent,
+		Plan: &v1pb.Plan{
+			Title:       title,
+			Description: description,
+			Steps: []*v1pb.Plan_Step{
+				{
+					Specs: []*v1pb.Plan_Spec{
+						{
+							Id: uuid.NewString(),
+							Config: &v1pb.Plan_Spec_ChangeDatabaseConfig{
+								ChangeDatabaseConfig: changeDatabaseConfig,
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	rollout, err := s.rolloutService.CreateRollout(ctx, &v1pb.CreateRolloutRequest{
+		Parent: parent,
+		Plan:   plan.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.issueService.CreateIssue(ctx, &v1pb.CreateIssueRequest{
+		Parent: parent,
+		Issue: &v1pb.Issue{
+			Title:       title,
+			Description: description,
+			Type:        v1pb.Issue_DATABASE_CHANGE,
+			Plan:        plan.Name,
+			Rollout:     rollout.Name,
+		},
+	})
+}
+
+// checkReconcileSchemaDriftPermission checks the permissions up front so that we don't leave orphan sheets, plans or rollouts behind
+// if the user is only allowed to create some of them.
+func (s *DatabaseService) checkReconcileSchemaDriftPermission(ctx context.Context, projectID string) error {
+	if !s.profile.DevelopmentIAM {
+		// Creating a database change issue requires the project developer role, see IssueService.CreateIssue.
+		ok, err := isUserAtLeastProjectDeveloper(ctx, s.store, projectID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+		}
+		if !ok {
+			return status.Errorf(codes.PermissionDenied, "only the project owner and developer can reconcile the schema drift")
+		}
+		return nil
+	}
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return status.Errorf(codes.Internal, "user not found")
+	}
+	return checkAllPermissions(reconcileSchemaDriftPermissions, func(p iam.Permission) (bool, error) {
+		return s.iamManager.CheckPermission(ctx, p, user, projectID)
+	})
+}
+
+// checkAllPermissions returns a PermissionDenied error naming the first permission the checker rejects.
+func checkAllPermissions(permissions []iam.Permission, check func(iam.Permission) (bool, error)) error {
+	for _, p := range permissions {
+		ok, err := check(p)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check permission %q, error: %v", p, err)
+		}
+		if !ok {
+			return status.Errorf(codes.PermissionDenied, "permission denied, user does not have permission %q", p)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestReconcileSchemaDriftPermissions(t *testing.T) {
+	a := require.New(t)
+	// The reconciliation creates a plan, a rollout and an issue, so all of the permissions are required.
+	a.ElementsMatch([]iam.Permission{
+		iam.PermissionPlansCreate,
+		iam.PermissionRolloutsCreate,
+		iam.PermissionIssuesCreate,
+	}, reconcileSchemaDriftPermissions)
+
+	tests := []struct {
+		name     string
+		granted  map[iam.Permission]bool
+		checkErr error
+		code     codes.Code
+	}{
+		{
+			name: "all granted",
+			granted: map[iam.Permission]bool{
+				iam.PermissionPlansCreate:    true,
+				iam.PermissionRolloutsCreate: true,
+				iam.PermissionIssuesCreate:   true,
+			},
+			code: codes.OK,
+		},
+		{
+			name: "only issues.create",
+			granted: map[iam.Permission]bool{
+				iam.PermissionIssuesCreate: true,
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "missing rollouts.create",
+			granted: map[iam.Permission]bool{
+				iam.PermissionPlansCreate:  true,
+				iam.PermissionIssuesCreate: true,
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name:     "check error",
+			checkErr: errors.New("boom"),
+			code:     codes.Internal,
+		},
+	}
+	for _, tc := range tests {
+		err := checkAllPermissions(reconcileSchemaDriftPermissions, func(p iam.Permission) (bool, error) {
+			if tc.checkErr != nil {
+				return false, tc.checkErr
+			}
+			return tc.granted[p], nil
+		})
+		a.Equal(tc.code, status.Code(err), tc.name)
+	}
+}
+
+func TestReconcileSchemaDriftInvalidName(t *testing.T) {
+	a := require.New(t)
+	s := &DatabaseService{}
+
+	_, err := s.ReconcileSchemaDrift(context.Background(), &v1pb.ReconcileSchemaDriftRequest{Name: "instances/i/databases/d"})
+	a.Equal(codes.Internal, status.Code(err))
+
+	ctx := context.WithValue(context.Background(), common.PrincipalIDContextKey, 101)
+	_, err = s.ReconcileSchemaDrift(ctx, &v1pb.ReconcileSchemaDriftRequest{Name: "projects/p"})
+	a.Equal(codes.InvalidArgument, status.Code(err))
+}
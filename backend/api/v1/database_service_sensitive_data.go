@@ -0,0 +1,472 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/sensitivedata"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// DiscoverSensitiveData scans the columns of a database for sensitive data and proposes the classifications and masking levels.
+func (s *DatabaseService) DiscoverSensitiveData(ctx context.Context, request *v1pb.DiscoverSensitiveDataRequest) (*v1pb.DiscoverSensitiveDataResponse, error) {
+	if request.SampleSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "sample_size must not be negative")
+	}
+	if request.SampleSize > sensitivedata.MaxSampleSize {
+		return nil, status.Errorf(codes.InvalidArgument, "sample_size must not exceed %d", sensitivedata.MaxSampleSize)
+	}
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	instance, database, err := s.getSensitiveDataInstanceDatabase(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// The sample size is kept, so that the re-scans after the schema sync sample the new columns as well.
+	if database.Metadata.GetSensitiveDataDiscovery().GetSampleSize() != request.SampleSize {
+		database, err = s.store.UpdateDatabase(ctx, &store.UpdateDatabaseMessage{
+			InstanceID:   database.InstanceID,
+			DatabaseName: database.DatabaseName,
+			MetadataUpsert: &storepb.DatabaseMetadata{
+				SensitiveDataDiscovery: &storepb.SensitiveDataDiscoveryConfig{
+					SampleSize: request.SampleSize,
+				},
+			},
+		}, principalID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update database %q: %v", request.Name, err)
+		}
+	}
+
+	if err := s.schemaSyncer.DiscoverSensitiveData(ctx, database); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to discover sensitive data: %v", err)
+	}
+
+	pendingState := store.SensitiveDataProposalStatePending
+	proposals, err := s.store.ListSensitiveDataProposals(ctx, &store.FindSensitiveDataProposalMessage{
+		DatabaseUID: &database.UID,
+		State:       &pendingState,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return &v1pb.DiscoverSensitiveDataResponse{
+		Proposals: convertToSensitiveDataProposals(instance, database, proposals),
+	}, nil
+}
+
+// ListSensitiveDataProposals lists the sensitive data proposals of a database.
+func (s *DatabaseService) ListSensitiveDataProposals(ctx context.Context, request *v1pb.ListSensitiveDataProposalsRequest) (*v1pb.ListSensitiveDataProposalsResponse, error) {
+	instance, database, err := s.getSensitiveDataInstanceDatabase(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	find := &store.FindSensitiveDataProposalMessage{
+		DatabaseUID: &database.UID,
+	}
+	filters, err := parseFilter(request.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	for _, expr := range filters {
+		switch expr.key {
+		case "state":
+			if expr.operator != comparatorTypeEqual {
+				return nil, status.Errorf(codes.InvalidArgument, `only support "=" operation for "state" filter`)
+			}
+			state, err := convertToStoreSensitiveDataProposalState(expr.value)
+			if err != nil {
+				return nil, err
+			}
+			find.State = &state
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter key %q", expr.key)
+		}
+	}
+
+	proposals, err := s.store.ListSensitiveDataProposals(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return &v1pb.ListSensitiveDataProposalsResponse{
+		Proposals: convertToSensitiveDataProposals(instance, database, proposals),
+	}, nil
+}
+
+// AcceptSensitiveDataProposal accepts a sensitive data proposal.
+// The classification is set to the column config and the masking level is set to the masking policy of the database.
+func (s *DatabaseService) AcceptSensitiveDataProposal(ctx context.Context, request *v1pb.AcceptSensitiveDataProposalRequest) (*v1pb.SensitiveDataProposal, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	instance, database, proposal, err := s.getPendingSensitiveDataProposal(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := proto.Clone(proposal.Payload).(*storepb.SensitiveDataProposalPayload)
+	if request.ClassificationId != "" {
+		payload.ClassificationId = request.ClassificationId
+	}
+	if request.MaskingLevel != v1pb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED {
+		payload.MaskingLevel = convertToStorePBMaskingLevel(request.MaskingLevel)
+	}
+	if err := s.checkMaskingPolicyPermission(ctx, database, true /* upsert */); err != nil {
+		return nil, err
+	}
+
+	if payload.ClassificationId != "" {
+		if err := s.validateClassificationID(ctx, database, payload.ClassificationId); err != nil {
+			return nil, err
+		}
+		if err := s.setColumnClassification(ctx, database, proposal, payload.ClassificationId, principalID); err != nil {
+			return nil, err
+		}
+	}
+	if payload.MaskingLevel != storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED {
+		if err := s.setColumnMaskingLevel(ctx, database, proposal, payload.MaskingLevel, principalID); err != nil {
+			return nil, err
+		}
+	}
+
+	acceptedState := store.SensitiveDataProposalStateAccepted
+	proposal, err = s.store.UpdateSensitiveDataProposal(ctx, &store.UpdateSensitiveDataProposalMessage{
+		UID:       proposal.UID,
+		UpdaterID: principalID,
+		State:     &acceptedState,
+		Payload:   payload,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return convertToSensitiveDataProposal(instance, database, proposal), nil
+}
+
+// RejectSensitiveDataProposal rejects a sensitive data proposal.
+// The rejected proposal won't be proposed again by the re-scans.
+func (s *DatabaseService) RejectSensitiveDataProposal(ctx context.Context, request *v1pb.RejectSensitiveDataProposalRequest) (*v1pb.SensitiveDataProposal, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	instance, database, proposal, err := s.getPendingSensitiveDataProposal(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkMaskingPolicyPermission(ctx, database, false /* upsert */); err != nil {
+		return nil, err
+	}
+
+	rejectedState := store.SensitiveDataProposalStateRejected
+	proposal, err = s.store.UpdateSensitiveDataProposal(ctx, &store.UpdateSensitiveDataProposalMessage{
+		UID:       proposal.UID,
+		UpdaterID: principalID,
+		State:     &rejectedState,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return convertToSensitiveDataProposal(instance, database, proposal), nil
+}
+
+func (s *DatabaseService) getSensitiveDataInstanceDatabase(ctx context.Context, name string) (*store.InstanceMessage, *store.DatabaseMessage, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if instance == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	if err := s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance); err != nil {
+		return nil, nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	return instance, database, nil
+}
+
+func (s *DatabaseService) getPendingSensitiveDataProposal(ctx context.Context, name string) (*store.InstanceMessage, *store.DatabaseMessage, *store.SensitiveDataProposalMessage, error) {
+	instanceID, databaseName, proposalUID, err := common.GetInstanceDatabaseIDSensitiveDataProposal(name)
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, database, err := s.getSensitiveDataInstanceDatabase(ctx, common.FormatDatabase(instanceID, databaseName))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	proposal, err := s.store.GetSensitiveDataProposal(ctx, &store.FindSensitiveDataProposalMessage{
+		UID:         &proposalUID,
+		DatabaseUID: &database.UID,
+	})
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if proposal == nil {
+		return nil, nil, nil, status.Errorf(codes.NotFound, "sensitive data proposal %q not found", name)
+	}
+	if proposal.State != store.SensitiveDataProposalStatePending {
+		return nil, nil, nil, status.Errorf(codes.FailedPrecondition, "sensitive data proposal %q is already %s", name, proposal.State)
+	}
+	return instance, database, proposal, nil
+}
+
+// checkMaskingPolicyPermission checks the permission to change the masking policy of the database.
+// Accepting or rejecting a proposal decides the masking of the column, so it requires the same permission as OrgPolicyService,
+// i.e. bb.policies.create if the upsert creates the masking policy, and bb.policies.update otherwise.
+func (s *DatabaseService) checkMaskingPolicyPermission(ctx context.Context, database *store.DatabaseMessage, upsert bool) error {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return status.Errorf(codes.Internal, "user not found")
+	}
+	permission := iam.PermissionPoliciesUpdate
+	if upsert {
+		resourceType := api.PolicyResourceTypeDatabase
+		policyType := api.PolicyTypeMasking
+		policy, err := s.store.GetPolicyV2(ctx, &store.FindPolicyMessage{
+			ResourceType: &resourceType,
+			ResourceUID:  &database.UID,
+			Type:         &policyType,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get masking policy: %v", err)
+		}
+		if policy == nil {
+			permission = iam.PermissionPoliciesCreate
+		}
+	}
+	ok, err := s.iamManager.CheckPermission(ctx, permission, user, database.ProjectID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+	}
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "permission denied, user does not have permission %q", permission)
+	}
+	return nil
+}
+
+// validateClassificationID checks the classification is defined in the data classification config of the project.
+func (s *DatabaseService) validateClassificationID(ctx context.Context, database *store.DatabaseMessage, classificationID string) error {
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return status.Errorf(codes.Internal, err.Error())
+	}
+	if project == nil {
+		return status.Errorf(codes.NotFound, "project %q not found", database.ProjectID)
+	}
+	setting, err := s.store.GetDataClassificationSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get data classification setting: %v", err)
+	}
+	for _, config := range setting.Configs {
+		if config.Id != project.DataClassificationConfigID {
+			continue
+		}
+		if _, ok := config.Classification[classificationID]; !ok {
+			return status.Errorf(codes.InvalidArgument, "classification %q is not defined in the data classification config of project %q", classificationID, project.ResourceID)
+		}
+		return nil
+	}
+	return status.Errorf(codes.FailedPrecondition, "project %q has no data classification config", project.ResourceID)
+}
+
+// setColumnClassification sets the classification to the column config and the column metadata.
+func (s *DatabaseService) setColumnClassification(ctx context.Context, database *store.DatabaseMessage, proposal *store.SensitiveDataProposalMessage, classificationID string, updaterID int) error {
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return status.Errorf(codes.Internal, err.Error())
+	}
+	if dbSchema == nil {
+		return status.Errorf(codes.FailedPrecondition, "database schema metadata not found")
+	}
+
+	config := proto.Clone(dbSchema.GetConfig()).(*storepb.DatabaseConfig)
+	columnConfig := getOrCreateColumnConfig(config, proposal.SchemaName, proposal.TableName, proposal.ColumnName)
+	columnConfig.ClassificationId = classificationID
+
+	// The classification from the column comment takes precedence.
+	metadata := proto.Clone(dbSchema.GetMetadata()).(*storepb.DatabaseSchemaMetadata)
+	for _, schema := range metadata.Schemas {
+		if schema.Name != proposal.SchemaName {
+			continue
+		}
+		for _, table := range schema.Tables {
+			if table.Name != proposal.TableName {
+				continue
+			}
+			for _, column := range table.Columns {
+				if column.Name == proposal.ColumnName && column.Classification == "" {
+					column.Classification = classificationID
+				}
+			}
+		}
+	}
+
+	if err := s.store.UpsertDBSchema(ctx, database.UID, model.NewDBSchema(metadata, dbSchema.GetSchema(), config), updaterID); err != nil {
+		return status.Errorf(codes.Internal, "failed to update database schema: %v", err)
+	}
+	return nil
+}
+
+// setColumnMaskingLevel upserts the column masking level to the masking policy of the database.
+func (s *DatabaseService) setColumnMaskingLevel(ctx context.Context, database *store.DatabaseMessage, proposal *store.SensitiveDataProposalMessage, maskingLevel storepb.MaskingLevel, updaterID int) error {
+	policy, err := s.store.GetMaskingPolicyByDatabaseUID(ctx, database.UID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get masking policy: %v", err)
+	}
+
+	var maskData *storepb.MaskData
+	for _, data := range policy.MaskData {
+		if data.Schema == proposal.SchemaName && data.Table == proposal.TableName && data.Column == proposal.ColumnName {
+			maskData = data
+			break
+		}
+	}
+	if maskData == nil {
+		maskData = &storepb.MaskData{
+			Schema: proposal.SchemaName,
+			Table:  proposal.TableName,
+			Column: proposal.ColumnName,
+		}
+		policy.MaskData = append(policy.MaskData, maskData)
+	}
+	maskData.MaskingLevel = maskingLevel
+
+	payload, err := protojson.Marshal(policy)
+	if err != nil {
+		return status.Errorf(codes.Internal, err.Error())
+	}
+	if _, err := s.store.CreatePolicyV2(ctx, &store.PolicyMessage{
+		ResourceUID:  database.UID,
+		ResourceType: api.PolicyResourceTypeDatabase,
+		Payload:      string(payload),
+		Type:         api.PolicyTypeMasking,
+		Enforce:      true,
+	}, updaterID); err != nil {
+		return status.Errorf(codes.Internal, "failed to update masking policy: %v", err)
+	}
+	return nil
+}
+
+func getOrCreateColumnConfig(config *storepb.DatabaseConfig, schemaName, tableName, columnName string) *storepb.ColumnConfig {
+	var schemaConfig *storepb.SchemaConfig
+	for _, sc := range config.SchemaConfigs {
+		if sc.Name == schemaName {
+			schemaConfig = sc
+			break
+		}
+	}
+	if schemaConfig == nil {
+		schemaConfig = &storepb.SchemaConfig{Name: schemaName}
+		config.SchemaConfigs = append(config.SchemaConfigs, schemaConfig)
+	}
+
+	var tableConfig *storepb.TableConfig
+	for _, tc := range schemaConfig.TableConfigs {
+		if tc.Name == tableName {
+			tableConfig = tc
+			break
+		}
+	}
+	if tableConfig == nil {
+		tableConfig = &storepb.TableConfig{Name: tableName}
+		schemaConfig.TableConfigs = append(schemaConfig.TableConfigs, tableConfig)
+	}
+
+	for _, cc := range tableConfig.ColumnConfigs {
+		if cc.Name == columnName {
+			return cc
+		}
+	}
+	columnConfig := &storepb.ColumnConfig{Name: columnName}
+	tableConfig.ColumnConfigs = append(tableConfig.ColumnConfigs, columnConfig)
+	return columnConfig
+}
+
+func convertToStoreSensitiveDataProposalState(state string) (store.SensitiveDataProposalState, error) {
+	switch state {
+	case v1pb.SensitiveDataProposal_PENDING.String():
+		return store.SensitiveDataProposalStatePending, nil
+	case v1pb.SensitiveDataProposal_ACCEPTED.String():
+		return store.SensitiveDataProposalStateAccepted, nil
+	case v1pb.SensitiveDataProposal_REJECTED.String():
+		return store.SensitiveDataProposalStateRejected, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "invalid sensitive data proposal state %q", state)
+	}
+}
+
+func convertToSensitiveDataProposals(instance *store.InstanceMessage, database *store.DatabaseMessage, proposals []*store.SensitiveDataProposalMessage) []*v1pb.SensitiveDataProposal {
+	var result []*v1pb.SensitiveDataProposal
+	for _, proposal := range proposals {
+		result = append(result, convertToSensitiveDataProposal(instance, database, proposal))
+	}
+	return result
+}
+
+func convertToSensitiveDataProposal(instance *store.InstanceMessage, database *store.DatabaseMessage, proposal *store.SensitiveDataProposalMessage) *v1pb.SensitiveDataProposal {
+	state := v1pb.SensitiveDataProposal_STATE_UNSPECIFIED
+	switch proposal.State {
+	case store.SensitiveDataProposalStatePending:
+		state = v1pb.SensitiveDataProposal_PENDING
+	case store.SensitiveDataProposalStateAccepted:
+		state = v1pb.SensitiveDataProposal_ACCEPTED
+	case store.SensitiveDataProposalStateRejected:
+		state = v1pb.SensitiveDataProposal_REJECTED
+	}
+
+	var detections []*v1pb.SensitiveDataProposal_Detection
+	for _, detection := range proposal.Payload.GetDetections() {
+		detections = append(detections, &v1pb.SensitiveDataProposal_Detection{
+			Category:     v1pb.SensitiveDataProposal_Category(detection.Category),
+			Source:       v1pb.SensitiveDataProposal_Detection_Source(detection.Source),
+			Confidence:   detection.Confidence,
+			MatchedCount: detection.MatchedCount,
+			SampleCount:  detection.SampleCount,
+		})
+	}
+
+	return &v1pb.SensitiveDataProposal{
+		Name:             fmt.Sprintf("%s/%s%d", common.FormatDatabase(instance.ResourceID, database.DatabaseName), common.SensitiveDataProposalPrefix, proposal.UID),
+		Schema:           proposal.SchemaName,
+		Table:            proposal.TableName,
+		Column:           proposal.ColumnName,
+		State:            state,
+		Category:         v1pb.SensitiveDataProposal_Category(proposal.Payload.GetCategory()),
+		Detections:       detections,
+		ClassificationId: proposal.Payload.GetClassificationId(),
+		MaskingLevel:     convertToV1PBMaskingLevel(proposal.Payload.GetMaskingLevel()),
+		CreateTime:       timestamppb.New(time.Unix(proposal.CreatedTs, 0)),
+		UpdateTime:       timestamppb.New(time.Unix(proposal.UpdatedTs, 0)),
+	}
+}
@@ -0,0 +1,198 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	orderByKeyTotalQueryTime = "total_query_time"
+
+	defaultTopQueryPageSize = 10
+	maximumTopQueryPageSize = 100
+	// defaultTopQueryPeriod is the default period of the query history to compute the top queries.
+	defaultTopQueryPeriod = 7 * 24 * time.Hour
+	// maximumTopQueryHistoryCount is the maximum number of the recent query history of the database to compute the top queries.
+	maximumTopQueryHistoryCount = 10000
+)
+
+// topQueryValue is the aggregation of the query history with the same fingerprint.
+type topQueryValue struct {
+	topQuery      *v1pb.TopQuery
+	totalDuration time.Duration
+	users         map[int]bool
+}
+
+// ListTopQueries lists the top queries executed in the SQL editor on the database, grouped by the query fingerprint.
+func (s *DatabaseService) ListTopQueries(ctx context.Context, request *v1pb.ListTopQueriesRequest) (*v1pb.ListTopQueriesResponse, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+
+	startTime := time.Now().Add(-defaultTopQueryPeriod)
+	filters, err := parseFilter(request.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	for _, expr := range filters {
+		if expr.key != filterKeyStartTime || (expr.operator != comparatorTypeGreater && expr.operator != comparatorTypeGreaterEqual) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter %q %q %q", expr.key, expr.operator, expr.value)
+		}
+		t, err := time.Parse(time.RFC3339, expr.value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid start_time filter %q", expr.value)
+		}
+		startTime = t
+	}
+
+	orderByKeys, err := parseOrderBy(request.OrderBy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	for _, key := range orderByKeys {
+		switch key.key {
+		case orderByKeyCount, orderByKeyTotalQueryTime, orderByKeyAverageQueryTime, orderByKeyMaximumQueryTime:
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid order by key %q", key.key)
+		}
+	}
+	if len(orderByKeys) == 0 {
+		orderByKeys = []orderByKey{{key: orderByKeyCount, isAscend: false}}
+	}
+
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultTopQueryPageSize
+	}
+	if pageSize > maximumTopQueryPageSize {
+		pageSize = maximumTopQueryPageSize
+	}
+
+	createdTsAfter := startTime.Unix()
+	limit := maximumTopQueryHistoryCount
+	order := api.DESC
+	activities, err := s.store.ListActivityV2(ctx, &store.FindActivityMessage{
+		TypeList:       []api.ActivityType{api.ActivitySQLEditorQuery},
+		ContainerUID:   &instance.UID,
+		DatabaseUID:    &database.UID,
+		CreatedTsAfter: &createdTsAfter,
+		Limit:          &limit,
+		Order:          &order,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list query history, error: %v", err)
+	}
+
+	topQueryMap := make(map[string]*topQueryValue)
+	for _, activity := range activities {
+		var payload api.ActivitySQLEditorQueryPayload
+		if err := json.Unmarshal([]byte(activity.Payload), &payload); err != nil {
+			slog.Warn("failed to unmarshal query history payload", slog.Int("activity", activity.UID), log.BBError(err))
+			continue
+		}
+		fingerprint := payload.Fingerprint
+		if fingerprint == "" {
+			// The query history before the fingerprint is introduced.
+			fingerprint = getQueryFingerprint(instance.Engine, payload.Statement)
+		}
+		if fingerprint == "" {
+			fingerprint = payload.Statement
+		}
+		duration := time.Duration(payload.DurationNs)
+		value, ok := topQueryMap[fingerprint]
+		if !ok {
+			// The activities are in descending order, so the first one is the latest.
+			value = &topQueryValue{
+				topQuery: &v1pb.TopQuery{
+					Fingerprint:      fingerprint,
+					SampleStatement:  payload.Statement,
+					LatestQueryTime:  timestamppb.New(time.Unix(activity.CreatedTs, 0)),
+					MaximumQueryTime: durationpb.New(duration),
+				},
+				users: make(map[int]bool),
+			}
+			topQueryMap[fingerprint] = value
+		}
+		value.topQuery.Count++
+		if payload.Error != "" {
+			value.topQuery.ErrorCount++
+		}
+		value.totalDuration += duration
+		if value.topQuery.MaximumQueryTime.AsDuration() < duration {
+			value.topQuery.MaximumQueryTime = durationpb.New(duration)
+		}
+		value.users[activity.CreatorUID] = true
+	}
+
+	var topQueries []*v1pb.TopQuery
+	for _, value := range topQueryMap {
+		value.topQuery.TotalQueryTime = durationpb.New(value.totalDuration)
+		value.topQuery.AverageQueryTime = durationpb.New(value.totalDuration / time.Duration(value.topQuery.Count))
+		value.topQuery.UserCount = int32(len(value.users))
+		topQueries = append(topQueries, value.topQuery)
+	}
+	sortTopQueries(topQueries, orderByKeys)
+	if len(topQueries) > pageSize {
+		topQueries = topQueries[:pageSize]
+	}
+
+	return &v1pb.ListTopQueriesResponse{TopQueries: topQueries}, nil
+}
+
+func sortTopQueries(topQueries []*v1pb.TopQuery, orderByKeys []orderByKey) {
+	sort.SliceStable(topQueries, func(i, j int) bool {
+		for _, key := range orderByKeys {
+			var l, r int64
+			switch key.key {
+			case orderByKeyCount:
+				l, r = int64(topQueries[i].Count), int64(topQueries[j].Count)
+			case orderByKeyTotalQueryTime:
+				l, r = int64(topQueries[i].TotalQueryTime.AsDuration()), int64(topQueries[j].TotalQueryTime.AsDuration())
+			case orderByKeyAverageQueryTime:
+				l, r = int64(topQueries[i].AverageQueryTime.AsDuration()), int64(topQueries[j].AverageQueryTime.AsDuration())
+			case orderByKeyMaximumQueryTime:
+				l, r = int64(topQueries[i].MaximumQueryTime.AsDuration()), int64(topQueries[j].MaximumQueryTime.AsDuration())
+			}
+			if l != r {
+				if key.isAscend {
+					return l < r
+				}
+				return l > r
+			}
+		}
+		return topQueries[i].Fingerprint < topQueries[j].Fingerprint
+	})
+}
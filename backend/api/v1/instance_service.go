@@ -22,6 +22,7 @@ import (
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	metricapi "github.com/bytebase/bytebase/backend/metric"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
 	"github.com/bytebase/bytebase/backend/plugin/metric"
 	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
@@ -161,6 +162,9 @@ func (s *InstanceService) CreateInstance(ctx context.Context, request *v1pb.Crea
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
+	if err := validateReadOnlyRoutingPolicy(instanceMessage.Engine, instanceMessage.Options.GetReadOnlyRoutingPolicy()); err != nil {
+		return nil, err
+	}
 
 	// Test connection.
 	if request.ValidateOnly {
@@ -239,6 +243,9 @@ func (s *InstanceService) CreateInstance(ctx context.Context, request *v1pb.Crea
 }
 
 func (s *InstanceService) checkDataSource(instance *store.InstanceMessage, dataSource *store.DataSourceMessage) error {
+	if err := util.CheckAuthenticationType(instance.Engine, dataSource.AuthenticationType); err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
 	password, err := common.Unobfuscate(dataSource.ObfuscatedPassword, s.secret)
 	if err != nil {
 		return err
@@ -312,6 +319,15 @@ func (s *InstanceService) UpdateInstance(ctx context.Context, request *v1pb.Upda
 				patch.OptionsUpsert = instance.Options
 			}
 			patch.OptionsUpsert.MaximumConnections = request.Instance.Options.GetMaximumConnections()
+		case "options.read_only_routing_policy":
+			policy := convertReadOnlyRoutingPolicy(request.Instance.Options.GetReadOnlyRoutingPolicy())
+			if err := validateReadOnlyRoutingPolicy(instance.Engine, policy); err != nil {
+				return nil, err
+			}
+			if patch.OptionsUpsert == nil {
+				patch.OptionsUpsert = instance.Options
+			}
+			patch.OptionsUpsert.ReadOnlyRoutingPolicy = policy
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, `unsupported update_mask "%s"`, path)
 		}
@@ -360,7 +376,7 @@ func (s *InstanceService) syncSlowQueriesForInstance(ctx context.Context, instan
 
 func (s *InstanceService) syncSlowQueriesImpl(ctx context.Context, project *store.ProjectMessage, instance *store.InstanceMessage) error {
 	switch instance.Engine {
-	case storepb.Engine_MYSQL:
+	case storepb.Engine_MYSQL, storepb.Engine_MSSQL, storepb.Engine_ORACLE, storepb.Engine_SNOWFLAKE:
 		driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */, db.ConnectionContext{})
 		if err != nil {
 			return err
@@ -454,7 +470,7 @@ func (s *InstanceService) syncSlowQueriesForProject(ctx context.Context, project
 		}
 
 		switch instance.Engine {
-		case storepb.Engine_MYSQL, storepb.Engine_POSTGRES:
+		case storepb.Engine_MYSQL, storepb.Engine_POSTGRES, storepb.Engine_MSSQL, storepb.Engine_ORACLE, storepb.Engine_SNOWFLAKE:
 			if instance.Deleted {
 				continue
 			}
@@ -542,6 +558,7 @@ func (s *InstanceService) DeleteInstance(ctx context.Context, request *v1pb.Dele
 	}, -1 /* don't need to pass the instance limition */); err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+	s.dbFactory.InvalidateInstance(instance.ResourceID)
 
 	return &emptypb.Empty{}, nil
 }
@@ -719,9 +736,6 @@ func (s *InstanceService) UpdateDataSource(ctx context.Context, request *v1pb.Up
 			return nil, status.Errorf(codes.PermissionDenied, err.Error())
 		}
 	}
-	if err := s.checkDataSource(instance, &dataSource); err != nil {
-		return nil, err
-	}
 
 	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
 	if !ok {
@@ -793,10 +807,20 @@ func (s *InstanceService) UpdateDataSource(ctx context.Context, request *v1pb.Up
 			obfuscated := common.Obfuscate(request.DataSource.SshPrivateKey, s.secret)
 			patch.SSHObfuscatedPrivateKey = &obfuscated
 			dataSource.SSHObfuscatedPrivateKey = obfuscated
+		case "authentication_type":
+			authenticationType := convertAuthenticationType(request.DataSource.AuthenticationType)
+			patch.AuthenticationType = &authenticationType
+			dataSource.AuthenticationType = authenticationType
+		case "region":
+			patch.Region = &request.DataSource.Region
+			dataSource.Region = request.DataSource.Region
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, `unsupport update_mask "%s"`, path)
 		}
 	}
+	if err := s.checkDataSource(instance, &dataSource); err != nil {
+		return nil, err
+	}
 
 	if patch.SSHHost != nil || patch.SSHPort != nil || patch.SSHUser != nil || patch.SSHObfuscatedPassword != nil || patch.SSHObfuscatedPrivateKey != nil {
 		if err := s.licenseService.IsFeatureEnabledForInstance(api.FeatureInstanceSSHConnection, instance); err != nil {
@@ -826,6 +850,8 @@ func (s *InstanceService) UpdateDataSource(ctx context.Context, request *v1pb.Up
 	if err := s.store.UpdateDataSourceV2(ctx, patch); err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+	// Close the pooled drivers connected with the old credentials.
+	s.dbFactory.InvalidateDataSource(instance.ResourceID, dataSource.ID)
 
 	instance, err = s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
 		UID: &instance.UID,
@@ -873,6 +899,7 @@ func (s *InstanceService) RemoveDataSource(ctx context.Context, request *v1pb.Re
 	if err := s.store.RemoveDataSourceV2(ctx, instance.UID, instance.ResourceID, dataSource.ID); err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+	s.dbFactory.InvalidateDataSource(instance.ResourceID, dataSource.ID)
 
 	instance, err = s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
 		ResourceID: &instance.ResourceID,
@@ -968,6 +995,8 @@ func convertToInstance(instance *store.InstanceMessage) *v1pb.Instance {
 			AuthenticationDatabase: ds.AuthenticationDatabase,
 			Sid:                    ds.SID,
 			ServiceName:            ds.ServiceName,
+			AuthenticationType:     convertToV1AuthenticationType(ds.AuthenticationType),
+			Region:                 ds.Region,
 		})
 	}
 	return &v1pb.Instance{
@@ -1057,6 +1086,8 @@ func (s *InstanceService) convertToDataSourceMessage(dataSource *v1pb.DataSource
 		SSHUser:                 dataSource.SshUser,
 		SSHObfuscatedPassword:   common.Obfuscate(dataSource.SshPassword, s.secret),
 		SSHObfuscatedPrivateKey: common.Obfuscate(dataSource.SshPrivateKey, s.secret),
+		AuthenticationType:      convertAuthenticationType(dataSource.AuthenticationType),
+		Region:                  dataSource.Region,
 	}, nil
 }
 
@@ -1087,15 +1118,65 @@ func convertDataSourceTp(tp v1pb.DataSourceType) (api.DataSourceType, error) {
 	return dsType, nil
 }
 
+func convertToV1AuthenticationType(authenticationType storepb.DataSourceOptions_AuthenticationType) v1pb.DataSource_AuthenticationType {
+	switch authenticationType {
+	case storepb.DataSourceOptions_PASSWORD:
+		return v1pb.DataSource_PASSWORD
+	case storepb.DataSourceOptions_AWS_RDS_IAM:
+		return v1pb.DataSource_AWS_RDS_IAM
+	case storepb.DataSourceOptions_GOOGLE_CLOUD_SQL_IAM:
+		return v1pb.DataSource_GOOGLE_CLOUD_SQL_IAM
+	case storepb.DataSourceOptions_AZURE_IAM:
+		return v1pb.DataSource_AZURE_IAM
+	default:
+		return v1pb.DataSource_AUTHENTICATION_UNSPECIFIED
+	}
+}
+
+func convertAuthenticationType(authenticationType v1pb.DataSource_AuthenticationType) storepb.DataSourceOptions_AuthenticationType {
+	switch authenticationType {
+	case v1pb.DataSource_PASSWORD:
+		return storepb.DataSourceOptions_PASSWORD
+	case v1pb.DataSource_AWS_RDS_IAM:
+		return storepb.DataSourceOptions_AWS_RDS_IAM
+	case v1pb.DataSource_GOOGLE_CLOUD_SQL_IAM:
+		return storepb.DataSourceOptions_GOOGLE_CLOUD_SQL_IAM
+	case v1pb.DataSource_AZURE_IAM:
+		return storepb.DataSourceOptions_AZURE_IAM
+	default:
+		return storepb.DataSourceOptions_AUTHENTICATION_UNSPECIFIED
+	}
+}
+
 func convertToInstanceOptions(options *storepb.InstanceOptions) *v1pb.InstanceOptions {
 	if options == nil {
 		return nil
 	}
 
 	return &v1pb.InstanceOptions{
-		SchemaTenantMode:   options.SchemaTenantMode,
-		SyncInterval:       options.SyncInterval,
-		MaximumConnections: options.MaximumConnections,
+		SchemaTenantMode:      options.SchemaTenantMode,
+		SyncInterval:          options.SyncInterval,
+		MaximumConnections:    options.MaximumConnections,
+		ReadOnlyRoutingPolicy: convertToReadOnlyRoutingPolicy(options.ReadOnlyRoutingPolicy),
+	}
+}
+
+func convertToReadOnlyRoutingPolicy(policy *storepb.ReadOnlyRoutingPolicy) *v1pb.ReadOnlyRoutingPolicy {
+	if policy == nil {
+		return nil
+	}
+	strategy := v1pb.ReadOnlyRoutingPolicy_STRATEGY_UNSPECIFIED
+	switch policy.Strategy {
+	case storepb.ReadOnlyRoutingPolicy_ROUND_ROBIN:
+		strategy = v1pb.ReadOnlyRoutingPolicy_ROUND_ROBIN
+	case storepb.ReadOnlyRoutingPolicy_LEAST_CONNECTIONS:
+		strategy = v1pb.ReadOnlyRoutingPolicy_LEAST_CONNECTIONS
+	}
+	return &v1pb.ReadOnlyRoutingPolicy{
+		Strategy:                  strategy,
+		MaxReplicationLag:         policy.MaxReplicationLag,
+		FallbackToAdmin:           policy.FallbackToAdmin,
+		SkipUnknownReplicationLag: policy.SkipUnknownReplicationLag,
 	}
 }
 
@@ -1105,8 +1186,44 @@ func convertInstanceOptions(options *v1pb.InstanceOptions) *storepb.InstanceOpti
 	}
 
 	return &storepb.InstanceOptions{
-		SchemaTenantMode:   options.SchemaTenantMode,
-		SyncInterval:       options.SyncInterval,
-		MaximumConnections: options.MaximumConnections,
+		SchemaTenantMode:      options.SchemaTenantMode,
+		SyncInterval:          options.SyncInterval,
+		MaximumConnections:    options.MaximumConnections,
+		ReadOnlyRoutingPolicy: convertReadOnlyRoutingPolicy(options.ReadOnlyRoutingPolicy),
+	}
+}
+
+func convertReadOnlyRoutingPolicy(policy *v1pb.ReadOnlyRoutingPolicy) *storepb.ReadOnlyRoutingPolicy {
+	if policy == nil {
+		return nil
 	}
+	strategy := storepb.ReadOnlyRoutingPolicy_STRATEGY_UNSPECIFIED
+	switch policy.Strategy {
+	case v1pb.ReadOnlyRoutingPolicy_ROUND_ROBIN:
+		strategy = storepb.ReadOnlyRoutingPolicy_ROUND_ROBIN
+	case v1pb.ReadOnlyRoutingPolicy_LEAST_CONNECTIONS:
+		strategy = storepb.ReadOnlyRoutingPolicy_LEAST_CONNECTIONS
+	}
+	return &storepb.ReadOnlyRoutingPolicy{
+		Strategy:                  strategy,
+		MaxReplicationLag:         policy.MaxReplicationLag,
+		FallbackToAdmin:           policy.FallbackToAdmin,
+		SkipUnknownReplicationLag: policy.SkipUnknownReplicationLag,
+	}
+}
+
+func validateReadOnlyRoutingPolicy(engine storepb.Engine, policy *storepb.ReadOnlyRoutingPolicy) error {
+	if policy.GetMaxReplicationLag() == nil {
+		return nil
+	}
+	if err := policy.GetMaxReplicationLag().CheckValid(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid max replication lag: %v", err)
+	}
+	if policy.GetMaxReplicationLag().AsDuration() < 0 {
+		return status.Errorf(codes.InvalidArgument, "max replication lag must not be negative")
+	}
+	if policy.GetMaxReplicationLag().AsDuration() > 0 && !dbfactory.IsReplicationLagSupported(engine) {
+		return status.Errorf(codes.InvalidArgument, "max replication lag is not supported for %s", engine)
+	}
+	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -235,6 +237,8 @@ func (s *IssueService) getIssueFind(ctx context.Context, projectIDs *[]string, p
 					api.TaskDatabaseSchemaUpdateSDL,
 					api.TaskDatabaseSchemaUpdateGhostSync,
 					api.TaskDatabaseSchemaUpdateGhostCutover,
+					api.TaskDatabaseSchemaUpdateOnlineSync,
+					api.TaskDatabaseSchemaUpdateOnlineCutover,
 				}
 			case "DML":
 				issueFind.TaskTypes = &[]api.TaskType{
@@ -806,14 +810,25 @@ func (s *IssueService) ApproveIssue(ctx context.Context, request *v1pb.ApproveIs
 		return nil, status.Errorf(codes.Internal, "failed to get project policy, error: %v", err)
 	}
 
-	canApprove, err := isUserReviewer(step, user, policy)
+	canApprove, err := canUserApproveStep(step, user, policy, payload.Approval)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to check if principal can approve step, error: %v", err)
 	}
 	if !canApprove {
 		return nil, status.Errorf(codes.PermissionDenied, "cannot approve because the user does not have the required permission")
 	}
+	// The quorum of the step must be reached by different users.
+	for _, approver := range utils.FindStepApprovers(payload.Approval.ApprovalTemplates[0], payload.Approval.Approvers, step) {
+		if approver.PrincipalId == int32(principalID) {
+			return nil, status.Errorf(codes.InvalidArgument, "the user has approved the current step")
+		}
+	}
 
+	// The approval may be changed concurrently, e.g. rejected by the approval SLA runner.
+	expectedApproval, ok := proto.Clone(payload.Approval).(*storepb.IssuePayloadApproval)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "failed to clone issue approval payload")
+	}
 	payload.Approval.Approvers = append(payload.Approval.Approvers, &storepb.IssuePayloadApproval_Approver{
 		Status:      storepb.IssuePayloadApproval_Approver_APPROVED,
 		PrincipalId: int32(principalID),
@@ -834,8 +849,12 @@ func (s *IssueService) ApproveIssue(ctx context.Context, request *v1pb.ApproveIs
 		PayloadUpsert: &storepb.IssuePayload{
 			Approval: payload.Approval,
 		},
+		ExpectedApproval: expectedApproval,
 	}, api.SystemBotID)
 	if err != nil {
+		if common.ErrorCode(err) == common.Conflict {
+			return nil, status.Errorf(codes.Aborted, "the approval of issue %q has been modified by others, please refresh and try again", request.Name)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update issue, error: %v", err)
 	}
 
@@ -905,7 +924,8 @@ func (s *IssueService) ApproveIssue(ctx context.Context, request *v1pb.ApproveIs
 			return nil
 		}
 		approvalStep := utils.FindNextPendingStep(payload.Approval.ApprovalTemplates[0], payload.Approval.Approvers)
-		if approvalStep == nil {
+		// The step has been notified if it is still waiting for the rest of the quorum.
+		if approvalStep == nil || approvalStep == step {
 			return nil
 		}
 		protoPayload, err := protojson.Marshal(&storepb.ActivityIssueApprovalNotifyPayload{
@@ -1079,13 +1099,18 @@ func (s *IssueService) RejectIssue(ctx context.Context, request *v1pb.RejectIssu
 		return nil, status.Errorf(codes.Internal, "failed to get project policy, error: %v", err)
 	}
 
-	canApprove, err := isUserReviewer(step, user, policy)
+	canApprove, err := canUserApproveStep(step, user, policy, payload.Approval)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to check if principal can reject step, error: %v", err)
 	}
 	if !canApprove {
 		return nil, status.Errorf(codes.PermissionDenied, "cannot reject because the user does not have the required permission")
 	}
+	// The approval may be changed concurrently, e.g. rejected by the approval SLA runner.
+	expectedApproval, ok := proto.Clone(payload.Approval).(*storepb.IssuePayloadApproval)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "failed to clone issue approval payload")
+	}
 	payload.Approval.Approvers = append(payload.Approval.Approvers, &storepb.IssuePayloadApproval_Approver{
 		Status:      storepb.IssuePayloadApproval_Approver_REJECTED,
 		PrincipalId: int32(principalID),
@@ -1095,8 +1120,12 @@ func (s *IssueService) RejectIssue(ctx context.Context, request *v1pb.RejectIssu
 		PayloadUpsert: &storepb.IssuePayload{
 			Approval: payload.Approval,
 		},
+		ExpectedApproval: expectedApproval,
 	}, api.SystemBotID)
 	if err != nil {
+		if common.ErrorCode(err) == common.Conflict {
+			return nil, status.Errorf(codes.Aborted, "the approval of issue %q has been modified by others, please refresh and try again", request.Name)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update issue, error: %v", err)
 	}
 
@@ -1546,6 +1575,24 @@ func (s *IssueService) BatchUpdateIssuesStatus(ctx context.Context, request *v1p
 		return nil, status.Errorf(codes.Internal, "failed to batch update issues, err: %v", err)
 	}
 
+	// The cutover of the online schema migration won't run on the closed issues, so drop the sync triggers on the original table.
+	if newStatus != api.IssueOpen {
+		for _, issue := range issues {
+			if issue.PipelineUID == nil {
+				continue
+			}
+			tasks, err := s.store.ListTasks(ctx, &api.TaskFind{PipelineID: issue.PipelineUID})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list tasks, err: %v", err)
+			}
+			for _, task := range tasks {
+				if task.Type == api.TaskDatabaseSchemaUpdateOnlineCutover && task.LatestTaskRunStatus != api.TaskRunDone {
+					s.stateCfg.OnlineMigrationCleanupChan <- task.ID
+				}
+			}
+		}
+	}
+
 	if err := func() error {
 		var errs error
 		for _, issue := range issues {
@@ -1796,6 +1843,24 @@ func canRequestIssue(issueCreator *store.UserMessage, user *store.UserMessage) b
 	return issueCreator.ID == user.ID
 }
 
+// canUserApproveStep checks if the user can approve the pending step, which also allows the users
+// with the escalation role of the approval template SLA once the step is escalated.
+func canUserApproveStep(step *storepb.ApprovalStep, user *store.UserMessage, policy *store.IAMPolicyMessage, approval *storepb.IssuePayloadApproval) (bool, error) {
+	ok, err := isUserReviewer(step, user, policy)
+	if err != nil || ok {
+		return ok, err
+	}
+	escalationRole := approval.GetApprovalTemplates()[0].GetSla().GetEscalationRole()
+	if !approval.GetSlaState().GetEscalated() || escalationRole == "" {
+		return false, nil
+	}
+	roles, err := utils.GetUserFormattedRolesMap(user, policy)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get user roles")
+	}
+	return roles[escalationRole], nil
+}
+
 func isUserReviewer(step *storepb.ApprovalStep, user *store.UserMessage, policy *store.IAMPolicyMessage) (bool, error) {
 	if len(step.Nodes) != 1 {
 		return false, errors.Errorf("expecting one node but got %v", len(step.Nodes))
@@ -1833,6 +1898,10 @@ func isUserReviewer(step *storepb.ApprovalStep, user *store.UserMessage, policy
 		return roles[val.Role], nil
 	case *storepb.ApprovalNode_ExternalNodeId:
 		return true, nil
+	case *storepb.ApprovalNode_Users_:
+		return slices.Contains(val.Users.GetMembers(), common.FormatUserEmail(user.Email)), nil
+	case *storepb.ApprovalNode_ApproverExpression:
+		return slices.Contains(node.ResolvedApprovers, user.Email), nil
 	default:
 		return false, errors.Errorf("invalid node payload type")
 	}
@@ -1902,6 +1971,7 @@ func convertToIssue(ctx context.Context, s *store.Store, issue *store.IssueMessa
 	if issuePayload.Approval != nil {
 		issueV1.ApprovalFindingDone = issuePayload.Approval.ApprovalFindingDone
 		issueV1.ApprovalFindingError = issuePayload.Approval.ApprovalFindingError
+		issueV1.ApprovalEscalated = issuePayload.Approval.SlaState.GetEscalated()
 		for _, template := range issuePayload.Approval.ApprovalTemplates {
 			issueV1.ApprovalTemplates = append(issueV1.ApprovalTemplates, convertToApprovalTemplate(template))
 		}
@@ -2019,6 +2089,31 @@ func convertToApprovalTemplate(template *storepb.ApprovalTemplate) *v1pb.Approva
 		Flow:        convertToApprovalFlow(template.Flow),
 		Title:       template.Title,
 		Description: template.Description,
+		Sla:         convertToApprovalSLA(template.Sla),
+	}
+}
+
+func convertToApprovalSLA(sla *storepb.ApprovalSLA) *v1pb.ApprovalSLA {
+	if sla == nil {
+		return nil
+	}
+	return &v1pb.ApprovalSLA{
+		ReminderInterval:  sla.ReminderInterval,
+		EscalationTimeout: sla.EscalationTimeout,
+		EscalationRole:    sla.EscalationRole,
+		RejectionTimeout:  sla.RejectionTimeout,
+	}
+}
+
+func convertV1ApprovalSLA(sla *v1pb.ApprovalSLA) *storepb.ApprovalSLA {
+	if sla == nil {
+		return nil
+	}
+	return &storepb.ApprovalSLA{
+		ReminderInterval:  sla.ReminderInterval,
+		EscalationTimeout: sla.EscalationTimeout,
+		EscalationRole:    sla.EscalationRole,
+		RejectionTimeout:  sla.RejectionTimeout,
 	}
 }
 
@@ -2056,7 +2151,19 @@ func convertToApprovalNode(node *storepb.ApprovalNode) *v1pb.ApprovalNode {
 		v1node.Payload = &v1pb.ApprovalNode_ExternalNodeId{
 			ExternalNodeId: payload.ExternalNodeId,
 		}
+	case *storepb.ApprovalNode_Users_:
+		v1node.Payload = &v1pb.ApprovalNode_Users_{
+			Users: &v1pb.ApprovalNode_Users{
+				Members: payload.Users.GetMembers(),
+			},
+		}
+	case *storepb.ApprovalNode_ApproverExpression:
+		v1node.Payload = &v1pb.ApprovalNode_ApproverExpression{
+			ApproverExpression: payload.ApproverExpression,
+		}
 	}
+	v1node.RequiredApprovals = node.RequiredApprovals
+	v1node.ResolvedApprovers = node.ResolvedApprovers
 	return v1node
 }
 
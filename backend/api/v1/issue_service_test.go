@@ -30,6 +30,42 @@ func TestConvertToApprovalNode(t *testing.T) {
 				},
 			},
 		},
+		{
+			node: &storepb.ApprovalNode{
+				Type: storepb.ApprovalNode_ANY_IN_GROUP,
+				Payload: &storepb.ApprovalNode_Users_{
+					Users: &storepb.ApprovalNode_Users{
+						Members: []string{"users/a@example.com", "users/b@example.com"},
+					},
+				},
+				RequiredApprovals: 2,
+			},
+			want: &v1pb.ApprovalNode{
+				Type: v1pb.ApprovalNode_ANY_IN_GROUP,
+				Payload: &v1pb.ApprovalNode_Users_{
+					Users: &v1pb.ApprovalNode_Users{
+						Members: []string{"users/a@example.com", "users/b@example.com"},
+					},
+				},
+				RequiredApprovals: 2,
+			},
+		},
+		{
+			node: &storepb.ApprovalNode{
+				Type: storepb.ApprovalNode_ANY_IN_GROUP,
+				Payload: &storepb.ApprovalNode_ApproverExpression{
+					ApproverExpression: "project_owners",
+				},
+				ResolvedApprovers: []string{"a@example.com"},
+			},
+			want: &v1pb.ApprovalNode{
+				Type: v1pb.ApprovalNode_ANY_IN_GROUP,
+				Payload: &v1pb.ApprovalNode_ApproverExpression{
+					ApproverExpression: "project_owners",
+				},
+				ResolvedApprovers: []string{"a@example.com"},
+			},
+		},
 	}
 
 	a := require.New(t)
@@ -179,6 +215,49 @@ func TestCanUserApproveStep(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			step: &storepb.ApprovalStep{
+				Type: storepb.ApprovalStep_ANY,
+				Nodes: []*storepb.ApprovalNode{
+					{
+						Type: storepb.ApprovalNode_ANY_IN_GROUP,
+						Payload: &storepb.ApprovalNode_Users_{
+							Users: &storepb.ApprovalNode_Users{
+								Members: []string{"users/a@example.com"},
+							},
+						},
+					},
+				},
+			},
+			user: &store.UserMessage{
+				ID:    1,
+				Email: "a@example.com",
+				Roles: []api.Role{api.WorkspaceMember},
+			},
+			policy: &store.IAMPolicyMessage{},
+			want:   true,
+		},
+		{
+			step: &storepb.ApprovalStep{
+				Type: storepb.ApprovalStep_ANY,
+				Nodes: []*storepb.ApprovalNode{
+					{
+						Type: storepb.ApprovalNode_ANY_IN_GROUP,
+						Payload: &storepb.ApprovalNode_ApproverExpression{
+							ApproverExpression: "project_owners",
+						},
+						ResolvedApprovers: []string{"a@example.com"},
+					},
+				},
+			},
+			user: &store.UserMessage{
+				ID:    2,
+				Email: "b@example.com",
+				Roles: []api.Role{api.WorkspaceDBA},
+			},
+			policy: &store.IAMPolicyMessage{},
+			want:   false,
+		},
 	}
 
 	a := require.New(t)
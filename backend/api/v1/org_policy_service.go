@@ -649,6 +649,45 @@ func (s *OrgPolicyService) convertPolicyPayloadToString(policy *v1pb.Policy) (st
 			return "", status.Errorf(codes.InvalidArgument, err.Error())
 		}
 		return payload.String()
+	case v1pb.PolicyType_SESSION_GUARD:
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureAccessControl); err != nil {
+			return "", status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		payload, err := convertToStorePBSessionGuardPolicy(policy.GetSessionGuardPolicy())
+		if err != nil {
+			return "", status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal session guard policy")
+		}
+		return string(payloadBytes), nil
+	case v1pb.PolicyType_LOCK_IMPACT:
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureSQLReview); err != nil {
+			return "", status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		payload, err := convertToStorePBLockImpactPolicy(policy.GetLockImpactPolicy())
+		if err != nil {
+			return "", status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal lock impact policy")
+		}
+		return string(payloadBytes), nil
+	case v1pb.PolicyType_DML_DRY_RUN:
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureSQLReview); err != nil {
+			return "", status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		payload, err := convertToStorePBDMLDryRunPolicy(policy.GetDmlDryRunPolicy())
+		if err != nil {
+			return "", status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal DML dry run policy")
+		}
+		return string(payloadBytes), nil
 	}
 
 	return "", status.Errorf(codes.InvalidArgument, "invalid policy %v", policy.Type)
@@ -765,6 +804,27 @@ func convertToPolicy(parentPath string, policyMessage *store.PolicyMessage) (*v1
 			return nil, err
 		}
 		policy.Policy = payload
+	case api.PolicyTypeSessionGuard:
+		pType = v1pb.PolicyType_SESSION_GUARD
+		payload, err := convertToV1PBSessionGuardPolicy(policyMessage.Payload)
+		if err != nil {
+			return nil, err
+		}
+		policy.Policy = payload
+	case api.PolicyTypeLockImpact:
+		pType = v1pb.PolicyType_LOCK_IMPACT
+		payload, err := convertToV1PBLockImpactPolicy(policyMessage.Payload)
+		if err != nil {
+			return nil, err
+		}
+		policy.Policy = payload
+	case api.PolicyTypeDMLDryRun:
+		pType = v1pb.PolicyType_DML_DRY_RUN
+		payload, err := convertToV1PBDMLDryRunPolicy(policyMessage.Payload)
+		if err != nil {
+			return nil, err
+		}
+		policy.Policy = payload
 	}
 
 	policy.Type = pType
@@ -1088,6 +1148,131 @@ func convertToDisableCopyDataPolicyPayload(policy *v1pb.DisableCopyDataPolicy) (
 	}, nil
 }
 
+func convertToV1PBSessionGuardPolicy(payloadStr string) (*v1pb.Policy_SessionGuardPolicy, error) {
+	p := &storepb.SessionGuardPolicy{}
+	if err := protojson.Unmarshal([]byte(payloadStr), p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal session guard policy")
+	}
+	return &v1pb.Policy_SessionGuardPolicy{
+		SessionGuardPolicy: &v1pb.SessionGuardPolicy{
+			MaximumExecutionTime: p.MaximumExecutionTime,
+			MaximumResultSize:    p.MaximumResultSize,
+			MaximumEstimatedCost: p.MaximumEstimatedCost,
+			MaximumEstimatedRows: p.MaximumEstimatedRows,
+		},
+	}, nil
+}
+
+func convertToStorePBSessionGuardPolicy(policy *v1pb.SessionGuardPolicy) (*storepb.SessionGuardPolicy, error) {
+	if policy == nil {
+		return nil, errors.New("session guard policy is required")
+	}
+	if policy.MaximumExecutionTime != nil {
+		if err := policy.MaximumExecutionTime.CheckValid(); err != nil {
+			return nil, errors.Wrapf(err, "invalid maximum execution time")
+		}
+		if policy.MaximumExecutionTime.AsDuration() < 0 {
+			return nil, errors.Errorf("maximum execution time cannot be negative")
+		}
+	}
+	if policy.MaximumResultSize < 0 {
+		return nil, errors.Errorf("maximum result size cannot be negative")
+	}
+	if policy.MaximumEstimatedCost < 0 {
+		return nil, errors.Errorf("maximum estimated cost cannot be negative")
+	}
+	if policy.MaximumEstimatedRows < 0 {
+		return nil, errors.Errorf("maximum estimated rows cannot be negative")
+	}
+	return &storepb.SessionGuardPolicy{
+		MaximumExecutionTime: policy.MaximumExecutionTime,
+		MaximumResultSize:    policy.MaximumResultSize,
+		MaximumEstimatedCost: policy.MaximumEstimatedCost,
+		MaximumEstimatedRows: policy.MaximumEstimatedRows,
+	}, nil
+}
+
+func convertToV1PBLockImpactPolicy(payloadStr string) (*v1pb.Policy_LockImpactPolicy, error) {
+	p := &storepb.LockImpactPolicy{}
+	if err := protojson.Unmarshal([]byte(payloadStr), p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal lock impact policy")
+	}
+	return &v1pb.Policy_LockImpactPolicy{
+		LockImpactPolicy: &v1pb.LockImpactPolicy{
+			WarningThreshold:  p.WarningThreshold,
+			ErrorThreshold:    p.ErrorThreshold,
+			ScanThroughput:    p.ScanThroughput,
+			RewriteThroughput: p.RewriteThroughput,
+		},
+	}, nil
+}
+
+func convertToStorePBLockImpactPolicy(policy *v1pb.LockImpactPolicy) (*storepb.LockImpactPolicy, error) {
+	if policy == nil {
+		return nil, errors.New("lock impact policy is required")
+	}
+	if err := validateLockImpactThreshold("warning threshold", policy.WarningThreshold); err != nil {
+		return nil, err
+	}
+	if err := validateLockImpactThreshold("error threshold", policy.ErrorThreshold); err != nil {
+		return nil, err
+	}
+	if policy.ScanThroughput < 0 {
+		return nil, errors.Errorf("scan throughput cannot be negative")
+	}
+	if policy.RewriteThroughput < 0 {
+		return nil, errors.Errorf("rewrite throughput cannot be negative")
+	}
+	return &storepb.LockImpactPolicy{
+		WarningThreshold:  policy.WarningThreshold,
+		ErrorThreshold:    policy.ErrorThreshold,
+		ScanThroughput:    policy.ScanThroughput,
+		RewriteThroughput: policy.RewriteThroughput,
+	}, nil
+}
+
+func validateLockImpactThreshold(name string, threshold *durationpb.Duration) error {
+	if threshold == nil {
+		return nil
+	}
+	if err := threshold.CheckValid(); err != nil {
+		return errors.Wrapf(err, "invalid %s", name)
+	}
+	if threshold.AsDuration() < 0 {
+		return errors.Errorf("%s cannot be negative", name)
+	}
+	return nil
+}
+
+func convertToV1PBDMLDryRunPolicy(payloadStr string) (*v1pb.Policy_DmlDryRunPolicy, error) {
+	p := &storepb.DMLDryRunPolicy{}
+	if err := protojson.Unmarshal([]byte(payloadStr), p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal DML dry run policy")
+	}
+	return &v1pb.Policy_DmlDryRunPolicy{
+		DmlDryRunPolicy: &v1pb.DMLDryRunPolicy{
+			StatementTimeout: p.StatementTimeout,
+		},
+	}, nil
+}
+
+func convertToStorePBDMLDryRunPolicy(policy *v1pb.DMLDryRunPolicy) (*storepb.DMLDryRunPolicy, error) {
+	if policy == nil {
+		return nil, errors.New("DML dry run policy is required")
+	}
+	if policy.StatementTimeout != nil {
+		if err := policy.StatementTimeout.CheckValid(); err != nil {
+			return nil, errors.Wrapf(err, "invalid statement timeout")
+		}
+		if policy.StatementTimeout.AsDuration() < 0 {
+			return nil, errors.Errorf("statement timeout cannot be negative")
+		}
+	}
+	return &storepb.DMLDryRunPolicy{
+		StatementTimeout: policy.StatementTimeout,
+	}, nil
+}
+
 func convertToStorePBMskingRulePolicy(policy *v1pb.MaskingRulePolicy) (*storepb.MaskingRulePolicy, error) {
 	var rules []*storepb.MaskingRulePolicy_MaskingRule
 	for _, rule := range policy.Rules {
@@ -1211,6 +1396,12 @@ func convertPolicyType(pType string) (api.PolicyType, error) {
 		return api.PolicyTypeDisableCopyData, nil
 	case v1pb.PolicyType_RESTRICT_ISSUE_CREATION_FOR_SQL_REVIEW.String():
 		return api.PolicyTypeRestrictIssueCreationForSQLReview, nil
+	case v1pb.PolicyType_SESSION_GUARD.String():
+		return api.PolicyTypeSessionGuard, nil
+	case v1pb.PolicyType_LOCK_IMPACT.String():
+		return api.PolicyTypeLockImpact, nil
+	case v1pb.PolicyType_DML_DRY_RUN.String():
+		return api.PolicyTypeDMLDryRun, nil
 	}
 	return policyType, errors.Errorf("invalid policy type %v", pType)
 }
@@ -1253,6 +1444,10 @@ func validateSQLReviewPolicy(policy *v1pb.SQLReviewPolicy) error {
 			if _, err := advisor.UnmarshalNamingCaseRulePayload(rule.Payload); err != nil {
 				return err
 			}
+		case advisor.SchemaRuleCustom:
+			if _, err := advisor.UnmarshalCustomRulePayload(rule.Payload); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
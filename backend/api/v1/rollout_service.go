@@ -22,6 +22,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/ghost"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/pgonline"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
@@ -661,6 +662,9 @@ func (s *RolloutService) BatchSkipTasks(ctx context.Context, request *v1pb.Batch
 
 	for _, task := range tasksToSkip {
 		s.stateCfg.TaskSkippedOrDoneChan <- task.ID
+		if task.Type == api.TaskDatabaseSchemaUpdateOnlineCutover {
+			s.stateCfg.OnlineMigrationCleanupChan <- task.ID
+		}
 	}
 
 	if err := s.activityManager.BatchCreateActivitiesForSkipTasks(ctx, tasksToSkip, issue, request.Reason, user.ID); err != nil {
@@ -893,20 +897,32 @@ func (s *RolloutService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePla
 				continue
 			}
 
-			// Flags for gh-ost.
+			// Flags for gh-ost and PostgreSQL online schema migration.
 			if err := func() error {
-				if task.Type != api.TaskDatabaseSchemaUpdateGhostSync {
-					return nil
-				}
-				payload := &api.TaskDatabaseSchemaUpdateGhostSyncPayload{}
-				if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
-					return status.Errorf(codes.Internal, "failed to unmarshal task payload: %v", err)
-				}
 				newFlags := spec.GetChangeDatabaseConfig().GetGhostFlags()
-				if _, err := ghost.GetUserFlags(newFlags); err != nil {
-					return status.Errorf(codes.InvalidArgument, "invalid ghost flags %q, error %v", newFlags, err)
+				var oldFlags map[string]string
+				switch task.Type {
+				case api.TaskDatabaseSchemaUpdateGhostSync:
+					payload := &api.TaskDatabaseSchemaUpdateGhostSyncPayload{}
+					if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+						return status.Errorf(codes.Internal, "failed to unmarshal task payload: %v", err)
+					}
+					if _, err := ghost.GetUserFlags(newFlags); err != nil {
+						return status.Errorf(codes.InvalidArgument, "invalid ghost flags %q, error %v", newFlags, err)
+					}
+					oldFlags = payload.Flags
+				case api.TaskDatabaseSchemaUpdateOnlineSync:
+					payload := &api.TaskDatabaseSchemaUpdateOnlineSyncPayload{}
+					if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+						return status.Errorf(codes.Internal, "failed to unmarshal task payload: %v", err)
+					}
+					if _, err := pgonline.GetConfig(newFlags); err != nil {
+						return status.Errorf(codes.InvalidArgument, "invalid online schema migration flags %q, error %v", newFlags, err)
+					}
+					oldFlags = payload.Flags
+				default:
+					return nil
 				}
-				oldFlags := payload.Flags
 				if cmp.Equal(oldFlags, newFlags) {
 					return nil
 				}
@@ -956,7 +972,7 @@ func (s *RolloutService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePla
 			// Sheet
 			if err := func() error {
 				switch task.Type {
-				case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseSchemaUpdateSDL, api.TaskDatabaseSchemaUpdateGhostSync, api.TaskDatabaseDataUpdate:
+				case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseSchemaUpdateSDL, api.TaskDatabaseSchemaUpdateGhostSync, api.TaskDatabaseSchemaUpdateOnlineSync, api.TaskDatabaseDataUpdate:
 					var taskPayload struct {
 						SpecID  string `json:"specId"`
 						SheetID int    `json:"sheetId"`
@@ -376,6 +376,10 @@ func convertToPlanCheckRunType(t store.PlanCheckRunType) v1pb.PlanCheckRun_Type
 		return v1pb.PlanCheckRun_DATABASE_STATEMENT_TYPE
 	case store.PlanCheckDatabaseStatementSummaryReport:
 		return v1pb.PlanCheckRun_DATABASE_STATEMENT_SUMMARY_REPORT
+	case store.PlanCheckDatabaseStatementLockImpact:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_LOCK_IMPACT
+	case store.PlanCheckDatabaseStatementDMLDryRun:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_DML_DRY_RUN
 	case store.PlanCheckDatabaseConnect:
 		return v1pb.PlanCheckRun_DATABASE_CONNECT
 	case store.PlanCheckDatabaseGhostSync:
@@ -435,6 +439,13 @@ func convertToPlanCheckRunResult(result *storepb.PlanCheckRunResult_Result) *v1p
 				Code:   report.SqlReviewReport.Code,
 			},
 		}
+	case *storepb.PlanCheckRunResult_Result_DmlDryRunReport:
+		resultV1.Report = &v1pb.PlanCheckRun_Result_DmlDryRunReport{
+			DmlDryRunReport: &v1pb.PlanCheckRun_Result_DMLDryRunReport{
+				Line:         report.DmlDryRunReport.Line,
+				AffectedRows: report.DmlDryRunReport.AffectedRows,
+			},
+		}
 	}
 	return resultV1
 }
@@ -576,9 +587,9 @@ func convertToTask(ctx context.Context, s *store.Store, project *store.ProjectMe
 		return convertToTaskFromDatabaseCreate(ctx, s, project, task)
 	case api.TaskDatabaseSchemaBaseline:
 		return convertToTaskFromSchemaBaseline(ctx, s, project, task)
-	case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseSchemaUpdateSDL, api.TaskDatabaseSchemaUpdateGhostSync:
+	case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseSchemaUpdateSDL, api.TaskDatabaseSchemaUpdateGhostSync, api.TaskDatabaseSchemaUpdateOnlineSync:
 		return convertToTaskFromSchemaUpdate(ctx, s, project, task)
-	case api.TaskDatabaseSchemaUpdateGhostCutover:
+	case api.TaskDatabaseSchemaUpdateGhostCutover, api.TaskDatabaseSchemaUpdateOnlineCutover:
 		return convertToTaskFromSchemaUpdateGhostCutover(ctx, s, project, task)
 	case api.TaskDatabaseDataUpdate:
 		return convertToTaskFromDataUpdate(ctx, s, project, task)
@@ -1031,6 +1042,10 @@ func convertToTaskType(taskType api.TaskType) v1pb.Task_Type {
 		return v1pb.Task_DATABASE_SCHEMA_UPDATE_GHOST_SYNC
 	case api.TaskDatabaseSchemaUpdateGhostCutover:
 		return v1pb.Task_DATABASE_SCHEMA_UPDATE_GHOST_CUTOVER
+	case api.TaskDatabaseSchemaUpdateOnlineSync:
+		return v1pb.Task_DATABASE_SCHEMA_UPDATE_ONLINE_SYNC
+	case api.TaskDatabaseSchemaUpdateOnlineCutover:
+		return v1pb.Task_DATABASE_SCHEMA_UPDATE_ONLINE_CUTOVER
 	case api.TaskDatabaseDataUpdate:
 		return v1pb.Task_DATABASE_DATA_UPDATE
 	case api.TaskDatabaseBackup:
@@ -354,6 +354,46 @@ func getPlanCheckRunsFromChangeDatabaseConfigForDatabase(ctx context.Context, s
 			DatabaseGroupUid:   databaseGroupUID,
 		},
 	})
+	// The SDL statement is the desired schema rather than the statements to run, so the lock impact cannot be analyzed.
+	if databaseGroupUID == nil && instance.Engine == storepb.Engine_POSTGRES && config.Type != storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_SDL {
+		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
+			CreatorUID: api.SystemBotID,
+			UpdaterUID: api.SystemBotID,
+			PlanUID:    plan.UID,
+			Status:     store.PlanCheckRunStatusRunning,
+			Type:       store.PlanCheckDatabaseStatementLockImpact,
+			Config: &storepb.PlanCheckRunConfig{
+				SheetUid:           int32(sheetUID),
+				ChangeDatabaseType: convertToChangeDatabaseType(config.Type),
+				InstanceUid:        int32(instance.UID),
+				DatabaseName:       database.DatabaseName,
+				DatabaseGroupUid:   databaseGroupUID,
+			},
+		})
+	}
+	// The DML dry run executes the statements against the database, so it's only created if the environment policy allows it.
+	if databaseGroupUID == nil && config.Type == storepb.PlanConfig_ChangeDatabaseConfig_DATA && (instance.Engine == storepb.Engine_MYSQL || instance.Engine == storepb.Engine_POSTGRES) {
+		enabled, err := isDMLDryRunEnabled(ctx, s, database)
+		if err != nil {
+			return nil, err
+		}
+		if enabled {
+			planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
+				CreatorUID: api.SystemBotID,
+				UpdaterUID: api.SystemBotID,
+				PlanUID:    plan.UID,
+				Status:     store.PlanCheckRunStatusRunning,
+				Type:       store.PlanCheckDatabaseStatementDMLDryRun,
+				Config: &storepb.PlanCheckRunConfig{
+					SheetUid:           int32(sheetUID),
+					ChangeDatabaseType: convertToChangeDatabaseType(config.Type),
+					InstanceUid:        int32(instance.UID),
+					DatabaseName:       database.DatabaseName,
+					DatabaseGroupUid:   databaseGroupUID,
+				},
+			})
+		}
+	}
 	if databaseGroupUID == nil && config.Type == storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_GHOST {
 		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
 			CreatorUID: api.SystemBotID,
@@ -375,6 +415,22 @@ func getPlanCheckRunsFromChangeDatabaseConfigForDatabase(ctx context.Context, s
 	return planCheckRuns, nil
 }
 
+// isDMLDryRunEnabled returns true if the DML dry run policy of the database environment is enforced.
+func isDMLDryRunEnabled(ctx context.Context, s *store.Store, database *store.DatabaseMessage) (bool, error) {
+	environment, err := s.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &database.EffectiveEnvironmentID})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get environment %q", database.EffectiveEnvironmentID)
+	}
+	if environment == nil {
+		return false, errors.Errorf("environment %q not found", database.EffectiveEnvironmentID)
+	}
+	policy, err := s.GetDMLDryRunPolicy(ctx, environment.UID)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get DML dry run policy for environment %q", environment.ResourceID)
+	}
+	return policy != nil, nil
+}
+
 func convertToChangeDatabaseType(t storepb.PlanConfig_ChangeDatabaseConfig_Type) storepb.PlanCheckRunConfig_ChangeDatabaseType {
 	switch t {
 	case
@@ -14,6 +14,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/ghost"
+	"github.com/bytebase/bytebase/backend/component/pgonline"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -388,6 +389,9 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "failed to get sheet id from sheet %q", c.Sheet)
 		}
+		if instance.Engine == storepb.Engine_POSTGRES {
+			return getTaskCreatesFromOnlineMigration(spec, c, instance, database, sheetUID)
+		}
 		if _, err := ghost.GetUserFlags(c.GhostFlags); err != nil {
 			return nil, nil, errors.Wrapf(err, "invalid ghost flags %q, error: %v", c.GhostFlags, err)
 		}
@@ -915,7 +919,15 @@ func checkCharacterSetCollationOwner(dbType storepb.Engine, characterSet, collat
 		if collation != "" {
 			return errors.Errorf("RisingWave does not support collation, but got %s", collation)
 		}
-	case storepb.Engine_SQLITE, storepb.Engine_MONGODB, storepb.Engine_MSSQL:
+	case storepb.Engine_COCKROACHDB:
+		// CockroachDB only supports the UTF8 encoding, and the collation is specified at the column level.
+		if characterSet != "" && !strings.EqualFold(characterSet, "UTF8") {
+			return errors.Errorf("CockroachDB only supports UTF8 character set, but got %s", characterSet)
+		}
+		if collation != "" {
+			return errors.Errorf("CockroachDB does not support collation, but got %s", collation)
+		}
+	case storepb.Engine_SQLITE, storepb.Engine_DUCKDB, storepb.Engine_MONGODB, storepb.Engine_MSSQL:
 		// no-op.
 	default:
 		if characterSet == "" {
@@ -970,6 +982,9 @@ func getCreateDatabaseStatement(dbType storepb.Engine, c *storepb.PlanConfig_Cre
 	case storepb.Engine_SQLITE:
 		// This is a fake CREATE DATABASE and USE statement since a single SQLite file represents a database. Engine driver will recognize it and establish a connection to create the sqlite file representing the database.
 		return fmt.Sprintf("CREATE DATABASE '%s';", databaseName), nil
+	case storepb.Engine_DUCKDB:
+		// Same as SQLite, a DuckDB file represents a database and the driver creates the file for the fake CREATE DATABASE statement.
+		return fmt.Sprintf("CREATE DATABASE '%s';", databaseName), nil
 	case storepb.Engine_MONGODB:
 		// We just run createCollection in mongosh instead of execute `use <database>` first, because we execute the
 		// mongodb statement in mongosh with --file flag, and it doesn't support `use <database>` statement in the file.
@@ -993,10 +1008,63 @@ func getCreateDatabaseStatement(dbType storepb.Engine, c *storepb.PlanConfig_Cre
 			stmt = fmt.Sprintf("%s WITH\n\t%s", stmt, strings.Join(list, "\n\t"))
 		}
 		return fmt.Sprintf("%s;", stmt), nil
+	case storepb.Engine_COCKROACHDB:
+		stmt = fmt.Sprintf("CREATE DATABASE \"%s\";", databaseName)
+		if c.Owner != "" {
+			stmt = fmt.Sprintf("%s\nALTER DATABASE \"%s\" OWNER TO \"%s\";", stmt, databaseName, c.Owner)
+		}
+		return stmt, nil
 	}
 	return "", errors.Errorf("unsupported database type %s", dbType)
 }
 
+// getTaskCreatesFromOnlineMigration returns the sync and cutover tasks of the PostgreSQL online schema migration.
+func getTaskCreatesFromOnlineMigration(spec *storepb.PlanConfig_Spec, c *storepb.PlanConfig_ChangeDatabaseConfig, instance *store.InstanceMessage, database *store.DatabaseMessage, sheetUID int) ([]*store.TaskMessage, []store.TaskIndexDAG, error) {
+	if _, err := pgonline.GetConfig(c.GhostFlags); err != nil {
+		return nil, nil, errors.Wrapf(err, "invalid online schema migration flags %q, error: %v", c.GhostFlags, err)
+	}
+	payloadSync := api.TaskDatabaseSchemaUpdateOnlineSyncPayload{
+		SpecID:        spec.Id,
+		SheetID:       sheetUID,
+		SchemaVersion: getOrDefaultSchemaVersion(c.SchemaVersion),
+		Flags:         c.GhostFlags,
+	}
+	bytesSync, err := json.Marshal(payloadSync)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to marshal database schema update online sync payload")
+	}
+	payloadCutover := api.TaskDatabaseSchemaUpdateOnlineCutoverPayload{
+		SpecID: spec.Id,
+	}
+	bytesCutover, err := json.Marshal(payloadCutover)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to marshal database schema update online cutover payload")
+	}
+	taskCreateList := []*store.TaskMessage{
+		{
+			Name:              fmt.Sprintf("Update schema online sync for database %q", database.DatabaseName),
+			InstanceID:        instance.UID,
+			DatabaseID:        &database.UID,
+			Type:              api.TaskDatabaseSchemaUpdateOnlineSync,
+			EarliestAllowedTs: spec.EarliestAllowedTime.GetSeconds(),
+			Payload:           string(bytesSync),
+		},
+		{
+			Name:              fmt.Sprintf("Update schema online cutover for database %q", database.DatabaseName),
+			InstanceID:        instance.UID,
+			DatabaseID:        &database.UID,
+			Type:              api.TaskDatabaseSchemaUpdateOnlineCutover,
+			EarliestAllowedTs: spec.EarliestAllowedTime.GetSeconds(),
+			Payload:           string(bytesCutover),
+		},
+	}
+	// Task "sync" blocks task "cutover".
+	taskIndexDAGList := []store.TaskIndexDAG{
+		{FromIndex: 0, ToIndex: 1},
+	}
+	return taskCreateList, taskIndexDAGList, nil
+}
+
 func getOrDefaultSchemaVersion(v string) string {
 	if v != "" {
 		return v
@@ -0,0 +1,518 @@
+package v1
+
+import (
+	"slices"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// mergeObjectKey identifies a database object in the merge.
+type mergeObjectKey struct {
+	objectType v1pb.MergeConflict_ObjectType
+	schema     string
+	table      string
+	name       string
+}
+
+// mergeSchemaMetadata merges the changes of head and theirs from the common ancestor.
+// It returns the conflicts without the merged metadata if any object is changed differently on both sides.
+func mergeSchemaMetadata(ancestor, head, theirs *storepb.DatabaseSchemaMetadata) (*storepb.DatabaseSchemaMetadata, []*v1pb.MergeConflict, error) {
+	conflicts, err := findMergeConflicts(ancestor, head, theirs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(conflicts) > 0 {
+		return nil, conflicts, nil
+	}
+	merged, err := tryMerge(ancestor, head, theirs)
+	if err != nil {
+		return nil, nil, err
+	}
+	mergeViewsAndFunctions(ancestor, head, theirs, merged)
+	return merged, nil, nil
+}
+
+// findMergeConflicts finds the objects changed differently by head and theirs from the common ancestor.
+// A conflict on the schema or table hides the conflicts on the objects inside it.
+func findMergeConflicts(ancestor, head, theirs *storepb.DatabaseSchemaMetadata) ([]*v1pb.MergeConflict, error) {
+	if ancestor == nil {
+		ancestor = &storepb.DatabaseSchemaMetadata{}
+	}
+	// The tryMerge of the diff nodes modifies the metadata, so we clone them here.
+	headDiff, err := diffMetadata(proto.Clone(ancestor).(*storepb.DatabaseSchemaMetadata), proto.Clone(head).(*storepb.DatabaseSchemaMetadata))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to diff between ancestor and head")
+	}
+	theirsDiff, err := diffMetadata(proto.Clone(ancestor).(*storepb.DatabaseSchemaMetadata), proto.Clone(theirs).(*storepb.DatabaseSchemaMetadata))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to diff between ancestor and theirs")
+	}
+
+	var keys []mergeObjectKey
+	conflictSchemas := make(map[string]bool)
+	for _, schemaName := range sortedKeys(headDiff.schemas) {
+		headSchema := headDiff.schemas[schemaName]
+		theirsSchema, ok := theirsDiff.schemas[schemaName]
+		if !ok {
+			continue
+		}
+		if conflict, _ := headSchema.tryMergeAction(theirsSchema); conflict {
+			keys = append(keys, mergeObjectKey{objectType: v1pb.MergeConflict_SCHEMA, schema: schemaName})
+			conflictSchemas[schemaName] = true
+			continue
+		}
+		if headSchema.action == diffActionDrop {
+			continue
+		}
+		for _, tableName := range sortedKeys(headSchema.tables) {
+			headTable := headSchema.tables[tableName]
+			theirsTable, ok := theirsSchema.tables[tableName]
+			if !ok {
+				continue
+			}
+			if conflict, _ := headTable.tryMergeAttributes(theirsTable); conflict {
+				keys = append(keys, mergeObjectKey{objectType: v1pb.MergeConflict_TABLE, schema: schemaName, table: tableName})
+				continue
+			}
+			if headTable.action == diffActionDrop {
+				continue
+			}
+			for _, columnName := range sortedKeys(headTable.columnsMap) {
+				if theirsColumn, ok := theirsTable.columnsMap[columnName]; ok {
+					if conflict, _ := headTable.columnsMap[columnName].tryMerge(theirsColumn); conflict {
+						keys = append(keys, mergeObjectKey{objectType: v1pb.MergeConflict_COLUMN, schema: schemaName, table: tableName, name: columnName})
+					}
+				}
+			}
+			for _, indexName := range sortedKeys(headTable.indexes) {
+				if theirsIndex, ok := theirsTable.indexes[indexName]; ok {
+					if conflict, _ := headTable.indexes[indexName].tryMerge(theirsIndex); conflict {
+						keys = append(keys, mergeObjectKey{objectType: v1pb.MergeConflict_INDEX, schema: schemaName, table: tableName, name: indexName})
+					}
+				}
+			}
+			for _, foreignKeyName := range sortedKeys(headTable.foreignKeys) {
+				if theirsForeignKey, ok := theirsTable.foreignKeys[foreignKeyName]; ok {
+					if conflict, _ := headTable.foreignKeys[foreignKeyName].tryMerge(theirsForeignKey); conflict {
+						keys = append(keys, mergeObjectKey{objectType: v1pb.MergeConflict_FOREIGN_KEY, schema: schemaName, table: tableName, name: foreignKeyName})
+					}
+				}
+			}
+		}
+	}
+
+	// The diff nodes do not contain views and functions, so we compare them by names.
+	for _, schemaName := range getSchemaNames(ancestor, head, theirs) {
+		if conflictSchemas[schemaName] {
+			continue
+		}
+		for _, objectType := range []v1pb.MergeConflict_ObjectType{v1pb.MergeConflict_VIEW, v1pb.MergeConflict_FUNCTION} {
+			for _, name := range getSchemaObjectNames(objectType, schemaName, theirs, head, ancestor) {
+				key := mergeObjectKey{objectType: objectType, schema: schemaName, name: name}
+				ancestorObject, headObject, theirsObject := getMergeObject(ancestor, key), getMergeObject(head, key), getMergeObject(theirs, key)
+				if !mergeObjectEqual(ancestorObject, headObject) && !mergeObjectEqual(ancestorObject, theirsObject) && !mergeObjectEqual(headObject, theirsObject) {
+					keys = append(keys, key)
+				}
+			}
+		}
+	}
+
+	var conflicts []*v1pb.MergeConflict
+	for _, key := range keys {
+		conflict := &v1pb.MergeConflict{
+			ObjectType: key.objectType,
+			Schema:     key.schema,
+			Table:      key.table,
+			Name:       key.name,
+		}
+		if conflict.BaseDefinition, err = marshalMergeObject(key, getMergeObject(ancestor, key)); err != nil {
+			return nil, err
+		}
+		if conflict.HeadDefinition, err = marshalMergeObject(key, getMergeObject(head, key)); err != nil {
+			return nil, err
+		}
+		if conflict.TheirsDefinition, err = marshalMergeObject(key, getMergeObject(theirs, key)); err != nil {
+			return nil, err
+		}
+		conflicts = append(conflicts, conflict)
+	}
+	return conflicts, nil
+}
+
+// applyMergeConflictResolutions sets the resolved objects to both head and theirs, so that they are no longer conflicting.
+func applyMergeConflictResolutions(head, theirs *storepb.DatabaseSchemaMetadata, resolutions []*v1pb.MergeConflictResolution) (*storepb.DatabaseSchemaMetadata, *storepb.DatabaseSchemaMetadata, error) {
+	if len(resolutions) == 0 {
+		return head, theirs, nil
+	}
+	head, theirs = proto.Clone(head).(*storepb.DatabaseSchemaMetadata), proto.Clone(theirs).(*storepb.DatabaseSchemaMetadata)
+	if head == nil {
+		head = &storepb.DatabaseSchemaMetadata{}
+	}
+	if theirs == nil {
+		theirs = &storepb.DatabaseSchemaMetadata{}
+	}
+	for _, resolution := range resolutions {
+		key := mergeObjectKey{
+			objectType: resolution.ObjectType,
+			schema:     resolution.Schema,
+			table:      resolution.Table,
+			name:       resolution.Name,
+		}
+		var object proto.Message
+		switch resolution.Strategy {
+		case v1pb.MergeConflictResolution_OURS:
+			object = getMergeObject(head, key)
+		case v1pb.MergeConflictResolution_THEIRS:
+			object = getMergeObject(theirs, key)
+		case v1pb.MergeConflictResolution_CUSTOM:
+			if resolution.CustomDefinition != "" {
+				o, err := unmarshalMergeObject(key, resolution.CustomDefinition)
+				if err != nil {
+					return nil, nil, errors.Wrapf(err, "invalid custom definition of %s", formatMergeObjectKey(key))
+				}
+				object = o
+			}
+		default:
+			return nil, nil, errors.Errorf("unsupported resolution strategy %s of %s", resolution.Strategy, formatMergeObjectKey(key))
+		}
+		if object != nil {
+			object = proto.Clone(object)
+		}
+		if err := setMergeObject(head, key, object); err != nil {
+			return nil, nil, err
+		}
+		var theirsObject proto.Message
+		if object != nil {
+			theirsObject = proto.Clone(object)
+		}
+		if err := setMergeObject(theirs, key, theirsObject); err != nil {
+			return nil, nil, err
+		}
+	}
+	return head, theirs, nil
+}
+
+// mergeViewsAndFunctions three-way merges the views and functions into the merged metadata,
+// the tryMerge only takes care of the tables.
+func mergeViewsAndFunctions(ancestor, head, theirs, merged *storepb.DatabaseSchemaMetadata) {
+	for _, schema := range merged.GetSchemas() {
+		var views []*storepb.ViewMetadata
+		for _, name := range getSchemaObjectNames(v1pb.MergeConflict_VIEW, schema.Name, theirs, head, ancestor) {
+			key := mergeObjectKey{objectType: v1pb.MergeConflict_VIEW, schema: schema.Name, name: name}
+			if object := mergeObject(getMergeObject(ancestor, key), getMergeObject(head, key), getMergeObject(theirs, key)); object != nil {
+				views = append(views, proto.Clone(object).(*storepb.ViewMetadata))
+			}
+		}
+		schema.Views = views
+
+		var functions []*storepb.FunctionMetadata
+		for _, name := range getSchemaObjectNames(v1pb.MergeConflict_FUNCTION, schema.Name, theirs, head, ancestor) {
+			key := mergeObjectKey{objectType: v1pb.MergeConflict_FUNCTION, schema: schema.Name, name: name}
+			if object := mergeObject(getMergeObject(ancestor, key), getMergeObject(head, key), getMergeObject(theirs, key)); object != nil {
+				functions = append(functions, proto.Clone(object).(*storepb.FunctionMetadata))
+			}
+		}
+		schema.Functions = functions
+	}
+}
+
+// mergeObject takes the head if head changes the object, otherwise takes theirs.
+func mergeObject(ancestor, head, theirs proto.Message) proto.Message {
+	if !mergeObjectEqual(ancestor, head) {
+		return head
+	}
+	return theirs
+}
+
+func mergeObjectEqual(a, b proto.Message) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return proto.Equal(a, b)
+}
+
+// getMergeObject returns the object in the metadata, or nil if not found.
+func getMergeObject(metadata *storepb.DatabaseSchemaMetadata, key mergeObjectKey) proto.Message {
+	var schema *storepb.SchemaMetadata
+	for _, s := range metadata.GetSchemas() {
+		if s.Name == key.schema {
+			schema = s
+			break
+		}
+	}
+	if schema == nil {
+		return nil
+	}
+	switch key.objectType {
+	case v1pb.MergeConflict_SCHEMA:
+		return schema
+	case v1pb.MergeConflict_VIEW:
+		for _, view := range schema.Views {
+			if view.Name == key.name {
+				return view
+			}
+		}
+		return nil
+	case v1pb.MergeConflict_FUNCTION:
+		for _, function := range schema.Functions {
+			if function.Name == key.name {
+				return function
+			}
+		}
+		return nil
+	}
+
+	var table *storepb.TableMetadata
+	for _, t := range schema.Tables {
+		if t.Name == key.table {
+			table = t
+			break
+		}
+	}
+	if table == nil {
+		return nil
+	}
+	switch key.objectType {
+	case v1pb.MergeConflict_TABLE:
+		return table
+	case v1pb.MergeConflict_COLUMN:
+		for _, column := range table.Columns {
+			if column.Name == key.name {
+				return column
+			}
+		}
+	case v1pb.MergeConflict_INDEX:
+		for _, index := range table.Indexes {
+			if index.Name == key.name {
+				return index
+			}
+		}
+	case v1pb.MergeConflict_FOREIGN_KEY:
+		for _, foreignKey := range table.ForeignKeys {
+			if foreignKey.Name == key.name {
+				return foreignKey
+			}
+		}
+	}
+	return nil
+}
+
+// setMergeObject replaces the object in the metadata, appends it if not found, or removes it if the object is nil.
+func setMergeObject(metadata *storepb.DatabaseSchemaMetadata, key mergeObjectKey, object proto.Message) error {
+	schemaIndex := slices.IndexFunc(metadata.Schemas, func(s *storepb.SchemaMetadata) bool { return s.Name == key.schema })
+	if key.objectType == v1pb.MergeConflict_SCHEMA {
+		metadata.Schemas = setOrRemove(metadata.Schemas, schemaIndex, object)
+		return nil
+	}
+	if schemaIndex < 0 {
+		if object == nil {
+			return nil
+		}
+		return errors.Errorf("schema %q not found for %s", key.schema, formatMergeObjectKey(key))
+	}
+	schema := metadata.Schemas[schemaIndex]
+	switch key.objectType {
+	case v1pb.MergeConflict_TABLE:
+		schema.Tables = setOrRemove(schema.Tables, slices.IndexFunc(schema.Tables, func(t *storepb.TableMetadata) bool { return t.Name == key.table }), object)
+		return nil
+	case v1pb.MergeConflict_VIEW:
+		schema.Views = setOrRemove(schema.Views, slices.IndexFunc(schema.Views, func(v *storepb.ViewMetadata) bool { return v.Name == key.name }), object)
+		return nil
+	case v1pb.MergeConflict_FUNCTION:
+		schema.Functions = setOrRemove(schema.Functions, slices.IndexFunc(schema.Functions, func(f *storepb.FunctionMetadata) bool { return f.Name == key.name }), object)
+		return nil
+	}
+
+	tableIndex := slices.IndexFunc(schema.Tables, func(t *storepb.TableMetadata) bool { return t.Name == key.table })
+	if tableIndex < 0 {
+		if object == nil {
+			return nil
+		}
+		return errors.Errorf("table %q not found for %s", key.table, formatMergeObjectKey(key))
+	}
+	table := schema.Tables[tableIndex]
+	switch key.objectType {
+	case v1pb.MergeConflict_COLUMN:
+		table.Columns = setOrRemove(table.Columns, slices.IndexFunc(table.Columns, func(c *storepb.ColumnMetadata) bool { return c.Name == key.name }), object)
+	case v1pb.MergeConflict_INDEX:
+		table.Indexes = setOrRemove(table.Indexes, slices.IndexFunc(table.Indexes, func(i *storepb.IndexMetadata) bool { return i.Name == key.name }), object)
+	case v1pb.MergeConflict_FOREIGN_KEY:
+		table.ForeignKeys = setOrRemove(table.ForeignKeys, slices.IndexFunc(table.ForeignKeys, func(f *storepb.ForeignKeyMetadata) bool { return f.Name == key.name }), object)
+	default:
+		return errors.Errorf("unsupported object type %s", key.objectType)
+	}
+	return nil
+}
+
+func setOrRemove[T proto.Message](list []T, index int, object proto.Message) []T {
+	if object == nil {
+		if index < 0 {
+			return list
+		}
+		return slices.Delete(list, index, index+1)
+	}
+	if index < 0 {
+		return append(list, object.(T))
+	}
+	list[index] = object.(T)
+	return list
+}
+
+// marshalMergeObject marshals the store object to the JSON of the corresponding v1 metadata, or empty if the object is nil.
+func marshalMergeObject(key mergeObjectKey, object proto.Message) (string, error) {
+	if object == nil {
+		return "", nil
+	}
+	var v1Object proto.Message
+	switch o := object.(type) {
+	case *storepb.SchemaMetadata:
+		v1Object = convertStoreDatabaseMetadata(&storepb.DatabaseSchemaMetadata{Schemas: []*storepb.SchemaMetadata{o}}, nil, v1pb.DatabaseMetadataView_DATABASE_METADATA_VIEW_FULL, nil).Schemas[0]
+	case *storepb.ViewMetadata:
+		v1Object = convertStoreDatabaseMetadata(&storepb.DatabaseSchemaMetadata{Schemas: []*storepb.SchemaMetadata{{Views: []*storepb.ViewMetadata{o}}}}, nil, v1pb.DatabaseMetadataView_DATABASE_METADATA_VIEW_FULL, nil).Schemas[0].Views[0]
+	case *storepb.FunctionMetadata:
+		v1Object = convertStoreDatabaseMetadata(&storepb.DatabaseSchemaMetadata{Schemas: []*storepb.SchemaMetadata{{Functions: []*storepb.FunctionMetadata{o}}}}, nil, v1pb.DatabaseMetadataView_DATABASE_METADATA_VIEW_FULL, nil).Schemas[0].Functions[0]
+	case *storepb.TableMetadata:
+		v1Object = convertStoreTableMetadata(o, v1pb.DatabaseMetadataView_DATABASE_METADATA_VIEW_FULL)
+	case *storepb.ColumnMetadata:
+		v1Object = convertStoreColumnMetadata(o)
+	case *storepb.IndexMetadata:
+		v1Object = convertStoreTableMetadata(&storepb.TableMetadata{Indexes: []*storepb.IndexMetadata{o}}, v1pb.DatabaseMetadataView_DATABASE_METADATA_VIEW_FULL).Indexes[0]
+	case *storepb.ForeignKeyMetadata:
+		v1Object = convertStoreTableMetadata(&storepb.TableMetadata{ForeignKeys: []*storepb.ForeignKeyMetadata{o}}, v1pb.DatabaseMetadataView_DATABASE_METADATA_VIEW_FULL).ForeignKeys[0]
+	default:
+		return "", errors.Errorf("unsupported object type %s", key.objectType)
+	}
+	bytes, err := protojson.Marshal(v1Object)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal %s", formatMergeObjectKey(key))
+	}
+	return string(bytes), nil
+}
+
+// unmarshalMergeObject unmarshals the JSON of the v1 metadata to the store object.
+// The name of the object is always taken from the key.
+func unmarshalMergeObject(key mergeObjectKey, definition string) (proto.Message, error) {
+	switch key.objectType {
+	case v1pb.MergeConflict_SCHEMA:
+		v1Schema := &v1pb.SchemaMetadata{}
+		if err := protojson.Unmarshal([]byte(definition), v1Schema); err != nil {
+			return nil, err
+		}
+		v1Schema.Name = key.schema
+		metadata, _ := convertV1DatabaseMetadata(&v1pb.DatabaseMetadata{Schemas: []*v1pb.SchemaMetadata{v1Schema}})
+		return metadata.Schemas[0], nil
+	case v1pb.MergeConflict_VIEW:
+		v1View := &v1pb.ViewMetadata{}
+		if err := protojson.Unmarshal([]byte(definition), v1View); err != nil {
+			return nil, err
+		}
+		v1View.Name = key.name
+		metadata, _ := convertV1DatabaseMetadata(&v1pb.DatabaseMetadata{Schemas: []*v1pb.SchemaMetadata{{Views: []*v1pb.ViewMetadata{v1View}}}})
+		return metadata.Schemas[0].Views[0], nil
+	case v1pb.MergeConflict_FUNCTION:
+		v1Function := &v1pb.FunctionMetadata{}
+		if err := protojson.Unmarshal([]byte(definition), v1Function); err != nil {
+			return nil, err
+		}
+		v1Function.Name = key.name
+		metadata, _ := convertV1DatabaseMetadata(&v1pb.DatabaseMetadata{Schemas: []*v1pb.SchemaMetadata{{Functions: []*v1pb.FunctionMetadata{v1Function}}}})
+		return metadata.Schemas[0].Functions[0], nil
+	case v1pb.MergeConflict_TABLE:
+		v1Table := &v1pb.TableMetadata{}
+		if err := protojson.Unmarshal([]byte(definition), v1Table); err != nil {
+			return nil, err
+		}
+		v1Table.Name = key.table
+		return convertV1TableMetadata(v1Table), nil
+	case v1pb.MergeConflict_COLUMN:
+		v1Column := &v1pb.ColumnMetadata{}
+		if err := protojson.Unmarshal([]byte(definition), v1Column); err != nil {
+			return nil, err
+		}
+		v1Column.Name = key.name
+		return convertV1ColumnMetadata(v1Column), nil
+	case v1pb.MergeConflict_INDEX:
+		v1Index := &v1pb.IndexMetadata{}
+		if err := protojson.Unmarshal([]byte(definition), v1Index); err != nil {
+			return nil, err
+		}
+		v1Index.Name = key.name
+		return convertV1TableMetadata(&v1pb.TableMetadata{Indexes: []*v1pb.IndexMetadata{v1Index}}).Indexes[0], nil
+	case v1pb.MergeConflict_FOREIGN_KEY:
+		v1ForeignKey := &v1pb.ForeignKeyMetadata{}
+		if err := protojson.Unmarshal([]byte(definition), v1ForeignKey); err != nil {
+			return nil, err
+		}
+		v1ForeignKey.Name = key.name
+		return convertV1TableMetadata(&v1pb.TableMetadata{ForeignKeys: []*v1pb.ForeignKeyMetadata{v1ForeignKey}}).ForeignKeys[0], nil
+	default:
+		return nil, errors.Errorf("unsupported object type %s", key.objectType)
+	}
+}
+
+func formatMergeObjectKey(key mergeObjectKey) string {
+	switch key.objectType {
+	case v1pb.MergeConflict_SCHEMA:
+		return "schema " + key.schema
+	case v1pb.MergeConflict_TABLE:
+		return "table " + key.schema + "." + key.table
+	case v1pb.MergeConflict_VIEW, v1pb.MergeConflict_FUNCTION:
+		return key.objectType.String() + " " + key.schema + "." + key.name
+	default:
+		return key.objectType.String() + " " + key.schema + "." + key.table + "." + key.name
+	}
+}
+
+func getSchemaNames(metadataList ...*storepb.DatabaseSchemaMetadata) []string {
+	var names []string
+	for _, metadata := range metadataList {
+		for _, schema := range metadata.GetSchemas() {
+			if !slices.Contains(names, schema.Name) {
+				names = append(names, schema.Name)
+			}
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// getSchemaObjectNames returns the distinct names of the views or functions of the schema in the order of appearance.
+func getSchemaObjectNames(objectType v1pb.MergeConflict_ObjectType, schemaName string, metadataList ...*storepb.DatabaseSchemaMetadata) []string {
+	var names []string
+	for _, metadata := range metadataList {
+		for _, schema := range metadata.GetSchemas() {
+			if schema.Name != schemaName {
+				continue
+			}
+			switch objectType {
+			case v1pb.MergeConflict_VIEW:
+				for _, view := range schema.Views {
+					if !slices.Contains(names, view.Name) {
+						names = append(names, view.Name)
+					}
+				}
+			case v1pb.MergeConflict_FUNCTION:
+				for _, function := range schema.Functions {
+					if !slices.Contains(names, function.Name) {
+						names = append(names, function.Name)
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func newConflictTestMetadata(columnType, viewDefinition string) *storepb.DatabaseSchemaMetadata {
+	return &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "t",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "int"},
+							{Name: "name", Type: columnType},
+						},
+					},
+				},
+				Views: []*storepb.ViewMetadata{
+					{Name: "v", Definition: viewDefinition},
+				},
+			},
+		},
+	}
+}
+
+func TestFindMergeConflicts(t *testing.T) {
+	a := require.New(t)
+	ancestor := newConflictTestMetadata("text", "SELECT 1")
+	head := newConflictTestMetadata("varchar(10)", "SELECT 2")
+	theirs := newConflictTestMetadata("varchar(20)", "SELECT 3")
+
+	conflicts, err := findMergeConflicts(ancestor, head, theirs)
+	a.NoError(err)
+	a.Len(conflicts, 2)
+	a.Equal(v1pb.MergeConflict_COLUMN, conflicts[0].ObjectType)
+	a.Equal("public", conflicts[0].Schema)
+	a.Equal("t", conflicts[0].Table)
+	a.Equal("name", conflicts[0].Name)
+	a.Contains(conflicts[0].BaseDefinition, `"text"`)
+	a.Contains(conflicts[0].HeadDefinition, `"varchar(10)"`)
+	a.Contains(conflicts[0].TheirsDefinition, `"varchar(20)"`)
+	a.Equal(v1pb.MergeConflict_VIEW, conflicts[1].ObjectType)
+	a.Equal("v", conflicts[1].Name)
+
+	// Both sides make the same change.
+	conflicts, err = findMergeConflicts(ancestor, head, head)
+	a.NoError(err)
+	a.Empty(conflicts)
+}
+
+func TestMergeWithResolutions(t *testing.T) {
+	tests := []struct {
+		description  string
+		resolutions  []*v1pb.MergeConflictResolution
+		wantType     string
+		wantView     string
+		wantConflict bool
+	}{
+		{
+			description:  "no resolution",
+			wantConflict: true,
+		},
+		{
+			description: "take ours and theirs",
+			resolutions: []*v1pb.MergeConflictResolution{
+				{ObjectType: v1pb.MergeConflict_COLUMN, Schema: "public", Table: "t", Name: "name", Strategy: v1pb.MergeConflictResolution_OURS},
+				{ObjectType: v1pb.MergeConflict_VIEW, Schema: "public", Name: "v", Strategy: v1pb.MergeConflictResolution_THEIRS},
+			},
+			wantType: "varchar(10)",
+			wantView: "SELECT 3",
+		},
+		{
+			description: "custom definition",
+			resolutions: []*v1pb.MergeConflictResolution{
+				{ObjectType: v1pb.MergeConflict_COLUMN, Schema: "public", Table: "t", Name: "name", Strategy: v1pb.MergeConflictResolution_CUSTOM, CustomDefinition: `{"type": "varchar(30)"}`},
+				{ObjectType: v1pb.MergeConflict_VIEW, Schema: "public", Name: "v", Strategy: v1pb.MergeConflictResolution_CUSTOM, CustomDefinition: `{"definition": "SELECT 4"}`},
+			},
+			wantType: "varchar(30)",
+			wantView: "SELECT 4",
+		},
+	}
+
+	for _, test := range tests {
+		a := require.New(t)
+		ancestor := newConflictTestMetadata("text", "SELECT 1")
+		head := newConflictTestMetadata("varchar(10)", "SELECT 2")
+		theirs := newConflictTestMetadata("varchar(20)", "SELECT 3")
+
+		resolvedHead, resolvedTheirs, err := applyMergeConflictResolutions(head, theirs, test.resolutions)
+		a.NoError(err, test.description)
+		merged, conflicts, err := mergeSchemaMetadata(ancestor, resolvedHead, resolvedTheirs)
+		a.NoError(err, test.description)
+		if test.wantConflict {
+			a.NotEmpty(conflicts, test.description)
+			a.Nil(merged, test.description)
+			continue
+		}
+		a.Empty(conflicts, test.description)
+		schema := merged.Schemas[0]
+		a.Equal(test.wantType, schema.Tables[0].Columns[1].Type, test.description)
+		a.Len(schema.Views, 1, test.description)
+		a.Equal(test.wantView, schema.Views[0].Definition, test.description)
+	}
+}
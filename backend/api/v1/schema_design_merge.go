@@ -106,7 +106,8 @@ type metadataDiffSchemaNode struct {
 	// SchemaMetadata contains other object types, likes function, view etc. But we do not support them yet.
 }
 
-func (n *metadataDiffSchemaNode) tryMerge(other *metadataDiffSchemaNode) (bool, string) {
+// tryMergeAction checks whether the action of other schema node conflicts with current schema node.
+func (n *metadataDiffSchemaNode) tryMergeAction(other *metadataDiffSchemaNode) (bool, string) {
 	if other == nil {
 		return true, "other node check conflict with schema node not be nil"
 	}
@@ -117,6 +118,13 @@ func (n *metadataDiffSchemaNode) tryMerge(other *metadataDiffSchemaNode) (bool,
 	if n.action != other.action {
 		return true, fmt.Sprintf("conflict schema action, one is %s, the other is %s", n.action, other.action)
 	}
+	return false, ""
+}
+
+func (n *metadataDiffSchemaNode) tryMerge(other *metadataDiffSchemaNode) (bool, string) {
+	if conflict, msg := n.tryMergeAction(other); conflict {
+		return true, msg
+	}
 
 	if n.action == diffActionDrop {
 		return false, ""
@@ -221,7 +229,9 @@ type metadataDiffTableNode struct {
 	// TableMetaData contains other object types, likes trigger, index etc. But we do not support them yet.
 }
 
-func (n *metadataDiffTableNode) tryMerge(other *metadataDiffTableNode) (bool, string) {
+// tryMergeAttributes merges the action and the attributes of other table node to current table node,
+// the columns, foreign keys and indexes are not merged.
+func (n *metadataDiffTableNode) tryMergeAttributes(other *metadataDiffTableNode) (bool, string) {
 	if other == nil {
 		return true, "other node check conflict with table node must not be nil"
 	}
@@ -233,10 +243,6 @@ func (n *metadataDiffTableNode) tryMerge(other *metadataDiffTableNode) (bool, st
 		return true, fmt.Sprintf("conflict table action, one is %s, the other is %s", n.action, other.action)
 	}
 
-	if n.action == diffActionDrop {
-		return false, ""
-	}
-
 	if n.action == diffActionCreate {
 		// If two actions are CREATE or UPDATE both, we need to check the table attributes is conflict.
 		// XXX: Expanding the table attributes check if we support more attributes.
@@ -287,6 +293,18 @@ func (n *metadataDiffTableNode) tryMerge(other *metadataDiffTableNode) (bool, st
 		}
 	}
 
+	return false, ""
+}
+
+func (n *metadataDiffTableNode) tryMerge(other *metadataDiffTableNode) (bool, string) {
+	if conflict, msg := n.tryMergeAttributes(other); conflict {
+		return true, msg
+	}
+
+	if n.action == diffActionDrop {
+		return false, ""
+	}
+
 	for _, columnName := range n.columnNames {
 		columnNode := n.columnsMap[columnName]
 		otherColumnNode, in := other.columnsMap[columnName]
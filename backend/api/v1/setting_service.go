@@ -17,6 +17,7 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/config"
@@ -228,6 +229,12 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 			if err := convertV1PbToStorePb(rule.Template.Flow, flow); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to unmarshal approval flow with error: %v", err)
 			}
+			// The approvers are resolved for each issue.
+			for _, step := range flow.Steps {
+				for _, node := range step.Nodes {
+					node.ResolvedApprovers = nil
+				}
+			}
 			payload.Rules = append(payload.Rules, &storepb.WorkspaceApprovalSetting_Rule{
 				Condition: rule.Condition,
 				Template: &storepb.ApprovalTemplate{
@@ -235,6 +242,7 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 					Title:       rule.Template.Title,
 					Description: rule.Template.Description,
 					CreatorId:   int32(creatorID),
+					Sla:         convertV1ApprovalSLA(rule.Template.Sla),
 				},
 			})
 		}
@@ -827,6 +835,58 @@ func validateApprovalTemplate(template *v1pb.ApprovalTemplate) error {
 		if len(step.Nodes) != 1 {
 			return errors.Errorf("expect 1 node in approval step, got: %v", len(step.Nodes))
 		}
+		if err := validateApprovalNode(step.Nodes[0]); err != nil {
+			return err
+		}
+	}
+	return validateApprovalSLA(template.Sla)
+}
+
+func validateApprovalSLA(sla *v1pb.ApprovalSLA) error {
+	if sla == nil {
+		return nil
+	}
+	for _, duration := range []*durationpb.Duration{sla.ReminderInterval, sla.EscalationTimeout, sla.RejectionTimeout} {
+		if duration.AsDuration() < 0 {
+			return errors.Errorf("invalid negative SLA duration %v", duration.AsDuration())
+		}
+	}
+	if sla.EscalationTimeout.AsDuration() > 0 && !strings.HasPrefix(sla.EscalationRole, common.RolePrefix) {
+		return errors.Errorf("invalid escalation role %q, expecting the roles/{role} format", sla.EscalationRole)
+	}
+	if sla.EscalationTimeout.AsDuration() > 0 && sla.RejectionTimeout.AsDuration() > 0 && sla.RejectionTimeout.AsDuration() <= sla.EscalationTimeout.AsDuration() {
+		return errors.Errorf("rejection timeout must be longer than the escalation timeout")
+	}
+	return nil
+}
+
+func validateApprovalNode(node *v1pb.ApprovalNode) error {
+	if node.RequiredApprovals < 0 {
+		return errors.Errorf("invalid required approvals: %v", node.RequiredApprovals)
+	}
+	switch payload := node.Payload.(type) {
+	case *v1pb.ApprovalNode_ExternalNodeId:
+		if node.RequiredApprovals > 1 {
+			return errors.Errorf("external approval node %q cannot require more than 1 approval", payload.ExternalNodeId)
+		}
+	case *v1pb.ApprovalNode_Users_:
+		members := map[string]bool{}
+		for _, member := range payload.Users.GetMembers() {
+			if _, err := common.GetUserEmail(member); err != nil {
+				return errors.Wrapf(err, "invalid user %q", member)
+			}
+			members[member] = true
+		}
+		if len(members) == 0 {
+			return errors.Errorf("approval node must have at least 1 user")
+		}
+		if int(node.RequiredApprovals) > len(members) {
+			return errors.Errorf("required approvals %v exceeds the number of users %v", node.RequiredApprovals, len(members))
+		}
+	case *v1pb.ApprovalNode_ApproverExpression:
+		if _, err := common.ValidateApproverExpression(payload.ApproverExpression); err != nil {
+			return errors.Wrapf(err, "invalid approver expression %q", payload.ApproverExpression)
+		}
 	}
 	return nil
 }
@@ -0,0 +1,259 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// querySessionRetention is how long the response of the finished background query is retained.
+	querySessionRetention = 30 * time.Minute
+	// backgroundQuerySessionLimit is the maximum number of the background sessions of each user, including the finished ones.
+	backgroundQuerySessionLimit = 10
+	// backgroundQueryResponseSizeLimit is the maximum total size in bytes of the retained responses of each user.
+	backgroundQueryResponseSizeLimit = 100 * 1024 * 1024
+)
+
+// querySessionManager tracks the running queries and the background queries in the SQL editor.
+// The sessions are kept in the memory of this server, so they are not shared across the replicas in the high availability deployment,
+// and the background query is lost if the server restarts.
+type querySessionManager struct {
+	mu       sync.Mutex
+	sessions map[string]*querySession
+	// retention is how long the finished background sessions are retained.
+	retention time.Duration
+	// sessionLimit is the maximum number of the background sessions of each user.
+	sessionLimit int
+	// responseSizeLimit is the maximum total size of the retained responses of each user.
+	responseSizeLimit int
+}
+
+// querySession is a query in the SQL editor.
+// The fields except the immutable request fields are guarded by the mutex of the manager.
+type querySession struct {
+	id                 string
+	creatorID          int
+	creatorEmail       string
+	instance           string
+	connectionDatabase string
+	statement          string
+	background         bool
+	createTime         time.Time
+
+	state      v1pb.QuerySession_State
+	finishTime time.Time
+	err        string
+	response   *v1pb.QueryResponse
+	// responseSize is the size of the response in bytes.
+	responseSize int
+	// cancel cancels the context of the query, which triggers the engine native cancellation.
+	cancel context.CancelFunc
+}
+
+func newQuerySessionManager() *querySessionManager {
+	return &querySessionManager{
+		sessions:          make(map[string]*querySession),
+		retention:         querySessionRetention,
+		sessionLimit:      backgroundQuerySessionLimit,
+		responseSizeLimit: backgroundQueryResponseSizeLimit,
+	}
+}
+
+// create creates a running query session for the request.
+// The oldest finished background sessions of the user are evicted to make room for the new background session,
+// and it fails if the user already has too many running background queries.
+func (m *querySessionManager) create(user *store.UserMessage, request *v1pb.QueryRequest, cancel context.CancelFunc) (*querySession, error) {
+	session := &querySession{
+		id:                 uuid.NewString(),
+		creatorID:          user.ID,
+		creatorEmail:       user.Email,
+		instance:           request.Name,
+		connectionDatabase: request.ConnectionDatabase,
+		statement:          request.Statement,
+		background:         request.Background,
+		createTime:         time.Now(),
+		state:              v1pb.QuerySession_RUNNING,
+		cancel:             cancel,
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pruneLocked()
+	if session.background {
+		m.evictLocked(user.ID, m.sessionLimit-1, m.responseSizeLimit)
+		if len(m.listBackgroundLocked(user.ID)) >= m.sessionLimit {
+			return nil, status.Errorf(codes.ResourceExhausted, "too many running background queries, the limit is %d", m.sessionLimit)
+		}
+	}
+	m.sessions[session.id] = session
+	return session, nil
+}
+
+// finish records the result of the query.
+// The foreground session is removed because the response has been returned to the caller.
+func (m *querySessionManager) finish(session *querySession, response *v1pb.QueryResponse, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !session.background {
+		delete(m.sessions, session.id)
+		return
+	}
+	session.finishTime = time.Now()
+	session.cancel = nil
+	if session.state == v1pb.QuerySession_CANCELED {
+		return
+	}
+	if err != nil {
+		session.state = v1pb.QuerySession_FAILED
+		session.err = err.Error()
+		return
+	}
+	size := proto.Size(response)
+	if size > m.responseSizeLimit {
+		session.state = v1pb.QuerySession_FAILED
+		session.err = fmt.Sprintf("the result size %d bytes exceeds the background query limit %d bytes, please add a limit or run the query in the foreground", size, m.responseSizeLimit)
+		return
+	}
+	// Make room for the response by evicting the other finished sessions of the user.
+	m.evictLocked(session.creatorID, m.sessionLimit, m.responseSizeLimit-size)
+	session.state = v1pb.QuerySession_DONE
+	session.response = response
+	session.responseSize = size
+}
+
+// cancel cancels the running query of the creator.
+func (m *querySessionManager) cancel(creatorID int, id string) error {
+	m.mu.Lock()
+	session, ok := m.sessions[id]
+	if !ok || session.creatorID != creatorID {
+		m.mu.Unlock()
+		return status.Errorf(codes.NotFound, "query session %q not found", id)
+	}
+	if session.state != v1pb.QuerySession_RUNNING {
+		m.mu.Unlock()
+		return status.Errorf(codes.FailedPrecondition, "query session %q is not running", id)
+	}
+	session.state = v1pb.QuerySession_CANCELED
+	session.err = "query canceled"
+	cancel := session.cancel
+	m.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	return nil
+}
+
+// get returns the session of the creator with the response.
+func (m *querySessionManager) get(creatorID int, id string) *v1pb.QuerySession {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pruneLocked()
+	session, ok := m.sessions[id]
+	if !ok || session.creatorID != creatorID {
+		return nil
+	}
+	v1Session := session.toV1Locked()
+	v1Session.Response = session.response
+	return v1Session
+}
+
+// list returns the sessions of the creator without the responses, ordered by the create time.
+func (m *querySessionManager) list(creatorID int) []*v1pb.QuerySession {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pruneLocked()
+	var sessions []*querySession
+	for _, session := range m.sessions {
+		if session.creatorID == creatorID {
+			sessions = append(sessions, session)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].createTime.Before(sessions[j].createTime)
+	})
+	var v1Sessions []*v1pb.QuerySession
+	for _, session := range sessions {
+		v1Sessions = append(v1Sessions, session.toV1Locked())
+	}
+	return v1Sessions
+}
+
+// pruneLocked removes the finished sessions out of the retention.
+func (m *querySessionManager) pruneLocked() {
+	for id, session := range m.sessions {
+		if session.state != v1pb.QuerySession_RUNNING && !session.finishTime.IsZero() && time.Since(session.finishTime) > m.retention {
+			delete(m.sessions, id)
+		}
+	}
+}
+
+// listBackgroundLocked returns the background sessions of the creator, ordered by the create time.
+func (m *querySessionManager) listBackgroundLocked(creatorID int) []*querySession {
+	var sessions []*querySession
+	for _, session := range m.sessions {
+		if session.creatorID == creatorID && session.background {
+			sessions = append(sessions, session)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].createTime.Before(sessions[j].createTime)
+	})
+	return sessions
+}
+
+// evictLocked removes the oldest finished background sessions of the creator
+// until there are at most maxCount background sessions and at most maxSize bytes of the retained responses.
+// The running sessions are never evicted.
+func (m *querySessionManager) evictLocked(creatorID int, maxCount int, maxSize int) {
+	sessions := m.listBackgroundLocked(creatorID)
+	count, size := len(sessions), 0
+	for _, session := range sessions {
+		size += session.responseSize
+	}
+	for _, session := range sessions {
+		if count <= maxCount && size <= maxSize {
+			return
+		}
+		if session.state == v1pb.QuerySession_RUNNING || session.finishTime.IsZero() {
+			continue
+		}
+		delete(m.sessions, session.id)
+		count--
+		size -= session.responseSize
+	}
+}
+
+func (session *querySession) name() string {
+	return common.QuerySessionPrefix + session.id
+}
+
+func (session *querySession) toV1Locked() *v1pb.QuerySession {
+	v1Session := &v1pb.QuerySession{
+		Name:               session.name(),
+		Creator:            common.FormatUserEmail(session.creatorEmail),
+		Instance:           session.instance,
+		ConnectionDatabase: session.connectionDatabase,
+		Statement:          session.statement,
+		Background:         session.background,
+		State:              session.state,
+		CreateTime:         timestamppb.New(session.createTime),
+		Error:              session.err,
+	}
+	if !session.finishTime.IsZero() {
+		v1Session.FinishTime = timestamppb.New(session.finishTime)
+	}
+	return v1Session
+}
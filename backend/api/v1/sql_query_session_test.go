@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestQuerySessionManager(t *testing.T) {
+	a := require.New(t)
+	m := newQuerySessionManager()
+	alice := &store.UserMessage{ID: 101, Email: "alice@example.com"}
+	bob := &store.UserMessage{ID: 102, Email: "bob@example.com"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	running, err := m.create(alice, &v1pb.QueryRequest{Name: "instances/prod", Statement: "SELECT 1"}, cancel)
+	a.NoError(err)
+	background, err := m.create(alice, &v1pb.QueryRequest{Name: "instances/prod", Statement: "SELECT 2", Background: true}, func() {})
+	a.NoError(err)
+	_, err = m.create(bob, &v1pb.QueryRequest{Name: "instances/prod", Statement: "SELECT 3"}, func() {})
+	a.NoError(err)
+
+	sessions := m.list(alice.ID)
+	a.Len(sessions, 2)
+	a.Equal(running.name(), sessions[0].Name)
+	a.Equal("users/alice@example.com", sessions[0].Creator)
+	a.Equal(v1pb.QuerySession_RUNNING, sessions[0].State)
+	a.Len(m.list(bob.ID), 1)
+
+	// Only the creator can cancel the query.
+	err = m.cancel(bob.ID, running.id)
+	a.Equal(codes.NotFound, status.Code(err))
+	a.NoError(m.cancel(alice.ID, running.id))
+	a.ErrorIs(ctx.Err(), context.Canceled)
+	a.Equal(v1pb.QuerySession_CANCELED, m.get(alice.ID, running.id).State)
+	err = m.cancel(alice.ID, running.id)
+	a.Equal(codes.FailedPrecondition, status.Code(err))
+	// The foreground session is removed once finished.
+	m.finish(running, nil, errors.New("timeout reached"))
+	a.Nil(m.get(alice.ID, running.id))
+
+	// The response of the background query is retained.
+	response := &v1pb.QueryResponse{Results: []*v1pb.QueryResult{{ColumnNames: []string{"2"}}}}
+	m.finish(background, response, nil)
+	session := m.get(alice.ID, background.id)
+	a.Equal(v1pb.QuerySession_DONE, session.State)
+	a.NotNil(session.FinishTime)
+	a.Equal(response, session.Response)
+	a.Nil(m.list(alice.ID)[0].Response)
+	a.Nil(m.get(bob.ID, background.id))
+
+	failed, err := m.create(alice, &v1pb.QueryRequest{Background: true}, func() {})
+	a.NoError(err)
+	m.finish(failed, nil, errors.New("syntax error"))
+	session = m.get(alice.ID, failed.id)
+	a.Equal(v1pb.QuerySession_FAILED, session.State)
+	a.Equal("syntax error", session.Error)
+
+	// The finished sessions are removed after the retention.
+	m.retention = time.Nanosecond
+	time.Sleep(time.Millisecond)
+	a.Nil(m.get(alice.ID, background.id))
+	a.Empty(m.list(alice.ID))
+	a.Len(m.list(bob.ID), 1)
+}
+
+func TestQuerySessionManagerLimit(t *testing.T) {
+	a := require.New(t)
+	m := newQuerySessionManager()
+	m.sessionLimit = 2
+	alice := &store.UserMessage{ID: 101, Email: "alice@example.com"}
+	bob := &store.UserMessage{ID: 102, Email: "bob@example.com"}
+	response := &v1pb.QueryResponse{Results: []*v1pb.QueryResult{{ColumnNames: []string{"a"}}}}
+	m.responseSizeLimit = proto.Size(response)
+
+	first, err := m.create(alice, &v1pb.QueryRequest{Background: true}, func() {})
+	a.NoError(err)
+	second, err := m.create(alice, &v1pb.QueryRequest{Background: true}, func() {})
+	a.NoError(err)
+	// The running background queries are never evicted.
+	_, err = m.create(alice, &v1pb.QueryRequest{Background: true}, func() {})
+	a.Equal(codes.ResourceExhausted, status.Code(err))
+	// The foreground queries and the other users are not limited.
+	_, err = m.create(alice, &v1pb.QueryRequest{}, func() {})
+	a.NoError(err)
+	_, err = m.create(bob, &v1pb.QueryRequest{Background: true}, func() {})
+	a.NoError(err)
+
+	// The oldest finished session is evicted for the new one.
+	m.finish(first, response, nil)
+	third, err := m.create(alice, &v1pb.QueryRequest{Background: true}, func() {})
+	a.NoError(err)
+	a.Nil(m.get(alice.ID, first.id))
+
+	// The response over the size limit is not retained.
+	m.finish(second, &v1pb.QueryResponse{Results: []*v1pb.QueryResult{{ColumnNames: []string{"a", "b"}}}}, nil)
+	session := m.get(alice.ID, second.id)
+	a.Equal(v1pb.QuerySession_FAILED, session.State)
+	a.Nil(session.Response)
+
+	// The retained responses are evicted to make room under the total size limit.
+	m.finish(third, response, nil)
+	fourth, err := m.create(alice, &v1pb.QueryRequest{Background: true}, func() {})
+	a.NoError(err)
+	a.Nil(m.get(alice.ID, second.id))
+	a.Equal(response, m.get(alice.ID, third.id).Response)
+	m.finish(fourth, response, nil)
+	a.Nil(m.get(alice.ID, third.id))
+	a.Equal(response, m.get(alice.ID, fourth.id).Response)
+}
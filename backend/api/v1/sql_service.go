@@ -25,6 +25,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	mapperparser "github.com/bytebase/bytebase/backend/plugin/parser/mybatis/mapper"
@@ -41,6 +42,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
 	"github.com/bytebase/bytebase/backend/plugin/advisor/catalog"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/transform"
@@ -70,6 +72,7 @@ type SQLService struct {
 	licenseService  enterprise.LicenseService
 	profile         *config.Profile
 	iamManager      *iam.Manager
+	querySessions   *querySessionManager
 }
 
 // NewSQLService creates a SQLService.
@@ -90,6 +93,7 @@ func NewSQLService(
 		licenseService:  licenseService,
 		profile:         profile,
 		iamManager:      iamManager,
+		querySessions:   newQuerySessionManager(),
 	}
 }
 
@@ -269,6 +273,7 @@ func (s *SQLService) preAdminExecute(ctx context.Context, request *v1pb.AdminExe
 	}
 	activity, err := s.createQueryActivity(ctx, user, api.ActivityInfo, instance.UID, api.ActivitySQLEditorQueryPayload{
 		Statement:              request.Statement,
+		Fingerprint:            getQueryFingerprint(instance.Engine, request.Statement),
 		InstanceID:             instance.UID,
 		DeprecatedInstanceName: instance.Title,
 		DatabaseID:             databaseID,
@@ -313,9 +318,9 @@ func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*
 		return nil, err
 	}
 
-	bytes, durationNs, exportErr := s.doExport(ctx, request, instance, database, sensitiveSchemaInfo)
+	bytes, durationNs, dataSourceID, exportErr := s.doExport(ctx, request, instance, database, sensitiveSchemaInfo)
 
-	if err := s.postExport(ctx, activity, durationNs, exportErr); err != nil {
+	if err := s.postExport(ctx, activity, durationNs, dataSourceID, exportErr); err != nil {
 		return nil, err
 	}
 
@@ -333,7 +338,7 @@ func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*
 	}, nil
 }
 
-func (s *SQLService) postExport(ctx context.Context, activity *store.ActivityMessage, durationNs int64, queryErr error) error {
+func (s *SQLService) postExport(ctx context.Context, activity *store.ActivityMessage, durationNs int64, dataSourceID string, queryErr error) error {
 	// Update the activity
 	var payload api.ActivitySQLExportPayload
 	if err := json.Unmarshal([]byte(activity.Payload), &payload); err != nil {
@@ -342,6 +347,7 @@ func (s *SQLService) postExport(ctx context.Context, activity *store.ActivityMes
 
 	var newLevel *api.ActivityLevel
 	payload.DurationNs = durationNs
+	payload.DataSourceID = dataSourceID
 	if queryErr != nil {
 		payload.Error = queryErr.Error()
 		errorLevel := api.ActivityError
@@ -400,15 +406,15 @@ func doEncrypt(data []byte, request *v1pb.ExportRequest) ([]byte, error) {
 	return b.Bytes(), nil
 }
 
-func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, sensitiveSchemaInfo *base.SensitiveSchemaInfo) ([]byte, int64, error) {
+func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, sensitiveSchemaInfo *base.SensitiveSchemaInfo) ([]byte, int64, string, error) {
 	// Don't anonymize data for exporting data using admin mode.
 	if request.Admin {
 		sensitiveSchemaInfo = nil
 	}
 
-	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, "" /* dataSourceID */)
+	driver, dataSource, err := s.dbFactory.RouteReadOnlyDatabaseDriver(ctx, instance, database, "" /* dataSourceID */)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 	defer driver.Close(ctx)
 
@@ -417,7 +423,7 @@ func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest,
 	if sqlDB != nil {
 		conn, err = sqlDB.Conn(ctx)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, dataSource.ID, err
 		}
 		defer conn.Close()
 	}
@@ -432,42 +438,42 @@ func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest,
 	})
 	durationNs := time.Now().UnixNano() - start
 	if err != nil {
-		return nil, durationNs, err
+		return nil, durationNs, dataSource.ID, err
 	}
 	if len(result) != 1 {
-		return nil, durationNs, errors.Errorf("expecting 1 result, but got %d", len(result))
+		return nil, durationNs, dataSource.ID, errors.Errorf("expecting 1 result, but got %d", len(result))
 	}
 
 	var content []byte
 	switch request.Format {
 	case v1pb.ExportFormat_CSV:
 		if content, err = exportCSV(result[0]); err != nil {
-			return nil, durationNs, err
+			return nil, durationNs, dataSource.ID, err
 		}
 	case v1pb.ExportFormat_JSON:
 		if content, err = exportJSON(result[0]); err != nil {
-			return nil, durationNs, err
+			return nil, durationNs, dataSource.ID, err
 		}
 	case v1pb.ExportFormat_SQL:
 		resourceList, err := s.extractResourceList(ctx, instance.Engine, request.ConnectionDatabase, request.Statement, instance)
 		if err != nil {
-			return nil, 0, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
+			return nil, 0, dataSource.ID, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
 		}
 		statementPrefix, err := getSQLStatementPrefix(instance.Engine, resourceList, result[0].ColumnNames)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, dataSource.ID, err
 		}
 		if content, err = exportSQL(instance.Engine, statementPrefix, result[0]); err != nil {
-			return nil, durationNs, err
+			return nil, durationNs, dataSource.ID, err
 		}
 	case v1pb.ExportFormat_XLSX:
 		if content, err = exportXLSX(result[0]); err != nil {
-			return nil, durationNs, err
+			return nil, durationNs, dataSource.ID, err
 		}
 	default:
-		return nil, durationNs, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", request.Format.String())
+		return nil, durationNs, dataSource.ID, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", request.Format.String())
 	}
-	return content, durationNs, nil
+	return content, durationNs, dataSource.ID, nil
 }
 
 func (*SQLService) StringifyMetadata(_ context.Context, request *v1pb.StringifyMetadataRequest) (*v1pb.StringifyMetadataResponse, error) {
@@ -569,7 +575,7 @@ func getSQLStatementPrefix(engine storepb.Engine, resourceList []base.SchemaReso
 	switch engine {
 	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE, storepb.Engine_SPANNER:
 		escapeQuote = "`"
-	case storepb.Engine_CLICKHOUSE, storepb.Engine_MSSQL, storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE, storepb.Engine_DM, storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_SQLITE, storepb.Engine_SNOWFLAKE:
+	case storepb.Engine_CLICKHOUSE, storepb.Engine_MSSQL, storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE, storepb.Engine_DM, storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_SQLITE, storepb.Engine_DUCKDB, storepb.Engine_SNOWFLAKE:
 		// ClickHouse takes both double-quotes or backticks.
 		escapeQuote = "\""
 	default:
@@ -950,6 +956,119 @@ func (s *SQLService) Check(ctx context.Context, request *v1pb.CheckRequest) (*v1
 //  2. do query
 //  3. post-query
 func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1pb.QueryResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Background {
+		// The background query outlives the request, and it's only canceled by CancelQuerySession.
+		queryCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		session, err := s.querySessions.create(user, request, cancel)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		go func() {
+			defer cancel()
+			response, err := s.query(queryCtx, request)
+			s.querySessions.finish(session, response, err)
+		}()
+		return &v1pb.QueryResponse{QuerySession: session.name()}, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	session, err := s.querySessions.create(user, request, cancel)
+	if err != nil {
+		return nil, err
+	}
+	response, err := s.query(ctx, request)
+	s.querySessions.finish(session, response, err)
+	if err != nil {
+		return nil, err
+	}
+	response.QuerySession = session.name()
+	return response, nil
+}
+
+// ListQuerySessions lists the running queries and the background queries of the current user.
+func (s *SQLService) ListQuerySessions(ctx context.Context, _ *v1pb.ListQuerySessionsRequest) (*v1pb.ListQuerySessionsResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.ListQuerySessionsResponse{
+		QuerySessions: s.querySessions.list(user.ID),
+	}, nil
+}
+
+// GetQuerySession gets the query session of the current user with the response if the background query is done.
+func (s *SQLService) GetQuerySession(ctx context.Context, request *v1pb.GetQuerySessionRequest) (*v1pb.QuerySession, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := common.GetQuerySessionID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	session := s.querySessions.get(user.ID, id)
+	if session == nil {
+		return nil, status.Errorf(codes.NotFound, "query session %q not found", request.Name)
+	}
+	return session, nil
+}
+
+// CancelQuerySession cancels the running query of the current user.
+func (s *SQLService) CancelQuerySession(ctx context.Context, request *v1pb.CancelQuerySessionRequest) (*emptypb.Empty, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := common.GetQuerySessionID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if err := s.querySessions.cancel(user.ID, id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// cancelQueryOnDone cancels the running query of the connection by the engine native cancellation once the context is done,
+// so that the query stops on the database if the request is canceled, timed out or the query session is canceled.
+// It returns the function to stop watching the context, which must be called before the connection is released.
+// If the cancellation has started, the function waits for it and discards the connection,
+// because the cancel signal may arrive late and cancel the next query on the reused session.
+func cancelQueryOnDone(ctx context.Context, engine storepb.Engine, sqlDB *sql.DB, conn *sql.Conn) func() {
+	if conn == nil || !util.IsNativeCancelSupported(engine) {
+		return func() {}
+	}
+	sessionID, err := util.GetSessionID(ctx, engine, conn)
+	if err != nil {
+		slog.Warn("failed to get the session ID to cancel the query", log.BBError(err))
+		return func() {}
+	}
+	done := make(chan struct{})
+	stop := context.AfterFunc(ctx, func() {
+		defer close(done)
+		cancelCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := util.CancelSessionQuery(cancelCtx, engine, sqlDB, sessionID); err != nil {
+			slog.Warn("failed to cancel the query", slog.String("sessionID", sessionID), log.BBError(err))
+		}
+	})
+	return func() {
+		if stop() {
+			return
+		}
+		<-done
+		util.DiscardConn(conn)
+	}
+}
+
+func (s *SQLService) query(ctx context.Context, request *v1pb.QueryRequest) (*v1pb.QueryResponse, error) {
 	// TODO(zp): Remove this hack after switching all engines to use query span.
 	_, _, instance, _, err := s.prepareRelatedMessage(ctx, request.Name, request.ConnectionDatabase)
 	if err != nil {
@@ -979,6 +1098,7 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 	}
 	activity, err := s.createQueryActivity(ctx, user, level, instance.UID, api.ActivitySQLEditorQueryPayload{
 		Statement:              request.Statement,
+		Fingerprint:            getQueryFingerprint(instance.Engine, request.Statement),
 		InstanceID:             instance.UID,
 		DeprecatedInstanceName: instance.Title,
 		DatabaseID:             databaseID,
@@ -991,11 +1111,12 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 	var results []*v1pb.QueryResult
 	var queryErr error
 	var durationNs int64
+	var dataSourceID string
 	if adviceStatus != advisor.Error {
-		results, durationNs, queryErr = s.doQuery(ctx, request, instance, database, sensitiveSchemaInfo)
+		results, durationNs, dataSourceID, queryErr = s.doQuery(ctx, request, instance, database, sensitiveSchemaInfo)
 	}
 
-	err = s.postQuery(ctx, activity, durationNs, queryErr)
+	err = s.postQuery(ctx, activity, durationNs, dataSourceID, queryErr)
 	if err != nil {
 		return nil, err
 	}
@@ -1063,7 +1184,7 @@ func (*SQLService) ParseMyBatisMapper(_ context.Context, request *v1pb.ParseMyBa
 // postQuery does the following:
 //  1. Check index hit Explain statements
 //  2. Update SQL query activity
-func (s *SQLService) postQuery(ctx context.Context, activity *store.ActivityMessage, durationNs int64, queryErr error) error {
+func (s *SQLService) postQuery(ctx context.Context, activity *store.ActivityMessage, durationNs int64, dataSourceID string, queryErr error) error {
 	newLevel := activity.Level
 
 	// Update the activity
@@ -1073,6 +1194,7 @@ func (s *SQLService) postQuery(ctx context.Context, activity *store.ActivityMess
 	}
 
 	payload.DurationNs = durationNs
+	payload.DataSourceID = dataSourceID
 	if queryErr != nil {
 		payload.Error = queryErr.Error()
 		newLevel = api.ActivityError
@@ -1101,10 +1223,14 @@ func (s *SQLService) postQuery(ctx context.Context, activity *store.ActivityMess
 	return nil
 }
 
-func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, sensitiveSchemaInfo *base.SensitiveSchemaInfo) ([]*v1pb.QueryResult, int64, error) {
-	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, request.DataSourceId)
+func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, sensitiveSchemaInfo *base.SensitiveSchemaInfo) ([]*v1pb.QueryResult, int64, string, error) {
+	guard, err := s.getSessionGuardPolicy(ctx, instance, database)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	driver, dataSource, err := s.dbFactory.RouteReadOnlyDatabaseDriver(ctx, instance, database, request.DataSourceId)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 	defer driver.Close(ctx)
 
@@ -1113,37 +1239,40 @@ func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, in
 	if sqlDB != nil {
 		conn, err = sqlDB.Conn(ctx)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, dataSource.ID, err
 		}
 		defer conn.Close()
 	}
 
-	timeout := defaultTimeout
-	if request.Timeout != nil {
-		timeout = request.Timeout.AsDuration()
-	}
+	timeout := getQueryTimeout(request, guard)
 	ctx, cancelCtx := context.WithTimeout(ctx, timeout)
 	defer cancelCtx()
+	stopCancelQuery := cancelQueryOnDone(ctx, instance.Engine, sqlDB, conn)
+	defer stopCancelQuery()
 
 	start := time.Now().UnixNano()
 	results, err := driver.QueryConn(ctx, conn, request.Statement, &db.QueryContext{
-		Limit:               int(request.Limit),
-		ReadOnly:            true,
-		CurrentDatabase:     request.ConnectionDatabase,
-		SensitiveSchemaInfo: sensitiveSchemaInfo,
-		EnableSensitive:     s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil,
+		Limit:                int(request.Limit),
+		ReadOnly:             true,
+		CurrentDatabase:      request.ConnectionDatabase,
+		SensitiveSchemaInfo:  sensitiveSchemaInfo,
+		EnableSensitive:      s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil,
+		MaximumExecutionTime: guard.GetMaximumExecutionTime().AsDuration(),
+		MaximumResultSize:    guard.GetMaximumResultSize(),
+		MaximumEstimatedCost: guard.GetMaximumEstimatedCost(),
+		MaximumEstimatedRows: guard.GetMaximumEstimatedRows(),
 	})
 	select {
 	case <-ctx.Done():
 		// canceled or timed out
-		return nil, time.Now().UnixNano() - start, errors.Errorf("timeout reached: %v", timeout)
+		return nil, time.Now().UnixNano() - start, dataSource.ID, errors.Errorf("timeout reached: %v", timeout)
 	default:
 		// So the select will not block
 	}
 
 	sanitizeResults(results)
 
-	return results, time.Now().UnixNano() - start, err
+	return results, time.Now().UnixNano() - start, dataSource.ID, err
 }
 
 // sanitizeResults sanitizes the strings in the results by replacing all the invalid UTF-8 characters with its hexadecimal representation.
@@ -1159,6 +1288,73 @@ func sanitizeResults(results []*v1pb.QueryResult) {
 	}
 }
 
+// getSessionGuardPolicy returns the session guard of the environment and the project of the query.
+func (s *SQLService) getSessionGuardPolicy(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage) (*storepb.SessionGuardPolicy, error) {
+	environmentID := instance.EnvironmentID
+	if database != nil {
+		environmentID = database.EffectiveEnvironmentID
+	}
+	environment, err := s.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &environmentID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get environment %q, error: %v", environmentID, err)
+	}
+	if environment == nil {
+		return nil, status.Errorf(codes.NotFound, "environment %q not found", environmentID)
+	}
+	environmentPolicy, err := s.store.GetSessionGuardPolicy(ctx, api.PolicyResourceTypeEnvironment, environment.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get session guard policy for environment %q, error: %v", environmentID, err)
+	}
+	if database == nil {
+		return environmentPolicy, nil
+	}
+
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project %q, error: %v", database.ProjectID, err)
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", database.ProjectID)
+	}
+	projectPolicy, err := s.store.GetSessionGuardPolicy(ctx, api.PolicyResourceTypeProject, project.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get session guard policy for project %q, error: %v", database.ProjectID, err)
+	}
+	return mergeSessionGuardPolicy(environmentPolicy, projectPolicy), nil
+}
+
+// mergeSessionGuardPolicy merges the session guard policies, and the tighter limit wins.
+func mergeSessionGuardPolicy(policies ...*storepb.SessionGuardPolicy) *storepb.SessionGuardPolicy {
+	merged := &storepb.SessionGuardPolicy{}
+	for _, policy := range policies {
+		if d := policy.GetMaximumExecutionTime(); d.AsDuration() > 0 && (merged.MaximumExecutionTime == nil || d.AsDuration() < merged.MaximumExecutionTime.AsDuration()) {
+			merged.MaximumExecutionTime = d
+		}
+		if v := policy.GetMaximumResultSize(); v > 0 && (merged.MaximumResultSize == 0 || v < merged.MaximumResultSize) {
+			merged.MaximumResultSize = v
+		}
+		if v := policy.GetMaximumEstimatedCost(); v > 0 && (merged.MaximumEstimatedCost == 0 || v < merged.MaximumEstimatedCost) {
+			merged.MaximumEstimatedCost = v
+		}
+		if v := policy.GetMaximumEstimatedRows(); v > 0 && (merged.MaximumEstimatedRows == 0 || v < merged.MaximumEstimatedRows) {
+			merged.MaximumEstimatedRows = v
+		}
+	}
+	return merged
+}
+
+// getQueryTimeout returns the requested timeout capped by the maximum execution time of the session guard.
+func getQueryTimeout(request *v1pb.QueryRequest, guard *storepb.SessionGuardPolicy) time.Duration {
+	timeout := defaultTimeout
+	if request.Timeout != nil {
+		timeout = request.Timeout.AsDuration()
+	}
+	if maximum := guard.GetMaximumExecutionTime().AsDuration(); maximum > 0 && (timeout <= 0 || maximum < timeout) {
+		timeout = maximum
+	}
+	return timeout
+}
+
 // preCheck does the following:
 //  1. Validate the request.
 //     i. Check if the instance exists.
@@ -1254,7 +1450,7 @@ func (s *SQLService) preCheck(ctx context.Context, instanceName, connectionDatab
 			for _, resource := range resources {
 				databaseMap[resource.Database] = true
 			}
-		case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_RISINGWAVE:
+		case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_RISINGWAVE, storepb.Engine_COCKROACHDB:
 			if !allPostgresSystemObjects(statement) {
 				databaseMap[connectionDatabase] = true
 			}
@@ -1349,6 +1545,18 @@ func (s *SQLService) createQueryActivity(ctx context.Context, user *store.UserMe
 	return activity, nil
 }
 
+// getQueryFingerprint returns the fingerprint of the statement, or empty if the engine doesn't support it.
+func getQueryFingerprint(engine storepb.Engine, statement string) string {
+	fingerprint, err := base.GetFingerprint(engine, statement)
+	if err != nil {
+		return ""
+	}
+	if len(fingerprint) > db.SlowQueryMaxLen {
+		fingerprint, _ = common.TruncateString(fingerprint, db.SlowQueryMaxLen)
+	}
+	return fingerprint
+}
+
 func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store.InstanceMessage, databaseList []string, currentDatabase string, action storepb.MaskingExceptionPolicy_MaskingException_Action) (*base.SensitiveSchemaInfo, error) {
 	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
 	if !ok {
@@ -1931,7 +2139,7 @@ func (s *SQLService) prepareRelatedMessage(ctx context.Context, instanceToken st
 // 4. Check if all statements are SELECT/EXPLAIN/SET statements.
 func validateQueryRequest(instance *store.InstanceMessage, databaseName string, statement string) error {
 	switch instance.Engine {
-	case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_RISINGWAVE:
+	case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_RISINGWAVE, storepb.Engine_COCKROACHDB:
 		if databaseName == "" {
 			return status.Error(codes.InvalidArgument, "connection_database is required for postgres instance")
 		}
@@ -1939,7 +2147,7 @@ func validateQueryRequest(instance *store.InstanceMessage, databaseName string,
 		if instance.Options != nil && instance.Options.SchemaTenantMode && databaseName == "" {
 			return status.Error(codes.InvalidArgument, "connection_database is required for oracle schema tenant mode instance")
 		}
-	case storepb.Engine_MONGODB, storepb.Engine_REDIS:
+	case storepb.Engine_MONGODB:
 		// Do nothing.
 		return nil
 	}
@@ -2692,7 +2900,7 @@ func (s *SQLService) getInstanceMessage(ctx context.Context, name string) (*stor
 // IsSQLReviewSupported checks the engine type if SQL review supports it.
 func IsSQLReviewSupported(dbType storepb.Engine) bool {
 	switch dbType {
-	case storepb.Engine_POSTGRES, storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_MARIADB, storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE, storepb.Engine_OCEANBASE, storepb.Engine_SNOWFLAKE, storepb.Engine_DM, storepb.Engine_MSSQL:
+	case storepb.Engine_POSTGRES, storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_MARIADB, storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE, storepb.Engine_OCEANBASE, storepb.Engine_SNOWFLAKE, storepb.Engine_DM, storepb.Engine_MSSQL, storepb.Engine_REDIS, storepb.Engine_COCKROACHDB:
 		return true
 	default:
 		return false
@@ -2,8 +2,11 @@ package v1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -259,3 +262,37 @@ func TestGetExcelColumnName(t *testing.T) {
 		a.Equal(test.want, got)
 	}
 }
+
+func TestMergeSessionGuardPolicy(t *testing.T) {
+	a := assert.New(t)
+	environmentPolicy := &storepb.SessionGuardPolicy{
+		MaximumExecutionTime: durationpb.New(time.Minute),
+		MaximumResultSize:    1024,
+		MaximumEstimatedRows: 1000000,
+	}
+	projectPolicy := &storepb.SessionGuardPolicy{
+		MaximumExecutionTime: durationpb.New(30 * time.Second),
+		MaximumResultSize:    4096,
+		MaximumEstimatedCost: 5000,
+	}
+	want := &storepb.SessionGuardPolicy{
+		MaximumExecutionTime: durationpb.New(30 * time.Second),
+		MaximumResultSize:    1024,
+		MaximumEstimatedCost: 5000,
+		MaximumEstimatedRows: 1000000,
+	}
+	a.True(proto.Equal(want, mergeSessionGuardPolicy(environmentPolicy, projectPolicy)))
+	a.True(proto.Equal(want, mergeSessionGuardPolicy(projectPolicy, environmentPolicy)))
+	a.True(proto.Equal(environmentPolicy, mergeSessionGuardPolicy(environmentPolicy, &storepb.SessionGuardPolicy{})))
+	a.True(proto.Equal(&storepb.SessionGuardPolicy{}, mergeSessionGuardPolicy()))
+}
+
+func TestGetQueryTimeout(t *testing.T) {
+	a := assert.New(t)
+	guard := &storepb.SessionGuardPolicy{MaximumExecutionTime: durationpb.New(time.Minute)}
+
+	a.Equal(defaultTimeout, getQueryTimeout(&v1pb.QueryRequest{}, &storepb.SessionGuardPolicy{}))
+	a.Equal(time.Minute, getQueryTimeout(&v1pb.QueryRequest{}, guard))
+	a.Equal(10*time.Second, getQueryTimeout(&v1pb.QueryRequest{Timeout: durationpb.New(10 * time.Second)}, guard))
+	a.Equal(time.Minute, getQueryTimeout(&v1pb.QueryRequest{Timeout: durationpb.New(time.Hour)}, guard))
+}
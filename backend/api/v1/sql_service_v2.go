@@ -104,9 +104,9 @@ func (s *SQLService) ExportV2(ctx context.Context, request *v1pb.ExportRequest)
 		return nil, err
 	}
 
-	bytes, durationNs, exportErr := s.doExportV2(ctx, request, instance, maybeDatabase, spans)
+	bytes, durationNs, dataSourceID, exportErr := s.doExportV2(ctx, request, instance, maybeDatabase, spans)
 
-	if err := s.postExport(ctx, activity, durationNs, exportErr); err != nil {
+	if err := s.postExport(ctx, activity, durationNs, dataSourceID, exportErr); err != nil {
 		return nil, err
 	}
 
@@ -202,6 +202,7 @@ func (s *SQLService) QueryV2(ctx context.Context, request *v1pb.QueryRequest) (*
 	}
 	activity, err := s.createQueryActivity(ctx, user, level, instance.UID, api.ActivitySQLEditorQueryPayload{
 		Statement:              request.Statement,
+		Fingerprint:            getQueryFingerprint(instance.Engine, request.Statement),
 		InstanceID:             instance.UID,
 		DeprecatedInstanceName: instance.Title,
 		DatabaseID:             databaseID,
@@ -214,8 +215,9 @@ func (s *SQLService) QueryV2(ctx context.Context, request *v1pb.QueryRequest) (*
 	var results []*v1pb.QueryResult
 	var queryErr error
 	var durationNs int64
+	var dataSourceID string
 	if adviceStatus != advisor.Error {
-		results, durationNs, queryErr = s.doQueryV2(ctx, request, instance, maybeDatabase)
+		results, durationNs, dataSourceID, queryErr = s.doQueryV2(ctx, request, instance, maybeDatabase)
 		if queryErr == nil && s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil {
 			if err := s.maskResults(ctx, spans, results, instance, storepb.MaskingExceptionPolicy_MaskingException_QUERY); err != nil {
 				return nil, err
@@ -224,7 +226,7 @@ func (s *SQLService) QueryV2(ctx context.Context, request *v1pb.QueryRequest) (*
 	}
 
 	// Update activity.
-	err = s.postQuery(ctx, activity, durationNs, queryErr)
+	err = s.postQuery(ctx, activity, durationNs, dataSourceID, queryErr)
 	if err != nil {
 		return nil, err
 	}
@@ -257,10 +259,10 @@ func (s *SQLService) QueryV2(ctx context.Context, request *v1pb.QueryRequest) (*
 }
 
 // doExportV2 is the copy of doExport, which use query span to improve performance.
-func (s *SQLService) doExportV2(ctx context.Context, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, spans []*base.QuerySpan) ([]byte, int64, error) {
-	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, "" /* dataSourceID */)
+func (s *SQLService) doExportV2(ctx context.Context, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, spans []*base.QuerySpan) ([]byte, int64, string, error) {
+	driver, dataSource, err := s.dbFactory.RouteReadOnlyDatabaseDriver(ctx, instance, database, "" /* dataSourceID */)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 	defer driver.Close(ctx)
 
@@ -269,7 +271,7 @@ func (s *SQLService) doExportV2(ctx context.Context, request *v1pb.ExportRequest
 	if sqlDB != nil {
 		conn, err = sqlDB.Conn(ctx)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, dataSource.ID, err
 		}
 		defer conn.Close()
 	}
@@ -284,15 +286,15 @@ func (s *SQLService) doExportV2(ctx context.Context, request *v1pb.ExportRequest
 	})
 	durationNs := time.Now().UnixNano() - start
 	if err != nil {
-		return nil, durationNs, err
+		return nil, durationNs, dataSource.ID, err
 	}
 	if len(result) != 1 {
-		return nil, durationNs, errors.Errorf("expecting 1 result, but got %d", len(result))
+		return nil, durationNs, dataSource.ID, errors.Errorf("expecting 1 result, but got %d", len(result))
 	}
 
 	if s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil {
 		if err := s.maskResults(ctx, spans, result, instance, storepb.MaskingExceptionPolicy_MaskingException_EXPORT); err != nil {
-			return nil, durationNs, err
+			return nil, durationNs, dataSource.ID, err
 		}
 	}
 
@@ -300,39 +302,43 @@ func (s *SQLService) doExportV2(ctx context.Context, request *v1pb.ExportRequest
 	switch request.Format {
 	case v1pb.ExportFormat_CSV:
 		if content, err = exportCSV(result[0]); err != nil {
-			return nil, durationNs, err
+			return nil, durationNs, dataSource.ID, err
 		}
 	case v1pb.ExportFormat_JSON:
 		if content, err = exportJSON(result[0]); err != nil {
-			return nil, durationNs, err
+			return nil, durationNs, dataSource.ID, err
 		}
 	case v1pb.ExportFormat_SQL:
 		resourceList, err := s.extractResourceList(ctx, instance.Engine, request.ConnectionDatabase, request.Statement, instance)
 		if err != nil {
-			return nil, 0, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
+			return nil, 0, dataSource.ID, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
 		}
 		statementPrefix, err := getSQLStatementPrefix(instance.Engine, resourceList, result[0].ColumnNames)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, dataSource.ID, err
 		}
 		if content, err = exportSQL(instance.Engine, statementPrefix, result[0]); err != nil {
-			return nil, durationNs, err
+			return nil, durationNs, dataSource.ID, err
 		}
 	case v1pb.ExportFormat_XLSX:
 		if content, err = exportXLSX(result[0]); err != nil {
-			return nil, durationNs, err
+			return nil, durationNs, dataSource.ID, err
 		}
 	default:
-		return nil, durationNs, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", request.Format.String())
+		return nil, durationNs, dataSource.ID, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", request.Format.String())
 	}
-	return content, durationNs, nil
+	return content, durationNs, dataSource.ID, nil
 }
 
 // doQueryV2 is the copy of doQuery, which use query span to improve performance.
-func (s *SQLService) doQueryV2(ctx context.Context, request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage) ([]*v1pb.QueryResult, int64, error) {
-	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, request.DataSourceId)
+func (s *SQLService) doQueryV2(ctx context.Context, request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage) ([]*v1pb.QueryResult, int64, string, error) {
+	guard, err := s.getSessionGuardPolicy(ctx, instance, database)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
+	}
+	driver, dataSource, err := s.dbFactory.RouteReadOnlyDatabaseDriver(ctx, instance, database, request.DataSourceId)
+	if err != nil {
+		return nil, 0, "", err
 	}
 	defer driver.Close(ctx)
 
@@ -341,37 +347,40 @@ func (s *SQLService) doQueryV2(ctx context.Context, request *v1pb.QueryRequest,
 	if sqlDB != nil {
 		conn, err = sqlDB.Conn(ctx)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, dataSource.ID, err
 		}
 		defer conn.Close()
 	}
 
-	timeout := defaultTimeout
-	if request.Timeout != nil {
-		timeout = request.Timeout.AsDuration()
-	}
+	timeout := getQueryTimeout(request, guard)
 	ctx, cancelCtx := context.WithTimeout(ctx, timeout)
 	defer cancelCtx()
+	stopCancelQuery := cancelQueryOnDone(ctx, instance.Engine, sqlDB, conn)
+	defer stopCancelQuery()
 
 	start := time.Now().UnixNano()
 	results, err := driver.QueryConn(ctx, conn, request.Statement, &db.QueryContext{
-		Limit:               int(request.Limit),
-		ReadOnly:            true,
-		CurrentDatabase:     request.ConnectionDatabase,
-		SensitiveSchemaInfo: nil,
-		EnableSensitive:     s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil,
+		Limit:                int(request.Limit),
+		ReadOnly:             true,
+		CurrentDatabase:      request.ConnectionDatabase,
+		SensitiveSchemaInfo:  nil,
+		EnableSensitive:      s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil,
+		MaximumExecutionTime: guard.GetMaximumExecutionTime().AsDuration(),
+		MaximumResultSize:    guard.GetMaximumResultSize(),
+		MaximumEstimatedCost: guard.GetMaximumEstimatedCost(),
+		MaximumEstimatedRows: guard.GetMaximumEstimatedRows(),
 	})
 	select {
 	case <-ctx.Done():
 		// canceled or timed out
-		return nil, time.Now().UnixNano() - start, errors.Errorf("timeout reached: %v", timeout)
+		return nil, time.Now().UnixNano() - start, dataSource.ID, errors.Errorf("timeout reached: %v", timeout)
 	default:
 		// So the select will not block
 	}
 
 	sanitizeResults(results)
 
-	return results, time.Now().UnixNano() - start, err
+	return results, time.Now().UnixNano() - start, dataSource.ID, err
 }
 
 // getMaskersForQuerySpan returns the maskers for the query span.
@@ -8,6 +8,7 @@ import (
 
 	"log/slog"
 
+	"github.com/pmezard/go-difflib/difflib"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -327,8 +328,14 @@ func (s *WorksheetService) UpdateWorksheet(ctx context.Context, request *v1pb.Up
 			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid update mask path %q", path))
 		}
 	}
+	if request.Worksheet.Etag != "" {
+		worksheetPatch.Etag = &request.Worksheet.Etag
+	}
 	storeWorksheet, err := s.store.PatchSheet(ctx, worksheetPatch)
 	if err != nil {
+		if common.ErrorCode(err) == common.Conflict {
+			return nil, status.Errorf(codes.Aborted, "worksheet %q has been modified by others, please refresh and try again", request.Worksheet.Name)
+		}
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to update worksheet: %v", err))
 	}
 	v1pbWorksheet, err := s.convertToAPIWorksheetMessage(ctx, storeWorksheet)
@@ -433,6 +440,323 @@ func (s *WorksheetService) UpdateWorksheetOrganizer(ctx context.Context, request
 	}, nil
 }
 
+// ListWorksheetRevisions lists the revisions of a worksheet.
+func (s *WorksheetService) ListWorksheetRevisions(ctx context.Context, request *v1pb.ListWorksheetRevisionsRequest) (*v1pb.ListWorksheetRevisionsResponse, error) {
+	worksheetUID, err := common.GetWorksheetUID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if _, err := s.getAccessibleWorksheet(ctx, worksheetUID, false /* write */); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.store.ListSheetRevisions(ctx, &store.FindSheetRevisionMessage{SheetUID: worksheetUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to list worksheet revisions: %v", err))
+	}
+	response := &v1pb.ListWorksheetRevisionsResponse{}
+	for _, revision := range revisions {
+		v1pbRevision, err := s.convertToAPIWorksheetRevision(ctx, revision)
+		if err != nil {
+			return nil, err
+		}
+		response.Revisions = append(response.Revisions, v1pbRevision)
+	}
+	return response, nil
+}
+
+// GetWorksheetRevision gets a revision of a worksheet with the full content.
+func (s *WorksheetService) GetWorksheetRevision(ctx context.Context, request *v1pb.GetWorksheetRevisionRequest) (*v1pb.WorksheetRevision, error) {
+	revision, err := s.getWorksheetRevision(ctx, request.Name, false /* write */)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertToAPIWorksheetRevision(ctx, revision)
+}
+
+// DiffWorksheetRevisions returns the unified diff between two revisions of a worksheet.
+func (s *WorksheetService) DiffWorksheetRevisions(ctx context.Context, request *v1pb.DiffWorksheetRevisionsRequest) (*v1pb.DiffWorksheetRevisionsResponse, error) {
+	base, err := s.getWorksheetRevision(ctx, request.Name, false /* write */)
+	if err != nil {
+		return nil, err
+	}
+	targetWorksheetUID, _, err := common.GetWorksheetUIDRevision(request.Target)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if targetWorksheetUID != base.SheetUID {
+		return nil, status.Errorf(codes.InvalidArgument, "revision %q does not belong to the worksheet of revision %q", request.Target, request.Name)
+	}
+	target, err := s.getWorksheetRevision(ctx, request.Target, false /* write */)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := diffWorksheetRevisions(request.Name, base.Statement, request.Target, target.Statement)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to diff worksheet revisions: %v", err))
+	}
+	return &v1pb.DiffWorksheetRevisionsResponse{
+		Diff: diff,
+	}, nil
+}
+
+// RestoreWorksheetRevision restores the content of a worksheet to a revision, the restore creates a new revision.
+func (s *WorksheetService) RestoreWorksheetRevision(ctx context.Context, request *v1pb.RestoreWorksheetRevisionRequest) (*v1pb.Worksheet, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	revision, err := s.getWorksheetRevision(ctx, request.Name, true /* write */)
+	if err != nil {
+		return nil, err
+	}
+
+	worksheetPatch := &store.PatchSheetMessage{
+		UID:       revision.SheetUID,
+		UpdaterID: principalID,
+		Statement: &revision.Statement,
+	}
+	if request.Etag != "" {
+		worksheetPatch.Etag = &request.Etag
+	}
+	worksheet, err := s.store.PatchSheet(ctx, worksheetPatch)
+	if err != nil {
+		if common.ErrorCode(err) == common.Conflict {
+			return nil, status.Errorf(codes.Aborted, "worksheet of revision %q has been modified by others, please refresh and try again", request.Name)
+		}
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to restore worksheet revision: %v", err))
+	}
+	return s.convertToAPIWorksheetMessage(ctx, worksheet)
+}
+
+// CreateWorksheetComment creates a comment anchored to a line of a worksheet revision.
+// Everyone who can read the worksheet can comment on it.
+func (s *WorksheetService) CreateWorksheetComment(ctx context.Context, request *v1pb.CreateWorksheetCommentRequest) (*v1pb.WorksheetComment, error) {
+	if request.Comment == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "comment must be set")
+	}
+	if request.Comment.Content == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "comment content cannot be empty")
+	}
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	worksheetUID, err := common.GetWorksheetUID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	revisionWorksheetUID, _, err := common.GetWorksheetUIDRevision(request.Comment.Revision)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if revisionWorksheetUID != worksheetUID {
+		return nil, status.Errorf(codes.InvalidArgument, "revision %q does not belong to worksheet %q", request.Comment.Revision, request.Parent)
+	}
+	revision, err := s.getWorksheetRevision(ctx, request.Comment.Revision, false /* write */)
+	if err != nil {
+		return nil, err
+	}
+	if lineCount := strings.Count(revision.Statement, "\n") + 1; request.Comment.Line < 1 || int(request.Comment.Line) > lineCount {
+		return nil, status.Errorf(codes.InvalidArgument, "line %d is out of range, revision %q has %d lines", request.Comment.Line, request.Comment.Revision, lineCount)
+	}
+
+	comment, err := s.store.CreateSheetComment(ctx, &store.SheetCommentMessage{
+		SheetUID:  worksheetUID,
+		Revision:  revision.Revision,
+		Line:      int(request.Comment.Line),
+		Content:   request.Comment.Content,
+		CreatorID: principalID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to create worksheet comment: %v", err))
+	}
+	return s.convertToAPIWorksheetComment(ctx, comment)
+}
+
+// ListWorksheetComments lists the comments of a worksheet.
+func (s *WorksheetService) ListWorksheetComments(ctx context.Context, request *v1pb.ListWorksheetCommentsRequest) (*v1pb.ListWorksheetCommentsResponse, error) {
+	worksheetUID, err := common.GetWorksheetUID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if _, err := s.getAccessibleWorksheet(ctx, worksheetUID, false /* write */); err != nil {
+		return nil, err
+	}
+
+	find := &store.FindSheetCommentMessage{
+		SheetUID: &worksheetUID,
+	}
+	if request.Revision != "" {
+		revisionWorksheetUID, revision, err := common.GetWorksheetUIDRevision(request.Revision)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if revisionWorksheetUID != worksheetUID {
+			return nil, status.Errorf(codes.InvalidArgument, "revision %q does not belong to worksheet %q", request.Revision, request.Parent)
+		}
+		find.Revision = &revision
+	}
+	comments, err := s.store.ListSheetComments(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to list worksheet comments: %v", err))
+	}
+	response := &v1pb.ListWorksheetCommentsResponse{}
+	for _, comment := range comments {
+		v1pbComment, err := s.convertToAPIWorksheetComment(ctx, comment)
+		if err != nil {
+			return nil, err
+		}
+		response.Comments = append(response.Comments, v1pbComment)
+	}
+	return response, nil
+}
+
+// UpdateWorksheetComment updates a worksheet comment, only the comment creator can update it.
+func (s *WorksheetService) UpdateWorksheetComment(ctx context.Context, request *v1pb.UpdateWorksheetCommentRequest) (*v1pb.WorksheetComment, error) {
+	if request.Comment == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "comment must be set")
+	}
+	if request.UpdateMask == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask cannot be empty")
+	}
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	comment, err := s.getWorksheetComment(ctx, request.Comment.Name)
+	if err != nil {
+		return nil, err
+	}
+	if comment.CreatorID != principalID {
+		return nil, status.Errorf(codes.PermissionDenied, "only the creator can update comment %q", request.Comment.Name)
+	}
+
+	patch := &store.UpdateSheetCommentMessage{
+		UID:       comment.UID,
+		UpdaterID: principalID,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "content":
+			if request.Comment.Content == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "comment content cannot be empty")
+			}
+			patch.Content = &request.Comment.Content
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid update mask path %q", path))
+		}
+	}
+	comment, err = s.store.UpdateSheetComment(ctx, patch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to update worksheet comment: %v", err))
+	}
+	return s.convertToAPIWorksheetComment(ctx, comment)
+}
+
+// DeleteWorksheetComment deletes a worksheet comment.
+// The comment can be deleted by its creator and the principals who can write the worksheet.
+func (s *WorksheetService) DeleteWorksheetComment(ctx context.Context, request *v1pb.DeleteWorksheetCommentRequest) (*emptypb.Empty, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	comment, err := s.getWorksheetComment(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if comment.CreatorID != principalID {
+		if _, err := s.getAccessibleWorksheet(ctx, comment.SheetUID, true /* write */); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.store.DeleteSheetComment(ctx, comment.UID); err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to delete worksheet comment: %v", err))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getAccessibleWorksheet returns the worksheet if the current principal can read it, or write it if `write` is true.
+func (s *WorksheetService) getAccessibleWorksheet(ctx context.Context, worksheetUID int, write bool) (*store.SheetMessage, error) {
+	if worksheetUID <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid worksheet id %d, must be positive integer", worksheetUID))
+	}
+	ws := store.SheetFromBytebase
+	worksheet, err := s.findWorksheet(ctx, &store.FindSheetMessage{
+		UID:    &worksheetUID,
+		Source: &ws,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if write {
+		canAccess, err := s.canWriteWorksheet(ctx, worksheet)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to check access with error: %v", err))
+		}
+		if !canAccess {
+			return nil, status.Errorf(codes.PermissionDenied, "cannot write worksheet %s", worksheet.Title)
+		}
+		return worksheet, nil
+	}
+	canAccess, err := s.canReadWorksheet(ctx, worksheet)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to check access with error: %v", err))
+	}
+	if !canAccess {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot access worksheet %s", worksheet.Title)
+	}
+	return worksheet, nil
+}
+
+// getWorksheetRevision returns the revision with the full statement after checking the access to the worksheet.
+func (s *WorksheetService) getWorksheetRevision(ctx context.Context, name string, write bool) (*store.SheetRevisionMessage, error) {
+	worksheetUID, revisionNumber, err := common.GetWorksheetUIDRevision(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if _, err := s.getAccessibleWorksheet(ctx, worksheetUID, write); err != nil {
+		return nil, err
+	}
+	revision, err := s.store.GetSheetRevision(ctx, &store.FindSheetRevisionMessage{
+		SheetUID: worksheetUID,
+		Revision: &revisionNumber,
+		LoadFull: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to get worksheet revision: %v", err))
+	}
+	if revision == nil {
+		return nil, status.Errorf(codes.NotFound, "worksheet revision %q not found", name)
+	}
+	return revision, nil
+}
+
+// getWorksheetComment returns the comment after checking the read access to the worksheet.
+func (s *WorksheetService) getWorksheetComment(ctx context.Context, name string) (*store.SheetCommentMessage, error) {
+	worksheetUID, commentUID, err := common.GetWorksheetUIDCommentUID(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if _, err := s.getAccessibleWorksheet(ctx, worksheetUID, false /* write */); err != nil {
+		return nil, err
+	}
+	comment, err := s.store.GetSheetComment(ctx, &store.FindSheetCommentMessage{
+		UID:      &commentUID,
+		SheetUID: &worksheetUID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to get worksheet comment: %v", err))
+	}
+	if comment == nil {
+		return nil, status.Errorf(codes.NotFound, "worksheet comment %q not found", name)
+	}
+	return comment, nil
+}
+
 func (s *WorksheetService) findWorksheet(ctx context.Context, find *store.FindSheetMessage) (*store.SheetMessage, error) {
 	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
 	if !ok {
@@ -565,9 +889,65 @@ func (s *WorksheetService) convertToAPIWorksheetMessage(ctx context.Context, wor
 		ContentSize: worksheet.Size,
 		Visibility:  visibility,
 		Starred:     worksheet.Starred,
+		Etag:        worksheet.Etag,
+	}, nil
+}
+
+func (s *WorksheetService) convertToAPIWorksheetRevision(ctx context.Context, revision *store.SheetRevisionMessage) (*v1pb.WorksheetRevision, error) {
+	creator, err := s.store.GetUserByID(ctx, revision.CreatorID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to get creator: %v", err))
+	}
+	return &v1pb.WorksheetRevision{
+		Name:        fmt.Sprintf("%s%d/%s%d", common.WorksheetIDPrefix, revision.SheetUID, common.WorksheetRevisionPrefix, revision.Revision),
+		Creator:     fmt.Sprintf("users/%s", creator.Email),
+		CreateTime:  timestamppb.New(revision.CreatedTime),
+		Content:     []byte(revision.Statement),
+		ContentSize: revision.Size,
 	}, nil
 }
 
+func (s *WorksheetService) convertToAPIWorksheetComment(ctx context.Context, comment *store.SheetCommentMessage) (*v1pb.WorksheetComment, error) {
+	creator, err := s.store.GetUserByID(ctx, comment.CreatorID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to get creator: %v", err))
+	}
+	return &v1pb.WorksheetComment{
+		Name:       fmt.Sprintf("%s%d/%s%d", common.WorksheetIDPrefix, comment.SheetUID, common.WorksheetCommentPrefix, comment.UID),
+		Revision:   fmt.Sprintf("%s%d/%s%d", common.WorksheetIDPrefix, comment.SheetUID, common.WorksheetRevisionPrefix, comment.Revision),
+		Line:       int32(comment.Line),
+		Content:    comment.Content,
+		Creator:    fmt.Sprintf("users/%s", creator.Email),
+		CreateTime: timestamppb.New(comment.CreatedTime),
+		UpdateTime: timestamppb.New(comment.UpdatedTime),
+	}, nil
+}
+
+// diffWorksheetRevisions returns the unified diff from the base statement to the target statement.
+func diffWorksheetRevisions(baseName, base, targetName, target string) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitWorksheetLines(base),
+		B:        splitWorksheetLines(target),
+		FromFile: baseName,
+		ToFile:   targetName,
+		Context:  3,
+	})
+}
+
+// splitWorksheetLines splits the statement into lines ending with the newline,
+// difflib.SplitLines would append an extra empty line if the statement ends with a newline.
+func splitWorksheetLines(statement string) []string {
+	if statement == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(statement, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	lines[len(lines)-1] += "\n"
+	return lines
+}
+
 func convertToStoreWorksheetMessage(projectUID int, databaseUID *int, creatorID int, worksheet *v1pb.Worksheet) (*store.SheetMessage, error) {
 	visibility, err := convertToStoreWorksheetVisibility(worksheet.Visibility)
 	if err != nil {
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffWorksheetRevisions(t *testing.T) {
+	tests := []struct {
+		base   string
+		target string
+		want   string
+	}{
+		{
+			base:   "SELECT 1;\nSELECT 2;\n",
+			target: "SELECT 1;\nSELECT 3;\n",
+			want: `--- worksheets/101/revisions/1
++++ worksheets/101/revisions/2
+@@ -1,2 +1,2 @@
+ SELECT 1;
+-SELECT 2;
++SELECT 3;
+`,
+		},
+		{
+			base:   "SELECT 1;",
+			target: "SELECT 1;\nSELECT 2;",
+			want: `--- worksheets/101/revisions/1
++++ worksheets/101/revisions/2
+@@ -1 +1,2 @@
+ SELECT 1;
++SELECT 2;
+`,
+		},
+		{
+			base:   "SELECT 1;",
+			target: "SELECT 1;",
+			want:   "",
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		diff, err := diffWorksheetRevisions("worksheets/101/revisions/1", test.base, "worksheets/101/revisions/2", test.target)
+		a.NoError(err)
+		a.Equal(test.want, diff)
+	}
+}
@@ -0,0 +1,286 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/catalog"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+
+	// Register pingcap parser driver.
+	_ "github.com/pingcap/tidb/pkg/types/parser_driver"
+	// Register tidb advisor.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/tidb"
+	// Register mysql advisor.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/mysql"
+	// Register postgresql advisor.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/pg"
+	// Register oracle advisor.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/oracle"
+	// Register snowflake advisor.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/snowflake"
+	// Register mssql advisor.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/mssql"
+	// Register cockroachdb advisor.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/cockroachdb"
+	// Register postgres parser driver.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/sql/engine/pg"
+)
+
+const defaultReviewTemplate = "bb.sql-review.prod"
+
+// reviewFile is the SQL review result of a file.
+type reviewFile struct {
+	path       string
+	adviceList []advisor.Advice
+}
+
+func newReviewCmd() *cobra.Command {
+	var (
+		engine     string
+		template   string
+		config     string
+		schema     string
+		format     string
+		output     string
+		charset    string
+		collation  string
+		failOnWarn bool
+	)
+	reviewCmd := &cobra.Command{
+		Use:   "review [file or directory]...",
+		Short: "Review SQL files against the SQL review rules offline.",
+		Long: `Review SQL files against the SQL review rules offline.
+
+The directories are walked recursively for .sql files, and each file is reviewed separately.
+The rules are the built-in template, optionally overridden by the YAML config in the same format as
+https://github.com/bytebase/bytebase/tree/main/backend/plugin/advisor/config/sql-review.override.yaml.`,
+		Args:         cobra.MinimumNArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			engineValue, ok := storepb.Engine_value[strings.ToUpper(engine)]
+			if !ok || storepb.Engine(engineValue) == storepb.Engine_ENGINE_UNSPECIFIED {
+				return errors.Errorf("invalid engine %q", engine)
+			}
+			writeReport, ok := reviewReportWriters[format]
+			if !ok {
+				return errors.Errorf("invalid format %q, supported formats: %s", format, strings.Join(getReviewReportFormats(), ", "))
+			}
+			ruleList, err := getReviewRuleList(template, config)
+			if err != nil {
+				return err
+			}
+			metadata, err := getReviewSchemaSnapshot(schema)
+			if err != nil {
+				return err
+			}
+			paths, err := getReviewFilePaths(args)
+			if err != nil {
+				return err
+			}
+
+			checkContext := advisor.SQLReviewCheckContext{
+				Charset:   charset,
+				Collation: collation,
+				DbType:    storepb.Engine(engineValue),
+				Driver:    nil,
+				Context:   context.Background(),
+			}
+			var files []*reviewFile
+			for _, path := range paths {
+				file, err := reviewSQLFile(path, ruleList, checkContext, metadata)
+				if err != nil {
+					return err
+				}
+				files = append(files, file)
+			}
+
+			out := cmd.OutOrStdout()
+			if output != "" {
+				f, err := os.Create(output)
+				if err != nil {
+					return errors.Wrapf(err, "failed to create output file %s", output)
+				}
+				defer f.Close()
+				out = f
+			}
+			if err := writeReport(out, files); err != nil {
+				return errors.Wrap(err, "failed to write report")
+			}
+
+			errorCount, warningCount := countReviewAdvice(files)
+			if errorCount > 0 || (failOnWarn && warningCount > 0) {
+				return errors.Errorf("found %d errors and %d warnings", errorCount, warningCount)
+			}
+			return nil
+		},
+	}
+
+	reviewCmd.Flags().StringVar(&engine, "engine", "", "Database engine of the SQL files, such as MYSQL, POSTGRES, TIDB, ORACLE, SNOWFLAKE, MSSQL.")
+	reviewCmd.Flags().StringVar(&template, "template", "", "SQL review template id, bb.sql-review.prod or bb.sql-review.dev. Defaults to the template of the config, or bb.sql-review.prod.")
+	reviewCmd.Flags().StringVar(&config, "config", "", "SQL review config override file in YAML format.")
+	reviewCmd.Flags().StringVar(&schema, "schema", "", "Schema snapshot file of the target database in JSON format, used by the catalog-aware rules.")
+	reviewCmd.Flags().StringVar(&format, "format", "text", "Output format, one of "+strings.Join(getReviewReportFormats(), ", ")+".")
+	reviewCmd.Flags().StringVar(&output, "output", "", "File to store the report. Output to stdout if unspecified.")
+	reviewCmd.Flags().StringVar(&charset, "charset", "utf8mb4", "Database character set.")
+	reviewCmd.Flags().StringVar(&collation, "collation", "utf8mb4_general_ci", "Database collation.")
+	reviewCmd.Flags().BoolVar(&failOnWarn, "fail-on-warning", false, "Exit with a non-zero code on warnings as well as errors.")
+	_ = reviewCmd.MarkFlagRequired("engine")
+	return reviewCmd
+}
+
+// getReviewRuleList merges the config override into the template like the /advise API of the SQL service.
+func getReviewRuleList(template string, config string) ([]*storepb.SQLReviewRule, error) {
+	override := &advisor.SQLReviewConfigOverride{}
+	if config != "" {
+		content, err := os.ReadFile(config)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read config file %s", config)
+		}
+		if err := yaml.Unmarshal(content, override); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse config file %s", config)
+		}
+	}
+	switch {
+	case template != "" && override.Template != "" && template != override.Template:
+		return nil, errors.Errorf("the config override should extend from the same template, found %s in config but got %s template", override.Template, template)
+	case template != "":
+		override.Template = template
+	case override.Template == "":
+		override.Template = defaultReviewTemplate
+	}
+	ruleList, err := advisor.MergeSQLReviewRules(override)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to merge the config for template %s", override.Template)
+	}
+	return ruleList, nil
+}
+
+// getReviewSchemaSnapshot reads the database schema metadata in protojson format.
+func getReviewSchemaSnapshot(schema string) (*storepb.DatabaseSchemaMetadata, error) {
+	if schema == "" {
+		return nil, nil
+	}
+	content, err := os.ReadFile(schema)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read schema file %s", schema)
+	}
+	metadata := &storepb.DatabaseSchemaMetadata{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(content, metadata); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse schema file %s", schema)
+	}
+	return metadata, nil
+}
+
+// getReviewFilePaths returns the files and the .sql files in the directories in order.
+func getReviewFilePaths(args []string) ([]string, error) {
+	var paths []string
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			paths = append(paths, arg)
+			continue
+		}
+		if err := filepath.WalkDir(arg, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && strings.EqualFold(filepath.Ext(path), ".sql") {
+				paths = append(paths, path)
+			}
+			return nil
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to walk directory %s", arg)
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func reviewSQLFile(path string, ruleList []*storepb.SQLReviewRule, checkContext advisor.SQLReviewCheckContext, metadata *storepb.DatabaseSchemaMetadata) (*reviewFile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read file %s", path)
+	}
+	// The catalog records the changes of the statements, so each file needs a new one.
+	finderContext := &catalog.FinderContext{CheckIntegrity: false, EngineType: checkContext.DbType, IgnoreCaseSensitive: false}
+	finder := catalog.NewEmptyFinder(finderContext)
+	if metadata != nil {
+		finderContext.CheckIntegrity = true
+		finder = catalog.NewFinder(metadata, finderContext)
+		checkContext.CurrentDatabase = metadata.Name
+	}
+	checkContext.Catalog = &reviewCatalog{finder: finder}
+
+	res, err := advisor.SQLReviewCheck(string(content), ruleList, checkContext)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to review file %s", path)
+	}
+	file := &reviewFile{path: path}
+	for _, advice := range res {
+		if advice.Status == advisor.Success {
+			continue
+		}
+		file.adviceList = append(file.adviceList, advice)
+	}
+	return file, nil
+}
+
+func countReviewAdvice(files []*reviewFile) (int, int) {
+	errorCount, warningCount := 0, 0
+	for _, file := range files {
+		for _, advice := range file.adviceList {
+			switch advice.Status {
+			case advisor.Error:
+				errorCount++
+			case advisor.Warn:
+				warningCount++
+			}
+		}
+	}
+	return errorCount, warningCount
+}
+
+// reviewCatalog is the catalog of the offline SQL review.
+type reviewCatalog struct {
+	finder *catalog.Finder
+}
+
+// GetFinder implements the catalog.Catalog interface.
+func (c *reviewCatalog) GetFinder() *catalog.Finder {
+	return c.finder
+}
+
+// reviewReportWriter writes the review result of the files in a format.
+type reviewReportWriter func(out io.Writer, files []*reviewFile) error
+
+var reviewReportWriters = map[string]reviewReportWriter{
+	"text":       writeTextReport,
+	"sarif":      writeSARIFReport,
+	"junit":      writeJUnitReport,
+	"checkstyle": writeCheckstyleReport,
+	"github":     writeGitHubReport,
+}
+
+func getReviewReportFormats() []string {
+	var formats []string
+	for format := range reviewReportWriters {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
@@ -0,0 +1,294 @@
+package cmd
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+const (
+	reviewToolName = "bytebase"
+	reviewToolURI  = "https://www.bytebase.com/docs/sql-review/review-rules"
+)
+
+func writeTextReport(out io.Writer, files []*reviewFile) error {
+	for _, file := range files {
+		for _, advice := range file.adviceList {
+			if _, err := fmt.Fprintf(out, "%s:%d:%d: %s %s: %s\n", file.path, getAdviceLine(advice), getAdviceColumn(advice), advice.Status, advice.Title, advice.Content); err != nil {
+				return err
+			}
+		}
+	}
+	errorCount, warningCount := countReviewAdvice(files)
+	_, err := fmt.Fprintf(out, "%d files reviewed, %d errors, %d warnings\n", len(files), errorCount, warningCount)
+	return err
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+func writeSARIFReport(out io.Writer, files []*reviewFile) error {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           reviewToolName,
+				InformationURI: reviewToolURI,
+				Rules:          []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+	}
+	seenRules := map[string]bool{}
+	for _, file := range files {
+		for _, advice := range file.adviceList {
+			if !seenRules[advice.Title] {
+				seenRules[advice.Title] = true
+				run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+					ID:               advice.Title,
+					ShortDescription: sarifMessage{Text: advice.Title},
+				})
+			}
+			level := "warning"
+			if advice.Status == advisor.Error {
+				level = "error"
+			}
+			run.Results = append(run.Results, sarifResult{
+				RuleID:  advice.Title,
+				Level:   level,
+				Message: sarifMessage{Text: advice.Content},
+				Locations: []sarifLocation{
+					{
+						PhysicalLocation: sarifPhysicalLocation{
+							ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(file.path)},
+							Region: sarifRegion{
+								StartLine:   getAdviceLine(advice),
+								StartColumn: advice.Column,
+							},
+						},
+					},
+				},
+			})
+		}
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifLog{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs:    []sarifRun{run},
+	})
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// writeJUnitReport writes a test case for each file, and the file fails if it has any advice.
+func writeJUnitReport(out io.Writer, files []*reviewFile) error {
+	suite := junitTestSuite{
+		Name:  "SQL Review",
+		Tests: len(files),
+	}
+	for _, file := range files {
+		testCase := junitTestCase{
+			Name:      file.path,
+			ClassName: "sql-review",
+		}
+		if len(file.adviceList) > 0 {
+			suite.Failures++
+			failureType := advisor.Warn
+			var lines []string
+			for _, advice := range file.adviceList {
+				if advice.Status == advisor.Error {
+					failureType = advisor.Error
+				}
+				lines = append(lines, fmt.Sprintf("%s:%d:%d: %s %s: %s", file.path, getAdviceLine(advice), getAdviceColumn(advice), advice.Status, advice.Title, advice.Content))
+			}
+			testCase.Failure = &junitFailure{
+				Message: fmt.Sprintf("%d problems found", len(file.adviceList)),
+				Type:    string(failureType),
+				Text:    strings.Join(lines, "\n"),
+			}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+	return writeXMLReport(out, junitTestSuites{TestSuites: []junitTestSuite{suite}})
+}
+
+type checkstyleReport struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr,omitempty"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+func writeCheckstyleReport(out io.Writer, files []*reviewFile) error {
+	report := checkstyleReport{Version: "4.3"}
+	for _, file := range files {
+		checkstyleFile := checkstyleFile{Name: file.path}
+		for _, advice := range file.adviceList {
+			severity := "warning"
+			if advice.Status == advisor.Error {
+				severity = "error"
+			}
+			checkstyleFile.Errors = append(checkstyleFile.Errors, checkstyleError{
+				Line:     getAdviceLine(advice),
+				Column:   advice.Column,
+				Severity: severity,
+				Message:  advice.Content,
+				Source:   advice.Title,
+			})
+		}
+		report.Files = append(report.Files, checkstyleFile)
+	}
+	return writeXMLReport(out, report)
+}
+
+func writeXMLReport(out io.Writer, v any) error {
+	if _, err := io.WriteString(out, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(out)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(out, "\n")
+	return err
+}
+
+// writeGitHubReport writes the GitHub Actions workflow commands to annotate the files.
+// https://docs.github.com/en/actions/using-workflows/workflow-commands-for-github-actions
+func writeGitHubReport(out io.Writer, files []*reviewFile) error {
+	for _, file := range files {
+		for _, advice := range file.adviceList {
+			command := "warning"
+			if advice.Status == advisor.Error {
+				command = "error"
+			}
+			properties := []string{
+				"file=" + escapeGitHubProperty(filepath.ToSlash(file.path)),
+				fmt.Sprintf("line=%d", getAdviceLine(advice)),
+			}
+			if advice.Column > 0 {
+				properties = append(properties, fmt.Sprintf("col=%d", advice.Column))
+			}
+			properties = append(properties, "title="+escapeGitHubProperty(advice.Title))
+			if _, err := fmt.Fprintf(out, "::%s %s::%s\n", command, strings.Join(properties, ","), escapeGitHubData(advice.Content)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func escapeGitHubData(s string) string {
+	s = strings.ReplaceAll(s, "%", "%25")
+	s = strings.ReplaceAll(s, "\r", "%0D")
+	return strings.ReplaceAll(s, "\n", "%0A")
+}
+
+func escapeGitHubProperty(s string) string {
+	s = escapeGitHubData(s)
+	s = strings.ReplaceAll(s, ":", "%3A")
+	return strings.ReplaceAll(s, ",", "%2C")
+}
+
+// getAdviceLine returns the 1-based line of the advice, because some advice is not bound to a line.
+func getAdviceLine(advice advisor.Advice) int {
+	return max(advice.Line, 1)
+}
+
+func getAdviceColumn(advice advisor.Advice) int {
+	return max(advice.Column, 1)
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+var testReviewFiles = []*reviewFile{
+	{
+		path: "migrations/1_init.sql",
+		adviceList: []advisor.Advice{
+			{
+				Status:  advisor.Error,
+				Title:   "table.drop-naming-convention",
+				Content: "`t` mismatches drop table naming convention",
+				Line:    2,
+			},
+			{
+				// The advice is not bound to a line, and the content needs to be escaped.
+				Status:  advisor.Warn,
+				Title:   "column.required",
+				Content: "Table \"t\" requires columns: id, name\n100% missing",
+				Column:  3,
+			},
+		},
+	},
+	{
+		path: "migrations/2_data.sql",
+	},
+}
+
+func TestReviewReport(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{
+			format: "text",
+			want: "migrations/1_init.sql:2:1: ERROR table.drop-naming-convention: `t` mismatches drop table naming convention\n" +
+				"migrations/1_init.sql:1:3: WARN column.required: Table \"t\" requires columns: id, name\n100% missing\n" +
+				"2 files reviewed, 1 errors, 1 warnings\n",
+		},
+		{
+			format: "junit",
+			want: `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="SQL Review" tests="2" failures="1">
+    <testcase name="migrations/1_init.sql" classname="sql-review">
+      <failure message="2 problems found" type="ERROR">migrations/1_init.sql:2:1: ERROR table.drop-naming-convention: ` + "`t`" + ` mismatches drop table naming convention&#xA;migrations/1_init.sql:1:3: WARN column.required: Table &#34;t&#34; requires columns: id, name&#xA;100% missing</failure>
+    </testcase>
+    <testcase name="migrations/2_data.sql" classname="sql-review"></testcase>
+  </testsuite>
+</testsuites>
+`,
+		},
+		{
+			format: "checkstyle",
+			want: `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="4.3">
+  <file name="migrations/1_init.sql">
+    <error line="2" severity="error" message="` + "`t`" + ` mismatches drop table naming convention" source="table.drop-naming-convention"></error>
+    <error line="1" column="3" severity="warning" message="Table &#34;t&#34; requires columns: id, name&#xA;100% missing" source="column.required"></error>
+  </file>
+  <file name="migrations/2_data.sql"></file>
+</checkstyle>
+`,
+		},
+		{
+			format: "github",
+			want: "::error file=migrations/1_init.sql,line=2,title=table.drop-naming-convention::`t` mismatches drop table naming convention\n" +
+				"::warning file=migrations/1_init.sql,line=1,col=3,title=column.required::Table \"t\" requires columns: id, name%0A100%25 missing\n",
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		var buf strings.Builder
+		a.NoError(reviewReportWriters[test.format](&buf, testReviewFiles), test.format)
+		a.Equal(test.want, buf.String(), test.format)
+	}
+}
+
+func TestSARIFReport(t *testing.T) {
+	a := require.New(t)
+	var buf strings.Builder
+	a.NoError(writeSARIFReport(&buf, testReviewFiles))
+
+	var report sarifLog
+	a.NoError(json.Unmarshal([]byte(buf.String()), &report))
+	a.Equal("2.1.0", report.Version)
+	a.Len(report.Runs, 1)
+	run := report.Runs[0]
+	a.Equal([]sarifRule{
+		{ID: "table.drop-naming-convention", ShortDescription: sarifMessage{Text: "table.drop-naming-convention"}},
+		{ID: "column.required", ShortDescription: sarifMessage{Text: "column.required"}},
+	}, run.Tool.Driver.Rules)
+	a.Equal([]sarifResult{
+		{
+			RuleID:  "table.drop-naming-convention",
+			Level:   "error",
+			Message: sarifMessage{Text: "`t` mismatches drop table naming convention"},
+			Locations: []sarifLocation{
+				{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: "migrations/1_init.sql"},
+						Region:           sarifRegion{StartLine: 2},
+					},
+				},
+			},
+		},
+		{
+			RuleID:  "column.required",
+			Level:   "warning",
+			Message: sarifMessage{Text: "Table \"t\" requires columns: id, name\n100% missing"},
+			Locations: []sarifLocation{
+				{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: "migrations/1_init.sql"},
+						Region:           sarifRegion{StartLine: 1, StartColumn: 3},
+					},
+				},
+			},
+		},
+	}, run.Results)
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewCmd(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"create.sql": "CREATE TABLE tech_book (id int NOT NULL, name text NOT NULL, PRIMARY KEY (id));\n",
+		"drop.sql":   "DROP TABLE tech_book;\n",
+		// The view depends on the table, so dropping the table is an error.
+		"schema.json": `{"name": "db", "schemas": [{"name": "public", "tables": [{"name": "tech_book", "columns": [{"name": "id", "type": "int"}]}], "views": [{"name": "book_view", "definition": "SELECT id FROM tech_book", "dependentColumns": [{"schema": "public", "table": "tech_book", "column": "id"}]}]}]}`,
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+	createFile, dropFile, schemaFile := filepath.Join(dir, "create.sql"), filepath.Join(dir, "drop.sql"), filepath.Join(dir, "schema.json")
+
+	tests := []struct {
+		args []string
+		// wantErr is the error of the command, which makes bb exit with a non-zero code.
+		wantErr    string
+		wantOutput []string
+	}{
+		{
+			// Warnings only.
+			args:       []string{"--engine", "postgres", createFile},
+			wantOutput: []string{"WARN column.require-default", "1 files reviewed, 0 errors, 5 warnings"},
+		},
+		{
+			args:       []string{"--engine", "postgres", "--fail-on-warning", createFile},
+			wantErr:    "found 0 errors and 5 warnings",
+			wantOutput: []string{"1 files reviewed, 0 errors, 5 warnings"},
+		},
+		{
+			args:       []string{"--engine", "postgres", dropFile},
+			wantErr:    "found 1 errors and 1 warnings",
+			wantOutput: []string{"ERROR table.drop-naming-convention"},
+		},
+		{
+			// The view definition cannot be read offline.
+			args:       []string{"--engine", "postgres", "--schema", schemaFile, dropFile},
+			wantErr:    "found 1 errors and 0 warnings",
+			wantOutput: []string{`ERROR Table is referenced by view: Cannot drop table "public"."tech_book", it's referenced by view: "public"."book_view"`},
+		},
+		{
+			// The directory is walked for the .sql files.
+			args:       []string{"--engine", "postgres", "--format", "github", dir},
+			wantErr:    "found 1 errors and 6 warnings",
+			wantOutput: []string{"::warning file=" + filepath.ToSlash(createFile), "::error file=" + filepath.ToSlash(dropFile)},
+		},
+		{
+			args:    []string{"--engine", "postgres", "--format", "html", createFile},
+			wantErr: `invalid format "html"`,
+		},
+		{
+			args:    []string{"--engine", "unknown", createFile},
+			wantErr: `invalid engine "unknown"`,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		cmd := newReviewCmd()
+		var out strings.Builder
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(test.args)
+		err := cmd.Execute()
+		name := strings.Join(test.args, " ")
+		if test.wantErr == "" {
+			a.NoError(err, name)
+		} else {
+			a.ErrorContains(err, test.wantErr, name)
+		}
+		for _, want := range test.wantOutput {
+			a.Contains(out.String(), want, name)
+		}
+	}
+}
@@ -31,6 +31,10 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/snowflake"
 	// Register mssql advisor.
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/mssql"
+	// Register cockroachdb advisor.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/cockroachdb"
+	// Register redis advisor.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/redis"
 
 	// Register postgres parser driver.
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/sql/engine/pg"
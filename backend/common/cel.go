@@ -2,6 +2,7 @@ package common
 
 import (
 	"encoding/base64"
+	"reflect"
 	"time"
 
 	"github.com/google/cel-go/cel"
@@ -40,6 +41,31 @@ var ApprovalFactors = []cel.EnvOption{
 	cel.ParserExpressionSizeLimit(celLimit),
 }
 
+// ApproverFactors are the variables when resolving the dynamic approvers of the approval nodes.
+var ApproverFactors = []cel.EnvOption{
+	// The email of the issue creator.
+	cel.Variable("issue_creator", cel.StringType),
+	// The emails of the owners of the issue project.
+	cel.Variable("project_owners", cel.ListType(cel.StringType)),
+	// The labels of each database the issue targets.
+	cel.Variable("database_labels", cel.ListType(cel.MapType(cel.StringType, cel.StringType))),
+	cel.ParserExpressionSizeLimit(celLimit),
+}
+
+// SQLReviewRuleFactors are the variables when evaluating the condition of the custom SQL review rules for each statement.
+var SQLReviewRuleFactors = []cel.EnvOption{
+	// The statement type, such as CREATE_TABLE, ALTER_TABLE, DROP_TABLE, INSERT, UPDATE and DELETE.
+	cel.Variable("statement_type", cel.StringType),
+	cel.Variable("statement_text", cel.StringType),
+	cel.Variable("database_name", cel.StringType),
+	cel.Variable("database_labels", cel.MapType(cel.StringType, cel.StringType)),
+	// The tables the statement touches, with the keys schema, name, exists, comment, classification and columns.
+	cel.Variable("tables", cel.ListType(cel.MapType(cel.StringType, cel.DynType))),
+	// The columns the statement creates, alters or drops, with the keys schema, table, name, action, type, nullable and has_default.
+	cel.Variable("columns", cel.ListType(cel.MapType(cel.StringType, cel.DynType))),
+	cel.ParserExpressionSizeLimit(celLimit),
+}
+
 // IAMPolicyConditionCELAttributes are the variables when evaluating IAM policy condition.
 var IAMPolicyConditionCELAttributes = []cel.EnvOption{
 	cel.Variable("resource.environment_name", cel.StringType),
@@ -311,3 +337,90 @@ func doEvalBindingCondition(expr string, input map[string]any) (bool, error) {
 	}
 	return res, nil
 }
+
+// ValidateApproverExpression validates the approver expression, which returns the approver emails in a string or a list of strings.
+func ValidateApproverExpression(expr string) (cel.Program, error) {
+	e, err := cel.NewEnv(ApproverFactors...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	ast, issues := e.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, status.Errorf(codes.InvalidArgument, issues.Err().Error())
+	}
+	if outputType := ast.OutputType(); !outputType.IsExactType(cel.StringType) && !outputType.IsExactType(cel.ListType(cel.StringType)) {
+		return nil, status.Errorf(codes.InvalidArgument, "approver expression must return a string or a list of strings, but got %v", outputType)
+	}
+	prog, err := e.Program(ast)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return prog, nil
+}
+
+// EvalApproverExpression evaluates the approver expression and returns the deduplicated approver emails.
+func EvalApproverExpression(expr string, input map[string]any) ([]string, error) {
+	prog, err := ValidateApproverExpression(expr)
+	if err != nil {
+		return nil, err
+	}
+	out, _, err := prog.Eval(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to eval approver expression %q", expr)
+	}
+	var emails []string
+	switch v := out.Value().(type) {
+	case string:
+		emails = []string{v}
+	default:
+		native, err := out.ConvertToNative(reflect.TypeOf([]string{}))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert approver expression result %v", out)
+		}
+		emails, _ = native.([]string)
+	}
+
+	var result []string
+	seen := map[string]bool{}
+	for _, email := range emails {
+		if email == "" || seen[email] {
+			continue
+		}
+		seen[email] = true
+		result = append(result, email)
+	}
+	return result, nil
+}
+
+// ValidateSQLReviewRuleExpression validates the condition of the custom SQL review rule, which must return a bool.
+func ValidateSQLReviewRuleExpression(expr string) (cel.Program, error) {
+	e, err := cel.NewEnv(SQLReviewRuleFactors...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	ast, issues := e.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, status.Errorf(codes.InvalidArgument, issues.Err().Error())
+	}
+	if outputType := ast.OutputType(); !outputType.IsExactType(cel.BoolType) {
+		return nil, status.Errorf(codes.InvalidArgument, "SQL review rule expression must return a bool, but got %v", outputType)
+	}
+	prog, err := e.Program(ast)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return prog, nil
+}
+
+// EvalSQLReviewRuleExpression evaluates the program of the custom SQL review rule against the statement.
+func EvalSQLReviewRuleExpression(prog cel.Program, input map[string]any) (bool, error) {
+	out, _, err := prog.Eval(input)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to eval SQL review rule expression")
+	}
+	res, ok := out.Value().(bool)
+	if !ok {
+		return false, errors.Errorf("failed to convert SQL review rule expression result %v to bool", out)
+	}
+	return res, nil
+}
@@ -49,6 +49,16 @@ const (
 	TaskTypeDropPrimaryKey Code = 408
 	TaskTypeDropForeignKey Code = 409
 	TaskTypeDropCheck      Code = 410
+
+	// 501 statement lock impact.
+	LockImpactTableRewrite Code = 501
+	LockImpactTableScan    Code = 502
+	LockImpactBlockingLock Code = 503
+	LockImpactSyntaxError  Code = 504
+
+	// 601 DML dry run.
+	DMLDryRunStatementFailed      Code = 601
+	DMLDryRunUnsupportedStatement Code = 602
 )
 
 // Int returns the int type of code.
@@ -35,6 +35,8 @@ const (
 	WebhookIDPrefix              = "webhooks/"
 	SheetIDPrefix                = "sheets/"
 	WorksheetIDPrefix            = "worksheets/"
+	WorksheetRevisionPrefix      = "revisions/"
+	WorksheetCommentPrefix       = "comments/"
 	DatabaseGroupNamePrefix      = "databaseGroups/"
 	SchemaGroupNamePrefix        = "schemaGroups/"
 	SchemaNamePrefix             = "schemas/"
@@ -46,6 +48,8 @@ const (
 	BranchPrefix                 = "branches/"
 	DeploymentConfigPrefix       = "deploymentConfigs/"
 	ChangelistsPrefix            = "changelists/"
+	SensitiveDataProposalPrefix  = "sensitiveDataProposals/"
+	QuerySessionPrefix           = "querySessions/"
 
 	BackupSettingSuffix   = "/backupSetting"
 	SchemaSuffix          = "/schema"
@@ -208,6 +212,20 @@ func GetInstanceDatabaseIDSecretName(name string) (string, string, string, error
 	return tokens[0], tokens[1], tokens[2], nil
 }
 
+// GetInstanceDatabaseIDSensitiveDataProposal returns the instance ID, database ID, and sensitive data proposal UID from a resource name.
+func GetInstanceDatabaseIDSensitiveDataProposal(name string) (string, string, int, error) {
+	// the name should be instances/{instance-id}/databases/{database-id}/sensitiveDataProposals/{proposal-uid}
+	tokens, err := GetNameParentTokens(name, InstanceNamePrefix, DatabaseIDPrefix, SensitiveDataProposalPrefix)
+	if err != nil {
+		return "", "", 0, err
+	}
+	uid, err := strconv.Atoi(tokens[2])
+	if err != nil {
+		return "", "", 0, errors.Errorf("invalid sensitive data proposal uid %q", tokens[2])
+	}
+	return tokens[0], tokens[1], uid, nil
+}
+
 // GetInstanceDatabaseIDBackupName returns the instance ID, database ID, and backup name from a resource name.
 func GetInstanceDatabaseIDBackupName(name string) (string, string, string, error) {
 	tokens, err := GetNameParentTokens(name, InstanceNamePrefix, DatabaseIDPrefix, BackupPrefix)
@@ -231,6 +249,15 @@ func GetUserEmail(name string) (string, error) {
 	return tokens[0], nil
 }
 
+// GetQuerySessionID returns the query session ID from a resource name.
+func GetQuerySessionID(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, QuerySessionPrefix)
+	if err != nil {
+		return "", err
+	}
+	return tokens[0], nil
+}
+
 // GetSettingName returns the setting name from a resource name.
 func GetSettingName(name string) (string, error) {
 	token, err := GetNameParentTokens(name, SettingNamePrefix)
@@ -498,6 +525,42 @@ func GetWorksheetUID(name string) (int, error) {
 	return sheetUID, nil
 }
 
+// GetWorksheetUIDRevision returns the worksheet UID and revision from a resource name.
+func GetWorksheetUIDRevision(name string) (int, int, error) {
+	// the name should be worksheets/{worksheet-uid}/revisions/{revision}
+	tokens, err := GetNameParentTokens(name, WorksheetIDPrefix, WorksheetRevisionPrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	sheetUID, err := strconv.Atoi(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to convert sheet uid %q to int", tokens[0])
+	}
+	revision, err := strconv.Atoi(tokens[1])
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to convert revision %q to int", tokens[1])
+	}
+	return sheetUID, revision, nil
+}
+
+// GetWorksheetUIDCommentUID returns the worksheet UID and comment UID from a resource name.
+func GetWorksheetUIDCommentUID(name string) (int, int, error) {
+	// the name should be worksheets/{worksheet-uid}/comments/{comment-uid}
+	tokens, err := GetNameParentTokens(name, WorksheetIDPrefix, WorksheetCommentPrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	sheetUID, err := strconv.Atoi(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to convert sheet uid %q to int", tokens[0])
+	}
+	commentUID, err := strconv.Atoi(tokens[1])
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to convert comment uid %q to int", tokens[1])
+	}
+	return sheetUID, commentUID, nil
+}
+
 var branchRegexp = regexp.MustCompile("^projects/([^/]+)/branches/(.+)$")
 
 // GetProjectAndBranchID returns the project and branch ID from a resource name.
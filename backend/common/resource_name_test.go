@@ -66,3 +66,21 @@ func TestGetRiskID(t *testing.T) {
 		a.Equal(test.want, got)
 	}
 }
+
+func TestGetWorksheetUIDRevision(t *testing.T) {
+	sheetUID, revision, err := GetWorksheetUIDRevision("worksheets/101/revisions/3")
+	require.NoError(t, err)
+	require.Equal(t, 101, sheetUID)
+	require.Equal(t, 3, revision)
+
+	_, _, err = GetWorksheetUIDRevision("worksheets/101/revisions/latest")
+	require.Error(t, err)
+
+	sheetUID, commentUID, err := GetWorksheetUIDCommentUID("worksheets/101/comments/102")
+	require.NoError(t, err)
+	require.Equal(t, 101, sheetUID)
+	require.Equal(t, 102, commentUID)
+
+	_, _, err = GetWorksheetUIDCommentUID("worksheets/101/revisions/3")
+	require.Error(t, err)
+}
@@ -684,33 +684,9 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *store.Activit
 
 		node := pendingStep.Nodes[0]
 
-		var usersGetter func(ctx context.Context) ([]*store.UserMessage, error)
-
-		switch val := node.Payload.(type) {
-		case *storepb.ApprovalNode_GroupValue_:
-			switch val.GroupValue {
-			case storepb.ApprovalNode_GROUP_VALUE_UNSPECIFILED:
-				return nil, errors.Errorf("invalid group value")
-			case storepb.ApprovalNode_WORKSPACE_OWNER:
-				usersGetter = getUsersFromWorkspaceRole(m.store, api.WorkspaceAdmin)
-			case storepb.ApprovalNode_WORKSPACE_DBA:
-				usersGetter = getUsersFromWorkspaceRole(m.store, api.WorkspaceDBA)
-			case storepb.ApprovalNode_PROJECT_OWNER:
-				usersGetter = getUsersFromProjectRole(m.store, api.ProjectOwner, meta.Issue.Project.ResourceID)
-			case storepb.ApprovalNode_PROJECT_MEMBER:
-				usersGetter = getUsersFromProjectRole(m.store, api.ProjectDeveloper, meta.Issue.Project.ResourceID)
-			default:
-				return nil, errors.Errorf("invalid group value")
-			}
-		case *storepb.ApprovalNode_Role:
-			role := api.Role(strings.TrimPrefix(val.Role, "roles/"))
-			usersGetter = getUsersFromProjectRole(m.store, role, meta.Issue.Project.ResourceID)
-		case *storepb.ApprovalNode_ExternalNodeId:
-			usersGetter = func(ctx context.Context) ([]*store.UserMessage, error) {
-				return nil, nil
-			}
-		default:
-			return nil, errors.Errorf("invalid node payload type")
+		usersGetter, err := getUsersFromApprovalNode(m.store, node, meta.Issue.Project.ResourceID)
+		if err != nil {
+			return nil, err
 		}
 
 		users, err := usersGetter(ctx)
@@ -761,6 +737,52 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *store.Activit
 	return &webhookCtx, nil
 }
 
+// GetApprovalNodeUsers returns the users who can approve the approval node in the project.
+func GetApprovalNodeUsers(ctx context.Context, s *store.Store, node *storepb.ApprovalNode, projectID string) ([]*store.UserMessage, error) {
+	usersGetter, err := getUsersFromApprovalNode(s, node, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return usersGetter(ctx)
+}
+
+func getUsersFromApprovalNode(s *store.Store, node *storepb.ApprovalNode, projectID string) (func(context.Context) ([]*store.UserMessage, error), error) {
+	switch val := node.Payload.(type) {
+	case *storepb.ApprovalNode_GroupValue_:
+		switch val.GroupValue {
+		case storepb.ApprovalNode_GROUP_VALUE_UNSPECIFILED:
+			return nil, errors.Errorf("invalid group value")
+		case storepb.ApprovalNode_WORKSPACE_OWNER:
+			return getUsersFromWorkspaceRole(s, api.WorkspaceAdmin), nil
+		case storepb.ApprovalNode_WORKSPACE_DBA:
+			return getUsersFromWorkspaceRole(s, api.WorkspaceDBA), nil
+		case storepb.ApprovalNode_PROJECT_OWNER:
+			return getUsersFromProjectRole(s, api.ProjectOwner, projectID), nil
+		case storepb.ApprovalNode_PROJECT_MEMBER:
+			return getUsersFromProjectRole(s, api.ProjectDeveloper, projectID), nil
+		default:
+			return nil, errors.Errorf("invalid group value")
+		}
+	case *storepb.ApprovalNode_Role:
+		role := api.Role(strings.TrimPrefix(val.Role, "roles/"))
+		return getUsersFromProjectRole(s, role, projectID), nil
+	case *storepb.ApprovalNode_ExternalNodeId:
+		return func(ctx context.Context) ([]*store.UserMessage, error) {
+			return nil, nil
+		}, nil
+	case *storepb.ApprovalNode_Users_:
+		var emails []string
+		for _, member := range val.Users.GetMembers() {
+			emails = append(emails, strings.TrimPrefix(member, common.UserNamePrefix))
+		}
+		return getUsersFromEmails(s, emails), nil
+	case *storepb.ApprovalNode_ApproverExpression:
+		return getUsersFromEmails(s, node.ResolvedApprovers), nil
+	default:
+		return nil, errors.Errorf("invalid node payload type")
+	}
+}
+
 func getUsersFromWorkspaceRole(s *store.Store, role api.Role) func(context.Context) ([]*store.UserMessage, error) {
 	return func(ctx context.Context) ([]*store.UserMessage, error) {
 		return s.ListUsers(ctx, &store.FindUserMessage{
@@ -788,6 +810,24 @@ func getUsersFromProjectRole(s *store.Store, role api.Role, projectID string) fu
 	}
 }
 
+func getUsersFromEmails(s *store.Store, emails []string) func(context.Context) ([]*store.UserMessage, error) {
+	return func(ctx context.Context) ([]*store.UserMessage, error) {
+		var users []*store.UserMessage
+		for _, email := range emails {
+			email := email
+			user, err := s.GetUser(ctx, &store.FindUserMessage{Email: &email})
+			if err != nil {
+				return nil, err
+			}
+			if user == nil {
+				continue
+			}
+			users = append(users, user)
+		}
+		return users, nil
+	}
+}
+
 func getUsersFromUsers(users ...*store.UserMessage) func(context.Context) ([]*store.UserMessage, error) {
 	return func(_ context.Context) ([]*store.UserMessage, error) {
 		return users, nil
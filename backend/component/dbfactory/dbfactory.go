@@ -3,8 +3,15 @@ package dbfactory
 
 import (
 	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/secret"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -20,6 +27,11 @@ type DBFactory struct {
 	mongoBinDir string
 	dataDir     string
 	secret      string
+
+	// pool keeps the open drivers for reuse.
+	pool *driverPool
+	// router routes the read-only queries to the read-only data sources.
+	router *replicaRouter
 }
 
 // New creates a new database driver factory.
@@ -30,11 +42,51 @@ func New(mysqlBinDir, mongoBinDir, pgBinDir, dataDir, secret string) *DBFactory
 		pgBinDir:    pgBinDir,
 		dataDir:     dataDir,
 		secret:      secret,
+		pool:        newDriverPool(),
+		router:      newReplicaRouter(),
 	}
 }
 
+// Run closes the idle drivers periodically, and closes the pool on shutdown.
+func (d *DBFactory) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(evictInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("Driver pool evictor started")
+	for {
+		select {
+		case <-ticker.C:
+			d.pool.evictIdle()
+		case <-ctx.Done():
+			d.pool.close()
+			return
+		}
+	}
+}
+
+// GetPoolStats returns the statistics of the driver pool.
+func (d *DBFactory) GetPoolStats() *PoolStats {
+	return d.pool.stats()
+}
+
+// InvalidateDataSource closes the pooled drivers of the data source, e.g. after the credentials are changed.
+// The drivers in use are closed when they are returned.
+func (d *DBFactory) InvalidateDataSource(instanceID, dataSourceID string) {
+	d.pool.invalidate(instanceID, func(key poolKey) bool {
+		return key.dataSourceID == dataSourceID
+	})
+}
+
+// InvalidateInstance closes the pooled drivers of the instance.
+func (d *DBFactory) InvalidateInstance(instanceID string) {
+	d.pool.invalidate(instanceID, func(poolKey) bool {
+		return true
+	})
+}
+
 // GetAdminDatabaseDriver gets the admin database driver using the instance's admin data source.
-// Upon successful return, caller must call driver.Close(). Otherwise, it will leak the database connection.
+// The driver is lent from the pool, and caller must call driver.Close() upon successful return to return the driver to the pool.
+// Otherwise, it will leak the database connection.
 func (d *DBFactory) GetAdminDatabaseDriver(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, connectionContext db.ConnectionContext) (db.Driver, error) {
 	dataSource := utils.DataSourceFromInstanceWithType(instance, api.Admin)
 	if dataSource == nil {
@@ -62,17 +114,22 @@ func (d *DBFactory) GetAdminDatabaseDriver(ctx context.Context, instance *store.
 	if instance.Options != nil && instance.Options.SchemaTenantMode {
 		schemaTenantMode = true
 	}
-	return d.GetDataSourceDriver(ctx, instance, dataSource, databaseName, datashare, false /* readOnly */, schemaTenantMode, connectionContext)
+	return d.getPooledDataSourceDriver(ctx, instance, dataSource, databaseName, datashare, false /* readOnly */, schemaTenantMode, connectionContext)
 }
 
 // GetReadOnlyDatabaseDriver gets the read-only database driver using the instance's read-only data source.
 // If the read-only data source is not defined, we will fallback to admin data source.
-// Upon successful return, caller must call driver.Close(). Otherwise, it will leak the database connection.
+// The driver is lent from the pool, and caller must call driver.Close() upon successful return to return the driver to the pool.
+// Otherwise, it will leak the database connection.
 func (d *DBFactory) GetReadOnlyDatabaseDriver(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, dataSourceID string) (db.Driver, error) {
-	dataSource, databaseName, err := d.GetReadOnlyDatabaseSource(instance, database, dataSourceID)
-	if err != nil {
-		return nil, err
-	}
+	driver, _, err := d.RouteReadOnlyDatabaseDriver(ctx, instance, database, dataSourceID)
+	return driver, err
+}
+
+// RouteReadOnlyDatabaseDriver is the same as GetReadOnlyDatabaseDriver, but also returns the data source of the driver.
+// If the data source ID is not specified, the read-only data source is chosen by the read-only routing policy of the instance.
+// The read-only data sources failing to connect or lagging behind are skipped.
+func (d *DBFactory) RouteReadOnlyDatabaseDriver(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, dataSourceID string) (db.Driver, *store.DataSourceMessage, error) {
 	schemaTenantMode := false
 	if instance.Options != nil && instance.Options.SchemaTenantMode {
 		schemaTenantMode = true
@@ -81,7 +138,73 @@ func (d *DBFactory) GetReadOnlyDatabaseDriver(ctx context.Context, instance *sto
 	if database != nil {
 		dataShare = database.DataShare
 	}
-	return d.GetDataSourceDriver(ctx, instance, dataSource, databaseName, dataShare, true /* readOnly */, schemaTenantMode, db.ConnectionContext{})
+	policy := instance.Options.GetReadOnlyRoutingPolicy()
+	maxReplicationLag := policy.GetMaxReplicationLag().AsDuration()
+	readOnlyDataSources := utils.DataSourcesFromInstanceWithType(instance, api.RO)
+	if dataSourceID != "" || len(readOnlyDataSources) == 0 || (len(readOnlyDataSources) == 1 && maxReplicationLag <= 0) {
+		dataSource, databaseName, err := d.GetReadOnlyDatabaseSource(instance, database, dataSourceID)
+		if err != nil {
+			return nil, nil, err
+		}
+		driver, err := d.getPooledDataSourceDriver(ctx, instance, dataSource, databaseName, dataShare, true /* readOnly */, schemaTenantMode, db.ConnectionContext{})
+		if err != nil {
+			return nil, nil, err
+		}
+		return driver, dataSource, nil
+	}
+
+	var errs error
+	orderedDataSources := d.router.order(instance.ResourceID, readOnlyDataSources, policy.GetStrategy(), func(dataSourceID string) int {
+		return d.pool.inUseCount(instance.ResourceID, dataSourceID)
+	})
+	for _, readOnlyDataSource := range orderedDataSources {
+		dataSource, databaseName := getDatabaseDataSource(instance, database, readOnlyDataSource)
+		if d.router.isUnreachable(instance.ResourceID, dataSource.ID) {
+			errs = multierr.Append(errs, errors.Errorf("skip data source %q failing to connect recently", dataSource.ID))
+			continue
+		}
+		driver, err := d.getPooledDataSourceDriver(ctx, instance, dataSource, databaseName, dataShare, true /* readOnly */, schemaTenantMode, db.ConnectionContext{})
+		if err != nil {
+			if ctx.Err() == nil {
+				d.router.setUnreachable(instance.ResourceID, dataSource.ID, true)
+			}
+			errs = multierr.Append(errs, errors.Wrapf(err, "failed to connect data source %q", dataSource.ID))
+			continue
+		}
+		d.router.setUnreachable(instance.ResourceID, dataSource.ID, false)
+		if maxReplicationLag > 0 {
+			lag, err := d.router.getLag(ctx, instance.ResourceID, dataSource.ID, func(ctx context.Context) (time.Duration, error) {
+				return getReplicationLag(ctx, instance.Engine, driver.GetDB())
+			})
+			if errors.Is(err, errReplicationLagUnknown) && !policy.GetSkipUnknownReplicationLag() {
+				slog.Debug("Route the read-only query to the data source with unknown replication lag", slog.String("instance", instance.ResourceID), slog.String("dataSource", dataSource.ID), log.BBError(err))
+				lag, err = 0, nil
+			}
+			if err == nil && lag > maxReplicationLag {
+				err = errors.Errorf("replication lag %v exceeds %v", lag, maxReplicationLag)
+			}
+			if err != nil {
+				driver.Close(ctx)
+				errs = multierr.Append(errs, errors.Wrapf(err, "skip data source %q", dataSource.ID))
+				continue
+			}
+		}
+		return driver, dataSource, nil
+	}
+
+	if policy.GetFallbackToAdmin() {
+		adminDataSource := utils.DataSourceFromInstanceWithType(instance, api.Admin)
+		if adminDataSource != nil {
+			slog.Debug("Route the read-only query to the admin data source", slog.String("instance", instance.ResourceID), log.BBError(errs))
+			dataSource, databaseName := getDatabaseDataSource(instance, database, adminDataSource)
+			driver, err := d.getPooledDataSourceDriver(ctx, instance, dataSource, databaseName, dataShare, true /* readOnly */, schemaTenantMode, db.ConnectionContext{})
+			if err != nil {
+				return nil, nil, err
+			}
+			return driver, dataSource, nil
+		}
+	}
+	return nil, nil, errors.Wrapf(errs, "no read-only data source is available for instance %q", instance.Title)
 }
 
 // GetReadOnlyDatabaseSource returns the read-only data source for the given instance and database.
@@ -106,6 +229,12 @@ func (*DBFactory) GetReadOnlyDatabaseSource(instance *store.InstanceMessage, dat
 		return nil, "", common.Errorf(common.Internal, "data source not found for instance %q", instance.Title)
 	}
 
+	dataSource, databaseName := getDatabaseDataSource(instance, database, dataSource)
+	return dataSource, databaseName, nil
+}
+
+// getDatabaseDataSource returns the data source and the database name to connect the database.
+func getDatabaseDataSource(instance *store.InstanceMessage, database *store.DatabaseMessage, dataSource *store.DataSourceMessage) (*store.DataSourceMessage, string) {
 	databaseName := ""
 	if database != nil {
 		databaseName = database.DatabaseName
@@ -120,12 +249,59 @@ func (*DBFactory) GetReadOnlyDatabaseSource(instance *store.InstanceMessage, dat
 		dataSource.SID = ""
 		databaseName = database.DatabaseName
 	}
-
-	return dataSource, databaseName, nil
+	return dataSource, databaseName
 }
 
-// GetDataSourceDriver returns the database driver for a data source.
+// GetDataSourceDriver opens a new database driver for a data source without the pool.
+// It's used for testing the connection of the data sources being created or updated.
+// Upon successful return, caller must call driver.Close(). Otherwise, it will leak the database connection.
 func (d *DBFactory) GetDataSourceDriver(ctx context.Context, instance *store.InstanceMessage, dataSource *store.DataSourceMessage, databaseName string, datashare, readOnly bool, schemaTenantMode bool, connectionContext db.ConnectionContext) (db.Driver, error) {
+	driverConfig, connectionConfig, err := d.getDriverConfig(instance, dataSource, databaseName, datashare, readOnly, schemaTenantMode, connectionContext)
+	if err != nil {
+		return nil, err
+	}
+	return db.Open(ctx, instance.Engine, driverConfig, connectionConfig)
+}
+
+// getPooledDataSourceDriver gets an idle driver from the pool, or opens a new one if there is none.
+// The engines locking the database files, such as DuckDB and SQLite, are not pooled, because an idle read-write driver
+// keeps the file lock and blocks the drivers of other keys opening the same file.
+func (d *DBFactory) getPooledDataSourceDriver(ctx context.Context, instance *store.InstanceMessage, dataSource *store.DataSourceMessage, databaseName string, datashare, readOnly bool, schemaTenantMode bool, connectionContext db.ConnectionContext) (db.Driver, error) {
+	driverConfig, connectionConfig, err := d.getDriverConfig(instance, dataSource, databaseName, datashare, readOnly, schemaTenantMode, connectionContext)
+	if err != nil {
+		return nil, err
+	}
+	switch instance.Engine {
+	case storepb.Engine_DUCKDB, storepb.Engine_SQLITE:
+		return db.Open(ctx, instance.Engine, driverConfig, connectionConfig)
+	}
+	fingerprint, err := getFingerprint(connectionConfig, driverConfig)
+	if err != nil {
+		return nil, err
+	}
+	key := poolKey{
+		instanceID:         instance.ResourceID,
+		dataSourceID:       dataSource.ID,
+		databaseName:       connectionConfig.Database,
+		connectionDatabase: connectionConfig.ConnectionDatabase,
+		readOnly:           readOnly,
+		schemaTenantMode:   schemaTenantMode,
+		connectionContext:  connectionContext,
+	}
+	return d.pool.get(ctx, key, fingerprint, func() (db.Driver, error) {
+		// The pooled driver outlives the request, so the driver must not be bound to the request context.
+		driver, err := db.Open(context.WithoutCancel(ctx), instance.Engine, driverConfig, connectionConfig)
+		if err != nil {
+			return nil, err
+		}
+		if sqlDB := driver.GetDB(); sqlDB != nil {
+			sqlDB.SetMaxIdleConns(maxIdleConnsPerDriver)
+		}
+		return driver, nil
+	})
+}
+
+func (d *DBFactory) getDriverConfig(instance *store.InstanceMessage, dataSource *store.DataSourceMessage, databaseName string, datashare, readOnly bool, schemaTenantMode bool, connectionContext db.ConnectionContext) (db.DriverConfig, db.ConnectionConfig, error) {
 	dbBinDir := ""
 	switch instance.Engine {
 	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
@@ -146,31 +322,31 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, instance *store.Ins
 	}
 	password, err := common.Unobfuscate(dataSource.ObfuscatedPassword, d.secret)
 	if err != nil {
-		return nil, err
+		return db.DriverConfig{}, db.ConnectionConfig{}, err
 	}
 	sslCA, err := common.Unobfuscate(dataSource.ObfuscatedSslCa, d.secret)
 	if err != nil {
-		return nil, err
+		return db.DriverConfig{}, db.ConnectionConfig{}, err
 	}
 	sslCert, err := common.Unobfuscate(dataSource.ObfuscatedSslCert, d.secret)
 	if err != nil {
-		return nil, err
+		return db.DriverConfig{}, db.ConnectionConfig{}, err
 	}
 	sslKey, err := common.Unobfuscate(dataSource.ObfuscatedSslKey, d.secret)
 	if err != nil {
-		return nil, err
+		return db.DriverConfig{}, db.ConnectionConfig{}, err
 	}
 	sshPassword, err := common.Unobfuscate(dataSource.SSHObfuscatedPassword, d.secret)
 	if err != nil {
-		return nil, err
+		return db.DriverConfig{}, db.ConnectionConfig{}, err
 	}
 	sshPrivateKey, err := common.Unobfuscate(dataSource.SSHObfuscatedPrivateKey, d.secret)
 	if err != nil {
-		return nil, err
+		return db.DriverConfig{}, db.ConnectionConfig{}, err
 	}
 	updatedPassword, err := secret.ReplaceExternalSecret(password)
 	if err != nil {
-		return nil, err
+		return db.DriverConfig{}, db.ConnectionConfig{}, err
 	}
 	password = updatedPassword
 	sshConfig := db.SSHConfig{
@@ -182,38 +358,32 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, instance *store.Ins
 	}
 	connectionContext.InstanceID = instance.ResourceID
 	connectionContext.EngineVersion = instance.EngineVersion
-	driver, err := db.Open(
-		ctx,
-		instance.Engine,
-		db.DriverConfig{
-			DbBinDir:  dbBinDir,
-			BinlogDir: common.GetBinlogAbsDir(d.dataDir, instance.UID),
-		},
-		db.ConnectionConfig{
-			Username: dataSource.Username,
-			Password: password,
-			TLSConfig: db.TLSConfig{
-				SslCA:   sslCA,
-				SslCert: sslCert,
-				SslKey:  sslKey,
-			},
-			Host:                   dataSource.Host,
-			Port:                   dataSource.Port,
-			Database:               databaseName,
-			ConnectionDatabase:     connectionDatabase,
-			SRV:                    dataSource.SRV,
-			AuthenticationDatabase: dataSource.AuthenticationDatabase,
-			SID:                    dataSource.SID,
-			ServiceName:            dataSource.ServiceName,
-			SSHConfig:              sshConfig,
-			ReadOnly:               readOnly,
-			SchemaTenantMode:       schemaTenantMode,
-			ConnectionContext:      connectionContext,
+	driverConfig := db.DriverConfig{
+		DbBinDir:  dbBinDir,
+		BinlogDir: common.GetBinlogAbsDir(d.dataDir, instance.UID),
+	}
+	connectionConfig := db.ConnectionConfig{
+		Username: dataSource.Username,
+		Password: password,
+		TLSConfig: db.TLSConfig{
+			SslCA:   sslCA,
+			SslCert: sslCert,
+			SslKey:  sslKey,
 		},
-	)
-	if err != nil {
-		return nil, err
+		Host:                   dataSource.Host,
+		Port:                   dataSource.Port,
+		Database:               databaseName,
+		ConnectionDatabase:     connectionDatabase,
+		SRV:                    dataSource.SRV,
+		AuthenticationDatabase: dataSource.AuthenticationDatabase,
+		SID:                    dataSource.SID,
+		ServiceName:            dataSource.ServiceName,
+		SSHConfig:              sshConfig,
+		ReadOnly:               readOnly,
+		SchemaTenantMode:       schemaTenantMode,
+		AuthenticationType:     dataSource.AuthenticationType,
+		Region:                 dataSource.Region,
+		ConnectionContext:      connectionContext,
 	}
-
-	return driver, nil
+	return driverConfig, connectionConfig, nil
 }
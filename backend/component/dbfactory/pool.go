@@ -0,0 +1,487 @@
+package dbfactory
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// defaultMaxDriversPerInstance is the maximum number of open drivers per instance, including the drivers in use.
+	defaultMaxDriversPerInstance = 10
+	// defaultMaxIdleTime is the duration after which an idle driver is closed.
+	defaultMaxIdleTime = 3 * time.Minute
+	// maxIdleConnsPerDriver is the maximum number of idle connections kept by the *sql.DB of a pooled driver.
+	// A driver is lent to one caller at a time, so it rarely needs more than a couple of idle connections.
+	maxIdleConnsPerDriver = 2
+	// defaultHealthCheckInterval is the idle duration after which a driver is pinged before it's reused.
+	defaultHealthCheckInterval = 30 * time.Second
+	// defaultWaitTimeout is the maximum duration to wait for a driver when the instance reaches the limit.
+	defaultWaitTimeout = 30 * time.Second
+	// evictInterval is the interval of closing the idle drivers in the background.
+	evictInterval = time.Minute
+)
+
+// poolKey identifies the drivers which can be shared.
+type poolKey struct {
+	instanceID         string
+	dataSourceID       string
+	databaseName       string
+	connectionDatabase string
+	readOnly           bool
+	schemaTenantMode   bool
+	// connectionContext is part of the connection config, so the drivers opened with different contexts are not shared.
+	connectionContext db.ConnectionContext
+}
+
+// pooledEntry is an open driver in the pool.
+type pooledEntry struct {
+	key poolKey
+	// fingerprint is the digest of the connection config, the driver cannot be reused after the config changes.
+	fingerprint [sha256.Size]byte
+	driver      db.Driver
+	inUse       bool
+	// discard entries are closed instead of being returned to the pool.
+	discard  bool
+	lastUsed time.Time
+}
+
+// driverPool is a keyed pool of open drivers.
+// A driver is lent to one caller at a time, and the caller returns the driver to the pool by closing it.
+type driverPool struct {
+	maxDriversPerInstance int
+	maxIdleTime           time.Duration
+	healthCheckInterval   time.Duration
+	waitTimeout           time.Duration
+
+	mu      sync.Mutex
+	closed  bool
+	entries map[string][]*pooledEntry // instance ID to the open drivers.
+	// released is closed and replaced whenever a driver is released or closed, to wake up the waiters.
+	released chan struct{}
+
+	hitCount             int64
+	missCount            int64
+	waitCount            int64
+	idleClosedCount      int64
+	unhealthyClosedCount int64
+	invalidatedCount     int64
+}
+
+func newDriverPool() *driverPool {
+	return &driverPool{
+		maxDriversPerInstance: defaultMaxDriversPerInstance,
+		maxIdleTime:           defaultMaxIdleTime,
+		healthCheckInterval:   defaultHealthCheckInterval,
+		waitTimeout:           defaultWaitTimeout,
+		entries:               make(map[string][]*pooledEntry),
+		released:              make(chan struct{}),
+	}
+}
+
+// PoolStats is the statistics of the driver pool.
+type PoolStats struct {
+	OpenCount      int
+	InUseCount     int
+	IdleCount      int
+	MaxPerInstance int
+
+	// HitCount is the number of times an open driver is reused.
+	HitCount int64
+	// MissCount is the number of times a new driver is opened.
+	MissCount int64
+	// WaitCount is the number of times a caller waits for a driver because the instance reaches the limit.
+	WaitCount            int64
+	IdleClosedCount      int64
+	UnhealthyClosedCount int64
+	InvalidatedCount     int64
+
+	Instances []*InstancePoolStats
+}
+
+// InstancePoolStats is the statistics of the driver pool for an instance.
+type InstancePoolStats struct {
+	InstanceID string
+	OpenCount  int
+	InUseCount int
+}
+
+// pooledDriver is the driver lent from the pool, closing it returns the driver to the pool.
+type pooledDriver struct {
+	db.Driver
+	pool  *driverPool
+	entry *pooledEntry
+	once  sync.Once
+}
+
+// Close returns the driver to the pool.
+func (d *pooledDriver) Close(ctx context.Context) error {
+	d.once.Do(func() {
+		d.pool.release(ctx, d.entry)
+	})
+	return nil
+}
+
+// Execute executes the statement.
+// The driver is closed on release instead of being reused, because the statements may change the session states of the
+// connections, such as USE and SET.
+func (d *pooledDriver) Execute(ctx context.Context, statement string, opts db.ExecuteOptions) (int64, error) {
+	d.pool.discard(d.entry)
+	return d.Driver.Execute(ctx, statement, opts)
+}
+
+// RunStatement runs the statement in the admin mode of SQL editor.
+// The driver is closed on release instead of being reused for the same reason as Execute.
+func (d *pooledDriver) RunStatement(ctx context.Context, conn *sql.Conn, statement string) ([]*v1pb.QueryResult, error) {
+	d.pool.discard(d.entry)
+	return d.Driver.RunStatement(ctx, conn, statement)
+}
+
+// Dump dumps the database.
+// The driver is closed on release instead of being reused, because dumping may lock the tables in the session.
+func (d *pooledDriver) Dump(ctx context.Context, out io.Writer, schemaOnly bool) (string, error) {
+	d.pool.discard(d.entry)
+	return d.Driver.Dump(ctx, out, schemaOnly)
+}
+
+// Restore restores the database.
+// The driver is closed on release instead of being reused for the same reason as Execute.
+func (d *pooledDriver) Restore(ctx context.Context, src io.Reader) error {
+	d.pool.discard(d.entry)
+	return d.Driver.Restore(ctx, src)
+}
+
+// Unwrap returns the underlying driver of the pooled driver.
+// It's used for type assertions to the engine drivers, such as *mysql.Driver. The driver is closed on release instead
+// of being reused, because the engine specific methods bypass the pooled driver.
+func Unwrap(driver db.Driver) db.Driver {
+	if d, ok := driver.(*pooledDriver); ok {
+		d.pool.discard(d.entry)
+		return d.Driver
+	}
+	return driver
+}
+
+func getFingerprint(config db.ConnectionConfig, driverConfig db.DriverConfig) ([sha256.Size]byte, error) {
+	b, err := json.Marshal(struct {
+		Connection db.ConnectionConfig
+		Driver     db.DriverConfig
+	}{config, driverConfig})
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(b), nil
+}
+
+// get gets an idle driver for the key, or opens a new driver with the open function.
+func (p *driverPool) get(ctx context.Context, key poolKey, fingerprint [sha256.Size]byte, open func() (db.Driver, error)) (db.Driver, error) {
+	var waitTimer <-chan time.Time
+	for {
+		entry, stale, released, err := p.acquire(key, fingerprint)
+		closeEntries(stale)
+		if err != nil {
+			return nil, err
+		}
+		if entry != nil && entry.driver != nil {
+			if !p.checkHealth(ctx, entry) {
+				continue
+			}
+			return &pooledDriver{Driver: entry.driver, pool: p, entry: entry}, nil
+		}
+		if entry != nil {
+			// The slot is reserved for the new driver.
+			driver, err := open()
+			if err != nil {
+				p.remove(entry)
+				return nil, err
+			}
+			p.mu.Lock()
+			entry.driver = driver
+			p.mu.Unlock()
+			return &pooledDriver{Driver: driver, pool: p, entry: entry}, nil
+		}
+
+		// The instance reaches the limit, wait for a driver to be released.
+		if waitTimer == nil {
+			timer := time.NewTimer(p.waitTimeout)
+			defer timer.Stop()
+			waitTimer = timer.C
+		}
+		select {
+		case <-released:
+		case <-waitTimer:
+			return nil, errors.Errorf("timeout waiting for a connection, instance %q reaches the maximum number of %d connections", key.instanceID, p.maxDriversPerInstance)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// acquire finds an idle driver for the key and marks it in use.
+// If there is none, it reserves a slot with a nil driver for opening a new driver.
+// If the instance reaches the limit, the entry is nil and the caller should wait on the released channel.
+// The stale drivers evicted are returned for closing outside the lock.
+func (p *driverPool) acquire(key poolKey, fingerprint [sha256.Size]byte) (*pooledEntry, []*pooledEntry, <-chan struct{}, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return nil, nil, nil, errors.Errorf("driver pool is closed")
+	}
+
+	now := time.Now()
+	var stale []*pooledEntry
+	var kept []*pooledEntry
+	var idle *pooledEntry
+	for _, entry := range p.entries[key.instanceID] {
+		if entry.inUse {
+			kept = append(kept, entry)
+			continue
+		}
+		if entry.key == key && entry.fingerprint != fingerprint {
+			// The connection config has changed, e.g. the password is rotated in the external secret manager.
+			stale = append(stale, entry)
+			p.invalidatedCount++
+			continue
+		}
+		if now.Sub(entry.lastUsed) > p.maxIdleTime {
+			stale = append(stale, entry)
+			p.idleClosedCount++
+			continue
+		}
+		if idle == nil && entry.key == key {
+			idle = entry
+		}
+		kept = append(kept, entry)
+	}
+	p.entries[key.instanceID] = kept
+
+	if idle != nil {
+		idle.inUse = true
+		p.hitCount++
+		return idle, stale, nil, nil
+	}
+
+	if len(kept) >= p.maxDriversPerInstance {
+		// Close the least recently used idle driver of other keys to make room.
+		var lru *pooledEntry
+		for _, entry := range kept {
+			if !entry.inUse && (lru == nil || entry.lastUsed.Before(lru.lastUsed)) {
+				lru = entry
+			}
+		}
+		if lru == nil {
+			p.waitCount++
+			return nil, stale, p.released, nil
+		}
+		p.removeLocked(lru)
+		p.idleClosedCount++
+		stale = append(stale, lru)
+	}
+
+	entry := &pooledEntry{
+		key:         key,
+		fingerprint: fingerprint,
+		inUse:       true,
+		lastUsed:    now,
+	}
+	p.entries[key.instanceID] = append(p.entries[key.instanceID], entry)
+	p.missCount++
+	return entry, stale, nil, nil
+}
+
+// checkHealth pings the driver idle for a while. The unhealthy driver is removed from the pool and closed.
+func (p *driverPool) checkHealth(ctx context.Context, entry *pooledEntry) bool {
+	p.mu.Lock()
+	idleTime := time.Since(entry.lastUsed)
+	p.mu.Unlock()
+	if idleTime < p.healthCheckInterval {
+		return true
+	}
+	if err := entry.driver.Ping(ctx); err != nil {
+		slog.Debug("close the unhealthy pooled driver", slog.String("instance", entry.key.instanceID), log.BBError(err))
+		p.mu.Lock()
+		p.unhealthyClosedCount++
+		p.mu.Unlock()
+		p.remove(entry)
+		closeEntries([]*pooledEntry{entry})
+		return false
+	}
+	return true
+}
+
+// release returns the driver to the pool, or closes it if the driver is invalidated or the pool is closed.
+func (p *driverPool) release(ctx context.Context, entry *pooledEntry) {
+	p.mu.Lock()
+	if entry.discard || p.closed {
+		p.removeLocked(entry)
+		p.mu.Unlock()
+		if err := entry.driver.Close(ctx); err != nil {
+			slog.Warn("failed to close the pooled driver", slog.String("instance", entry.key.instanceID), log.BBError(err))
+		}
+		return
+	}
+	entry.inUse = false
+	entry.lastUsed = time.Now()
+	p.notifyLocked()
+	p.mu.Unlock()
+}
+
+func (p *driverPool) discard(entry *pooledEntry) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	entry.discard = true
+}
+
+func (p *driverPool) remove(entry *pooledEntry) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.removeLocked(entry)
+}
+
+func (p *driverPool) removeLocked(entry *pooledEntry) {
+	entries := p.entries[entry.key.instanceID]
+	for i, e := range entries {
+		if e == entry {
+			p.entries[entry.key.instanceID] = append(entries[:i:i], entries[i+1:]...)
+			break
+		}
+	}
+	if len(p.entries[entry.key.instanceID]) == 0 {
+		delete(p.entries, entry.key.instanceID)
+	}
+	p.notifyLocked()
+}
+
+func (p *driverPool) notifyLocked() {
+	close(p.released)
+	p.released = make(chan struct{})
+}
+
+// invalidate closes the idle drivers matching the filter, and marks the drivers in use to be closed on release.
+func (p *driverPool) invalidate(instanceID string, match func(key poolKey) bool) {
+	p.mu.Lock()
+	var stale []*pooledEntry
+	for _, entry := range p.entries[instanceID] {
+		if !match(entry.key) {
+			continue
+		}
+		entry.discard = true
+		p.invalidatedCount++
+		if !entry.inUse {
+			stale = append(stale, entry)
+		}
+	}
+	for _, entry := range stale {
+		p.removeLocked(entry)
+	}
+	p.mu.Unlock()
+	closeEntries(stale)
+}
+
+// evictIdle closes the drivers idle longer than the max idle time.
+func (p *driverPool) evictIdle() {
+	p.mu.Lock()
+	now := time.Now()
+	var stale []*pooledEntry
+	for _, entries := range p.entries {
+		for _, entry := range entries {
+			if !entry.inUse && now.Sub(entry.lastUsed) > p.maxIdleTime {
+				stale = append(stale, entry)
+			}
+		}
+	}
+	for _, entry := range stale {
+		p.removeLocked(entry)
+		p.idleClosedCount++
+	}
+	p.mu.Unlock()
+	closeEntries(stale)
+}
+
+// close closes the idle drivers and the pool. The drivers in use are closed on release.
+func (p *driverPool) close() {
+	p.mu.Lock()
+	p.closed = true
+	var stale []*pooledEntry
+	for _, entries := range p.entries {
+		for _, entry := range entries {
+			if !entry.inUse {
+				stale = append(stale, entry)
+			}
+		}
+	}
+	for _, entry := range stale {
+		p.removeLocked(entry)
+	}
+	p.mu.Unlock()
+	closeEntries(stale)
+}
+
+func (p *driverPool) stats() *PoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	stats := &PoolStats{
+		MaxPerInstance:       p.maxDriversPerInstance,
+		HitCount:             p.hitCount,
+		MissCount:            p.missCount,
+		WaitCount:            p.waitCount,
+		IdleClosedCount:      p.idleClosedCount,
+		UnhealthyClosedCount: p.unhealthyClosedCount,
+		InvalidatedCount:     p.invalidatedCount,
+	}
+	for instanceID, entries := range p.entries {
+		instanceStats := &InstancePoolStats{
+			InstanceID: instanceID,
+			OpenCount:  len(entries),
+		}
+		for _, entry := range entries {
+			if entry.inUse {
+				instanceStats.InUseCount++
+			}
+		}
+		stats.OpenCount += instanceStats.OpenCount
+		stats.InUseCount += instanceStats.InUseCount
+		stats.Instances = append(stats.Instances, instanceStats)
+	}
+	stats.IdleCount = stats.OpenCount - stats.InUseCount
+	sort.Slice(stats.Instances, func(i, j int) bool {
+		return stats.Instances[i].InstanceID < stats.Instances[j].InstanceID
+	})
+	return stats
+}
+
+// inUseCount returns the number of the drivers in use of the data source.
+func (p *driverPool) inUseCount(instanceID, dataSourceID string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	count := 0
+	for _, entry := range p.entries[instanceID] {
+		if entry.inUse && entry.key.dataSourceID == dataSourceID {
+			count++
+		}
+	}
+	return count
+}
+
+func closeEntries(entries []*pooledEntry) {
+	for _, entry := range entries {
+		if entry.driver == nil {
+			continue
+		}
+		if err := entry.driver.Close(context.Background()); err != nil {
+			slog.Warn("failed to close the pooled driver", slog.String("instance", entry.key.instanceID), log.BBError(err))
+		}
+	}
+}
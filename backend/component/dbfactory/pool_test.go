@@ -0,0 +1,246 @@
+package dbfactory
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+)
+
+type fakeDriver struct {
+	db.Driver
+	pingErr error
+	closed  atomic.Bool
+}
+
+func (d *fakeDriver) Ping(context.Context) error {
+	return d.pingErr
+}
+
+func (d *fakeDriver) Close(context.Context) error {
+	d.closed.Store(true)
+	return nil
+}
+
+func (*fakeDriver) Execute(context.Context, string, db.ExecuteOptions) (int64, error) {
+	return 0, nil
+}
+
+type fakeOpener struct {
+	drivers []*fakeDriver
+}
+
+func (o *fakeOpener) open() (db.Driver, error) {
+	driver := &fakeDriver{}
+	o.drivers = append(o.drivers, driver)
+	return driver, nil
+}
+
+func TestPoolReuse(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	pool := newDriverPool()
+	opener := &fakeOpener{}
+	key := poolKey{instanceID: "prod", dataSourceID: "admin", databaseName: "db"}
+	fingerprint := sha256.Sum256([]byte("config"))
+
+	driver, err := pool.get(ctx, key, fingerprint, opener.open)
+	a.NoError(err)
+	a.NoError(driver.Close(ctx))
+	// Closing twice doesn't return the driver twice.
+	a.NoError(driver.Close(ctx))
+	driver, err = pool.get(ctx, key, fingerprint, opener.open)
+	a.NoError(err)
+	a.Len(opener.drivers, 1)
+	a.Equal(opener.drivers[0], Unwrap(driver))
+
+	// The driver in use is not shared.
+	other, err := pool.get(ctx, key, fingerprint, opener.open)
+	a.NoError(err)
+	a.Len(opener.drivers, 2)
+	// The driver of another database is not shared.
+	otherDatabase, err := pool.get(ctx, poolKey{instanceID: "prod", dataSourceID: "admin", databaseName: "db2"}, fingerprint, opener.open)
+	a.NoError(err)
+	a.Len(opener.drivers, 3)
+	a.NoError(other.Close(ctx))
+	a.NoError(otherDatabase.Close(ctx))
+
+	stats := pool.stats()
+	a.Equal(3, stats.OpenCount)
+	a.Equal(1, stats.InUseCount)
+	a.Equal(2, stats.IdleCount)
+	a.Equal(int64(1), stats.HitCount)
+	a.Equal(int64(3), stats.MissCount)
+	a.Len(stats.Instances, 1)
+	a.Equal("prod", stats.Instances[0].InstanceID)
+
+	// The driver whose connection config changes is closed.
+	a.NoError(driver.Close(ctx))
+	_, err = pool.get(ctx, key, sha256.Sum256([]byte("rotated")), opener.open)
+	a.NoError(err)
+	a.True(opener.drivers[0].closed.Load())
+	a.True(opener.drivers[1].closed.Load())
+	a.False(opener.drivers[2].closed.Load())
+	a.Len(opener.drivers, 4)
+}
+
+func TestPoolConnectionContext(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	pool := newDriverPool()
+	opener := &fakeOpener{}
+	prodKey := poolKey{instanceID: "prod", dataSourceID: "admin", connectionContext: db.ConnectionContext{EnvironmentID: "prod"}}
+	testKey := poolKey{instanceID: "prod", dataSourceID: "admin", connectionContext: db.ConnectionContext{EnvironmentID: "test"}}
+
+	// The drivers opened with different connection contexts have different fingerprints, and they don't invalidate each other.
+	driver, err := pool.get(ctx, prodKey, sha256.Sum256([]byte("prod")), opener.open)
+	a.NoError(err)
+	a.NoError(driver.Close(ctx))
+	driver, err = pool.get(ctx, testKey, sha256.Sum256([]byte("test")), opener.open)
+	a.NoError(err)
+	a.NoError(driver.Close(ctx))
+	driver, err = pool.get(ctx, prodKey, sha256.Sum256([]byte("prod")), opener.open)
+	a.NoError(err)
+	a.NoError(driver.Close(ctx))
+	a.Len(opener.drivers, 2)
+	a.False(opener.drivers[0].closed.Load())
+	a.Equal(int64(0), pool.stats().InvalidatedCount)
+}
+
+func TestPoolDiscardAfterExecute(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	pool := newDriverPool()
+	opener := &fakeOpener{}
+	key := poolKey{instanceID: "prod", dataSourceID: "admin"}
+	fingerprint := sha256.Sum256([]byte("config"))
+
+	driver, err := pool.get(ctx, key, fingerprint, opener.open)
+	a.NoError(err)
+	_, err = driver.Execute(ctx, "USE db;", db.ExecuteOptions{})
+	a.NoError(err)
+	a.NoError(driver.Close(ctx))
+	a.True(opener.drivers[0].closed.Load())
+	a.Equal(0, pool.stats().OpenCount)
+}
+
+func TestPoolHealthCheck(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	pool := newDriverPool()
+	pool.healthCheckInterval = 0
+	opener := &fakeOpener{}
+	key := poolKey{instanceID: "prod", dataSourceID: "admin"}
+	fingerprint := sha256.Sum256([]byte("config"))
+
+	driver, err := pool.get(ctx, key, fingerprint, opener.open)
+	a.NoError(err)
+	a.NoError(driver.Close(ctx))
+	opener.drivers[0].pingErr = errors.New("connection reset")
+
+	driver, err = pool.get(ctx, key, fingerprint, opener.open)
+	a.NoError(err)
+	a.Len(opener.drivers, 2)
+	a.True(opener.drivers[0].closed.Load())
+	a.Equal(opener.drivers[1], Unwrap(driver))
+	a.Equal(int64(1), pool.stats().UnhealthyClosedCount)
+}
+
+func TestPoolInstanceLimit(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	pool := newDriverPool()
+	pool.maxDriversPerInstance = 1
+	pool.waitTimeout = 50 * time.Millisecond
+	opener := &fakeOpener{}
+	fingerprint := sha256.Sum256([]byte("config"))
+	key1 := poolKey{instanceID: "prod", dataSourceID: "admin", databaseName: "db1"}
+	key2 := poolKey{instanceID: "prod", dataSourceID: "admin", databaseName: "db2"}
+
+	driver1, err := pool.get(ctx, key1, fingerprint, opener.open)
+	a.NoError(err)
+	// Other instances are not limited.
+	other, err := pool.get(ctx, poolKey{instanceID: "test"}, fingerprint, opener.open)
+	a.NoError(err)
+	a.NoError(other.Close(ctx))
+
+	_, err = pool.get(ctx, key2, fingerprint, opener.open)
+	a.ErrorContains(err, "timeout waiting for a connection")
+
+	// The waiter gets a driver after the driver in use is released, and the idle driver of the other key is closed.
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		driver1.Close(ctx)
+	}()
+	driver2, err := pool.get(ctx, key2, fingerprint, opener.open)
+	a.NoError(err)
+	a.True(opener.drivers[0].closed.Load())
+	a.NoError(driver2.Close(ctx))
+
+	stats := pool.stats()
+	a.Equal(2, stats.OpenCount)
+	a.Equal(int64(2), stats.WaitCount)
+}
+
+func TestPoolInvalidate(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	pool := newDriverPool()
+	opener := &fakeOpener{}
+	fingerprint := sha256.Sum256([]byte("config"))
+
+	idle, err := pool.get(ctx, poolKey{instanceID: "prod", dataSourceID: "admin"}, fingerprint, opener.open)
+	a.NoError(err)
+	a.NoError(idle.Close(ctx))
+	inUse, err := pool.get(ctx, poolKey{instanceID: "prod", dataSourceID: "admin", databaseName: "db"}, fingerprint, opener.open)
+	a.NoError(err)
+	readOnly, err := pool.get(ctx, poolKey{instanceID: "prod", dataSourceID: "read-only"}, fingerprint, opener.open)
+	a.NoError(err)
+	a.NoError(readOnly.Close(ctx))
+
+	pool.invalidate("prod", func(key poolKey) bool {
+		return key.dataSourceID == "admin"
+	})
+	a.True(opener.drivers[0].closed.Load())
+	a.False(opener.drivers[1].closed.Load())
+	a.False(opener.drivers[2].closed.Load())
+	// The driver in use is closed on release.
+	a.NoError(inUse.Close(ctx))
+	a.True(opener.drivers[1].closed.Load())
+	a.Equal(1, pool.stats().OpenCount)
+	a.Equal(int64(2), pool.stats().InvalidatedCount)
+}
+
+func TestPoolEvictIdle(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	pool := newDriverPool()
+	opener := &fakeOpener{}
+	fingerprint := sha256.Sum256([]byte("config"))
+
+	driver, err := pool.get(ctx, poolKey{instanceID: "prod"}, fingerprint, opener.open)
+	a.NoError(err)
+	a.NoError(driver.Close(ctx))
+	inUse, err := pool.get(ctx, poolKey{instanceID: "test"}, fingerprint, opener.open)
+	a.NoError(err)
+
+	pool.maxIdleTime = 0
+	time.Sleep(time.Millisecond)
+	pool.evictIdle()
+	a.True(opener.drivers[0].closed.Load())
+	a.False(opener.drivers[1].closed.Load())
+	a.Equal(int64(1), pool.stats().IdleClosedCount)
+
+	// The drivers in use are closed on release after the pool is closed.
+	pool.close()
+	a.NoError(inUse.Close(ctx))
+	a.True(opener.drivers[1].closed.Load())
+	_, err = pool.get(ctx, poolKey{instanceID: "prod"}, fingerprint, opener.open)
+	a.Error(err)
+}
@@ -0,0 +1,253 @@
+package dbfactory
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// defaultReplicationLagTTL is how long the probed replication lag is reused.
+	defaultReplicationLagTTL = 10 * time.Second
+	// defaultUnreachableTTL is how long a data source failing to connect is skipped, so that the queries don't wait for
+	// the connect timeout of the unreachable replica one after another.
+	defaultUnreachableTTL = 30 * time.Second
+
+	// pgReplicationLagQuery returns the replication lag in seconds of the Postgres standby.
+	// The lag is zero if all the received WAL has been replayed, otherwise the replay timestamp keeps growing on an idle primary.
+	pgReplicationLagQuery = `
+	SELECT CASE
+		WHEN NOT pg_is_in_recovery() THEN 0
+		WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+		ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+	END`
+)
+
+// errReplicationLagUnknown is the error of probing the replication lag without the privilege to read the replication status,
+// e.g. SHOW REPLICA STATUS requires the REPLICATION CLIENT privilege, which the read-only users usually lack.
+var errReplicationLagUnknown = errors.New("replication lag is unknown")
+
+// replicaRouter routes the read-only queries to the read-only data sources.
+type replicaRouter struct {
+	mu sync.Mutex
+	// counters is the round-robin counter by instance ID.
+	counters map[string]uint64
+	// lags is the probed replication lag by data source.
+	lags   map[replicaKey]*replicationLag
+	lagTTL time.Duration
+	// unreachable is the time when the data source failed to connect.
+	unreachable    map[replicaKey]time.Time
+	unreachableTTL time.Duration
+}
+
+type replicaKey struct {
+	instanceID   string
+	dataSourceID string
+}
+
+type replicationLag struct {
+	lag      time.Duration
+	err      error
+	probedAt time.Time
+}
+
+func newReplicaRouter() *replicaRouter {
+	return &replicaRouter{
+		counters:       make(map[string]uint64),
+		lags:           make(map[replicaKey]*replicationLag),
+		lagTTL:         defaultReplicationLagTTL,
+		unreachable:    make(map[replicaKey]time.Time),
+		unreachableTTL: defaultUnreachableTTL,
+	}
+}
+
+// isUnreachable returns true if the data source failed to connect recently.
+func (r *replicaRouter) isUnreachable(instanceID, dataSourceID string) bool {
+	key := replicaKey{instanceID: instanceID, dataSourceID: dataSourceID}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	failedAt, ok := r.unreachable[key]
+	if !ok {
+		return false
+	}
+	if time.Since(failedAt) >= r.unreachableTTL {
+		delete(r.unreachable, key)
+		return false
+	}
+	return true
+}
+
+// setUnreachable marks the data source unreachable if it fails to connect, or reachable otherwise.
+func (r *replicaRouter) setUnreachable(instanceID, dataSourceID string, unreachable bool) {
+	key := replicaKey{instanceID: instanceID, dataSourceID: dataSourceID}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if unreachable {
+		r.unreachable[key] = time.Now()
+	} else {
+		delete(r.unreachable, key)
+	}
+}
+
+// order returns the data sources in the order to try by the routing strategy.
+// The data sources are rotated for every call, so that the data sources with the same connections in use take turns.
+func (r *replicaRouter) order(instanceID string, dataSources []*store.DataSourceMessage, strategy storepb.ReadOnlyRoutingPolicy_Strategy, inUseCount func(dataSourceID string) int) []*store.DataSourceMessage {
+	if len(dataSources) == 0 {
+		return nil
+	}
+	r.mu.Lock()
+	start := int(r.counters[instanceID] % uint64(len(dataSources)))
+	r.counters[instanceID]++
+	r.mu.Unlock()
+
+	var ordered []*store.DataSourceMessage
+	for i := range dataSources {
+		ordered = append(ordered, dataSources[(start+i)%len(dataSources)])
+	}
+	if strategy == storepb.ReadOnlyRoutingPolicy_LEAST_CONNECTIONS {
+		counts := make(map[string]int)
+		for _, dataSource := range ordered {
+			counts[dataSource.ID] = inUseCount(dataSource.ID)
+		}
+		sort.SliceStable(ordered, func(i, j int) bool {
+			return counts[ordered[i].ID] < counts[ordered[j].ID]
+		})
+	}
+	return ordered
+}
+
+// getLag returns the replication lag of the data source, and probes the lag if the cached one expires.
+func (r *replicaRouter) getLag(ctx context.Context, instanceID, dataSourceID string, probe func(context.Context) (time.Duration, error)) (time.Duration, error) {
+	key := replicaKey{instanceID: instanceID, dataSourceID: dataSourceID}
+	r.mu.Lock()
+	cached, ok := r.lags[key]
+	r.mu.Unlock()
+	if ok && time.Since(cached.probedAt) < r.lagTTL {
+		return cached.lag, cached.err
+	}
+
+	lag, err := probe(ctx)
+	if ctx.Err() != nil {
+		// Don't cache the failure caused by the canceled request.
+		return lag, err
+	}
+	r.mu.Lock()
+	r.lags[key] = &replicationLag{lag: lag, err: err, probedAt: time.Now()}
+	r.mu.Unlock()
+	return lag, err
+}
+
+// IsReplicationLagSupported returns true if the replication lag can be probed for the engine.
+func IsReplicationLagSupported(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_POSTGRES:
+		return true
+	default:
+		return false
+	}
+}
+
+// getReplicationLag probes the replication lag of the replica by the engine specific query.
+// The lag of the data source which is not a replica is zero.
+// It returns errReplicationLagUnknown if the user lacks the privilege to read the replication status.
+func getReplicationLag(ctx context.Context, engine storepb.Engine, sqlDB *sql.DB) (time.Duration, error) {
+	lag, err := probeReplicationLag(ctx, engine, sqlDB)
+	if err != nil && isPermissionDenied(err) {
+		return 0, errors.Wrap(errReplicationLagUnknown, err.Error())
+	}
+	return lag, err
+}
+
+// isPermissionDenied returns true if the error is caused by the lack of the privilege.
+func isPermissionDenied(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		// ER_SPECIFIC_ACCESS_DENIED_ERROR, ER_DBACCESS_DENIED_ERROR and ER_TABLEACCESS_DENIED_ERROR.
+		return mysqlErr.Number == 1227 || mysqlErr.Number == 1044 || mysqlErr.Number == 1142
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// insufficient_privilege.
+		return pgErr.Code == "42501"
+	}
+	return false
+}
+
+func probeReplicationLag(ctx context.Context, engine storepb.Engine, sqlDB *sql.DB) (time.Duration, error) {
+	if !IsReplicationLagSupported(engine) || sqlDB == nil {
+		return 0, errors.Errorf("replication lag is not supported for %s", engine)
+	}
+	if engine == storepb.Engine_POSTGRES {
+		var seconds float64
+		if err := sqlDB.QueryRowContext(ctx, pgReplicationLagQuery).Scan(&seconds); err != nil {
+			return 0, err
+		}
+		return time.Duration(seconds * float64(time.Second)), nil
+	}
+
+	// SHOW REPLICA STATUS replaces SHOW SLAVE STATUS since MySQL 8.0.22 and MariaDB 10.5.1.
+	rows, err := sqlDB.QueryContext(ctx, "SHOW REPLICA STATUS")
+	if err != nil {
+		rows, err = sqlDB.QueryContext(ctx, "SHOW SLAVE STATUS")
+		if err != nil {
+			return 0, err
+		}
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	values := make([]sql.NullString, len(columns))
+	refs := make([]any, len(columns))
+	for i := range values {
+		refs[i] = &values[i]
+	}
+	// There are multiple rows for the multi-source replication, and we use the largest lag.
+	var maxLag time.Duration
+	for rows.Next() {
+		if err := rows.Scan(refs...); err != nil {
+			return 0, err
+		}
+		lag, err := parseMySQLReplicationLag(columns, values)
+		if err != nil {
+			return 0, err
+		}
+		if lag > maxLag {
+			maxLag = lag
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	return maxLag, nil
+}
+
+// parseMySQLReplicationLag parses the lag from a row of SHOW REPLICA STATUS.
+func parseMySQLReplicationLag(columns []string, values []sql.NullString) (time.Duration, error) {
+	for i, column := range columns {
+		if column != "Seconds_Behind_Source" && column != "Seconds_Behind_Master" {
+			continue
+		}
+		// The lag is NULL if the replication threads are not running.
+		if !values[i].Valid {
+			return 0, errors.New("replication is not running")
+		}
+		seconds, err := strconv.ParseInt(values[i].String, 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to parse %s %q", column, values[i].String)
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return 0, errors.New("replication lag column not found")
+}
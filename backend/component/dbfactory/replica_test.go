@@ -0,0 +1,130 @@
+package dbfactory
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func getDataSourceIDs(dataSources []*store.DataSourceMessage) []string {
+	var ids []string
+	for _, dataSource := range dataSources {
+		ids = append(ids, dataSource.ID)
+	}
+	return ids
+}
+
+func TestReplicaRouterOrder(t *testing.T) {
+	a := require.New(t)
+	router := newReplicaRouter()
+	dataSources := []*store.DataSourceMessage{{ID: "ro1"}, {ID: "ro2"}, {ID: "ro3"}}
+	noConnections := func(string) int { return 0 }
+
+	// Round-robin.
+	a.Equal([]string{"ro1", "ro2", "ro3"}, getDataSourceIDs(router.order("prod", dataSources, storepb.ReadOnlyRoutingPolicy_STRATEGY_UNSPECIFIED, noConnections)))
+	a.Equal([]string{"ro2", "ro3", "ro1"}, getDataSourceIDs(router.order("prod", dataSources, storepb.ReadOnlyRoutingPolicy_ROUND_ROBIN, noConnections)))
+	a.Equal([]string{"ro3", "ro1", "ro2"}, getDataSourceIDs(router.order("prod", dataSources, storepb.ReadOnlyRoutingPolicy_ROUND_ROBIN, noConnections)))
+	// The counter is per instance.
+	a.Equal([]string{"ro1", "ro2", "ro3"}, getDataSourceIDs(router.order("test", dataSources, storepb.ReadOnlyRoutingPolicy_ROUND_ROBIN, noConnections)))
+
+	// Least connections, and the data sources with the same connections take turns.
+	connections := map[string]int{"ro1": 2, "ro2": 1, "ro3": 1}
+	inUseCount := func(id string) int { return connections[id] }
+	a.Equal([]string{"ro2", "ro3", "ro1"}, getDataSourceIDs(router.order("stage", dataSources, storepb.ReadOnlyRoutingPolicy_LEAST_CONNECTIONS, inUseCount)))
+	a.Equal([]string{"ro2", "ro3", "ro1"}, getDataSourceIDs(router.order("stage", dataSources, storepb.ReadOnlyRoutingPolicy_LEAST_CONNECTIONS, inUseCount)))
+	a.Equal([]string{"ro3", "ro2", "ro1"}, getDataSourceIDs(router.order("stage", dataSources, storepb.ReadOnlyRoutingPolicy_LEAST_CONNECTIONS, inUseCount)))
+}
+
+func TestReplicaRouterGetLag(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	router := newReplicaRouter()
+	probeCount := 0
+	probe := func(context.Context) (time.Duration, error) {
+		probeCount++
+		return time.Duration(probeCount) * time.Second, nil
+	}
+
+	lag, err := router.getLag(ctx, "prod", "ro1", probe)
+	a.NoError(err)
+	a.Equal(time.Second, lag)
+	// The cached lag is reused.
+	lag, err = router.getLag(ctx, "prod", "ro1", probe)
+	a.NoError(err)
+	a.Equal(time.Second, lag)
+	a.Equal(1, probeCount)
+	// The lag is cached by data source.
+	lag, err = router.getLag(ctx, "prod", "ro2", probe)
+	a.NoError(err)
+	a.Equal(2*time.Second, lag)
+
+	router.lagTTL = 0
+	lag, err = router.getLag(ctx, "prod", "ro1", probe)
+	a.NoError(err)
+	a.Equal(3*time.Second, lag)
+
+	// The failure of the canceled request is not cached.
+	router.lagTTL = time.Minute
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = router.getLag(canceledCtx, "prod", "ro3", func(ctx context.Context) (time.Duration, error) {
+		return 0, ctx.Err()
+	})
+	a.Error(err)
+	lag, err = router.getLag(ctx, "prod", "ro3", probe)
+	a.NoError(err)
+	a.Equal(4*time.Second, lag)
+	_, err = router.getLag(ctx, "prod", "ro4", func(context.Context) (time.Duration, error) {
+		return 0, errors.New("replication is not running")
+	})
+	a.Error(err)
+	_, err = router.getLag(ctx, "prod", "ro4", probe)
+	a.ErrorContains(err, "replication is not running")
+}
+
+func TestParseMySQLReplicationLag(t *testing.T) {
+	a := require.New(t)
+	lag, err := parseMySQLReplicationLag([]string{"Replica_IO_State", "Seconds_Behind_Source"}, []sql.NullString{{String: "Waiting", Valid: true}, {String: "42", Valid: true}})
+	a.NoError(err)
+	a.Equal(42*time.Second, lag)
+	lag, err = parseMySQLReplicationLag([]string{"Seconds_Behind_Master"}, []sql.NullString{{String: "0", Valid: true}})
+	a.NoError(err)
+	a.Equal(time.Duration(0), lag)
+	_, err = parseMySQLReplicationLag([]string{"Seconds_Behind_Master"}, []sql.NullString{{}})
+	a.ErrorContains(err, "replication is not running")
+	_, err = parseMySQLReplicationLag([]string{"Slave_IO_State"}, []sql.NullString{{}})
+	a.Error(err)
+}
+
+func TestReplicaRouterUnreachable(t *testing.T) {
+	a := require.New(t)
+	router := newReplicaRouter()
+	a.False(router.isUnreachable("prod", "ro1"))
+	router.setUnreachable("prod", "ro1", true)
+	a.True(router.isUnreachable("prod", "ro1"))
+	a.False(router.isUnreachable("prod", "ro2"))
+	router.setUnreachable("prod", "ro1", false)
+	a.False(router.isUnreachable("prod", "ro1"))
+
+	// The data source is retried after the TTL.
+	router.unreachableTTL = 0
+	router.setUnreachable("prod", "ro1", true)
+	a.False(router.isUnreachable("prod", "ro1"))
+}
+
+func TestIsPermissionDenied(t *testing.T) {
+	a := require.New(t)
+	a.True(isPermissionDenied(errors.Wrap(&mysql.MySQLError{Number: 1227, Message: "Access denied; you need (at least one of) the SUPER, REPLICATION CLIENT privilege(s)"}, "query")))
+	a.False(isPermissionDenied(&mysql.MySQLError{Number: 1064}))
+	a.True(isPermissionDenied(&pgconn.PgError{Code: "42501"}))
+	a.False(isPermissionDenied(errors.New("connection refused")))
+}
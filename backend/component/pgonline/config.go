@@ -0,0 +1,104 @@
+// Package pgonline implements the trigger based online schema migration for PostgreSQL tables.
+package pgonline
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var defaultConfig = struct {
+	chunkSize                 int64
+	maxLagMillis              int64
+	niceRatio                 float64
+	cutoverLockTimeoutSeconds int64
+	defaultRetries            int64
+}{
+	chunkSize:                 1000, // chunk-size
+	maxLagMillis:              1500, // max-lag-millis
+	niceRatio:                 0,    // nice-ratio
+	cutoverLockTimeoutSeconds: 3,    // cut-over-lock-timeout-seconds
+	defaultRetries:            60,   // default-retries
+}
+
+// Config is the config of the online schema migration.
+type Config struct {
+	// ChunkSize is the number of rows copied in each backfill batch.
+	ChunkSize int64
+	// MaxLag throttles the backfill while the replay lag of any replica exceeds it.
+	MaxLag time.Duration
+	// NiceRatio is the ratio of the sleep time to the copy time of each batch.
+	NiceRatio float64
+	// CutoverLockTimeout is the lock timeout of acquiring the ACCESS EXCLUSIVE lock on the original table at cutover.
+	CutoverLockTimeout time.Duration
+	// DefaultRetries is the number of the cutover attempts when failing to acquire the lock.
+	DefaultRetries int64
+}
+
+var knownKeys = map[string]bool{
+	"chunk-size":                    true,
+	"max-lag-millis":                true,
+	"nice-ratio":                    true,
+	"cut-over-lock-timeout-seconds": true,
+	"default-retries":               true,
+}
+
+// GetConfig returns the config with the user flags applied on the default config.
+func GetConfig(flags map[string]string) (*Config, error) {
+	for k := range flags {
+		if !knownKeys[k] {
+			return nil, errors.Errorf("unsupported flag: %s", k)
+		}
+	}
+
+	chunkSize, err := getPositiveInt(flags, "chunk-size", defaultConfig.chunkSize)
+	if err != nil {
+		return nil, err
+	}
+	maxLagMillis, err := getPositiveInt(flags, "max-lag-millis", defaultConfig.maxLagMillis)
+	if err != nil {
+		return nil, err
+	}
+	cutoverLockTimeoutSeconds, err := getPositiveInt(flags, "cut-over-lock-timeout-seconds", defaultConfig.cutoverLockTimeoutSeconds)
+	if err != nil {
+		return nil, err
+	}
+	defaultRetries, err := getPositiveInt(flags, "default-retries", defaultConfig.defaultRetries)
+	if err != nil {
+		return nil, err
+	}
+	niceRatio := defaultConfig.niceRatio
+	if v, ok := flags["nice-ratio"]; ok {
+		niceRatio, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert nice-ratio %q to float", v)
+		}
+		if niceRatio < 0 {
+			return nil, errors.Errorf("nice-ratio %q must not be negative", v)
+		}
+	}
+
+	return &Config{
+		ChunkSize:          chunkSize,
+		MaxLag:             time.Duration(maxLagMillis) * time.Millisecond,
+		NiceRatio:          niceRatio,
+		CutoverLockTimeout: time.Duration(cutoverLockTimeoutSeconds) * time.Second,
+		DefaultRetries:     defaultRetries,
+	}, nil
+}
+
+func getPositiveInt(flags map[string]string, key string, defaultValue int64) (int64, error) {
+	v, ok := flags[key]
+	if !ok {
+		return defaultValue, nil
+	}
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to convert %s %q to int", key, v)
+	}
+	if i <= 0 {
+		return 0, errors.Errorf("%s %q must be positive", key, v)
+	}
+	return i, nil
+}
@@ -0,0 +1,1035 @@
+package pgonline
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	pgquery "github.com/pganalyze/pg_query_go/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxIdentifierLength is the max byte length of the PostgreSQL identifiers.
+	maxIdentifierLength = 63
+	// lockNotAvailable is the SQLSTATE of failing to acquire the lock in lock_timeout.
+	lockNotAvailable = "55P03"
+)
+
+// supportedCommands are the ALTER TABLE commands which can be applied on the shadow table.
+// The commands depending on the objects outside the table, e.g. inheritance and partitions, are not supported.
+// The sync trigger writes every change of the original table to the shadow table in the same transaction,
+// so the commands adding the rules on the rows, e.g. SET NOT NULL and ADD CONSTRAINT, are not supported either,
+// because a write on the original table breaking the new rule would fail until the cutover.
+var supportedCommands = map[pgquery.AlterTableType]bool{
+	pgquery.AlterTableType_AT_AddColumn:          true,
+	pgquery.AlterTableType_AT_ColumnDefault:      true,
+	pgquery.AlterTableType_AT_DropNotNull:        true,
+	pgquery.AlterTableType_AT_SetStatistics:      true,
+	pgquery.AlterTableType_AT_SetStorage:         true,
+	pgquery.AlterTableType_AT_SetCompression:     true,
+	pgquery.AlterTableType_AT_DropColumn:         true,
+	pgquery.AlterTableType_AT_DropConstraint:     true,
+	pgquery.AlterTableType_AT_AlterConstraint:    true,
+	pgquery.AlterTableType_AT_ValidateConstraint: true,
+	pgquery.AlterTableType_AT_AlterColumnType:    true,
+	pgquery.AlterTableType_AT_SetRelOptions:      true,
+	pgquery.AlterTableType_AT_ResetRelOptions:    true,
+	pgquery.AlterTableType_AT_SetTableSpace:      true,
+	pgquery.AlterTableType_AT_SetIdentity:        true,
+	pgquery.AlterTableType_AT_DropIdentity:       true,
+}
+
+// prerequisites are the objects depending on the original table, which would be lost or left on the old table after the cutover.
+var prerequisites = []struct {
+	query  string
+	reason string
+}{
+	{
+		query:  `SELECT inhparent::regclass::text FROM pg_inherits WHERE inhrelid = $1::regclass UNION ALL SELECT inhrelid::regclass::text FROM pg_inherits WHERE inhparent = $1::regclass`,
+		reason: "inheritance",
+	},
+	{
+		query:  `SELECT conname || ' on ' || conrelid::regclass::text FROM pg_constraint WHERE confrelid = $1::regclass AND contype = 'f'`,
+		reason: "referencing foreign keys",
+	},
+	{
+		query:  `SELECT DISTINCT r.ev_class::regclass::text FROM pg_depend d JOIN pg_rewrite r ON r.oid = d.objid WHERE d.classid = 'pg_rewrite'::regclass AND d.refobjid = $1::regclass AND r.ev_class <> $1::regclass`,
+		reason: "dependent views",
+	},
+	{
+		query:  `SELECT tgname FROM pg_trigger WHERE tgrelid = $1::regclass AND NOT tgisinternal`,
+		reason: "triggers",
+	},
+	{
+		query:  `SELECT rulename FROM pg_rewrite WHERE ev_class = $1::regclass AND rulename <> '_RETURN'`,
+		reason: "rules",
+	},
+	{
+		query:  `SELECT polname FROM pg_policy WHERE polrelid = $1::regclass`,
+		reason: "row level security policies",
+	},
+	{
+		query:  `SELECT p.pubname FROM pg_publication_rel r JOIN pg_publication p ON p.oid = r.prpubid WHERE r.prrelid = $1::regclass`,
+		reason: "publications",
+	},
+}
+
+// Migration is the online schema migration of a PostgreSQL table.
+//
+// The migration runs in the following phases:
+//  1. Prepare creates the shadow table in a dedicated schema with the same definition as the original table,
+//     applies the ALTER TABLE statement on it, and creates the triggers capturing the ongoing changes on the original table.
+//  2. Backfill copies the existing rows to the shadow table in batches by the primary key, throttled by the replica lag.
+//  3. Cutover swaps the tables under a short ACCESS EXCLUSIVE lock. The original table is kept with the "_del" suffix.
+//
+// The shadow schema is named after the sync task, so the phases can run in different task runs.
+type Migration struct {
+	db     *sql.DB
+	config *Config
+	taskID int
+	tree   *pgquery.ParseResult
+	alter  *pgquery.AlterTableStmt
+
+	// The fields below are resolved from the database.
+	schema     string
+	table      string
+	primaryKey []*column
+	// columns are the columns copied from the original table to the shadow table.
+	columns []string
+}
+
+type column struct {
+	name string
+	typ  string
+}
+
+// Progress is the progress of the backfill.
+type Progress struct {
+	TotalRows  int64
+	CopiedRows int64
+	// Throttled is the reason of throttling the backfill, empty if not throttled.
+	Throttled string
+}
+
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// NewMigration creates the online schema migration of the statement for the sync task.
+func NewMigration(db *sql.DB, taskID int, statement string, flags map[string]string) (*Migration, error) {
+	config, err := GetConfig(flags)
+	if err != nil {
+		return nil, err
+	}
+	tree, alter, err := parseStatement(statement)
+	if err != nil {
+		return nil, err
+	}
+	return &Migration{
+		db:     db,
+		config: config,
+		taskID: taskID,
+		tree:   tree,
+		alter:  alter,
+	}, nil
+}
+
+// CheckStatement checks if the statement can be migrated online.
+func CheckStatement(statement string) error {
+	_, _, err := parseStatement(statement)
+	return err
+}
+
+func parseStatement(statement string) (*pgquery.ParseResult, *pgquery.AlterTableStmt, error) {
+	tree, err := pgquery.Parse(statement)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to parse statement")
+	}
+	if len(tree.Stmts) != 1 {
+		return nil, nil, errors.Errorf("online schema migration requires exactly one ALTER TABLE statement, but got %d statements", len(tree.Stmts))
+	}
+	alter := tree.Stmts[0].GetStmt().GetAlterTableStmt()
+	if alter == nil || alter.Objtype != pgquery.ObjectType_OBJECT_TABLE {
+		return nil, nil, errors.Errorf("online schema migration requires an ALTER TABLE statement")
+	}
+	for _, node := range alter.Cmds {
+		cmd := node.GetAlterTableCmd()
+		if !supportedCommands[cmd.GetSubtype()] {
+			return nil, nil, errors.Errorf("%s is not supported by online schema migration", strings.TrimPrefix(cmd.GetSubtype().String(), "AT_"))
+		}
+		switch cmd.GetSubtype() {
+		case pgquery.AlterTableType_AT_AddColumn:
+			if err := checkColumnConstraints(cmd.GetDef().GetColumnDef()); err != nil {
+				return nil, nil, err
+			}
+		case pgquery.AlterTableType_AT_AlterColumnType:
+			// The rows are copied by the assignment cast, so the USING expression is not applied.
+			if cmd.GetDef().GetColumnDef().GetRawDefault() != nil {
+				return nil, nil, errors.Errorf("changing the type of column %q with USING is not supported by online schema migration", cmd.GetName())
+			}
+		default:
+		}
+	}
+	return tree, alter, nil
+}
+
+// checkColumnConstraints checks the constraints of the added column don't reject the rows synced from the original table.
+// The synced rows have no value for the new column, so NOT NULL requires a default value.
+func checkColumnConstraints(def *pgquery.ColumnDef) error {
+	hasDefault, notNull := false, false
+	for _, node := range def.GetConstraints() {
+		switch constraint := node.GetConstraint(); constraint.GetContype() {
+		case pgquery.ConstrType_CONSTR_DEFAULT, pgquery.ConstrType_CONSTR_IDENTITY, pgquery.ConstrType_CONSTR_GENERATED:
+			hasDefault = true
+		case pgquery.ConstrType_CONSTR_NOTNULL:
+			notNull = true
+		case pgquery.ConstrType_CONSTR_NULL:
+		default:
+			return errors.Errorf("adding column %q with %s constraint is not supported by online schema migration", def.GetColname(), strings.TrimPrefix(constraint.GetContype().String(), "CONSTR_"))
+		}
+	}
+	if notNull && !hasDefault {
+		return errors.Errorf("adding NOT NULL column %q without default value is not supported by online schema migration", def.GetColname())
+	}
+	return nil
+}
+
+// Check checks the prerequisites and applies the statement on the shadow table without committing.
+func (m *Migration) Check(ctx context.Context) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := m.checkPrerequisites(ctx, tx); err != nil {
+		return err
+	}
+	return m.createShadowTable(ctx, tx)
+}
+
+// Prepare creates the shadow table and the triggers syncing the changes on the original table.
+// The leftover of the previous attempt is removed first.
+func (m *Migration) Prepare(ctx context.Context) error {
+	if err := m.Cleanup(ctx); err != nil {
+		return err
+	}
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := m.checkPrerequisites(ctx, tx); err != nil {
+		return err
+	}
+	if err := m.createShadowTable(ctx, tx); err != nil {
+		return err
+	}
+	if err := m.resolveColumns(ctx, tx); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL lock_timeout = %d", m.config.CutoverLockTimeout.Milliseconds())); err != nil {
+		return err
+	}
+	for _, statement := range m.syncStatements() {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to create the sync trigger")
+		}
+	}
+	return tx.Commit()
+}
+
+// Backfill copies the rows of the original table to the shadow table in batches, and validates the foreign keys afterwards.
+func (m *Migration) Backfill(ctx context.Context, report func(Progress)) error {
+	if err := m.resolve(ctx, m.db); err != nil {
+		return err
+	}
+	if err := m.resolveColumns(ctx, m.db); err != nil {
+		return err
+	}
+	var progress Progress
+	// reltuples is -1 if the table has never been analyzed.
+	if err := m.db.QueryRowContext(ctx, "SELECT GREATEST(reltuples, 0)::bigint FROM pg_class WHERE oid = $1::regclass", m.qualifiedTable()).Scan(&progress.TotalRows); err != nil {
+		return errors.Wrapf(err, "failed to estimate the rows of table %s", m.qualifiedTable())
+	}
+	report(progress)
+
+	var lastKey []any
+	for {
+		if err := m.throttle(ctx, &progress, report); err != nil {
+			return err
+		}
+		start := time.Now()
+		count, key, err := m.copyBatch(ctx, lastKey)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			break
+		}
+		lastKey = key
+		progress.CopiedRows += count
+		progress.TotalRows = max(progress.TotalRows, progress.CopiedRows)
+		report(progress)
+		if m.config.NiceRatio > 0 {
+			if err := sleep(ctx, time.Duration(float64(time.Since(start))*m.config.NiceRatio)); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Only the foreign keys validated on the original table are validated.
+	constraints, err := queryStrings(ctx, m.db, "SELECT conname FROM pg_constraint WHERE conrelid = $1::regclass AND contype = 'f' AND NOT convalidated AND conname IN (SELECT conname FROM pg_constraint WHERE conrelid = $2::regclass AND contype = 'f' AND convalidated)", m.qualifiedShadowTable(), m.qualifiedTable())
+	if err != nil {
+		return err
+	}
+	for _, constraint := range constraints {
+		if _, err := m.db.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s VALIDATE CONSTRAINT %s", m.qualifiedShadowTable(), quote(constraint))); err != nil {
+			return errors.Wrapf(err, "failed to validate foreign key %q", constraint)
+		}
+	}
+	return nil
+}
+
+// Cutover swaps the original table and the shadow table.
+// It retries on the lock timeout so that the ACCESS EXCLUSIVE lock doesn't queue the other sessions for long.
+func (m *Migration) Cutover(ctx context.Context) error {
+	if err := m.resolve(ctx, m.db); err != nil {
+		return err
+	}
+	if err := m.resolveColumns(ctx, m.db); err != nil {
+		return err
+	}
+	var err error
+	for i := int64(0); i < m.config.DefaultRetries; i++ {
+		if err := m.waitForReplicaLag(ctx); err != nil {
+			return err
+		}
+		if err = m.cutoverOnce(ctx); err == nil {
+			return nil
+		}
+		var pgErr *pgconn.PgError
+		if !errors.As(err, &pgErr) || pgErr.Code != lockNotAvailable {
+			return err
+		}
+		if err := sleep(ctx, time.Second); err != nil {
+			return err
+		}
+	}
+	return errors.Wrapf(err, "failed to cutover after %d attempts", m.config.DefaultRetries)
+}
+
+// Cleanup drops the shadow schema, which also drops the sync function and the triggers depending on it.
+func (m *Migration) Cleanup(ctx context.Context) error {
+	return CleanupTask(ctx, m.db, m.taskID)
+}
+
+// CleanupTask drops the shadow schema of the migration of the task.
+// Unlike Cleanup, it doesn't require the statement, so it works for the migrations that can't be created anymore.
+func CleanupTask(ctx context.Context, db *sql.DB, taskID int) error {
+	m := &Migration{taskID: taskID}
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("DROP SCHEMA IF EXISTS %s CASCADE", quote(m.shadowSchema()))); err != nil {
+		return errors.Wrapf(err, "failed to drop schema %q", m.shadowSchema())
+	}
+	return nil
+}
+
+// OldTable returns the name of the original table after the cutover.
+func (m *Migration) OldTable() string {
+	return objectName(m.table, fmt.Sprintf("_%d_del", m.taskID))
+}
+
+func (m *Migration) shadowSchema() string {
+	return fmt.Sprintf("_bb_online_%d", m.taskID)
+}
+
+func (m *Migration) qualifiedTable() string {
+	return quote(m.schema, m.table)
+}
+
+func (m *Migration) qualifiedShadowTable() string {
+	return quote(m.shadowSchema(), m.table)
+}
+
+func (m *Migration) syncTrigger() string {
+	return fmt.Sprintf("_bb_online_%d_sync", m.taskID)
+}
+
+func (m *Migration) truncateTrigger() string {
+	return fmt.Sprintf("_bb_online_%d_truncate", m.taskID)
+}
+
+// resolve resolves the schema, the name and the primary key of the original table.
+func (m *Migration) resolve(ctx context.Context, q queryer) error {
+	name := quote(m.alter.Relation.Relname)
+	if m.alter.Relation.Schemaname != "" {
+		name = quote(m.alter.Relation.Schemaname, m.alter.Relation.Relname)
+	}
+	var kind, persistence string
+	if err := q.QueryRowContext(ctx, `SELECT n.nspname, c.relname, c.relkind::text, c.relpersistence::text FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace WHERE c.oid = to_regclass($1)`, name).Scan(&m.schema, &m.table, &kind, &persistence); err != nil {
+		if err == sql.ErrNoRows {
+			return errors.Errorf("table %s does not exist", name)
+		}
+		return errors.Wrapf(err, "failed to get table %s", name)
+	}
+	if kind != "r" {
+		return errors.Errorf("%s is not a regular table, online schema migration doesn't support partitioned tables", name)
+	}
+	if persistence == "t" {
+		return errors.Errorf("online schema migration doesn't support temporary table %s", name)
+	}
+
+	rows, err := q.QueryContext(ctx, `SELECT a.attname, format_type(a.atttypid, a.atttypmod) FROM pg_index i JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey) WHERE i.indrelid = $1::regclass AND i.indisprimary ORDER BY array_position(i.indkey::int2[], a.attnum)`, m.qualifiedTable())
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	m.primaryKey = nil
+	for rows.Next() {
+		c := &column{}
+		if err := rows.Scan(&c.name, &c.typ); err != nil {
+			return err
+		}
+		m.primaryKey = append(m.primaryKey, c)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(m.primaryKey) == 0 {
+		return errors.Errorf("table %s has no primary key, which is required by online schema migration", m.qualifiedTable())
+	}
+	return nil
+}
+
+func (m *Migration) checkPrerequisites(ctx context.Context, q queryer) error {
+	if err := m.resolve(ctx, q); err != nil {
+		return err
+	}
+	for _, prerequisite := range prerequisites {
+		names, err := queryStrings(ctx, q, prerequisite.query, m.qualifiedTable())
+		if err != nil {
+			return errors.Wrapf(err, "failed to check %s", prerequisite.reason)
+		}
+		if len(names) > 0 {
+			return errors.Errorf("table %s has %s (%s), which are not supported by online schema migration", m.qualifiedTable(), prerequisite.reason, strings.Join(names, ", "))
+		}
+	}
+	return nil
+}
+
+// createShadowTable creates the shadow table like the original table and applies the statement on it.
+func (m *Migration) createShadowTable(ctx context.Context, q queryer) error {
+	shadowTable := m.qualifiedShadowTable()
+	statements := []string{
+		fmt.Sprintf("CREATE SCHEMA %s", quote(m.shadowSchema())),
+		fmt.Sprintf("CREATE TABLE %s (LIKE %s INCLUDING ALL)", shadowTable, m.qualifiedTable()),
+	}
+	owner := ""
+	if err := q.QueryRowContext(ctx, "SELECT pg_get_userbyid(relowner) FROM pg_class WHERE oid = $1::regclass", m.qualifiedTable()).Scan(&owner); err != nil {
+		return err
+	}
+	statements = append(statements, fmt.Sprintf("ALTER TABLE %s OWNER TO %s", shadowTable, quote(owner)))
+	for _, statement := range statements {
+		if _, err := q.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to create the shadow table")
+		}
+	}
+
+	// The shadow table is in its own schema, so the indexes can have the same names as the original ones.
+	// It keeps the index and constraint names after the cutover, and the statement can refer to them.
+	renames, err := m.indexRenames(ctx, q)
+	if err != nil {
+		return err
+	}
+	for _, statement := range renames {
+		if _, err := q.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to rename the index of the shadow table")
+		}
+	}
+
+	grants, err := m.grantStatements(ctx, q)
+	if err != nil {
+		return err
+	}
+	for _, statement := range grants {
+		if _, err := q.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to grant privileges on the shadow table")
+		}
+	}
+
+	// The foreign keys are not copied by LIKE. They are validated after the backfill to avoid scanning the shadow table.
+	foreignKeys, err := m.foreignKeyStatements(ctx, q)
+	if err != nil {
+		return err
+	}
+	for _, statement := range foreignKeys {
+		if _, err := q.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to copy the foreign key to the shadow table")
+		}
+	}
+
+	statement, err := m.shadowStatement()
+	if err != nil {
+		return err
+	}
+	if _, err := q.ExecContext(ctx, statement); err != nil {
+		return errors.Wrapf(err, "failed to apply the statement on the shadow table")
+	}
+
+	shadowPrimaryKey, err := queryStrings(ctx, q, `SELECT a.attname FROM pg_index i JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey) WHERE i.indrelid = $1::regclass AND i.indisprimary ORDER BY array_position(i.indkey::int2[], a.attnum)`, shadowTable)
+	if err != nil {
+		return err
+	}
+	if len(shadowPrimaryKey) != len(m.primaryKey) {
+		return errors.Errorf("online schema migration doesn't support changing the primary key")
+	}
+	for i, c := range m.primaryKey {
+		if c.name != shadowPrimaryKey[i] {
+			return errors.Errorf("online schema migration doesn't support changing the primary key")
+		}
+	}
+	return m.checkColumnTypes(ctx, q)
+}
+
+// checkColumnTypes checks the type changes are widening.
+// Otherwise a value on the original table not fitting the new type would fail the write by the sync trigger until the cutover.
+func (m *Migration) checkColumnTypes(ctx context.Context, q queryer) error {
+	query := `SELECT format_type(atttypid, atttypmod) FROM pg_attribute WHERE attrelid = $1::regclass AND attname = $2 AND NOT attisdropped`
+	for _, node := range m.alter.Cmds {
+		cmd := node.GetAlterTableCmd()
+		if cmd.GetSubtype() != pgquery.AlterTableType_AT_AlterColumnType {
+			continue
+		}
+		var from, to string
+		if err := q.QueryRowContext(ctx, query, m.qualifiedTable(), cmd.GetName()).Scan(&from); err != nil {
+			// The column is added by the statement, so it has no value from the original table.
+			if err == sql.ErrNoRows {
+				continue
+			}
+			return err
+		}
+		if err := q.QueryRowContext(ctx, query, m.qualifiedShadowTable(), cmd.GetName()).Scan(&to); err != nil {
+			return err
+		}
+		if !isWideningType(from, to) {
+			return errors.Errorf("changing the type of column %q from %s to %s is not supported by online schema migration, only widening type changes are supported", cmd.GetName(), from, to)
+		}
+	}
+	return nil
+}
+
+// wideningTypes are the types that every value of the key type can be converted to.
+var wideningTypes = map[string][]string{
+	"smallint":          {"integer", "bigint", "numeric"},
+	"integer":           {"bigint", "numeric"},
+	"bigint":            {"numeric"},
+	"real":              {"double precision"},
+	"character varying": {"text"},
+	"text":              {"character varying"},
+}
+
+// isWideningType returns true if every value of the type from can be converted to the type to.
+// The types are in the format of format_type(), e.g. "character varying(20)" and "numeric(10,2)".
+func isWideningType(from, to string) bool {
+	if from == to {
+		return true
+	}
+	fromBase, fromModifiers := splitTypeModifiers(from)
+	toBase, toModifiers := splitTypeModifiers(to)
+	if fromBase == toBase {
+		if toModifiers == nil {
+			return true
+		}
+		if fromModifiers == nil || len(fromModifiers) != len(toModifiers) {
+			return false
+		}
+		switch fromBase {
+		case "character varying", "bit varying":
+			return toModifiers[0] >= fromModifiers[0]
+		case "numeric":
+			// The integer digits and the scale must not be narrowed.
+			fromScale, toScale := 0, 0
+			if len(fromModifiers) == 2 {
+				fromScale, toScale = fromModifiers[1], toModifiers[1]
+			}
+			return toScale >= fromScale && toModifiers[0]-toScale >= fromModifiers[0]-fromScale
+		default:
+			return false
+		}
+	}
+	return toModifiers == nil && slices.Contains(wideningTypes[fromBase], toBase)
+}
+
+// splitTypeModifiers splits "numeric(10,2)" to "numeric" and [10, 2].
+func splitTypeModifiers(typ string) (string, []int) {
+	base, rest, ok := strings.Cut(typ, "(")
+	if !ok {
+		return typ, nil
+	}
+	rest, ok = strings.CutSuffix(rest, ")")
+	if !ok {
+		// The modifiers are not at the end, e.g. arrays.
+		return typ, nil
+	}
+	var modifiers []int
+	for _, s := range strings.Split(rest, ",") {
+		v, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			return typ, nil
+		}
+		modifiers = append(modifiers, v)
+	}
+	return base, modifiers
+}
+
+// shadowStatement returns the statement altering the shadow table instead of the original table.
+func (m *Migration) shadowStatement() (string, error) {
+	tree, ok := proto.Clone(m.tree).(*pgquery.ParseResult)
+	if !ok {
+		return "", errors.Errorf("failed to clone the parse tree")
+	}
+	relation := tree.Stmts[0].GetStmt().GetAlterTableStmt().GetRelation()
+	relation.Schemaname = m.shadowSchema()
+	relation.Relname = m.table
+	statement, err := pgquery.Deparse(tree)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to deparse statement")
+	}
+	return statement, nil
+}
+
+// indexRenames returns the statements renaming the shadow indexes to the names of the matched original indexes.
+// The indexes are matched by the definition after the table name.
+func (m *Migration) indexRenames(ctx context.Context, q queryer) ([]string, error) {
+	query := `SELECT c.relname, i.indisunique, pg_get_indexdef(i.indexrelid) FROM pg_index i JOIN pg_class c ON c.oid = i.indexrelid WHERE i.indrelid = $1::regclass ORDER BY c.relname`
+	originals, err := queryIndexes(ctx, q, query, m.qualifiedTable())
+	if err != nil {
+		return nil, err
+	}
+	shadows, err := queryIndexes(ctx, q, query, m.qualifiedShadowTable())
+	if err != nil {
+		return nil, err
+	}
+	var statements []string
+	matched := make(map[int]bool)
+	for _, original := range originals {
+		for i, shadow := range shadows {
+			if matched[i] || shadow.key != original.key {
+				continue
+			}
+			matched[i] = true
+			if shadow.name != original.name {
+				statements = append(statements, fmt.Sprintf("ALTER INDEX %s RENAME TO %s", quote(m.shadowSchema(), shadow.name), quote(original.name)))
+			}
+			break
+		}
+	}
+	return statements, nil
+}
+
+type index struct {
+	name string
+	key  string
+}
+
+func queryIndexes(ctx context.Context, q queryer, query string, table string) ([]*index, error) {
+	rows, err := q.QueryContext(ctx, query, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var indexes []*index
+	for rows.Next() {
+		var name, definition string
+		var unique bool
+		if err := rows.Scan(&name, &unique, &definition); err != nil {
+			return nil, err
+		}
+		key := definition
+		if i := strings.Index(definition, " USING "); i >= 0 {
+			key = definition[i:]
+		}
+		indexes = append(indexes, &index{name: name, key: fmt.Sprintf("%t%s", unique, key)})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return indexes, nil
+}
+
+func (m *Migration) grantStatements(ctx context.Context, q queryer) ([]string, error) {
+	rows, err := q.QueryContext(ctx, `SELECT grantee, privilege_type, is_grantable FROM information_schema.table_privileges WHERE table_schema = $1 AND table_name = $2 AND grantee <> (SELECT pg_get_userbyid(relowner) FROM pg_class WHERE oid = $3::regclass)`, m.schema, m.table, m.qualifiedTable())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var statements []string
+	for rows.Next() {
+		var grantee, privilege, grantable string
+		if err := rows.Scan(&grantee, &privilege, &grantable); err != nil {
+			return nil, err
+		}
+		if grantee != "PUBLIC" {
+			grantee = quote(grantee)
+		}
+		statement := fmt.Sprintf("GRANT %s ON %s TO %s", privilege, m.qualifiedShadowTable(), grantee)
+		if grantable == "YES" {
+			statement += " WITH GRANT OPTION"
+		}
+		statements = append(statements, statement)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return statements, nil
+}
+
+func (m *Migration) foreignKeyStatements(ctx context.Context, q queryer) ([]string, error) {
+	rows, err := q.QueryContext(ctx, `SELECT conname, pg_get_constraintdef(oid) FROM pg_constraint WHERE conrelid = $1::regclass AND contype = 'f' ORDER BY conname`, m.qualifiedTable())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var statements []string
+	for rows.Next() {
+		var name, definition string
+		if err := rows.Scan(&name, &definition); err != nil {
+			return nil, err
+		}
+		if !strings.HasSuffix(definition, "NOT VALID") {
+			definition += " NOT VALID"
+		}
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s %s", m.qualifiedShadowTable(), quote(name), definition))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return statements, nil
+}
+
+// resolveColumns resolves the columns copied to the shadow table.
+// The generated columns are computed by the shadow table, and the dropped columns are skipped.
+func (m *Migration) resolveColumns(ctx context.Context, q queryer) error {
+	query := `SELECT column_name FROM information_schema.columns WHERE table_schema = $1 AND table_name = $2 AND is_generated = 'NEVER' ORDER BY ordinal_position`
+	originals, err := queryStrings(ctx, q, query, m.schema, m.table)
+	if err != nil {
+		return err
+	}
+	shadows, err := queryStrings(ctx, q, query, m.shadowSchema(), m.table)
+	if err != nil {
+		return err
+	}
+	if len(shadows) == 0 {
+		return errors.Errorf("shadow table %s does not exist", m.qualifiedShadowTable())
+	}
+	shadowColumns := make(map[string]bool)
+	for _, c := range shadows {
+		shadowColumns[c] = true
+	}
+	m.columns = nil
+	for _, c := range originals {
+		if shadowColumns[c] {
+			m.columns = append(m.columns, c)
+		}
+	}
+	return nil
+}
+
+// syncStatements returns the statements creating the function and the triggers syncing the changes to the shadow table.
+// The row is upserted so that the values of the columns only in the shadow table are kept on update.
+func (m *Migration) syncStatements() []string {
+	var primaryKey, oldKey, newKey, values, updates []string
+	isPrimaryKey := make(map[string]bool)
+	for _, c := range m.primaryKey {
+		isPrimaryKey[c.name] = true
+		primaryKey = append(primaryKey, quote(c.name))
+		oldKey = append(oldKey, "OLD."+quote(c.name))
+		newKey = append(newKey, "NEW."+quote(c.name))
+	}
+	for _, c := range m.columns {
+		values = append(values, "NEW."+quote(c))
+		if !isPrimaryKey[c] {
+			updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", quote(c), quote(c)))
+		}
+	}
+	conflict := "DO NOTHING"
+	if len(updates) > 0 {
+		conflict = "DO UPDATE SET " + strings.Join(updates, ", ")
+	}
+	function := quote(m.shadowSchema(), "sync")
+	shadowTable := m.qualifiedShadowTable()
+	deleteOld := fmt.Sprintf("DELETE FROM %s WHERE (%s) = (%s);", shadowTable, strings.Join(primaryKey, ", "), strings.Join(oldKey, ", "))
+
+	var body strings.Builder
+	_, _ = fmt.Fprintf(&body, "BEGIN\n")
+	_, _ = fmt.Fprintf(&body, "  IF TG_OP = 'TRUNCATE' THEN\n    TRUNCATE %s;\n    RETURN NULL;\n  END IF;\n", shadowTable)
+	_, _ = fmt.Fprintf(&body, "  IF TG_OP = 'DELETE' THEN\n    %s\n    RETURN NULL;\n  END IF;\n", deleteOld)
+	_, _ = fmt.Fprintf(&body, "  IF TG_OP = 'UPDATE' AND ROW(%s) IS DISTINCT FROM ROW(%s) THEN\n    %s\n  END IF;\n", strings.Join(oldKey, ", "), strings.Join(newKey, ", "), deleteOld)
+	_, _ = fmt.Fprintf(&body, "  INSERT INTO %s (%s) OVERRIDING SYSTEM VALUE VALUES (%s) ON CONFLICT (%s) %s;\n", shadowTable, m.columnList(), strings.Join(values, ", "), strings.Join(primaryKey, ", "), conflict)
+	_, _ = fmt.Fprintf(&body, "  RETURN NULL;\nEND")
+
+	return []string{
+		fmt.Sprintf("CREATE FUNCTION %s() RETURNS trigger LANGUAGE plpgsql AS $bb$\n%s\n$bb$", function, body.String()),
+		fmt.Sprintf("CREATE TRIGGER %s AFTER INSERT OR UPDATE OR DELETE ON %s FOR EACH ROW EXECUTE PROCEDURE %s()", quote(m.syncTrigger()), m.qualifiedTable(), function),
+		fmt.Sprintf("CREATE TRIGGER %s AFTER TRUNCATE ON %s FOR EACH STATEMENT EXECUTE PROCEDURE %s()", quote(m.truncateTrigger()), m.qualifiedTable(), function),
+	}
+}
+
+// backfillQuery returns the query copying the next batch of rows after the last primary key.
+// The rows are locked in share mode so that the concurrent changes are synced by the trigger after the batch.
+// It returns the number of copied rows and the last primary key in text.
+func (m *Migration) backfillQuery(first bool) string {
+	var primaryKey, lastKey, lastKeyText []string
+	for i, c := range m.primaryKey {
+		primaryKey = append(primaryKey, quote(c.name))
+		lastKey = append(lastKey, fmt.Sprintf("$%d::text::%s", i+1, c.typ))
+		lastKeyText = append(lastKeyText, quote(c.name)+"::text")
+	}
+	where := ""
+	if !first {
+		where = fmt.Sprintf(" WHERE ROW(%s) > ROW(%s)", strings.Join(primaryKey, ", "), strings.Join(lastKey, ", "))
+	}
+	columns := m.columnList()
+	return fmt.Sprintf(
+		"WITH batch AS (SELECT %s FROM %s%s ORDER BY %s LIMIT %d FOR SHARE), "+
+			"copied AS (INSERT INTO %s (%s) OVERRIDING SYSTEM VALUE SELECT %s FROM batch ON CONFLICT (%s) DO NOTHING) "+
+			"SELECT (SELECT count(*) FROM batch), %s FROM batch ORDER BY %s LIMIT 1",
+		columns, m.qualifiedTable(), where, strings.Join(primaryKey, ", "), m.config.ChunkSize,
+		m.qualifiedShadowTable(), columns, columns, strings.Join(primaryKey, ", "),
+		strings.Join(lastKeyText, ", "), strings.Join(descending(primaryKey), ", "),
+	)
+}
+
+func (m *Migration) copyBatch(ctx context.Context, lastKey []any) (int64, []any, error) {
+	dest := make([]any, len(m.primaryKey)+1)
+	var count int64
+	dest[0] = &count
+	key := make([]string, len(m.primaryKey))
+	for i := range key {
+		dest[i+1] = &key[i]
+	}
+	if err := m.db.QueryRowContext(ctx, m.backfillQuery(lastKey == nil), lastKey...).Scan(dest...); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil, nil
+		}
+		return 0, nil, errors.Wrapf(err, "failed to copy rows to the shadow table")
+	}
+	var next []any
+	for _, k := range key {
+		next = append(next, k)
+	}
+	return count, next, nil
+}
+
+func (m *Migration) cutoverOnce(ctx context.Context) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL lock_timeout = %d", m.config.CutoverLockTimeout.Milliseconds())); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("LOCK TABLE %s, %s IN ACCESS EXCLUSIVE MODE", m.qualifiedTable(), m.qualifiedShadowTable())); err != nil {
+		return err
+	}
+
+	statements, err := m.cutoverStatements(ctx, tx)
+	if err != nil {
+		return err
+	}
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to execute %q", statement)
+		}
+	}
+	return tx.Commit()
+}
+
+// cutoverStatements returns the statements swapping the tables, which run under the ACCESS EXCLUSIVE lock.
+func (m *Migration) cutoverStatements(ctx context.Context, q queryer) ([]string, error) {
+	var statements []string
+	statements = append(statements,
+		fmt.Sprintf("DROP TRIGGER %s ON %s", quote(m.syncTrigger()), m.qualifiedTable()),
+		fmt.Sprintf("DROP TRIGGER %s ON %s", quote(m.truncateTrigger()), m.qualifiedTable()),
+	)
+
+	// Free the index names for the shadow indexes.
+	indexes, err := queryStrings(ctx, q, `SELECT c.relname FROM pg_index i JOIN pg_class c ON c.oid = i.indexrelid WHERE i.indrelid = $1::regclass ORDER BY c.relname`, m.qualifiedTable())
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indexes {
+		statements = append(statements, fmt.Sprintf("ALTER INDEX %s RENAME TO %s", quote(m.schema, index), quote(objectName(index, fmt.Sprintf("_%d_del", m.taskID)))))
+	}
+
+	sequences, err := m.ownedSequences(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	copied := make(map[string]bool)
+	for _, c := range m.columns {
+		copied[c] = true
+	}
+	var ownedBy []string
+	for _, sequence := range sequences {
+		original := quote(sequence.schema, sequence.name)
+		if sequence.identity {
+			// The identity sequence of the shadow table continues from the original one, and takes over its name.
+			var shadow sql.NullString
+			if err := q.QueryRowContext(ctx, "SELECT pg_get_serial_sequence($1, $2)", m.qualifiedShadowTable(), sequence.column).Scan(&shadow); err != nil {
+				return nil, err
+			}
+			if shadow.Valid {
+				statements = append(statements, fmt.Sprintf("SELECT setval('%s', last_value, is_called) FROM %s", strings.ReplaceAll(shadow.String, "'", "''"), original))
+			}
+			statements = append(statements, fmt.Sprintf("ALTER SEQUENCE %s RENAME TO %s", original, quote(objectName(sequence.name, fmt.Sprintf("_%d_del", m.taskID)))))
+			continue
+		}
+		// The serial sequences owned by the original table would be dropped with it.
+		if copied[sequence.column] {
+			ownedBy = append(ownedBy, fmt.Sprintf("ALTER SEQUENCE %s OWNED BY %s", original, quote(m.schema, m.table, sequence.column)))
+		}
+	}
+
+	statements = append(statements,
+		fmt.Sprintf("ALTER TABLE %s RENAME TO %s", m.qualifiedTable(), quote(m.OldTable())),
+		fmt.Sprintf("ALTER TABLE %s SET SCHEMA %s", m.qualifiedShadowTable(), quote(m.schema)),
+	)
+	statements = append(statements, ownedBy...)
+	statements = append(statements, fmt.Sprintf("DROP SCHEMA %s CASCADE", quote(m.shadowSchema())))
+	return statements, nil
+}
+
+type sequence struct {
+	schema   string
+	name     string
+	column   string
+	identity bool
+}
+
+// ownedSequences returns the serial and identity sequences owned by the original table.
+func (m *Migration) ownedSequences(ctx context.Context, q queryer) ([]*sequence, error) {
+	rows, err := q.QueryContext(ctx, `SELECT n.nspname, s.relname, a.attname, d.deptype = 'i' FROM pg_depend d JOIN pg_class s ON s.oid = d.objid AND s.relkind = 'S' JOIN pg_namespace n ON n.oid = s.relnamespace JOIN pg_attribute a ON a.attrelid = d.refobjid AND a.attnum = d.refobjsubid WHERE d.refobjid = $1::regclass AND d.deptype IN ('a', 'i') ORDER BY s.relname`, m.qualifiedTable())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var sequences []*sequence
+	for rows.Next() {
+		s := &sequence{}
+		if err := rows.Scan(&s.schema, &s.name, &s.column, &s.identity); err != nil {
+			return nil, err
+		}
+		sequences = append(sequences, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return sequences, nil
+}
+
+// throttle waits until the replica lag is under the threshold.
+func (m *Migration) throttle(ctx context.Context, progress *Progress, report func(Progress)) error {
+	for {
+		lag, err := m.replicaLag(ctx)
+		if err != nil {
+			return err
+		}
+		if lag <= m.config.MaxLag {
+			if progress.Throttled != "" {
+				progress.Throttled = ""
+				report(*progress)
+			}
+			return nil
+		}
+		progress.Throttled = fmt.Sprintf("replica lag %s exceeds %s", lag, m.config.MaxLag)
+		report(*progress)
+		if err := sleep(ctx, time.Second); err != nil {
+			return err
+		}
+	}
+}
+
+func (m *Migration) waitForReplicaLag(ctx context.Context) error {
+	return m.throttle(ctx, &Progress{}, func(Progress) {})
+}
+
+// replicaLag returns the max replay lag of the streaming replicas.
+// The lag is zero if the user isn't allowed to read the lag.
+func (m *Migration) replicaLag(ctx context.Context) (time.Duration, error) {
+	var millis float64
+	if err := m.db.QueryRowContext(ctx, "SELECT COALESCE(MAX(EXTRACT(EPOCH FROM replay_lag)), 0) * 1000 FROM pg_stat_replication").Scan(&millis); err != nil {
+		return 0, errors.Wrapf(err, "failed to get the replica lag")
+	}
+	return time.Duration(millis * float64(time.Millisecond)), nil
+}
+
+func (m *Migration) columnList() string {
+	var columns []string
+	for _, c := range m.columns {
+		columns = append(columns, quote(c))
+	}
+	return strings.Join(columns, ", ")
+}
+
+func queryStrings(ctx context.Context, q queryer, query string, args ...any) ([]string, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []string
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			return nil, err
+		}
+		result = append(result, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func descending(columns []string) []string {
+	var result []string
+	for _, c := range columns {
+		result = append(result, c+" DESC")
+	}
+	return result
+}
+
+func quote(parts ...string) string {
+	return pgx.Identifier(parts).Sanitize()
+}
+
+// objectName returns the name with the suffix, truncating the name to fit in the identifier length.
+func objectName(name, suffix string) string {
+	if n := maxIdentifierLength - len(suffix) - 1; len(name) > n {
+		name = strings.ToValidUTF8(name[:n], "")
+	}
+	return "_" + name + suffix
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
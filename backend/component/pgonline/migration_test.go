@@ -0,0 +1,144 @@
+package pgonline
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckStatement(t *testing.T) {
+	tests := []struct {
+		statement string
+		err       string
+	}{
+		{
+			statement: "ALTER TABLE orders ADD COLUMN note text, ALTER COLUMN amount TYPE bigint;",
+		},
+		{
+			statement: "ALTER TABLE orders ALTER COLUMN amount TYPE bigint USING amount::bigint;",
+			err:       `changing the type of column "amount" with USING is not supported by online schema migration`,
+		},
+		{
+			statement: "ALTER TABLE orders ATTACH PARTITION orders_2024 FOR VALUES IN (2024);",
+			err:       "AttachPartition is not supported by online schema migration",
+		},
+		{
+			statement: "ALTER TABLE orders ADD COLUMN status text NOT NULL DEFAULT 'new', ADD COLUMN seq bigint GENERATED ALWAYS AS IDENTITY;",
+		},
+		{
+			statement: "ALTER TABLE orders ALTER COLUMN note SET NOT NULL;",
+			err:       "SetNotNull is not supported by online schema migration",
+		},
+		{
+			statement: "ALTER TABLE orders ADD CONSTRAINT orders_amount_check CHECK (amount > 0);",
+			err:       "AddConstraint is not supported by online schema migration",
+		},
+		{
+			statement: "ALTER TABLE orders ADD COLUMN status text NOT NULL;",
+			err:       `adding NOT NULL column "status" without default value is not supported by online schema migration`,
+		},
+		{
+			statement: "ALTER TABLE orders ADD COLUMN customer_id int REFERENCES customers (id);",
+			err:       `adding column "customer_id" with FOREIGN constraint is not supported by online schema migration`,
+		},
+		{
+			statement: "ALTER TABLE orders ADD COLUMN note text; ALTER TABLE orders ADD COLUMN memo text;",
+			err:       "online schema migration requires exactly one ALTER TABLE statement, but got 2 statements",
+		},
+		{
+			statement: "CREATE INDEX idx_orders_note ON orders (note);",
+			err:       "online schema migration requires an ALTER TABLE statement",
+		},
+	}
+	for _, test := range tests {
+		err := CheckStatement(test.statement)
+		if test.err == "" {
+			require.NoError(t, err, test.statement)
+		} else {
+			require.EqualError(t, err, test.err, test.statement)
+		}
+	}
+}
+
+func TestIsWideningType(t *testing.T) {
+	tests := []struct {
+		from string
+		to   string
+		want bool
+	}{
+		{from: "integer", to: "integer", want: true},
+		{from: "integer", to: "bigint", want: true},
+		{from: "smallint", to: "numeric", want: true},
+		{from: "bigint", to: "integer", want: false},
+		{from: "integer", to: "numeric(5,0)", want: false},
+		{from: "real", to: "double precision", want: true},
+		{from: "character varying(20)", to: "character varying(50)", want: true},
+		{from: "character varying(50)", to: "character varying(20)", want: false},
+		{from: "character varying(20)", to: "text", want: true},
+		{from: "text", to: "character varying", want: true},
+		{from: "text", to: "character varying(20)", want: false},
+		{from: "numeric(10,2)", to: "numeric(12,2)", want: true},
+		{from: "numeric(10,2)", to: "numeric(10,3)", want: false},
+		{from: "numeric(10,2)", to: "numeric", want: true},
+		{from: "numeric", to: "numeric(10,2)", want: false},
+		{from: "integer", to: "text", want: false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, isWideningType(test.from, test.to), "%s -> %s", test.from, test.to)
+	}
+}
+
+func TestGetConfig(t *testing.T) {
+	a := require.New(t)
+	config, err := GetConfig(nil)
+	a.NoError(err)
+	a.Equal(&Config{ChunkSize: 1000, MaxLag: 1500 * time.Millisecond, CutoverLockTimeout: 3 * time.Second, DefaultRetries: 60}, config)
+
+	config, err = GetConfig(map[string]string{"chunk-size": "500", "nice-ratio": "0.5"})
+	a.NoError(err)
+	a.Equal(int64(500), config.ChunkSize)
+	a.Equal(0.5, config.NiceRatio)
+
+	_, err = GetConfig(map[string]string{"max-load": "Threads_running=25"})
+	a.EqualError(err, "unsupported flag: max-load")
+	_, err = GetConfig(map[string]string{"chunk-size": "0"})
+	a.EqualError(err, `chunk-size "0" must be positive`)
+}
+
+func TestMigrationStatements(t *testing.T) {
+	a := require.New(t)
+	m, err := NewMigration(nil, 42, "ALTER TABLE orders ALTER COLUMN amount TYPE bigint;", map[string]string{"chunk-size": "100"})
+	a.NoError(err)
+	m.schema = "public"
+	m.table = "orders"
+	m.primaryKey = []*column{{name: "tenant", typ: "integer"}, {name: "id", typ: "bigint"}}
+	m.columns = []string{"tenant", "id", "amount"}
+
+	statement, err := m.shadowStatement()
+	a.NoError(err)
+	a.Equal(`ALTER TABLE _bb_online_42.orders ALTER COLUMN amount TYPE bigint`, statement)
+	// The original statement is not changed.
+	a.Equal("orders", m.alter.Relation.Relname)
+	a.Empty(m.alter.Relation.Schemaname)
+
+	a.Equal(`WITH batch AS (SELECT "tenant", "id", "amount" FROM "public"."orders" ORDER BY "tenant", "id" LIMIT 100 FOR SHARE), `+
+		`copied AS (INSERT INTO "_bb_online_42"."orders" ("tenant", "id", "amount") OVERRIDING SYSTEM VALUE SELECT "tenant", "id", "amount" FROM batch ON CONFLICT ("tenant", "id") DO NOTHING) `+
+		`SELECT (SELECT count(*) FROM batch), "tenant"::text, "id"::text FROM batch ORDER BY "tenant" DESC, "id" DESC LIMIT 1`, m.backfillQuery(true))
+	a.Contains(m.backfillQuery(false), `FROM "public"."orders" WHERE ROW("tenant", "id") > ROW($1::text::integer, $2::text::bigint) ORDER BY`)
+
+	statements := m.syncStatements()
+	a.Len(statements, 3)
+	a.Contains(statements[0], `CREATE FUNCTION "_bb_online_42"."sync"() RETURNS trigger LANGUAGE plpgsql`)
+	a.Contains(statements[0], `DELETE FROM "_bb_online_42"."orders" WHERE ("tenant", "id") = (OLD."tenant", OLD."id");`)
+	a.Contains(statements[0], `IF TG_OP = 'UPDATE' AND ROW(OLD."tenant", OLD."id") IS DISTINCT FROM ROW(NEW."tenant", NEW."id") THEN`)
+	a.Contains(statements[0], `INSERT INTO "_bb_online_42"."orders" ("tenant", "id", "amount") OVERRIDING SYSTEM VALUE VALUES (NEW."tenant", NEW."id", NEW."amount") ON CONFLICT ("tenant", "id") DO UPDATE SET "amount" = EXCLUDED."amount";`)
+	a.Equal(`CREATE TRIGGER "_bb_online_42_sync" AFTER INSERT OR UPDATE OR DELETE ON "public"."orders" FOR EACH ROW EXECUTE PROCEDURE "_bb_online_42"."sync"()`, statements[1])
+	a.Equal(`CREATE TRIGGER "_bb_online_42_truncate" AFTER TRUNCATE ON "public"."orders" FOR EACH STATEMENT EXECUTE PROCEDURE "_bb_online_42"."sync"()`, statements[2])
+
+	a.Equal("_orders_42_del", m.OldTable())
+	long := objectName(strings.Repeat("t", 70), "_42_del")
+	a.Len(long, maxIdentifierLength)
+	a.True(strings.HasSuffix(long, "_42_del"))
+}
@@ -0,0 +1,423 @@
+// Package sensitivedata detects personally identifiable information in columns and proposes the classification and masking for them.
+package sensitivedata
+
+import (
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// MaxSampleSize is the maximum number of values sampled per column.
+	MaxSampleSize = 1000
+
+	// minSampleCount is the minimum number of non-empty values to trust the value detection.
+	minSampleCount = 5
+	// valueMatchRatio is the minimum ratio of matched values to report a value detection.
+	valueMatchRatio = 0.8
+	// valueMismatchRatio is the ratio under which the sampled values contradict a name detection.
+	valueMismatchRatio = 0.1
+	// nameConfidence is the confidence of a column name detection.
+	nameConfidence = 0.6
+	// typeConfidence is the confidence of a column type detection.
+	typeConfidence = 0.9
+	// proposalConfidence is the minimum confidence to propose a column as sensitive.
+	proposalConfidence = 0.5
+)
+
+// Column is the column to detect.
+type Column struct {
+	Name string
+	Type string
+	// Values are the sampled values of the column, empty if sampling is disabled.
+	Values []string
+}
+
+// namePatterns are the token sequences of column names for each category.
+var namePatterns = map[storepb.SensitiveDataCategory][][]string{
+	storepb.SensitiveDataCategory_EMAIL: {
+		{"email"}, {"e", "mail"}, {"mail", "address"}, {"mail", "addr"},
+	},
+	storepb.SensitiveDataCategory_PHONE: {
+		{"phone"}, {"telephone"}, {"tel"}, {"mobile"}, {"cellphone"}, {"cell", "phone"}, {"msisdn"}, {"fax"},
+	},
+	storepb.SensitiveDataCategory_NATIONAL_ID: {
+		{"ssn"}, {"social", "security"}, {"national", "id"}, {"id", "card"}, {"idcard"}, {"identity", "card"},
+		{"identity", "number"}, {"resident", "id"}, {"citizen", "id"}, {"passport"}, {"tax", "id"},
+	},
+	storepb.SensitiveDataCategory_CREDIT_CARD: {
+		{"credit", "card"}, {"debit", "card"}, {"bank", "card"}, {"card", "number"}, {"card", "no"}, {"card", "num"},
+		{"cardno"}, {"ccn"}, {"cc", "number"}, {"cc", "num"},
+	},
+	storepb.SensitiveDataCategory_IP_ADDRESS: {
+		{"ip"}, {"ipv"}, {"ip", "address"}, {"ip", "addr"}, {"remote", "addr"},
+	},
+}
+
+var (
+	emailRegexp = regexp.MustCompile(`^[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}$`)
+	phoneRegexp = regexp.MustCompile(`^\+?[0-9][0-9 \-().]{5,18}[0-9]$`)
+	ssnRegexp   = regexp.MustCompile(`^([0-9]{3})-([0-9]{2})-([0-9]{4})$`)
+)
+
+// Detect detects the sensitive data of a column.
+// It returns nil if the column is not likely to contain sensitive data.
+// The classification id of the returned payload is left empty, see MatchClassification.
+func Detect(column *Column) *storepb.SensitiveDataProposalPayload {
+	var detections []*storepb.SensitiveDataProposalPayload_Detection
+
+	tokens := tokenize(column.Name)
+	columnType := strings.ToLower(column.Type)
+	if isTextType(columnType) || isIntegerType(columnType) {
+		for _, category := range matchCategories(tokens) {
+			if isIntegerType(columnType) && (category == storepb.SensitiveDataCategory_EMAIL || category == storepb.SensitiveDataCategory_IP_ADDRESS) {
+				continue
+			}
+			detections = append(detections, &storepb.SensitiveDataProposalPayload_Detection{
+				Category:   category,
+				Source:     storepb.SensitiveDataProposalPayload_Detection_COLUMN_NAME,
+				Confidence: nameConfidence,
+			})
+		}
+	}
+	if columnType == "inet" || columnType == "cidr" {
+		detections = append(detections, &storepb.SensitiveDataProposalPayload_Detection{
+			Category:   storepb.SensitiveDataCategory_IP_ADDRESS,
+			Source:     storepb.SensitiveDataProposalPayload_Detection_COLUMN_TYPE,
+			Confidence: typeConfidence,
+		})
+	}
+
+	if sampleCount, matched := matchValues(column.Values); sampleCount >= minSampleCount {
+		var contradicted []storepb.SensitiveDataCategory
+		for _, detection := range detections {
+			if detection.Source != storepb.SensitiveDataProposalPayload_Detection_COLUMN_NAME {
+				continue
+			}
+			if float64(matched[detection.Category])/float64(sampleCount) < valueMismatchRatio {
+				contradicted = append(contradicted, detection.Category)
+			}
+		}
+		detections = removeNameDetections(detections, contradicted)
+		for category, count := range matched {
+			ratio := float64(count) / float64(sampleCount)
+			if ratio < valueMatchRatio {
+				continue
+			}
+			detections = append(detections, &storepb.SensitiveDataProposalPayload_Detection{
+				Category:     category,
+				Source:       storepb.SensitiveDataProposalPayload_Detection_VALUE_SAMPLE,
+				Confidence:   ratio,
+				MatchedCount: int32(count),
+				SampleCount:  int32(sampleCount),
+			})
+		}
+	}
+	if len(detections) == 0 {
+		return nil
+	}
+
+	// Combine the detections of the same category, each detection is an independent evidence.
+	confidences := make(map[storepb.SensitiveDataCategory]float64)
+	for _, detection := range detections {
+		confidences[detection.Category] = 1 - (1-confidences[detection.Category])*(1-detection.Confidence)
+	}
+	category := storepb.SensitiveDataCategory_SENSITIVE_DATA_CATEGORY_UNSPECIFIED
+	for c, confidence := range confidences {
+		if category == storepb.SensitiveDataCategory_SENSITIVE_DATA_CATEGORY_UNSPECIFIED ||
+			confidence > confidences[category] ||
+			(confidence == confidences[category] && c < category) {
+			category = c
+		}
+	}
+	if confidences[category] < proposalConfidence {
+		return nil
+	}
+
+	sort.SliceStable(detections, func(i, j int) bool {
+		if detections[i].Confidence != detections[j].Confidence {
+			return detections[i].Confidence > detections[j].Confidence
+		}
+		return detections[i].Category < detections[j].Category
+	})
+	return &storepb.SensitiveDataProposalPayload{
+		Detections:   detections,
+		Category:     category,
+		MaskingLevel: getMaskingLevel(category),
+	}
+}
+
+// MatchClassification returns the id of the classification in the config whose title or description matches the category.
+// It returns empty string if no classification matches.
+func MatchClassification(category storepb.SensitiveDataCategory, config *storepb.DataClassificationSetting_DataClassificationConfig) string {
+	if config == nil {
+		return ""
+	}
+	var ids []string
+	for id, classification := range config.Classification {
+		for _, text := range []string{classification.Title, classification.Description} {
+			if containsCategory(matchCategories(tokenize(text)), category) {
+				ids = append(ids, id)
+				break
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return ""
+	}
+	// Prefer the classifications with a level, and make the choice stable.
+	sort.Slice(ids, func(i, j int) bool {
+		iLevel, jLevel := config.Classification[ids[i]].LevelId != nil, config.Classification[ids[j]].LevelId != nil
+		if iLevel != jLevel {
+			return iLevel
+		}
+		return ids[i] < ids[j]
+	})
+	return ids[0]
+}
+
+func getMaskingLevel(category storepb.SensitiveDataCategory) storepb.MaskingLevel {
+	switch category {
+	case storepb.SensitiveDataCategory_NATIONAL_ID, storepb.SensitiveDataCategory_CREDIT_CARD:
+		return storepb.MaskingLevel_FULL
+	default:
+		return storepb.MaskingLevel_PARTIAL
+	}
+}
+
+func removeNameDetections(detections []*storepb.SensitiveDataProposalPayload_Detection, categories []storepb.SensitiveDataCategory) []*storepb.SensitiveDataProposalPayload_Detection {
+	if len(categories) == 0 {
+		return detections
+	}
+	var result []*storepb.SensitiveDataProposalPayload_Detection
+	for _, detection := range detections {
+		if detection.Source == storepb.SensitiveDataProposalPayload_Detection_COLUMN_NAME && containsCategory(categories, detection.Category) {
+			continue
+		}
+		result = append(result, detection)
+	}
+	return result
+}
+
+func containsCategory(categories []storepb.SensitiveDataCategory, category storepb.SensitiveDataCategory) bool {
+	for _, c := range categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// matchCategories returns the categories whose name patterns appear in the tokens.
+func matchCategories(tokens []string) []storepb.SensitiveDataCategory {
+	var categories []storepb.SensitiveDataCategory
+	for category, patterns := range namePatterns {
+		for _, pattern := range patterns {
+			if containsSequence(tokens, pattern) {
+				categories = append(categories, category)
+				break
+			}
+		}
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i] < categories[j]
+	})
+	return categories
+}
+
+func containsSequence(tokens []string, sequence []string) bool {
+	for i := 0; i+len(sequence) <= len(tokens); i++ {
+		match := true
+		for j, s := range sequence {
+			if tokens[i+j] != s {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenize splits the name into lower case words by separators, case changes and digits,
+// e.g. "userEmail_address2" becomes ["user", "email", "address"] and "ipv4" becomes ["ipv"].
+func tokenize(name string) []string {
+	var tokens []string
+	var current []rune
+	flush := func() {
+		if len(current) > 0 {
+			tokens = append(tokens, strings.ToLower(string(current)))
+			current = nil
+		}
+	}
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case unicode.IsUpper(r):
+			// Split "userEmail" and "IPAddress" but keep "IP" and "SSN".
+			if len(current) > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				flush()
+			}
+			current = append(current, r)
+		case unicode.IsLower(r):
+			current = append(current, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+func isTextType(columnType string) bool {
+	for _, s := range []string{"char", "text", "string", "clob", "inet", "cidr"} {
+		if strings.Contains(columnType, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func isIntegerType(columnType string) bool {
+	if strings.Contains(columnType, "point") || strings.Contains(columnType, "interval") {
+		return false
+	}
+	for _, s := range []string{"int", "number", "numeric", "decimal"} {
+		if strings.Contains(columnType, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchValues returns the number of non-empty values and the number of matched values per category.
+func matchValues(values []string) (int, map[storepb.SensitiveDataCategory]int) {
+	sampleCount := 0
+	matched := make(map[storepb.SensitiveDataCategory]int)
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		sampleCount++
+		if isEmail(value) {
+			matched[storepb.SensitiveDataCategory_EMAIL]++
+		}
+		if isPhone(value) {
+			matched[storepb.SensitiveDataCategory_PHONE]++
+		}
+		if isNationalID(value) {
+			matched[storepb.SensitiveDataCategory_NATIONAL_ID]++
+		}
+		if isCreditCard(value) {
+			matched[storepb.SensitiveDataCategory_CREDIT_CARD]++
+		}
+		if isIPAddress(value) {
+			matched[storepb.SensitiveDataCategory_IP_ADDRESS]++
+		}
+	}
+	return sampleCount, matched
+}
+
+func isEmail(value string) bool {
+	return emailRegexp.MatchString(value)
+}
+
+func isPhone(value string) bool {
+	if !phoneRegexp.MatchString(value) || ssnRegexp.MatchString(value) {
+		return false
+	}
+	digits := 0
+	for _, r := range value {
+		if unicode.IsDigit(r) {
+			digits++
+		}
+	}
+	return digits >= 7 && digits <= 15
+}
+
+// isNationalID matches the US social security number and the China resident identity card number.
+func isNationalID(value string) bool {
+	if matches := ssnRegexp.FindStringSubmatch(value); matches != nil {
+		area, group, serial := matches[1], matches[2], matches[3]
+		return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+	}
+	return isChinaResidentID(value)
+}
+
+// isChinaResidentID validates the ISO 7064 MOD 11-2 check digit of the 18-character China resident identity card number.
+func isChinaResidentID(value string) bool {
+	if len(value) != 18 {
+		return false
+	}
+	weights := []int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+	sum := 0
+	for i := 0; i < 17; i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return false
+		}
+		sum += int(value[i]-'0') * weights[i]
+	}
+	checkDigits := "10X98765432"
+	return strings.ToUpper(value[17:]) == string(checkDigits[sum%11])
+}
+
+func isCreditCard(value string) bool {
+	var digits []int
+	for _, r := range value {
+		switch {
+		case r >= '0' && r <= '9':
+			digits = append(digits, int(r-'0'))
+		case r == ' ' || r == '-':
+		default:
+			return false
+		}
+	}
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	same := true
+	for _, d := range digits {
+		if d != digits[0] {
+			same = false
+			break
+		}
+	}
+	if same {
+		return false
+	}
+	return luhn(digits)
+}
+
+// luhn validates the digits with the Luhn algorithm.
+func luhn(digits []int) bool {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := digits[i]
+		if (len(digits)-1-i)%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+func isIPAddress(value string) bool {
+	// PostgreSQL inet values may carry the netmask.
+	if i := strings.IndexByte(value, '/'); i >= 0 {
+		value = value[:i]
+	}
+	if !strings.ContainsAny(value, ".:") {
+		return false
+	}
+	return net.ParseIP(value) != nil
+}
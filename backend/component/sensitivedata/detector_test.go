@@ -0,0 +1,147 @@
+package sensitivedata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{name: "email", want: []string{"email"}},
+		{name: "user_email_address", want: []string{"user", "email", "address"}},
+		{name: "userEmail", want: []string{"user", "email"}},
+		{name: "IPAddress", want: []string{"ip", "address"}},
+		{name: "SSN", want: []string{"ssn"}},
+		{name: "client_ipv4", want: []string{"client", "ipv"}},
+		{name: "phone2", want: []string{"phone"}},
+		{name: "", want: nil},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, tokenize(test.name), test.name)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		description  string
+		column       *Column
+		wantCategory storepb.SensitiveDataCategory
+		wantLevel    storepb.MaskingLevel
+	}{
+		{
+			description:  "email by name",
+			column:       &Column{Name: "contact_email", Type: "varchar(255)"},
+			wantCategory: storepb.SensitiveDataCategory_EMAIL,
+			wantLevel:    storepb.MaskingLevel_PARTIAL,
+		},
+		{
+			description:  "phone by camel case name",
+			column:       &Column{Name: "mobilePhone", Type: "VARCHAR2(32)"},
+			wantCategory: storepb.SensitiveDataCategory_PHONE,
+			wantLevel:    storepb.MaskingLevel_PARTIAL,
+		},
+		{
+			description:  "zip is not an ip address",
+			column:       &Column{Name: "zip", Type: "text"},
+			wantCategory: storepb.SensitiveDataCategory_SENSITIVE_DATA_CATEGORY_UNSPECIFIED,
+		},
+		{
+			description:  "boolean column is not sensitive",
+			column:       &Column{Name: "phone_verified", Type: "boolean"},
+			wantCategory: storepb.SensitiveDataCategory_SENSITIVE_DATA_CATEGORY_UNSPECIFIED,
+		},
+		{
+			description:  "ip address by type",
+			column:       &Column{Name: "origin", Type: "inet"},
+			wantCategory: storepb.SensitiveDataCategory_IP_ADDRESS,
+			wantLevel:    storepb.MaskingLevel_PARTIAL,
+		},
+		{
+			description: "credit card by values",
+			column: &Column{Name: "payload", Type: "text", Values: []string{
+				"4111 1111 1111 1111", "5500-0000-0000-0004", "340000000000009", "6011000000000004", "4012888888881881", "",
+			}},
+			wantCategory: storepb.SensitiveDataCategory_CREDIT_CARD,
+			wantLevel:    storepb.MaskingLevel_FULL,
+		},
+		{
+			description: "invalid luhn numbers are not credit cards",
+			column: &Column{Name: "payload", Type: "text", Values: []string{
+				"4111111111111112", "5500000000000005", "340000000000001", "6011000000000005", "4012888888881882",
+			}},
+			wantCategory: storepb.SensitiveDataCategory_SENSITIVE_DATA_CATEGORY_UNSPECIFIED,
+		},
+		{
+			description: "national id by values",
+			column: &Column{Name: "code", Type: "varchar(20)", Values: []string{
+				"123-45-6789", "219-09-9999", "11010519491231002X", "078-05-1120", "536-22-1234",
+			}},
+			wantCategory: storepb.SensitiveDataCategory_NATIONAL_ID,
+			wantLevel:    storepb.MaskingLevel_FULL,
+		},
+		{
+			description: "sampled values contradict the name",
+			column: &Column{Name: "email_template", Type: "text", Values: []string{
+				"Hello", "Welcome", "Reset your password", "Invoice", "Receipt",
+			}},
+			wantCategory: storepb.SensitiveDataCategory_SENSITIVE_DATA_CATEGORY_UNSPECIFIED,
+		},
+		{
+			description: "too few samples fall back to the name",
+			column: &Column{Name: "email", Type: "text", Values: []string{
+				"n/a",
+			}},
+			wantCategory: storepb.SensitiveDataCategory_EMAIL,
+			wantLevel:    storepb.MaskingLevel_PARTIAL,
+		},
+		{
+			description: "ip addresses by values",
+			column: &Column{Name: "source", Type: "varchar(64)", Values: []string{
+				"10.0.0.1", "192.168.1.20", "2001:db8::1", "172.16.0.0/12", "8.8.8.8",
+			}},
+			wantCategory: storepb.SensitiveDataCategory_IP_ADDRESS,
+			wantLevel:    storepb.MaskingLevel_PARTIAL,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		payload := Detect(test.column)
+		if test.wantCategory == storepb.SensitiveDataCategory_SENSITIVE_DATA_CATEGORY_UNSPECIFIED {
+			a.Nil(payload, test.description)
+			continue
+		}
+		a.NotNil(payload, test.description)
+		a.Equal(test.wantCategory, payload.Category, test.description)
+		a.Equal(test.wantLevel, payload.MaskingLevel, test.description)
+		a.NotEmpty(payload.Detections, test.description)
+	}
+}
+
+func TestMatchClassification(t *testing.T) {
+	levelID := "2"
+	config := &storepb.DataClassificationSetting_DataClassificationConfig{
+		Classification: map[string]*storepb.DataClassificationSetting_DataClassificationConfig_DataClassification{
+			"1":     {Id: "1", Title: "Personal"},
+			"1-1":   {Id: "1-1", Title: "Basic"},
+			"1-2":   {Id: "1-2", Title: "Contact", Description: "Phone number and email address"},
+			"1-2-1": {Id: "1-2-1", Title: "Email", LevelId: &levelID},
+			"1-3":   {Id: "1-3", Title: "Credit Card Number", LevelId: &levelID},
+		},
+	}
+
+	a := require.New(t)
+	a.Equal("1-2-1", MatchClassification(storepb.SensitiveDataCategory_EMAIL, config))
+	a.Equal("1-2", MatchClassification(storepb.SensitiveDataCategory_PHONE, config))
+	a.Equal("1-3", MatchClassification(storepb.SensitiveDataCategory_CREDIT_CARD, config))
+	a.Equal("", MatchClassification(storepb.SensitiveDataCategory_IP_ADDRESS, config))
+	a.Equal("", MatchClassification(storepb.SensitiveDataCategory_EMAIL, nil))
+}
@@ -61,6 +61,8 @@ type State struct {
 
 	// TaskSkippedOrDoneChan is the channel for notifying the task is skipped or done.
 	TaskSkippedOrDoneChan chan int
+	// OnlineMigrationCleanupChan is the channel for notifying the cutover task of the online schema migration won't run.
+	OnlineMigrationCleanupChan chan int
 
 	// InstanceSyncTickleChan is the tickler for syncing instances.
 	InstanceSyncTickleChan chan int
@@ -84,6 +86,7 @@ func New() (*State, error) {
 		InstanceOutstandingConnections:       make(map[int]int),
 		IssueExternalApprovalRelayCancelChan: make(chan int, 1),
 		TaskSkippedOrDoneChan:                make(chan int, 1000),
+		OnlineMigrationCleanupChan:           make(chan int, 1000),
 		InstanceSyncTickleChan:               make(chan int, 1000),
 		PlanCheckTickleChan:                  make(chan int, 1000),
 		TaskRunTickleChan:                    make(chan int, 1000),
@@ -266,15 +266,19 @@ type ActivityProjectDatabaseTransferPayload struct {
 // ActivitySQLEditorQueryPayload is the API message payloads for the executed query info.
 type ActivitySQLEditorQueryPayload struct {
 	// Used by activity table to display info without paying the join cost
-	Statement  string `json:"statement"`
-	DurationNs int64  `json:"durationNs"`
-	InstanceID int    `json:"instanceId"`
+	Statement string `json:"statement"`
+	// Fingerprint is the statement with the literals replaced by placeholders, used to group the query history.
+	Fingerprint string `json:"fingerprint,omitempty"`
+	DurationNs  int64  `json:"durationNs"`
+	InstanceID  int    `json:"instanceId"`
 	// DeprecatedInstanceName is deprecated and should be removed from future version.
 	DeprecatedInstanceName string           `json:"instanceName"`
 	DatabaseID             int              `json:"databaseId"`
 	DatabaseName           string           `json:"databaseName"`
 	Error                  string           `json:"error"`
 	AdviceList             []advisor.Advice `json:"adviceList"`
+	// DataSourceID is the data source running the query, which is chosen by the read-only routing policy if not specified.
+	DataSourceID string `json:"dataSourceId,omitempty"`
 }
 
 // ActivitySQLExportPayload is the API message payloads for the exported SQL info.
@@ -285,6 +289,8 @@ type ActivitySQLExportPayload struct {
 	InstanceID   int    `json:"instanceId"`
 	DatabaseID   int    `json:"databaseId"`
 	DatabaseName string `json:"databaseName"`
+	// DataSourceID is the data source running the export, which is chosen by the read-only routing policy.
+	DataSourceID string `json:"dataSourceId,omitempty"`
 	Error        string `json:"error"`
 }
 
@@ -50,6 +50,12 @@ const (
 	PolicyTypeMaskingRule PolicyType = "bb.policy.masking-rule"
 	// PolicyTypeRestrictIssueCreationForSQLReview is the policy type for restricting issue creation for SQL review.
 	PolicyTypeRestrictIssueCreationForSQLReview PolicyType = "bb.policy.restrict-issue-creation-for-sql-review"
+	// PolicyTypeSessionGuard is the policy type for guarding the SQL editor queries.
+	PolicyTypeSessionGuard PolicyType = "bb.policy.session-guard"
+	// PolicyTypeLockImpact is the policy type for the lock impact plan check.
+	PolicyTypeLockImpact PolicyType = "bb.policy.lock-impact"
+	// PolicyTypeDMLDryRun is the policy type for the DML dry run plan check.
+	PolicyTypeDMLDryRun PolicyType = "bb.policy.dml-dry-run"
 
 	// PipelineApprovalValueManualNever means the pipeline will automatically be approved without user intervention.
 	PipelineApprovalValueManualNever PipelineApprovalValue = "MANUAL_APPROVAL_NEVER"
@@ -101,6 +107,9 @@ var (
 		PolicyTypeMaskingRule:                       {PolicyResourceTypeWorkspace},
 		PolicyTypeMaskingException:                  {PolicyResourceTypeProject},
 		PolicyTypeRestrictIssueCreationForSQLReview: {PolicyResourceTypeWorkspace},
+		PolicyTypeSessionGuard:                      {PolicyResourceTypeEnvironment, PolicyResourceTypeProject},
+		PolicyTypeLockImpact:                        {PolicyResourceTypeEnvironment},
+		PolicyTypeDMLDryRun:                         {PolicyResourceTypeEnvironment},
 	}
 )
 
@@ -42,6 +42,10 @@ const (
 	TaskDatabaseSchemaUpdateGhostSync TaskType = "bb.task.database.schema.update.ghost.sync"
 	// TaskDatabaseSchemaUpdateGhostCutover is the task type for gh-ost switching the original table and the ghost table.
 	TaskDatabaseSchemaUpdateGhostCutover TaskType = "bb.task.database.schema.update.ghost.cutover"
+	// TaskDatabaseSchemaUpdateOnlineSync is the task type for PostgreSQL online schema migration syncing the shadow table.
+	TaskDatabaseSchemaUpdateOnlineSync TaskType = "bb.task.database.schema.update.online.sync"
+	// TaskDatabaseSchemaUpdateOnlineCutover is the task type for PostgreSQL online schema migration switching the original table and the shadow table.
+	TaskDatabaseSchemaUpdateOnlineCutover TaskType = "bb.task.database.schema.update.online.cutover"
 	// TaskDatabaseDataUpdate is the task type for updating database data.
 	TaskDatabaseDataUpdate TaskType = "bb.task.database.data.update"
 	// TaskDatabaseBackup is the task type for creating database backups.
@@ -169,6 +173,27 @@ type TaskDatabaseSchemaUpdateGhostCutoverPayload struct {
 	SpecID        string `json:"specId,omitempty"`
 }
 
+// TaskDatabaseSchemaUpdateOnlineSyncPayload is the task payload for PostgreSQL online schema migration syncing the shadow table.
+type TaskDatabaseSchemaUpdateOnlineSyncPayload struct {
+	// Common fields
+	Skipped       bool   `json:"skipped,omitempty"`
+	SkippedReason string `json:"skippedReason,omitempty"`
+	SpecID        string `json:"specId,omitempty"`
+
+	SheetID       int    `json:"sheetId,omitempty"`
+	SchemaVersion string `json:"schemaVersion,omitempty"`
+
+	Flags map[string]string `json:"flags,omitempty"`
+}
+
+// TaskDatabaseSchemaUpdateOnlineCutoverPayload is the task payload for PostgreSQL online schema migration switching the original table and the shadow table.
+type TaskDatabaseSchemaUpdateOnlineCutoverPayload struct {
+	// Common fields
+	Skipped       bool   `json:"skipped,omitempty"`
+	SkippedReason string `json:"skippedReason,omitempty"`
+	SpecID        string `json:"specId,omitempty"`
+}
+
 // RollbackSQLStatus is the status of a rollback SQL generation task.
 type RollbackSQLStatus string
 
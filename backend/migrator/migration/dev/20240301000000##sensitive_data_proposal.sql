@@ -0,0 +1,24 @@
+CREATE TABLE sensitive_data_proposal (
+    id SERIAL PRIMARY KEY,
+    row_status row_status NOT NULL DEFAULT 'NORMAL',
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updater_id INTEGER NOT NULL REFERENCES principal (id),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    database_id INTEGER NOT NULL REFERENCES db (id),
+    schema_name TEXT NOT NULL DEFAULT '',
+    table_name TEXT NOT NULL,
+    column_name TEXT NOT NULL,
+    state TEXT NOT NULL CHECK (state IN ('PENDING', 'ACCEPTED', 'REJECTED')) DEFAULT 'PENDING',
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_sensitive_data_proposal_unique_database_id_schema_name_table_name_column_name ON sensitive_data_proposal(database_id, schema_name, table_name, column_name);
+
+ALTER SEQUENCE sensitive_data_proposal_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_sensitive_data_proposal_updated_ts
+BEFORE
+UPDATE
+    ON sensitive_data_proposal FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
@@ -0,0 +1,38 @@
+CREATE TABLE sheet_revision (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    sheet_id INTEGER NOT NULL REFERENCES sheet (id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    statement TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_sheet_revision_unique_sheet_id_revision ON sheet_revision(sheet_id, revision);
+
+ALTER SEQUENCE sheet_revision_id_seq RESTART WITH 101;
+
+-- Backfill the first revision for the existing worksheets.
+INSERT INTO sheet_revision (creator_id, created_ts, sheet_id, revision, statement)
+SELECT updater_id, updated_ts, id, 1, statement FROM sheet WHERE source = 'BYTEBASE';
+
+CREATE TABLE sheet_comment (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updater_id INTEGER NOT NULL REFERENCES principal (id),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    sheet_id INTEGER NOT NULL REFERENCES sheet (id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    line INTEGER NOT NULL,
+    content TEXT NOT NULL
+);
+
+CREATE INDEX idx_sheet_comment_sheet_id ON sheet_comment(sheet_id);
+
+ALTER SEQUENCE sheet_comment_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_sheet_comment_updated_ts
+BEFORE
+UPDATE
+    ON sheet_comment FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
@@ -1098,6 +1098,43 @@ CREATE UNIQUE INDEX idx_sheet_organizer_unique_sheet_id_principal_id ON sheet_or
 
 CREATE INDEX idx_sheet_organizer_principal_id ON sheet_organizer(principal_id);
 
+-- sheet_revision stores the immutable statement history of a worksheet.
+CREATE TABLE sheet_revision (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    sheet_id INTEGER NOT NULL REFERENCES sheet (id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    statement TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_sheet_revision_unique_sheet_id_revision ON sheet_revision(sheet_id, revision);
+
+ALTER SEQUENCE sheet_revision_id_seq RESTART WITH 101;
+
+-- sheet_comment stores the comments anchored to a line of a worksheet revision.
+CREATE TABLE sheet_comment (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updater_id INTEGER NOT NULL REFERENCES principal (id),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    sheet_id INTEGER NOT NULL REFERENCES sheet (id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    line INTEGER NOT NULL,
+    content TEXT NOT NULL
+);
+
+CREATE INDEX idx_sheet_comment_sheet_id ON sheet_comment(sheet_id);
+
+ALTER SEQUENCE sheet_comment_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_sheet_comment_updated_ts
+BEFORE
+UPDATE
+    ON sheet_comment FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
 -- external_approval stores approval instances of third party applications.
 CREATE TABLE external_approval ( 
     id SERIAL PRIMARY KEY,
@@ -1279,4 +1316,30 @@ CREATE TRIGGER update_branch_updated_ts
 BEFORE
 UPDATE
     ON branch FOR EACH ROW
-EXECUTE FUNCTION trigger_update_updated_ts();
\ No newline at end of file
+EXECUTE FUNCTION trigger_update_updated_ts();
+
+-- sensitive_data_proposal stores the classification and masking proposals from sensitive data discovery.
+CREATE TABLE sensitive_data_proposal (
+    id SERIAL PRIMARY KEY,
+    row_status row_status NOT NULL DEFAULT 'NORMAL',
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updater_id INTEGER NOT NULL REFERENCES principal (id),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    database_id INTEGER NOT NULL REFERENCES db (id),
+    schema_name TEXT NOT NULL DEFAULT '',
+    table_name TEXT NOT NULL,
+    column_name TEXT NOT NULL,
+    state TEXT NOT NULL CHECK (state IN ('PENDING', 'ACCEPTED', 'REJECTED')) DEFAULT 'PENDING',
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_sensitive_data_proposal_unique_database_id_schema_name_table_name_column_name ON sensitive_data_proposal(database_id, schema_name, table_name, column_name);
+
+ALTER SEQUENCE sensitive_data_proposal_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_sensitive_data_proposal_updated_ts
+BEFORE
+UPDATE
+    ON sensitive_data_proposal FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
@@ -233,6 +233,9 @@ const (
 	// MySQLDisallowProcedure is an advisor type for MySQL disallow procedure.
 	MySQLDisallowProcedure Type = "bb.plugin.advisor.mysql.disallow-procedure"
 
+	// MySQLCustomRule is an advisor type for MySQL custom rules.
+	MySQLCustomRule Type = "bb.plugin.advisor.mysql.custom"
+
 	// PostgreSQL Advisor.
 
 	// PostgreSQLSyntax is an advisor type for PostgreSQL syntax.
@@ -265,6 +268,9 @@ const (
 	// PostgreSQLCommentConvention is an advisor type for PostgreSQL comment convention.
 	PostgreSQLCommentConvention Type = "bb.plugin.advisor.postgresql.comment"
 
+	// PostgreSQLCustomRule is an advisor type for PostgreSQL custom rules.
+	PostgreSQLCustomRule Type = "bb.plugin.advisor.postgresql.custom"
+
 	// PostgreSQLTableRequirePK is an advisor type for PostgreSQL table require primary key.
 	PostgreSQLTableRequirePK Type = "bb.plugin.advisor.postgresql.table.require-pk"
 
@@ -498,6 +504,22 @@ const (
 
 	// MSSQLColumnRequirement is an advisor type for MSSQL column requirement.
 	MSSQLColumnRequirement Type = "bb.plugin.advisor.mssql.column.require"
+
+	// Redis Advisor.
+
+	// RedisDisallowDangerousCommand is an advisor type for Redis disallowing dangerous commands.
+	RedisDisallowDangerousCommand Type = "bb.plugin.advisor.redis.disallow-dangerous-command"
+
+	// RedisDisallowLinearCommand is an advisor type for Redis disallowing O(N) commands.
+	RedisDisallowLinearCommand Type = "bb.plugin.advisor.redis.disallow-linear-command"
+
+	// CockroachDB Advisor.
+
+	// CockroachDBDisallowSequentialPrimaryKey is an advisor type for CockroachDB disallowing sequential primary keys.
+	CockroachDBDisallowSequentialPrimaryKey Type = "bb.plugin.advisor.cockroachdb.index.disallow-sequential-primary-key"
+
+	// CockroachDBDisallowSchemaChangeInTransaction is an advisor type for CockroachDB disallowing schema changes in explicit transactions.
+	CockroachDBDisallowSchemaChangeInTransaction Type = "bb.plugin.advisor.cockroachdb.statement.disallow-schema-change-in-transaction"
 )
 
 // Advice is the result of an advisor.
@@ -543,6 +565,8 @@ type Context struct {
 	// Statement is the original statement of AST, it is used for some PostgreSQL
 	// advisors which need to check the token stream.
 	Statements string
+	// DatabaseLabels is the labels of the database, used by the custom rules.
+	DatabaseLabels map[string]string
 }
 
 // Advisor is the interface for advisor.
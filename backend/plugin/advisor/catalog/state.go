@@ -6,6 +6,7 @@ package catalog
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -82,13 +83,14 @@ func newViewState(v *storepb.ViewMetadata) *ViewState {
 
 func newTableState(t *storepb.TableMetadata, context *FinderContext) *TableState {
 	table := &TableState{
-		name:          t.Name,
-		engine:        newStringPointer(t.Engine),
-		collation:     newStringPointer(t.Collation),
-		comment:       newStringPointer(t.Comment),
-		columnSet:     make(columnStateMap),
-		indexSet:      make(IndexStateMap),
-		dependentView: make(map[string]bool),
+		name:           t.Name,
+		engine:         newStringPointer(t.Engine),
+		collation:      newStringPointer(t.Collation),
+		comment:        newStringPointer(t.Comment),
+		classification: newStringPointer(t.Classification),
+		columnSet:      make(columnStateMap),
+		indexSet:       make(IndexStateMap),
+		dependentView:  make(map[string]bool),
 	}
 
 	for i, column := range t.Columns {
@@ -385,8 +387,10 @@ type TableState struct {
 	// collation isn't supported for Postgres, ClickHouse, Snowflake, SQLite.
 	collation *string
 	// comment isn't supported for SQLite.
-	comment   *string
-	columnSet columnStateMap
+	comment *string
+	// classification is parsed from the comment.
+	classification *string
+	columnSet      columnStateMap
 	// indexSet isn't supported for ClickHouse, Snowflake.
 	indexSet IndexStateMap
 
@@ -405,14 +409,41 @@ func (table *TableState) Index(_ *TableIndexFind) *IndexStateMap {
 	return &table.indexSet
 }
 
+// Comment returns the comment of the table.
+func (table *TableState) Comment() string {
+	if table.comment != nil {
+		return *table.comment
+	}
+	return ""
+}
+
+// Classification returns the classification of the table.
+func (table *TableState) Classification() string {
+	if table.classification != nil {
+		return *table.classification
+	}
+	return ""
+}
+
+// ColumnNames returns the sorted column names of the table.
+func (table *TableState) ColumnNames() []string {
+	var names []string
+	for _, column := range table.columnSet {
+		names = append(names, column.name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 func (table *TableState) copy() *TableState {
 	return &TableState{
-		name:      table.name,
-		engine:    copyStringPointer(table.engine),
-		collation: copyStringPointer(table.collation),
-		comment:   copyStringPointer(table.comment),
-		columnSet: table.columnSet.copy(),
-		indexSet:  table.indexSet.copy(),
+		name:           table.name,
+		engine:         copyStringPointer(table.engine),
+		collation:      copyStringPointer(table.collation),
+		comment:        copyStringPointer(table.comment),
+		classification: copyStringPointer(table.classification),
+		columnSet:      table.columnSet.copy(),
+		indexSet:       table.indexSet.copy(),
 	}
 }
 
@@ -1017,6 +1017,7 @@ func (d *DatabaseState) getSchema(schemaName string) (*SchemaState, *WalkThrough
 			}
 		}
 		schema = &SchemaState{
+			ctx:           d.ctx.Copy(),
 			name:          publicSchemaName,
 			tableSet:      make(tableStateMap),
 			viewSet:       make(viewStateMap),
@@ -0,0 +1,70 @@
+// Package cockroachdb is the advisor for CockroachDB.
+package cockroachdb
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*DisallowSchemaChangeInTransactionAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_COCKROACHDB, advisor.CockroachDBDisallowSchemaChangeInTransaction, &DisallowSchemaChangeInTransactionAdvisor{})
+}
+
+// DisallowSchemaChangeInTransactionAdvisor is the advisor checking for schema changes in explicit transactions.
+// CockroachDB runs the schema changes asynchronously after the transaction commits, so the schema changes mixed
+// with the writes in an explicit transaction may fail in the commit phase after part of the transaction has taken effect.
+type DisallowSchemaChangeInTransactionAdvisor struct {
+}
+
+// Check checks for schema changes in explicit transactions.
+func (*DisallowSchemaChangeInTransactionAdvisor) Check(ctx advisor.Context, _ string) ([]advisor.Advice, error) {
+	stmtList, ok := ctx.AST.([]ast.Node)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Node")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []advisor.Advice
+	inTransaction := false
+	for _, stmt := range stmtList {
+		switch stmt.(type) {
+		case *ast.BeginStmt:
+			inTransaction = true
+		case *ast.CommitStmt, *ast.RollbackStmt:
+			inTransaction = false
+		case ast.DDLNode:
+			if inTransaction {
+				adviceList = append(adviceList, advisor.Advice{
+					Status:  level,
+					Code:    advisor.StatementSchemaChangeInTransaction,
+					Title:   string(ctx.Rule.Type),
+					Content: fmt.Sprintf("Schema change in an explicit transaction is not allowed, related statement: \"%s\"", stmt.Text()),
+					Line:    stmt.LastLine(),
+				})
+			}
+		}
+	}
+
+	if len(adviceList) == 0 {
+		adviceList = append(adviceList, advisor.Advice{
+			Status:  advisor.Success,
+			Code:    advisor.Ok,
+			Title:   "OK",
+			Content: "",
+		})
+	}
+	return adviceList, nil
+}
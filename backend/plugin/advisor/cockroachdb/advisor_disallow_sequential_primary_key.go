@@ -0,0 +1,140 @@
+package cockroachdb
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*DisallowSequentialPrimaryKeyAdvisor)(nil)
+	_ ast.Visitor     = (*disallowSequentialPrimaryKeyChecker)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_COCKROACHDB, advisor.CockroachDBDisallowSequentialPrimaryKey, &DisallowSequentialPrimaryKeyAdvisor{})
+}
+
+// DisallowSequentialPrimaryKeyAdvisor is the advisor checking for sequential primary keys.
+// CockroachDB splits the tables into ranges by the primary key, so the monotonically increasing primary keys
+// concentrate the writes on the last range and cause the hotspots.
+type DisallowSequentialPrimaryKeyAdvisor struct {
+}
+
+// Check checks for sequential primary keys.
+func (*DisallowSequentialPrimaryKeyAdvisor) Check(ctx advisor.Context, _ string) ([]advisor.Advice, error) {
+	stmtList, ok := ctx.AST.([]ast.Node)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Node")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	checker := &disallowSequentialPrimaryKeyChecker{
+		level: level,
+		title: string(ctx.Rule.Type),
+	}
+
+	for _, stmt := range stmtList {
+		ast.Walk(checker, stmt)
+	}
+
+	if len(checker.adviceList) == 0 {
+		checker.adviceList = append(checker.adviceList, advisor.Advice{
+			Status:  advisor.Success,
+			Code:    advisor.Ok,
+			Title:   "OK",
+			Content: "",
+		})
+	}
+	return checker.adviceList, nil
+}
+
+type disallowSequentialPrimaryKeyChecker struct {
+	adviceList []advisor.Advice
+	level      advisor.Status
+	title      string
+}
+
+// Visit implements ast.Visitor interface.
+func (checker *disallowSequentialPrimaryKeyChecker) Visit(in ast.Node) ast.Visitor {
+	var columnList []*ast.ColumnDef
+	switch node := in.(type) {
+	case *ast.CreateTableStmt:
+		columnMap := make(map[string]*ast.ColumnDef)
+		for _, column := range node.ColumnList {
+			columnMap[column.ColumnName] = column
+			if isPKColumn(column) {
+				columnList = append(columnList, column)
+			}
+		}
+		for _, constraint := range node.ConstraintList {
+			if constraint.Type == ast.ConstraintTypePrimary {
+				for _, key := range constraint.KeyList {
+					if column, exists := columnMap[key]; exists {
+						columnList = append(columnList, column)
+					}
+				}
+			}
+		}
+	case *ast.AddColumnListStmt:
+		for _, column := range node.ColumnList {
+			if isPKColumn(column) {
+				columnList = append(columnList, column)
+			}
+		}
+	}
+
+	for _, column := range columnList {
+		if reason := getSequentialReason(column); reason != "" {
+			line := column.LastLine()
+			if line == 0 {
+				// The columns in ALTER TABLE don't have the line number.
+				line = in.LastLine()
+			}
+			checker.adviceList = append(checker.adviceList, advisor.Advice{
+				Status:  checker.level,
+				Code:    advisor.SequentialPrimaryKey,
+				Title:   checker.title,
+				Content: fmt.Sprintf("The primary key column \"%s\" is %s, which causes write hotspots in CockroachDB, use UUID with gen_random_uuid() instead", column.ColumnName, reason),
+				Line:    line,
+			})
+		}
+	}
+
+	return checker
+}
+
+func isPKColumn(column *ast.ColumnDef) bool {
+	for _, constraint := range column.ConstraintList {
+		if constraint.Type == ast.ConstraintTypePrimary {
+			return true
+		}
+	}
+	return false
+}
+
+// getSequentialReason returns the reason why the column is sequential, or empty if it's not sequential.
+func getSequentialReason(column *ast.ColumnDef) string {
+	if _, ok := column.Type.(*ast.Serial); ok {
+		return "SERIAL"
+	}
+	for _, constraint := range column.ConstraintList {
+		switch constraint.Type {
+		case ast.ConstraintTypeIdentity:
+			return "an IDENTITY column"
+		case ast.ConstraintTypeDefault:
+			if constraint.Expression != nil && strings.Contains(strings.ToLower(constraint.Expression.Text()), "nextval(") {
+				return "generated by a sequence"
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,19 @@
+package cockroachdb
+
+import (
+	"testing"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestCockroachDBRules(t *testing.T) {
+	cockroachdbRules := []advisor.SQLReviewRuleType{
+		advisor.SchemaRuleIndexCockroachDBDisallowSequentialPrimaryKey,
+		advisor.SchemaRuleStatementCockroachDBDisallowSchemaChangeInTransaction,
+	}
+
+	for _, rule := range cockroachdbRules {
+		advisor.RunSQLReviewRuleTest(t, rule, storepb.Engine_COCKROACHDB, false /* record */)
+	}
+}
@@ -40,6 +40,9 @@ const (
 	StatementDisallowCascade                Code = 213
 	StatementCheckSelectFullTableScanFailed Code = 214
 	StatementHasTableFullScan               Code = 215
+	StatementDangerousCommand               Code = 216
+	StatementLinearCommand                  Code = 217
+	StatementSchemaChangeInTransaction      Code = 218
 
 	// 301 ～ 399 naming error code
 	// 301 table naming advisor error code.
@@ -123,6 +126,7 @@ const (
 	IndexCountExceedsLimit     Code = 813
 	CreateIndexUnconcurrently  Code = 814
 	DuplicateIndexInTable      Code = 815
+	SequentialPrimaryKey       Code = 816
 
 	// 1001 ~ 1099 charset error code.
 	DisabledCharset Code = 1001
@@ -145,6 +149,10 @@ const (
 
 	// 1401 ~ 1499 procedure error code.
 	DisallowProcedure Code = 1401
+
+	// 1501 ~ 1599 custom rule error code.
+	CustomRuleViolation        Code = 1501
+	CustomRuleEvaluationFailed Code = 1502
 )
 
 // Int returns the int type of code.
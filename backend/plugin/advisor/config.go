@@ -67,6 +67,18 @@ func MergeSQLReviewRules(override *SQLReviewConfigOverride) ([]*storepb.SQLRevie
 		res = append(res, rule)
 	}
 
+	// The custom rules are not in the template, and there can be multiple ones.
+	for _, rule := range override.RuleList {
+		if rule.Type != SchemaRuleCustom {
+			continue
+		}
+		customRule, err := mergeRule(rule, nil)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, customRule)
+	}
+
 	return res, nil
 }
 
@@ -31,6 +31,15 @@ ruleList:
     payload:
       list:
         - name
+  - type: custom.cel
+    level: ERROR
+    payload:
+      expression: 'statement_type == "DROP_TABLE" && tables.exists(t, t.classification == "critical")'
+      message: Tables tagged critical cannot be dropped
+  - type: custom.cel
+    level: WARNING
+    payload:
+      expression: 'statement_type == "CREATE_TABLE" && !columns.exists(c, c.name == "tenant_id")'
 `
 
 func TestConfigOverride(t *testing.T) {
@@ -41,6 +50,7 @@ func TestConfigOverride(t *testing.T) {
 	ruleList, err := MergeSQLReviewRules(override)
 	require.NoError(t, err)
 
+	var customRuleList []*storepb.SQLReviewRule
 	for _, rule := range ruleList {
 		switch rule.Type {
 		case "statement.select.no-select-all":
@@ -76,6 +86,13 @@ func TestConfigOverride(t *testing.T) {
 
 			assert.Equal(t, 1, len(payload.List))
 			assert.Equal(t, "name", payload.List[0])
+		case "custom.cel":
+			_, err := UnmarshalCustomRulePayload(rule.Payload)
+			require.NoError(t, err)
+			customRuleList = append(customRuleList, rule)
 		}
 	}
+	require.Len(t, customRuleList, 2)
+	assert.Equal(t, storepb.SQLReviewRuleLevel_ERROR, customRuleList[0].Level)
+	assert.Equal(t, storepb.SQLReviewRuleLevel_WARNING, customRuleList[1].Level)
 }
@@ -0,0 +1,193 @@
+package advisor
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/catalog"
+)
+
+const (
+	// CustomRuleColumnActionCreate is the action for the columns defined by CREATE TABLE or ADD COLUMN.
+	CustomRuleColumnActionCreate = "CREATE"
+	// CustomRuleColumnActionAlter is the action for the columns modified by ALTER TABLE.
+	CustomRuleColumnActionAlter = "ALTER"
+	// CustomRuleColumnActionDrop is the action for the columns dropped by ALTER TABLE.
+	CustomRuleColumnActionDrop = "DROP"
+)
+
+// CustomRulePayload is the payload for the custom rule.
+type CustomRulePayload struct {
+	// Title is the title of the advice, defaults to the rule type.
+	Title string `json:"title"`
+	// Expression is the CEL condition evaluated against each statement.
+	// The statement violates the rule if the condition is true.
+	Expression string `json:"expression"`
+	// Message is the content of the advice.
+	Message string `json:"message"`
+}
+
+// UnmarshalCustomRulePayload will unmarshal payload to CustomRulePayload and validate the expression.
+func UnmarshalCustomRulePayload(payload string) (*CustomRulePayload, error) {
+	var cr CustomRulePayload
+	if err := json.Unmarshal([]byte(payload), &cr); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal custom rule payload %q", payload)
+	}
+	if cr.Expression == "" {
+		return nil, errors.Errorf("invalid custom rule payload %q, expression cannot be empty", payload)
+	}
+	if _, err := common.ValidateSQLReviewRuleExpression(cr.Expression); err != nil {
+		return nil, err
+	}
+	return &cr, nil
+}
+
+// CustomRuleStatement is the normalized view of a statement to evaluate the custom rule.
+type CustomRuleStatement struct {
+	Type    string
+	Text    string
+	Line    int
+	Tables  []*CustomRuleTable
+	Columns []*CustomRuleColumn
+}
+
+// CustomRuleTable is the table touched by the statement.
+type CustomRuleTable struct {
+	Schema string
+	Name   string
+}
+
+// CustomRuleColumn is the column created, altered or dropped by the statement.
+type CustomRuleColumn struct {
+	Schema string
+	Table  string
+	Name   string
+	// Action is one of CREATE, ALTER and DROP.
+	Action string
+	// Type, Nullable and HasDefault are only set for the column definitions.
+	Type       string
+	Nullable   bool
+	HasDefault bool
+}
+
+// CheckCustomRule evaluates the custom rule against the statements, and returns an advice for each violated statement.
+// The tables are enriched with the catalog metadata of the database before the change.
+func CheckCustomRule(ctx Context, statements []*CustomRuleStatement) ([]Advice, error) {
+	level, err := NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := UnmarshalCustomRulePayload(ctx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+	prog, err := common.ValidateSQLReviewRuleExpression(payload.Expression)
+	if err != nil {
+		return nil, err
+	}
+	title := payload.Title
+	if title == "" {
+		title = ctx.Rule.Type
+	}
+
+	databaseName := ctx.CurrentDatabase
+	if ctx.Catalog != nil && ctx.Catalog.Origin.DatabaseName() != "" {
+		databaseName = ctx.Catalog.Origin.DatabaseName()
+	}
+	databaseLabels := ctx.DatabaseLabels
+	if databaseLabels == nil {
+		databaseLabels = map[string]string{}
+	}
+
+	var adviceList []Advice
+	for _, statement := range statements {
+		tables := []map[string]any{}
+		for _, table := range statement.Tables {
+			tables = append(tables, convertCustomRuleTable(ctx.Catalog, table))
+		}
+		columns := []map[string]any{}
+		for _, column := range statement.Columns {
+			columns = append(columns, map[string]any{
+				"schema":      column.Schema,
+				"table":       column.Table,
+				"name":        column.Name,
+				"action":      column.Action,
+				"type":        column.Type,
+				"nullable":    column.Nullable,
+				"has_default": column.HasDefault,
+			})
+		}
+		violated, err := common.EvalSQLReviewRuleExpression(prog, map[string]any{
+			"statement_type":  statement.Type,
+			"statement_text":  statement.Text,
+			"database_name":   databaseName,
+			"database_labels": databaseLabels,
+			"tables":          tables,
+			"columns":         columns,
+		})
+		if err != nil {
+			// The runtime error only depends on the statement, e.g. tables[0] of a statement without tables,
+			// so it fails the statement instead of the whole review.
+			adviceList = append(adviceList, Advice{
+				Status:  Error,
+				Code:    CustomRuleEvaluationFailed,
+				Title:   title,
+				Content: fmt.Sprintf("Failed to evaluate the custom rule %q: %v", payload.Expression, err),
+				Line:    statement.Line,
+			})
+			continue
+		}
+		if !violated {
+			continue
+		}
+		content := payload.Message
+		if content == "" {
+			content = fmt.Sprintf("The statement violates the custom rule %q", payload.Expression)
+		}
+		adviceList = append(adviceList, Advice{
+			Status:  level,
+			Code:    CustomRuleViolation,
+			Title:   title,
+			Content: content,
+			Line:    statement.Line,
+		})
+	}
+
+	if len(adviceList) == 0 {
+		adviceList = append(adviceList, Advice{
+			Status:  Success,
+			Code:    Ok,
+			Title:   "OK",
+			Content: "",
+		})
+	}
+	return adviceList, nil
+}
+
+func convertCustomRuleTable(finder *catalog.Finder, table *CustomRuleTable) map[string]any {
+	result := map[string]any{
+		"schema":         table.Schema,
+		"name":           table.Name,
+		"exists":         false,
+		"comment":        "",
+		"classification": "",
+		"columns":        []string{},
+	}
+	if finder == nil {
+		return result
+	}
+	tableState := finder.Origin.FindTable(&catalog.TableFind{SchemaName: table.Schema, TableName: table.Name})
+	if tableState == nil {
+		return result
+	}
+	result["exists"] = true
+	result["comment"] = tableState.Comment()
+	result["classification"] = tableState.Classification()
+	if columnNames := tableState.ColumnNames(); len(columnNames) > 0 {
+		result["columns"] = columnNames
+	}
+	return result
+}
@@ -0,0 +1,165 @@
+package advisor
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor/catalog"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestCheckCustomRule(t *testing.T) {
+	finder := catalog.NewFinder(&storepb.DatabaseSchemaMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "billing",
+				Tables: []*storepb.TableMetadata{
+					{Name: "invoice", Classification: "critical", Columns: []*storepb.ColumnMetadata{{Name: "id"}, {Name: "tenant_id"}}},
+					{Name: "draft"},
+				},
+			},
+		},
+	}, &catalog.FinderContext{CheckIntegrity: true, EngineType: storepb.Engine_POSTGRES})
+
+	tests := []struct {
+		expression string
+		statements []*CustomRuleStatement
+		wantLines  []int
+	}{
+		{
+			// Tables in schema billing must have a tenant_id column.
+			expression: `statement_type == "CREATE_TABLE" && tables.exists(t, t.schema == "billing") && !columns.exists(c, c.name == "tenant_id")`,
+			statements: []*CustomRuleStatement{
+				{
+					Type:    "CREATE_TABLE",
+					Line:    1,
+					Tables:  []*CustomRuleTable{{Schema: "billing", Name: "payment"}},
+					Columns: []*CustomRuleColumn{{Schema: "billing", Table: "payment", Name: "id", Action: CustomRuleColumnActionCreate}},
+				},
+				{
+					Type:   "CREATE_TABLE",
+					Line:   2,
+					Tables: []*CustomRuleTable{{Schema: "billing", Name: "refund"}},
+					Columns: []*CustomRuleColumn{
+						{Schema: "billing", Table: "refund", Name: "id", Action: CustomRuleColumnActionCreate},
+						{Schema: "billing", Table: "refund", Name: "tenant_id", Action: CustomRuleColumnActionCreate},
+					},
+				},
+				{
+					Type:    "CREATE_TABLE",
+					Line:    3,
+					Tables:  []*CustomRuleTable{{Schema: "public", Name: "t"}},
+					Columns: []*CustomRuleColumn{{Schema: "public", Table: "t", Name: "id", Action: CustomRuleColumnActionCreate}},
+				},
+			},
+			wantLines: []int{1},
+		},
+		{
+			// No DROP on tables tagged critical.
+			expression: `statement_type == "DROP_TABLE" && tables.exists(t, t.classification == "critical")`,
+			statements: []*CustomRuleStatement{
+				{Type: "DROP_TABLE", Line: 1, Tables: []*CustomRuleTable{{Schema: "billing", Name: "draft"}}},
+				{Type: "DROP_TABLE", Line: 2, Tables: []*CustomRuleTable{{Schema: "billing", Name: "draft"}, {Schema: "billing", Name: "invoice"}}},
+				{Type: "DELETE", Line: 3, Tables: []*CustomRuleTable{{Schema: "billing", Name: "invoice"}}},
+			},
+			wantLines: []int{2},
+		},
+		{
+			expression: `database_labels["tier"] == "gold" && statement_type == "ALTER_TABLE" && columns.exists(c, c.action == "DROP" && tables.exists(t, t.name == c.table && c.name in t.columns))`,
+			statements: []*CustomRuleStatement{
+				{
+					Type:    "ALTER_TABLE",
+					Line:    1,
+					Tables:  []*CustomRuleTable{{Schema: "billing", Name: "invoice"}},
+					Columns: []*CustomRuleColumn{{Schema: "billing", Table: "invoice", Name: "tenant_id", Action: CustomRuleColumnActionDrop}},
+				},
+				{
+					Type:    "ALTER_TABLE",
+					Line:    2,
+					Tables:  []*CustomRuleTable{{Schema: "billing", Name: "invoice"}},
+					Columns: []*CustomRuleColumn{{Schema: "billing", Table: "invoice", Name: "amount", Action: CustomRuleColumnActionCreate}},
+				},
+			},
+			wantLines: []int{1},
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		payload, err := json.Marshal(CustomRulePayload{Title: "custom", Expression: test.expression, Message: "violated"})
+		a.NoError(err)
+		adviceList, err := CheckCustomRule(Context{
+			Rule: &storepb.SQLReviewRule{
+				Type:    string(SchemaRuleCustom),
+				Level:   storepb.SQLReviewRuleLevel_ERROR,
+				Payload: string(payload),
+			},
+			Catalog:        finder,
+			DatabaseLabels: map[string]string{"tier": "gold"},
+		}, test.statements)
+		a.NoError(err, test.expression)
+
+		var lines []int
+		for _, advice := range adviceList {
+			if advice.Status == Success {
+				continue
+			}
+			a.Equal(Error, advice.Status)
+			a.Equal(CustomRuleViolation, advice.Code)
+			a.Equal("custom", advice.Title)
+			a.Equal("violated", advice.Content)
+			lines = append(lines, advice.Line)
+		}
+		a.Equal(test.wantLines, lines, test.expression)
+	}
+}
+
+func TestCheckCustomRuleEvaluationError(t *testing.T) {
+	a := require.New(t)
+	payload, err := json.Marshal(CustomRulePayload{Title: "custom", Expression: `tables[0].name == "t"`, Message: "violated"})
+	a.NoError(err)
+	adviceList, err := CheckCustomRule(Context{
+		Rule: &storepb.SQLReviewRule{
+			Type:    string(SchemaRuleCustom),
+			Level:   storepb.SQLReviewRuleLevel_WARNING,
+			Payload: string(payload),
+		},
+	}, []*CustomRuleStatement{
+		// The statement has no tables, so the expression fails at runtime.
+		{Type: "SET", Line: 1},
+		{Type: "DROP_TABLE", Line: 2, Tables: []*CustomRuleTable{{Name: "t"}}},
+	})
+	a.NoError(err)
+	a.Len(adviceList, 2)
+	a.Equal(Error, adviceList[0].Status)
+	a.Equal(CustomRuleEvaluationFailed, adviceList[0].Code)
+	a.Equal(1, adviceList[0].Line)
+	a.Equal(Warn, adviceList[1].Status)
+	a.Equal(CustomRuleViolation, adviceList[1].Code)
+	a.Equal(2, adviceList[1].Line)
+}
+
+func TestUnmarshalCustomRulePayload(t *testing.T) {
+	tests := []struct {
+		payload string
+		wantErr bool
+	}{
+		{payload: `{"expression": "statement_type == \"DROP_TABLE\""}`},
+		{payload: `{"expression": ""}`, wantErr: true},
+		{payload: `{"expression": "statement_type"}`, wantErr: true},
+		{payload: `{"expression": "unknown_factor == 1"}`, wantErr: true},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		_, err := UnmarshalCustomRulePayload(test.payload)
+		if test.wantErr {
+			a.Error(err, test.payload)
+		} else {
+			a.NoError(err, test.payload)
+		}
+	}
+}
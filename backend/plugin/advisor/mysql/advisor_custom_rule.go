@@ -0,0 +1,184 @@
+package mysql
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	mysql "github.com/bytebase/mysql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*CustomRuleAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_MYSQL, advisor.MySQLCustomRule, &CustomRuleAdvisor{})
+	advisor.Register(storepb.Engine_MARIADB, advisor.MySQLCustomRule, &CustomRuleAdvisor{})
+	advisor.Register(storepb.Engine_OCEANBASE, advisor.MySQLCustomRule, &CustomRuleAdvisor{})
+}
+
+// CustomRuleAdvisor is the advisor checking for the custom rule.
+type CustomRuleAdvisor struct {
+}
+
+// Check checks for the custom rule.
+func (*CustomRuleAdvisor) Check(ctx advisor.Context, _ string) ([]advisor.Advice, error) {
+	list, ok := ctx.AST.([]*mysqlparser.ParseResult)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to mysql parser result")
+	}
+
+	var statements []*advisor.CustomRuleStatement
+	for _, stmt := range list {
+		checker := &customRuleChecker{
+			baseLine: stmt.BaseLine,
+			statement: &advisor.CustomRuleStatement{
+				Type: mysqlparser.GetStatementType(stmt),
+			},
+		}
+		antlr.ParseTreeWalkerDefault.Walk(checker, stmt.Tree)
+		statements = append(statements, checker.statement)
+	}
+	return advisor.CheckCustomRule(ctx, statements)
+}
+
+// customRuleChecker collects the normalized view of a statement for the custom rule.
+type customRuleChecker struct {
+	*mysql.BaseMySQLParserListener
+
+	baseLine  int
+	statement *advisor.CustomRuleStatement
+}
+
+// EnterQuery is called when production query is entered.
+func (checker *customRuleChecker) EnterQuery(ctx *mysql.QueryContext) {
+	checker.statement.Text = ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx)
+	checker.statement.Line = checker.baseLine + ctx.GetStart().GetLine()
+}
+
+// EnterTableName is called when production tableName is entered.
+func (checker *customRuleChecker) EnterTableName(ctx *mysql.TableNameContext) {
+	_, tableName := mysqlparser.NormalizeMySQLTableName(ctx)
+	checker.addTable(tableName)
+}
+
+// EnterTableRef is called when production tableRef is entered.
+func (checker *customRuleChecker) EnterTableRef(ctx *mysql.TableRefContext) {
+	_, tableName := mysqlparser.NormalizeMySQLTableRef(ctx)
+	checker.addTable(tableName)
+}
+
+// EnterCreateTable is called when production createTable is entered.
+func (checker *customRuleChecker) EnterCreateTable(ctx *mysql.CreateTableContext) {
+	if ctx.TableName() == nil || ctx.TableElementList() == nil {
+		return
+	}
+	_, tableName := mysqlparser.NormalizeMySQLTableName(ctx.TableName())
+	for _, tableElement := range ctx.TableElementList().AllTableElement() {
+		if tableElement.ColumnDefinition() == nil || tableElement.ColumnDefinition().ColumnName() == nil {
+			continue
+		}
+		_, _, columnName := mysqlparser.NormalizeMySQLColumnName(tableElement.ColumnDefinition().ColumnName())
+		checker.addColumnDef(tableName, columnName, tableElement.ColumnDefinition().FieldDefinition())
+	}
+}
+
+// EnterAlterTable is called when production alterTable is entered.
+func (checker *customRuleChecker) EnterAlterTable(ctx *mysql.AlterTableContext) {
+	if ctx.AlterTableActions() == nil {
+		return
+	}
+	if ctx.AlterTableActions().AlterCommandList() == nil {
+		return
+	}
+	if ctx.AlterTableActions().AlterCommandList().AlterList() == nil {
+		return
+	}
+
+	_, tableName := mysqlparser.NormalizeMySQLTableRef(ctx.TableRef())
+	for _, item := range ctx.AlterTableActions().AlterCommandList().AlterList().AllAlterListItem() {
+		if item == nil {
+			continue
+		}
+
+		switch {
+		// add column
+		case item.ADD_SYMBOL() != nil:
+			switch {
+			case item.Identifier() != nil && item.FieldDefinition() != nil:
+				columnName := mysqlparser.NormalizeMySQLIdentifier(item.Identifier())
+				checker.addColumnDef(tableName, columnName, item.FieldDefinition())
+			case item.OPEN_PAR_SYMBOL() != nil && item.TableElementList() != nil:
+				for _, tableElement := range item.TableElementList().AllTableElement() {
+					if tableElement.ColumnDefinition() == nil || tableElement.ColumnDefinition().ColumnName() == nil {
+						continue
+					}
+					_, _, columnName := mysqlparser.NormalizeMySQLColumnName(tableElement.ColumnDefinition().ColumnName())
+					checker.addColumnDef(tableName, columnName, tableElement.ColumnDefinition().FieldDefinition())
+				}
+			}
+		// drop column
+		case item.DROP_SYMBOL() != nil && item.ColumnInternalRef() != nil:
+			columnName := mysqlparser.NormalizeMySQLColumnInternalRef(item.ColumnInternalRef())
+			checker.addColumn(tableName, columnName, advisor.CustomRuleColumnActionDrop)
+		// modify column, change column, rename column and alter column
+		case item.ColumnInternalRef() != nil:
+			columnName := mysqlparser.NormalizeMySQLColumnInternalRef(item.ColumnInternalRef())
+			checker.addColumn(tableName, columnName, advisor.CustomRuleColumnActionAlter)
+		}
+	}
+}
+
+func (checker *customRuleChecker) addTable(tableName string) {
+	if tableName == "" {
+		return
+	}
+	for _, table := range checker.statement.Tables {
+		if table.Name == tableName {
+			return
+		}
+	}
+	checker.statement.Tables = append(checker.statement.Tables, &advisor.CustomRuleTable{
+		Schema: "",
+		Name:   tableName,
+	})
+}
+
+func (checker *customRuleChecker) addColumn(tableName string, columnName string, action string) {
+	checker.statement.Columns = append(checker.statement.Columns, &advisor.CustomRuleColumn{
+		Schema: "",
+		Table:  tableName,
+		Name:   columnName,
+		Action: action,
+	})
+}
+
+func (checker *customRuleChecker) addColumnDef(tableName string, columnName string, fieldDef mysql.IFieldDefinitionContext) {
+	column := &advisor.CustomRuleColumn{
+		Schema:   "",
+		Table:    tableName,
+		Name:     columnName,
+		Action:   advisor.CustomRuleColumnActionCreate,
+		Nullable: true,
+	}
+	if fieldDef != nil {
+		if fieldDef.DataType() != nil {
+			column.Type = mysqlparser.NormalizeMySQLDataType(fieldDef.DataType(), true /* compact */)
+		}
+		for _, attr := range fieldDef.AllColumnAttribute() {
+			if attr == nil {
+				continue
+			}
+			if (attr.NullLiteral() != nil && attr.NOT_SYMBOL() != nil) || attr.PRIMARY_SYMBOL() != nil {
+				column.Nullable = false
+			}
+			if attr.DEFAULT_SYMBOL() != nil || attr.AUTO_INCREMENT_SYMBOL() != nil {
+				column.HasDefault = true
+			}
+		}
+	}
+	checker.statement.Columns = append(checker.statement.Columns, column)
+}
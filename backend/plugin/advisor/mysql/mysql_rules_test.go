@@ -99,6 +99,8 @@ func TestMySQLRules(t *testing.T) {
 		advisor.SchemaRuleColumnRequireDefault,
 		// advisor.SchemaRuleDisallowProcedure enforce the disallow procedure.
 		advisor.SchemaRuleDisallowProcedure,
+		// advisor.SchemaRuleCustom enforce the custom rule with CEL condition.
+		advisor.SchemaRuleCustom,
 
 		// advisor.SchemaRuleSchemaBackwardCompatibility enforce the MySQL and TiDB support check whether the schema change is backward compatible.
 		advisor.SchemaRuleSchemaBackwardCompatibility,
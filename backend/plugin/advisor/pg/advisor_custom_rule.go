@@ -0,0 +1,170 @@
+package pg
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
+	pgrawparser "github.com/bytebase/bytebase/backend/plugin/parser/sql/engine/pg"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*CustomRuleAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_POSTGRES, advisor.PostgreSQLCustomRule, &CustomRuleAdvisor{})
+}
+
+// CustomRuleAdvisor is the advisor checking for the custom rule.
+type CustomRuleAdvisor struct {
+}
+
+// Check checks for the custom rule.
+func (*CustomRuleAdvisor) Check(ctx advisor.Context, _ string) ([]advisor.Advice, error) {
+	stmts, ok := ctx.AST.([]ast.Node)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Node")
+	}
+
+	var statements []*advisor.CustomRuleStatement
+	for _, stmt := range stmts {
+		statement, err := convertToCustomRuleStatement(stmt)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, statement)
+	}
+	return advisor.CheckCustomRule(ctx, statements)
+}
+
+func convertToCustomRuleStatement(node ast.Node) (*advisor.CustomRuleStatement, error) {
+	statement := &advisor.CustomRuleStatement{
+		Type: "UNKNOWN",
+		Text: node.Text(),
+		Line: node.LastLine(),
+	}
+	switch n := node.(type) {
+	case *ast.CreateTableStmt:
+		statement.Type = "CREATE_TABLE"
+		addCustomRuleTable(statement, n.Name)
+		for _, column := range n.ColumnList {
+			if err := addCustomRuleColumnDef(statement, n.Name, column); err != nil {
+				return nil, err
+			}
+		}
+	case *ast.AlterTableStmt:
+		statement.Type = "ALTER_TABLE"
+		addCustomRuleTable(statement, n.Table)
+		for _, item := range n.AlterItemList {
+			switch cmd := item.(type) {
+			case *ast.AddColumnListStmt:
+				for _, column := range cmd.ColumnList {
+					if err := addCustomRuleColumnDef(statement, n.Table, column); err != nil {
+						return nil, err
+					}
+				}
+			case *ast.DropColumnStmt:
+				addCustomRuleColumn(statement, n.Table, cmd.ColumnName, advisor.CustomRuleColumnActionDrop)
+			case *ast.AlterColumnTypeStmt:
+				addCustomRuleColumn(statement, n.Table, cmd.ColumnName, advisor.CustomRuleColumnActionAlter)
+			case *ast.RenameColumnStmt:
+				addCustomRuleColumn(statement, n.Table, cmd.ColumnName, advisor.CustomRuleColumnActionAlter)
+			case *ast.SetDefaultStmt:
+				addCustomRuleColumn(statement, n.Table, cmd.ColumnName, advisor.CustomRuleColumnActionAlter)
+			case *ast.DropDefaultStmt:
+				addCustomRuleColumn(statement, n.Table, cmd.ColumnName, advisor.CustomRuleColumnActionAlter)
+			case *ast.SetNotNullStmt:
+				addCustomRuleColumn(statement, n.Table, cmd.ColumnName, advisor.CustomRuleColumnActionAlter)
+			case *ast.DropNotNullStmt:
+				addCustomRuleColumn(statement, n.Table, cmd.ColumnName, advisor.CustomRuleColumnActionAlter)
+			}
+		}
+	case *ast.RenameTableStmt:
+		statement.Type = "ALTER_TABLE"
+		addCustomRuleTable(statement, n.Table)
+	case *ast.DropTableStmt:
+		statement.Type = "DROP_TABLE"
+		for _, table := range n.TableList {
+			addCustomRuleTable(statement, table)
+		}
+	case *ast.CreateIndexStmt:
+		statement.Type = "CREATE_INDEX"
+		if n.Index != nil {
+			addCustomRuleTable(statement, n.Index.Table)
+		}
+	case *ast.DropIndexStmt:
+		statement.Type = "DROP_INDEX"
+	case *ast.InsertStmt:
+		statement.Type = "INSERT"
+		addCustomRuleTable(statement, n.Table)
+	case *ast.UpdateStmt:
+		statement.Type = "UPDATE"
+		addCustomRuleTable(statement, n.Table)
+	case *ast.DeleteStmt:
+		statement.Type = "DELETE"
+		addCustomRuleTable(statement, n.Table)
+	case *ast.SelectStmt:
+		statement.Type = "SELECT"
+	}
+	return statement, nil
+}
+
+func addCustomRuleTable(statement *advisor.CustomRuleStatement, table *ast.TableDef) {
+	if table == nil {
+		return
+	}
+	schema := normalizeSchemaName(table.Schema)
+	for _, t := range statement.Tables {
+		if t.Schema == schema && t.Name == table.Name {
+			return
+		}
+	}
+	statement.Tables = append(statement.Tables, &advisor.CustomRuleTable{
+		Schema: schema,
+		Name:   table.Name,
+	})
+}
+
+func addCustomRuleColumn(statement *advisor.CustomRuleStatement, table *ast.TableDef, column string, action string) {
+	statement.Columns = append(statement.Columns, &advisor.CustomRuleColumn{
+		Schema: normalizeSchemaName(table.Schema),
+		Table:  table.Name,
+		Name:   column,
+		Action: action,
+	})
+}
+
+func addCustomRuleColumnDef(statement *advisor.CustomRuleStatement, table *ast.TableDef, column *ast.ColumnDef) error {
+	columnType, err := pgrawparser.Deparse(pgrawparser.DeparseContext{}, column.Type)
+	if err != nil {
+		return errors.Wrapf(err, "failed to deparse the type of column %q", column.ColumnName)
+	}
+	nullable := true
+	hasDefault := false
+	switch strings.ToLower(columnType) {
+	case "serial", "smallserial", "bigserial":
+		hasDefault = true
+	}
+	for _, constraint := range column.ConstraintList {
+		switch constraint.Type {
+		case ast.ConstraintTypeNotNull, ast.ConstraintTypePrimary:
+			nullable = false
+		case ast.ConstraintTypeDefault:
+			hasDefault = true
+		}
+	}
+	statement.Columns = append(statement.Columns, &advisor.CustomRuleColumn{
+		Schema:     normalizeSchemaName(table.Schema),
+		Table:      table.Name,
+		Name:       column.ColumnName,
+		Action:     advisor.CustomRuleColumnActionCreate,
+		Type:       columnType,
+		Nullable:   nullable,
+		HasDefault: hasDefault,
+	})
+	return nil
+}
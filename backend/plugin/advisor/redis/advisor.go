@@ -0,0 +1,19 @@
+// Package redis is the advisor for Redis.
+package redis
+
+import (
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+// generateAdvice returns the advices, the advices must not be empty.
+func generateAdvice(adviceList []advisor.Advice) []advisor.Advice {
+	if len(adviceList) == 0 {
+		adviceList = append(adviceList, advisor.Advice{
+			Status:  advisor.Success,
+			Code:    advisor.Ok,
+			Title:   "OK",
+			Content: "",
+		})
+	}
+	return adviceList
+}
@@ -0,0 +1,59 @@
+package redis
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	redisparser "github.com/bytebase/bytebase/backend/plugin/parser/redis"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*DisallowDangerousCommandAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_REDIS, advisor.RedisDisallowDangerousCommand, &DisallowDangerousCommandAdvisor{})
+}
+
+// DisallowDangerousCommandAdvisor is the advisor checking for the dangerous commands.
+type DisallowDangerousCommandAdvisor struct {
+}
+
+// Check checks for the dangerous commands such as FLUSHALL, KEYS and CONFIG SET.
+func (*DisallowDangerousCommandAdvisor) Check(ctx advisor.Context, _ string) ([]advisor.Advice, error) {
+	commands, ok := ctx.AST.([]*redisparser.Command)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Redis commands")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []advisor.Advice
+	for _, command := range commands {
+		var content string
+		switch redisparser.GetDangerousRisk(command) {
+		case redisparser.DangerousRiskDestroyData:
+			content = fmt.Sprintf("\"%s\" may destroy the data", command.Text)
+		case redisparser.DangerousRiskBlockServer:
+			content = fmt.Sprintf("\"%s\" may block the server", command.Text)
+		case redisparser.DangerousRiskChangeServerState:
+			content = fmt.Sprintf("\"%s\" changes the server state", command.Text)
+		default:
+			continue
+		}
+		adviceList = append(adviceList, advisor.Advice{
+			Status:  level,
+			Code:    advisor.StatementDangerousCommand,
+			Title:   string(ctx.Rule.Type),
+			Content: content,
+			Line:    command.Line,
+		})
+	}
+	return generateAdvice(adviceList), nil
+}
@@ -0,0 +1,51 @@
+package redis
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	redisparser "github.com/bytebase/bytebase/backend/plugin/parser/redis"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*DisallowLinearCommandAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_REDIS, advisor.RedisDisallowLinearCommand, &DisallowLinearCommandAdvisor{})
+}
+
+// DisallowLinearCommandAdvisor is the advisor checking for the O(N) commands.
+type DisallowLinearCommandAdvisor struct {
+}
+
+// Check checks for the commands whose time complexity is O(N) of the keyspace or a whole collection.
+func (*DisallowLinearCommandAdvisor) Check(ctx advisor.Context, _ string) ([]advisor.Advice, error) {
+	commands, ok := ctx.AST.([]*redisparser.Command)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Redis commands")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []advisor.Advice
+	for _, command := range commands {
+		if !redisparser.IsLinearCommand(command) {
+			continue
+		}
+		adviceList = append(adviceList, advisor.Advice{
+			Status:  level,
+			Code:    advisor.StatementLinearCommand,
+			Title:   string(ctx.Rule.Type),
+			Content: fmt.Sprintf("\"%s\" is O(N) of the keyspace or the whole collection and may block the server, use SCAN, HSCAN, SSCAN, ZSCAN or a bounded range instead", command.Text),
+			Line:    command.Line,
+		})
+	}
+	return generateAdvice(adviceList), nil
+}
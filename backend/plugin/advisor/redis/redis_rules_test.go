@@ -0,0 +1,19 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestRedisRules(t *testing.T) {
+	redisRules := []advisor.SQLReviewRuleType{
+		advisor.SchemaRuleStatementRedisDisallowDangerousCommand,
+		advisor.SchemaRuleStatementRedisDisallowLinearCommand,
+	}
+
+	for _, rule := range redisRules {
+		advisor.RunSQLReviewRuleTest(t, rule, storepb.Engine_REDIS, false /* record */)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
 	plsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/plsql"
+	redisparser "github.com/bytebase/bytebase/backend/plugin/parser/redis"
 	snowsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/snowflake"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
 	pgrawparser "github.com/bytebase/bytebase/backend/plugin/parser/sql/engine/pg"
@@ -89,6 +90,12 @@ const (
 	SchemaRuleStatementAffectedRowLimit SQLReviewRuleType = "statement.affected-row-limit"
 	// SchemaRuleStatementDMLDryRun dry run the dml.
 	SchemaRuleStatementDMLDryRun SQLReviewRuleType = "statement.dml-dry-run"
+	// SchemaRuleStatementRedisDisallowDangerousCommand disallow the Redis commands that may destroy the data or block the server, such as FLUSHALL and KEYS.
+	SchemaRuleStatementRedisDisallowDangerousCommand SQLReviewRuleType = "statement.redis.disallow-dangerous-command"
+	// SchemaRuleStatementRedisDisallowLinearCommand disallow the Redis commands whose time complexity is O(N) of the keyspace or a whole collection, such as HGETALL and SMEMBERS.
+	SchemaRuleStatementRedisDisallowLinearCommand SQLReviewRuleType = "statement.redis.disallow-linear-command"
+	// SchemaRuleStatementCockroachDBDisallowSchemaChangeInTransaction disallow the CockroachDB schema changes in explicit transactions.
+	SchemaRuleStatementCockroachDBDisallowSchemaChangeInTransaction SQLReviewRuleType = "statement.cockroachdb.disallow-schema-change-in-transaction"
 	// SchemaRuleStatementDisallowAddColumnWithDefault disallow to add column with DEFAULT.
 	SchemaRuleStatementDisallowAddColumnWithDefault = "statement.disallow-add-column-with-default"
 	// SchemaRuleStatementAddCheckNotValid require add check constraints not valid.
@@ -168,6 +175,8 @@ const (
 	SchemaRuleIndexTotalNumberLimit SQLReviewRuleType = "index.total-number-limit"
 	// SchemaRuleIndexPrimaryKeyTypeAllowlist enforce the primary key type allowlist.
 	SchemaRuleIndexPrimaryKeyTypeAllowlist SQLReviewRuleType = "index.primary-key-type-allowlist"
+	// SchemaRuleIndexCockroachDBDisallowSequentialPrimaryKey disallow the CockroachDB primary keys generated by sequences, which cause the write hotspots.
+	SchemaRuleIndexCockroachDBDisallowSequentialPrimaryKey SQLReviewRuleType = "index.cockroachdb.disallow-sequential-primary-key"
 	// SchemaRuleCreateIndexConcurrently require creating indexes concurrently.
 	SchemaRuleCreateIndexConcurrently SQLReviewRuleType = "index.create-concurrently"
 
@@ -183,6 +192,9 @@ const (
 	// SchemaRuleDisallowProcedure disallow procedure.
 	SchemaRuleDisallowProcedure SQLReviewRuleType = "system.procedure.disallow"
 
+	// SchemaRuleCustom is the user-defined rule whose condition is a CEL expression.
+	SchemaRuleCustom SQLReviewRuleType = "custom.cel"
+
 	// TableNameTemplateToken is the token for table name.
 	TableNameTemplateToken = "{{table}}"
 	// ColumnListTemplateToken is the token for column name list.
@@ -410,6 +422,9 @@ type SQLReviewCheckContext struct {
 	CurrentDatabase string
 	// Oracle specific fields
 	CurrentSchema string
+
+	// DatabaseLabels is the labels of the database, used by the custom rules.
+	DatabaseLabels map[string]string
 }
 
 func syntaxCheck(statement string, checkContext SQLReviewCheckContext) (any, []Advice) {
@@ -420,12 +435,16 @@ func syntaxCheck(statement string, checkContext SQLReviewCheckContext) (any, []A
 		return mysqlSyntaxCheck(statement)
 	case storepb.Engine_POSTGRES:
 		return postgresSyntaxCheck(statement)
+	case storepb.Engine_COCKROACHDB:
+		return cockroachdbSyntaxCheck(statement)
 	case storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE:
 		return oracleSyntaxCheck(statement)
 	case storepb.Engine_SNOWFLAKE:
 		return snowflakeSyntaxCheck(statement)
 	case storepb.Engine_MSSQL:
 		return mssqlSyntaxCheck(statement)
+	case storepb.Engine_REDIS:
+		return redisSyntaxCheck(statement)
 	}
 	return nil, []Advice{
 		{
@@ -438,6 +457,34 @@ func syntaxCheck(statement string, checkContext SQLReviewCheckContext) (any, []A
 	}
 }
 
+func redisSyntaxCheck(statement string) (any, []Advice) {
+	commands, err := redisparser.ParseRedisCommands(statement)
+	if err != nil {
+		if syntaxErr, ok := err.(*base.SyntaxError); ok {
+			return nil, []Advice{
+				{
+					Status:  Warn,
+					Code:    StatementSyntaxError,
+					Title:   SyntaxErrorTitle,
+					Content: syntaxErr.Message,
+					Line:    syntaxErr.Line,
+					Column:  syntaxErr.Column,
+				},
+			}
+		}
+		return nil, []Advice{
+			{
+				Status:  Warn,
+				Code:    Internal,
+				Title:   "Parse error",
+				Content: err.Error(),
+				Line:    1,
+			},
+		}
+	}
+	return commands, nil
+}
+
 func mssqlSyntaxCheck(statement string) (any, []Advice) {
 	result, err := tsqlparser.ParseTSQL(statement)
 	if err != nil {
@@ -565,6 +612,17 @@ func postgresSyntaxCheck(statement string) (any, []Advice) {
 	return res, nil
 }
 
+// cockroachdbSyntaxCheck parses the statement by the PostgreSQL parser.
+// CockroachDB extends the PostgreSQL syntax, such as the INDEX and FAMILY definitions in CREATE TABLE,
+// so the parse errors are reported as warnings instead of errors.
+func cockroachdbSyntaxCheck(statement string) (any, []Advice) {
+	res, adviceList := postgresSyntaxCheck(statement)
+	for i := range adviceList {
+		adviceList[i].Status = Warn
+	}
+	return res, adviceList
+}
+
 func calculatePostgresErrorLine(statement string) int {
 	singleSQLs, err := base.SplitMultiSQL(storepb.Engine_POSTGRES, statement)
 	if err != nil {
@@ -720,6 +778,7 @@ func SQLReviewCheck(statements string, ruleList []*storepb.SQLReviewRule, checkC
 				Context:         checkContext.Context,
 				CurrentSchema:   checkContext.CurrentSchema,
 				CurrentDatabase: checkContext.CurrentDatabase,
+				DatabaseLabels:  checkContext.DatabaseLabels,
 			},
 			statements,
 		)
@@ -958,6 +1017,10 @@ func convertWalkThroughErrorToAdvice(checkContext SQLReviewCheckContext, err err
 }
 
 func getViewDefinition(checkContext SQLReviewCheckContext, viewList []string) (string, error) {
+	// The offline review, e.g. bb review, has no database connection to read the view definitions.
+	if checkContext.Driver == nil {
+		return "", nil
+	}
 	var buf bytes.Buffer
 	sql := fmt.Sprintf(`
 		WITH view_list(view_name) AS (
@@ -1380,6 +1443,10 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 		case storepb.Engine_POSTGRES:
 			return PostgreSQLPrimaryKeyTypeAllowlist, nil
 		}
+	case SchemaRuleIndexCockroachDBDisallowSequentialPrimaryKey:
+		if engine == storepb.Engine_COCKROACHDB {
+			return CockroachDBDisallowSequentialPrimaryKey, nil
+		}
 	case SchemaRuleCreateIndexConcurrently:
 		if engine == storepb.Engine_POSTGRES {
 			return PostgreSQLCreateIndexConcurrently, nil
@@ -1431,6 +1498,18 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 		case storepb.Engine_POSTGRES:
 			return PostgreSQLStatementAffectedRowLimit, nil
 		}
+	case SchemaRuleStatementRedisDisallowDangerousCommand:
+		if engine == storepb.Engine_REDIS {
+			return RedisDisallowDangerousCommand, nil
+		}
+	case SchemaRuleStatementRedisDisallowLinearCommand:
+		if engine == storepb.Engine_REDIS {
+			return RedisDisallowLinearCommand, nil
+		}
+	case SchemaRuleStatementCockroachDBDisallowSchemaChangeInTransaction:
+		if engine == storepb.Engine_COCKROACHDB {
+			return CockroachDBDisallowSchemaChangeInTransaction, nil
+		}
 	case SchemaRuleStatementDMLDryRun:
 		switch engine {
 		case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
@@ -1463,6 +1542,13 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 		if engine == storepb.Engine_MYSQL {
 			return MySQLDisallowProcedure, nil
 		}
+	case SchemaRuleCustom:
+		switch engine {
+		case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
+			return MySQLCustomRule, nil
+		case storepb.Engine_POSTGRES:
+			return PostgreSQLCustomRule, nil
+		}
 	}
 	return Fake, errors.Errorf("unknown SQL review rule type %v for %v", ruleType, engine)
 }
@@ -327,6 +327,10 @@ func SetDefaultSQLReviewRulePayload(ruleTp SQLReviewRuleType, dbType storepb.Eng
 		SchemaRuleStatementInsertMustSpecifyColumn,
 		SchemaRuleStatementInsertDisallowOrderByRand,
 		SchemaRuleStatementDMLDryRun,
+		SchemaRuleStatementRedisDisallowDangerousCommand,
+		SchemaRuleStatementRedisDisallowLinearCommand,
+		SchemaRuleStatementCockroachDBDisallowSchemaChangeInTransaction,
+		SchemaRuleIndexCockroachDBDisallowSequentialPrimaryKey,
 		SchemaRuleTableRequirePK,
 		SchemaRuleTableNoFK,
 		SchemaRuleTableDisallowPartition,
@@ -458,6 +462,11 @@ func SetDefaultSQLReviewRulePayload(ruleTp SQLReviewRuleType, dbType storepb.Eng
 		payload, err = json.Marshal(NamingCaseRulePayload{
 			Upper: true,
 		})
+	case SchemaRuleCustom:
+		payload, err = json.Marshal(CustomRulePayload{
+			Expression: `(statement_type == "CREATE_TABLE" && !columns.exists(c, c.name == "tenant_id")) || (statement_type == "DROP_TABLE" && tables.exists(t, t.exists))`,
+			Message:    "The table requires the tenant_id column and the existing table cannot be dropped",
+		})
 	default:
 		return "", errors.Errorf("unknown SQL review type for default payload: %s", ruleTp)
 	}
@@ -0,0 +1,457 @@
+// Package cockroachdb is the plugin for CockroachDB driver.
+package cockroachdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	// Import pg driver.
+	// init() in pgx/v5/stdlib will register it's pgx driver.
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+var (
+	// driverName is the driver name that our driver dependence register, now is "pgx".
+	driverName = "pgx"
+
+	// versionRegexp matches the version string such as "CockroachDB CCL v23.1.11 (x86_64-pc-linux-gnu, built 2023/09/27 01:53:43, go1.19.10)".
+	versionRegexp = regexp.MustCompile(`CockroachDB \w+ v(\S+)`)
+
+	// schemaChangeRegexp matches the statements changing the schema.
+	schemaChangeRegexp = regexp.MustCompile(`(?i)^\s*(CREATE|ALTER|DROP|RENAME|TRUNCATE|COMMENT\s+ON)\s`)
+
+	_ db.Driver = (*Driver)(nil)
+)
+
+func init() {
+	db.Register(storepb.Engine_COCKROACHDB, newDriver)
+}
+
+// Driver is the CockroachDB driver.
+type Driver struct {
+	config db.ConnectionConfig
+
+	db        *sql.DB
+	sshClient *ssh.Client
+	// connectionString is the connection string registered by pgx.
+	// Unregister connectionString if we don't need it.
+	connectionString string
+	databaseName     string
+}
+
+func newDriver(db.DriverConfig) db.Driver {
+	return &Driver{}
+}
+
+// Open opens a CockroachDB driver.
+func (driver *Driver) Open(_ context.Context, _ storepb.Engine, config db.ConnectionConfig) (db.Driver, error) {
+	if config.Username == "" {
+		return nil, errors.Errorf("user must be set")
+	}
+
+	if config.Host == "" {
+		return nil, errors.Errorf("host must be set")
+	}
+
+	if config.Port == "" {
+		return nil, errors.Errorf("port must be set")
+	}
+
+	if (config.TLSConfig.SslCert == "" && config.TLSConfig.SslKey != "") ||
+		(config.TLSConfig.SslCert != "" && config.TLSConfig.SslKey == "") {
+		return nil, errors.Errorf("ssl-cert and ssl-key must be both set or unset")
+	}
+
+	connStr := fmt.Sprintf("host=%s port=%s", config.Host, config.Port)
+	connConfig, err := pgx.ParseConfig(connStr)
+	if err != nil {
+		return nil, err
+	}
+	connConfig.Config.User = config.Username
+	connConfig.Config.Password = config.Password
+	connConfig.Config.Database = config.Database
+	if config.TLSConfig.SslCert != "" || config.TLSConfig.SslCA != "" {
+		cfg, err := config.TLSConfig.GetSslConfig()
+		if err != nil {
+			return nil, err
+		}
+		connConfig.TLSConfig = cfg
+	}
+	if config.SSHConfig.Host != "" {
+		sshClient, err := util.GetSSHClient(config.SSHConfig)
+		if err != nil {
+			return nil, err
+		}
+		driver.sshClient = sshClient
+
+		connConfig.Config.DialFunc = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			conn, err := sshClient.Dial(network, addr)
+			if err != nil {
+				return nil, err
+			}
+			return &noDeadlineConn{Conn: conn}, nil
+		}
+	}
+	if config.ReadOnly {
+		connConfig.RuntimeParams["default_transaction_read_only"] = "true"
+	}
+	connConfig.RuntimeParams["application_name"] = "bytebase"
+
+	driver.databaseName = config.Database
+	if config.Database == "" {
+		databaseName, cfg, err := guessDSN(connConfig)
+		if err != nil {
+			return nil, err
+		}
+		connConfig = cfg
+		driver.databaseName = databaseName
+	}
+	driver.config = config
+
+	driver.connectionString = stdlib.RegisterConnConfig(connConfig)
+	db, err := sql.Open(driverName, driver.connectionString)
+	if err != nil {
+		return nil, err
+	}
+	driver.db = db
+	return driver, nil
+}
+
+type noDeadlineConn struct{ net.Conn }
+
+func (*noDeadlineConn) SetDeadline(time.Time) error      { return nil }
+func (*noDeadlineConn) SetReadDeadline(time.Time) error  { return nil }
+func (*noDeadlineConn) SetWriteDeadline(time.Time) error { return nil }
+
+// guessDSN will guess a valid DB connection and its database name.
+func guessDSN(baseConnConfig *pgx.ConnConfig) (string, *pgx.ConnConfig, error) {
+	// CockroachDB creates the default `defaultdb` database, and the `postgres` database for the compatibility.
+	guesses := []string{"defaultdb", "postgres"}
+	for _, guessDatabase := range guesses {
+		connConfig := *baseConnConfig
+		connConfig.Database = guessDatabase
+		if err := func() error {
+			connectionString := stdlib.RegisterConnConfig(&connConfig)
+			defer stdlib.UnregisterConnConfig(connectionString)
+			db, err := sql.Open(driverName, connectionString)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			return db.Ping()
+		}(); err != nil {
+			slog.Debug("guessDSN attempt failed", log.BBError(err))
+			continue
+		}
+		return guessDatabase, &connConfig, nil
+	}
+	return "", nil, errors.Errorf("cannot connect to the instance, make sure the connection info is correct")
+}
+
+// Close closes the driver.
+func (driver *Driver) Close(context.Context) error {
+	stdlib.UnregisterConnConfig(driver.connectionString)
+	var err error
+	err = multierr.Append(err, driver.db.Close())
+	if driver.sshClient != nil {
+		err = multierr.Append(err, driver.sshClient.Close())
+	}
+	return err
+}
+
+// Ping pings the database.
+func (driver *Driver) Ping(ctx context.Context) error {
+	return driver.db.PingContext(ctx)
+}
+
+// GetType returns the database type.
+func (*Driver) GetType() storepb.Engine {
+	return storepb.Engine_COCKROACHDB
+}
+
+// GetDB gets the database.
+func (driver *Driver) GetDB() *sql.DB {
+	return driver.db
+}
+
+// getDatabases gets all databases of an instance.
+// CockroachDB only supports the UTF8 encoding, and the collation is specified at the column level.
+func (driver *Driver) getDatabases(ctx context.Context) ([]*storepb.DatabaseSchemaMetadata, error) {
+	query := "SELECT name FROM crdb_internal.databases WHERE name <> 'system' ORDER BY name;"
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var databases []*storepb.DatabaseSchemaMetadata
+	for rows.Next() {
+		database := &storepb.DatabaseSchemaMetadata{
+			CharacterSet: "UTF8",
+		}
+		if err := rows.Scan(&database.Name); err != nil {
+			return nil, err
+		}
+		databases = append(databases, database)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return databases, nil
+}
+
+// getVersion gets the version of CockroachDB server.
+func (driver *Driver) getVersion(ctx context.Context) (string, error) {
+	query := "SELECT version();"
+	var version string
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&version); err != nil {
+		if err == sql.ErrNoRows {
+			return "", common.FormatDBErrorEmptyRowWithQuery(query)
+		}
+		return "", util.FormatErrorWithQuery(err, query)
+	}
+	return parseVersion(version)
+}
+
+// parseVersion parses the version string such as "CockroachDB CCL v23.1.11 (...)" into "23.1.11".
+func parseVersion(version string) (string, error) {
+	matches := versionRegexp.FindStringSubmatch(version)
+	if len(matches) != 2 {
+		return "", errors.Errorf("cannot parse version %q", version)
+	}
+	return matches[1], nil
+}
+
+// Execute will execute the statement.
+// CockroachDB runs the schema changes asynchronously after the transaction commits, and the schema changes mixed with
+// the writes in an explicit transaction may fail in the commit phase after part of them has taken effect.
+// So the script containing schema changes is executed statement by statement in implicit transactions, and the other
+// scripts are executed in a single transaction.
+func (driver *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteOptions) (int64, error) {
+	if opts.CreateDatabase {
+		if err := driver.createDatabaseExecute(ctx, statement); err != nil {
+			return 0, err
+		}
+		return 0, nil
+	}
+
+	singleSQLs, err := pgparser.SplitSQL(statement)
+	if err != nil {
+		return 0, err
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+	if len(singleSQLs) == 0 {
+		return 0, nil
+	}
+
+	if !containsSchemaChange(singleSQLs) {
+		tx, err := driver.db.BeginTx(ctx, nil)
+		if err != nil {
+			return 0, err
+		}
+		defer tx.Rollback()
+		rowsAffected, err := execSingleSQLs(ctx, tx, singleSQLs, opts)
+		if err != nil {
+			return 0, err
+		}
+		if err := tx.Commit(); err != nil {
+			return 0, err
+		}
+		return rowsAffected, nil
+	}
+
+	conn, err := driver.db.Conn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	return execSingleSQLs(ctx, conn, singleSQLs, opts)
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func execSingleSQLs(ctx context.Context, e execer, singleSQLs []base.SingleSQL, opts db.ExecuteOptions) (int64, error) {
+	totalRowsAffected := int64(0)
+	for i, singleSQL := range singleSQLs {
+		if opts.UpdateExecutionStatus != nil {
+			opts.UpdateExecutionStatus(&v1pb.TaskRun_ExecutionDetail{
+				CommandsTotal:     int32(len(singleSQLs)),
+				CommandsCompleted: int32(i),
+				CommandStartPosition: &v1pb.TaskRun_ExecutionDetail_Position{
+					Line:   int32(singleSQL.FirstStatementLine),
+					Column: int32(singleSQL.FirstStatementColumn),
+				},
+				CommandEndPosition: &v1pb.TaskRun_ExecutionDetail_Position{
+					Line:   int32(singleSQL.LastLine),
+					Column: int32(singleSQL.LastColumn),
+				},
+			})
+		}
+		sqlResult, err := e.ExecContext(ctx, singleSQL.Text)
+		if err != nil {
+			return 0, &db.ErrorWithPosition{
+				Err: errors.Wrapf(err, "failed to execute statement"),
+				Start: &storepb.TaskRunResult_Position{
+					Line:   int32(singleSQL.FirstStatementLine),
+					Column: int32(singleSQL.FirstStatementColumn),
+				},
+				End: &storepb.TaskRunResult_Position{
+					Line:   int32(singleSQL.LastLine),
+					Column: int32(singleSQL.LastColumn),
+				},
+			}
+		}
+		rowsAffected, err := sqlResult.RowsAffected()
+		if err != nil {
+			// Since we cannot differentiate DDL and DML yet, we have to ignore the error.
+			slog.Debug("rowsAffected returns error", log.BBError(err))
+		}
+		totalRowsAffected += rowsAffected
+	}
+	return totalRowsAffected, nil
+}
+
+// containsSchemaChange returns true if any of the statements changes the schema.
+func containsSchemaChange(singleSQLs []base.SingleSQL) bool {
+	for _, singleSQL := range singleSQLs {
+		if schemaChangeRegexp.MatchString(stripLeadingComments(singleSQL.Text)) {
+			return true
+		}
+	}
+	return false
+}
+
+func stripLeadingComments(stmt string) string {
+	for {
+		stmt = strings.TrimSpace(stmt)
+		switch {
+		case strings.HasPrefix(stmt, "--"):
+			index := strings.Index(stmt, "\n")
+			if index < 0 {
+				return ""
+			}
+			stmt = stmt[index+1:]
+		case strings.HasPrefix(stmt, "/*"):
+			index := strings.Index(stmt, "*/")
+			if index < 0 {
+				return ""
+			}
+			stmt = stmt[index+2:]
+		default:
+			return stmt
+		}
+	}
+}
+
+func (driver *Driver) createDatabaseExecute(ctx context.Context, statement string) error {
+	databaseName, err := getDatabaseInCreateDatabaseStatement(statement)
+	if err != nil {
+		return err
+	}
+	databases, err := driver.getDatabases(ctx)
+	if err != nil {
+		return err
+	}
+	for _, database := range databases {
+		if database.Name == databaseName {
+			// Database already exists.
+			return nil
+		}
+	}
+
+	for _, s := range strings.Split(statement, "\n") {
+		if _, err := driver.db.ExecContext(ctx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getDatabaseInCreateDatabaseStatement(createDatabaseStatement string) (string, error) {
+	raw := strings.Split(createDatabaseStatement, "\n")[0]
+	raw = strings.TrimRight(raw, ";")
+	raw = strings.TrimPrefix(raw, "CREATE DATABASE")
+	tokens := strings.Fields(raw)
+	if len(tokens) == 0 {
+		return "", errors.Errorf("database name not found")
+	}
+	databaseName := strings.TrimLeft(tokens[0], `"`)
+	databaseName = strings.TrimRight(databaseName, `"`)
+	return databaseName, nil
+}
+
+// QueryConn queries a SQL statement in a given connection.
+func (driver *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string, queryContext *db.QueryContext) ([]*v1pb.QueryResult, error) {
+	singleSQLs, err := pgparser.SplitSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+	if len(singleSQLs) == 0 {
+		return nil, nil
+	}
+
+	var results []*v1pb.QueryResult
+	for _, singleSQL := range singleSQLs {
+		result, err := driver.querySingleSQL(ctx, conn, singleSQL, queryContext)
+		if err != nil {
+			results = append(results, &v1pb.QueryResult{
+				Error: err.Error(),
+			})
+		} else {
+			results = append(results, result)
+		}
+	}
+
+	return results, nil
+}
+
+func getStatementWithResultLimit(stmt string, limit int) string {
+	return fmt.Sprintf("WITH result AS (%s) SELECT * FROM result LIMIT %d;", stmt, limit)
+}
+
+func (*Driver) querySingleSQL(ctx context.Context, conn *sql.Conn, singleSQL base.SingleSQL, queryContext *db.QueryContext) (*v1pb.QueryResult, error) {
+	statement := strings.Trim(singleSQL.Text, " \n\t;")
+
+	stmt := statement
+	upperStatement := strings.ToUpper(stmt)
+	if !strings.HasPrefix(upperStatement, "EXPLAIN") && !strings.HasPrefix(upperStatement, "SHOW") && queryContext.Limit > 0 {
+		stmt = getStatementWithResultLimit(stmt, queryContext.Limit)
+	}
+
+	startTime := time.Now()
+	result, err := util.Query(ctx, storepb.Engine_POSTGRES, conn, stmt, queryContext)
+	if err != nil {
+		return nil, err
+	}
+	result.Latency = durationpb.New(time.Since(startTime))
+	result.Statement = statement
+	return result, nil
+}
+
+// RunStatement runs a SQL statement in a given connection.
+func (*Driver) RunStatement(ctx context.Context, conn *sql.Conn, statement string) ([]*v1pb.QueryResult, error) {
+	return util.RunStatement(ctx, storepb.Engine_POSTGRES, conn, statement)
+}
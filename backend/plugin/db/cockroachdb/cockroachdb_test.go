@@ -0,0 +1,79 @@
+package cockroachdb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestParseVersion(t *testing.T) {
+	a := require.New(t)
+	version, err := parseVersion("CockroachDB CCL v23.1.11 (x86_64-pc-linux-gnu, built 2023/09/27 01:53:43, go1.19.10)")
+	a.NoError(err)
+	a.Equal("23.1.11", version)
+
+	version, err = parseVersion("CockroachDB OSS v21.2.0-beta.1 (x86_64-apple-darwin19, built 2021/09/13 16:00:00, go1.16.6)")
+	a.NoError(err)
+	a.Equal("21.2.0-beta.1", version)
+
+	_, err = parseVersion("PostgreSQL 15.4 on x86_64-pc-linux-gnu")
+	a.Error(err)
+}
+
+func TestContainsSchemaChange(t *testing.T) {
+	tests := []struct {
+		statements []string
+		want       bool
+	}{
+		{
+			statements: []string{"INSERT INTO t VALUES (1);", "UPDATE t SET a = 2;"},
+			want:       false,
+		},
+		{
+			statements: []string{"INSERT INTO t VALUES (1);", "ALTER TABLE t ADD COLUMN b INT;"},
+			want:       true,
+		},
+		{
+			statements: []string{"-- create the table\n/* comment */ CREATE TABLE t (id UUID PRIMARY KEY);"},
+			want:       true,
+		},
+		{
+			statements: []string{"SELECT 'CREATE TABLE t';"},
+			want:       false,
+		},
+	}
+
+	for _, test := range tests {
+		var singleSQLs []base.SingleSQL
+		for _, statement := range test.statements {
+			singleSQLs = append(singleSQLs, base.SingleSQL{Text: statement})
+		}
+		require.Equal(t, test.want, containsSchemaChange(singleSQLs), test.statements)
+	}
+}
+
+func TestWriteStatements(t *testing.T) {
+	a := require.New(t)
+	var out strings.Builder
+	err := writeStatements(&out, []string{
+		"CREATE TABLE public.users (\n\tid UUID NOT NULL DEFAULT gen_random_uuid(),\n\tCONSTRAINT users_pkey PRIMARY KEY (id ASC)\n);",
+		"",
+		"ALTER TABLE public.orders ADD CONSTRAINT orders_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id)",
+	})
+	a.NoError(err)
+	a.Equal("CREATE TABLE public.users (\n\tid UUID NOT NULL DEFAULT gen_random_uuid(),\n\tCONSTRAINT users_pkey PRIMARY KEY (id ASC)\n);\n\n"+
+		"ALTER TABLE public.orders ADD CONSTRAINT orders_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id);\n\n", out.String())
+}
+
+func TestGetDatabaseInCreateDatabaseStatement(t *testing.T) {
+	a := require.New(t)
+	databaseName, err := getDatabaseInCreateDatabaseStatement("CREATE DATABASE \"hello\";\nALTER DATABASE \"hello\" OWNER TO \"bob\";")
+	a.NoError(err)
+	a.Equal("hello", databaseName)
+
+	_, err = getDatabaseInCreateDatabaseStatement("CREATE DATABASE;")
+	a.Error(err)
+}
@@ -0,0 +1,86 @@
+package cockroachdb
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+// Dump dumps the database schema by SHOW CREATE ALL TABLES.
+// The statements are in the dependency order, the tables, views and sequences are created first,
+// and the foreign keys are added and validated at the end.
+// Dumping the data isn't supported, please use BACKUP for CockroachDB instead.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer, schemaOnly bool) (string, error) {
+	if !schemaOnly {
+		return "", errors.New("dumping data is not supported for CockroachDB")
+	}
+
+	txn, err := driver.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return "", err
+	}
+	defer txn.Rollback()
+
+	query := "SHOW CREATE ALL TABLES;"
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return "", util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var statements []string
+	for rows.Next() {
+		var statement string
+		if err := rows.Scan(&statement); err != nil {
+			return "", err
+		}
+		statements = append(statements, statement)
+	}
+	if err := rows.Err(); err != nil {
+		return "", util.FormatErrorWithQuery(err, query)
+	}
+
+	if err := writeStatements(out, statements); err != nil {
+		return "", err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return "", err
+	}
+	return "", nil
+}
+
+// writeStatements writes the statements terminated by semicolons and separated by blank lines.
+func writeStatements(out io.Writer, statements []string) error {
+	for _, statement := range statements {
+		statement = strings.TrimSpace(statement)
+		if statement == "" {
+			continue
+		}
+		if !strings.HasSuffix(statement, ";") {
+			statement += ";"
+		}
+		if _, err := io.WriteString(out, statement+"\n\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Restore restores a database from the schema dump.
+func (driver *Driver) Restore(ctx context.Context, sc io.Reader) error {
+	buf, err := io.ReadAll(sc)
+	if err != nil {
+		return err
+	}
+	if _, err := driver.Execute(ctx, string(buf), db.ExecuteOptions{}); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,599 @@
+package cockroachdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const systemSchemas = "'crdb_internal', 'information_schema', 'pg_catalog', 'pg_extension'"
+
+// SyncInstance syncs the instance.
+func (driver *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, error) {
+	version, err := driver.getVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceRoles, err := driver.getInstanceRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Query db info
+	databases, err := driver.getDatabases(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get databases")
+	}
+
+	return &db.InstanceMetadata{
+		Version:       version,
+		InstanceRoles: instanceRoles,
+		Databases:     databases,
+	}, nil
+}
+
+// SyncDBSchema syncs a single database schema.
+func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchemaMetadata, error) {
+	// Query db info
+	databases, err := driver.getDatabases(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get databases")
+	}
+
+	var databaseMetadata *storepb.DatabaseSchemaMetadata
+	for _, database := range databases {
+		if database.Name == driver.databaseName {
+			databaseMetadata = database
+			break
+		}
+	}
+	if databaseMetadata == nil {
+		return nil, common.Errorf(common.NotFound, "database %q not found", driver.databaseName)
+	}
+
+	txn, err := driver.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback()
+
+	schemaList, err := getSchemas(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get schemas from database %q", driver.databaseName)
+	}
+	tableMap, err := getTables(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get tables from database %q", driver.databaseName)
+	}
+	viewMap, err := getViews(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get views from database %q", driver.databaseName)
+	}
+	materializedViewMap, err := getMaterializedViews(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get materialized views from database %q", driver.databaseName)
+	}
+	functionMap, err := getFunctions(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get functions from database %q", driver.databaseName)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+
+	schemaNameMap := make(map[string]bool)
+	for _, schemaName := range schemaList {
+		schemaNameMap[schemaName] = true
+	}
+	for schemaName := range tableMap {
+		schemaNameMap[schemaName] = true
+	}
+	for schemaName := range viewMap {
+		schemaNameMap[schemaName] = true
+	}
+	var schemaNames []string
+	for schemaName := range schemaNameMap {
+		schemaNames = append(schemaNames, schemaName)
+	}
+	sort.Strings(schemaNames)
+	for _, schemaName := range schemaNames {
+		var tables []*storepb.TableMetadata
+		var views []*storepb.ViewMetadata
+		var materializedViews []*storepb.MaterializedViewMetadata
+		var functions []*storepb.FunctionMetadata
+		var exists bool
+		if tables, exists = tableMap[schemaName]; !exists {
+			tables = []*storepb.TableMetadata{}
+		}
+		if views, exists = viewMap[schemaName]; !exists {
+			views = []*storepb.ViewMetadata{}
+		}
+		if materializedViews, exists = materializedViewMap[schemaName]; !exists {
+			materializedViews = []*storepb.MaterializedViewMetadata{}
+		}
+		if functions, exists = functionMap[schemaName]; !exists {
+			functions = []*storepb.FunctionMetadata{}
+		}
+		databaseMetadata.Schemas = append(databaseMetadata.Schemas, &storepb.SchemaMetadata{
+			Name:              schemaName,
+			Tables:            tables,
+			Views:             views,
+			MaterializedViews: materializedViews,
+			Functions:         functions,
+		})
+	}
+	// CockroachDB doesn't support the Postgres extensions.
+	databaseMetadata.Extensions = make([]*storepb.ExtensionMetadata, 0)
+
+	return databaseMetadata, err
+}
+
+var listSchemaQuery = fmt.Sprintf(`
+SELECT schema_name
+FROM information_schema.schemata
+WHERE catalog_name = current_database() AND schema_name NOT IN (%s);
+`, systemSchemas)
+
+func getSchemas(txn *sql.Tx) ([]string, error) {
+	rows, err := txn.Query(listSchemaQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []string
+	for rows.Next() {
+		var schemaName string
+		if err := rows.Scan(&schemaName); err != nil {
+			return nil, err
+		}
+		result = append(result, schemaName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// listTableQuery lists the base tables with the estimated row count from the table statistics.
+var listTableQuery = `
+SELECT
+	t.schema_name,
+	t.name,
+	COALESCE(s.estimated_row_count, 0),
+	COALESCE(obj_description(t.table_id::OID, 'pg_class'), '')
+FROM crdb_internal.tables AS t
+	JOIN information_schema.tables AS it
+	ON it.table_catalog = t.database_name AND it.table_schema = t.schema_name AND it.table_name = t.name
+	LEFT JOIN crdb_internal.table_row_statistics AS s
+	ON s.table_id = t.table_id
+WHERE t.database_name = current_database()
+	AND t.state = 'PUBLIC'
+	AND it.table_type = 'BASE TABLE'` + fmt.Sprintf(`
+	AND t.schema_name NOT IN (%s)
+ORDER BY t.schema_name, t.name;`, systemSchemas)
+
+// getTables gets all tables of a database.
+func getTables(txn *sql.Tx) (map[string][]*storepb.TableMetadata, error) {
+	columnMap, err := getTableColumns(txn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get table columns")
+	}
+	indexMap, err := getIndexes(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get indices")
+	}
+	foreignKeysMap, err := getForeignKeys(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get foreign keys")
+	}
+
+	tableMap := make(map[string][]*storepb.TableMetadata)
+	rows, err := txn.Query(listTableQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		table := &storepb.TableMetadata{}
+		var schemaName string
+		if err := rows.Scan(&schemaName, &table.Name, &table.RowCount, &table.Comment); err != nil {
+			return nil, err
+		}
+		key := db.TableKey{Schema: schemaName, Table: table.Name}
+		table.Columns = columnMap[key]
+		table.Indexes = indexMap[key]
+		table.ForeignKeys = foreignKeysMap[key]
+
+		tableMap[schemaName] = append(tableMap[schemaName], table)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tableMap, nil
+}
+
+// listColumnQuery lists the visible columns, the hidden columns such as the implicit "rowid" primary key are skipped.
+var listColumnQuery = `
+SELECT
+	cols.table_schema,
+	cols.table_name,
+	cols.column_name,
+	cols.data_type,
+	cols.ordinal_position,
+	cols.column_default,
+	cols.is_nullable,
+	cols.collation_name,
+	cols.udt_schema,
+	cols.udt_name,
+	COALESCE(cols.column_comment, '')
+FROM information_schema.columns AS cols` + fmt.Sprintf(`
+WHERE cols.table_catalog = current_database() AND cols.table_schema NOT IN (%s) AND cols.is_hidden = 'NO'
+ORDER BY cols.table_schema, cols.table_name, cols.ordinal_position;`, systemSchemas)
+
+// getTableColumns gets the columns of a table.
+func getTableColumns(txn *sql.Tx) (map[db.TableKey][]*storepb.ColumnMetadata, error) {
+	columnsMap := make(map[db.TableKey][]*storepb.ColumnMetadata)
+	rows, err := txn.Query(listColumnQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		column := &storepb.ColumnMetadata{}
+		var schemaName, tableName, nullable string
+		var defaultStr, collation, udtSchema, udtName sql.NullString
+		if err := rows.Scan(&schemaName, &tableName, &column.Name, &column.Type, &column.Position, &defaultStr, &nullable, &collation, &udtSchema, &udtName, &column.Comment); err != nil {
+			return nil, err
+		}
+		if defaultStr.Valid {
+			column.DefaultValue = &storepb.ColumnMetadata_DefaultExpression{DefaultExpression: defaultStr.String}
+		}
+		isNullBool, err := util.ConvertYesNo(nullable)
+		if err != nil {
+			return nil, err
+		}
+		column.Nullable = isNullBool
+		switch column.Type {
+		case "USER-DEFINED":
+			column.Type = fmt.Sprintf("%s.%s", udtSchema.String, udtName.String)
+		case "ARRAY":
+			column.Type = udtName.String
+		}
+		column.Collation = collation.String
+
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		columnsMap[key] = append(columnsMap[key], column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return columnsMap, nil
+}
+
+// listIndexQuery lists the indexes from crdb_internal.table_indexes, the index definitions come from pg_indexes.
+var listIndexQuery = `
+SELECT
+	t.schema_name,
+	t.name,
+	i.index_name,
+	i.index_type,
+	i.is_unique,
+	i.is_inverted,
+	COALESCE(p.indexdef, ''),
+	COALESCE(obj_description(i.descriptor_id::OID, 'pg_class'), '')
+FROM crdb_internal.table_indexes AS i
+	JOIN crdb_internal.tables AS t
+	ON t.table_id = i.descriptor_id
+	LEFT JOIN pg_catalog.pg_indexes AS p
+	ON p.schemaname = t.schema_name AND p.tablename = t.name AND p.indexname = i.index_name
+WHERE t.database_name = current_database() AND t.state = 'PUBLIC'` + fmt.Sprintf(`
+	AND t.schema_name NOT IN (%s)
+ORDER BY t.schema_name, t.name, i.index_id;`, systemSchemas)
+
+// listIndexColumnQuery lists the key columns of the indexes, the storing columns and the implicit columns are skipped.
+var listIndexColumnQuery = `
+SELECT
+	table_schema,
+	table_name,
+	index_name,
+	column_name
+FROM information_schema.statistics` + fmt.Sprintf(`
+WHERE table_catalog = current_database() AND table_schema NOT IN (%s) AND storing = 'NO' AND implicit = 'NO'
+ORDER BY table_schema, table_name, index_name, seq_in_index;`, systemSchemas)
+
+type indexKey struct {
+	schema string
+	table  string
+	index  string
+}
+
+// getIndexes gets all indices of a database.
+func getIndexes(txn *sql.Tx) (map[db.TableKey][]*storepb.IndexMetadata, error) {
+	expressionMap := make(map[indexKey][]string)
+	columnRows, err := txn.Query(listIndexColumnQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer columnRows.Close()
+	for columnRows.Next() {
+		var key indexKey
+		var column string
+		if err := columnRows.Scan(&key.schema, &key.table, &key.index, &column); err != nil {
+			return nil, err
+		}
+		expressionMap[key] = append(expressionMap[key], column)
+	}
+	if err := columnRows.Err(); err != nil {
+		return nil, err
+	}
+
+	indexMap := make(map[db.TableKey][]*storepb.IndexMetadata)
+	rows, err := txn.Query(listIndexQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		index := &storepb.IndexMetadata{}
+		var schemaName, tableName, indexType string
+		var inverted bool
+		if err := rows.Scan(&schemaName, &tableName, &index.Name, &indexType, &index.Unique, &inverted, &index.Definition, &index.Comment); err != nil {
+			return nil, err
+		}
+		index.Primary = indexType == "primary"
+		index.Type = "prefix"
+		if inverted {
+			index.Type = "inverted"
+		}
+		index.Expressions = expressionMap[indexKey{schema: schemaName, table: tableName, index: index.Name}]
+		// The primary key on the hidden "rowid" column is implicitly created by CockroachDB.
+		if index.Primary && len(index.Expressions) == 0 {
+			continue
+		}
+
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		indexMap[key] = append(indexMap[key], index)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return indexMap, nil
+}
+
+// listForeignKeyQuery lists the foreign keys with the referenced columns matched by the position in the unique constraint.
+// The constraint names are only unique within a table in CockroachDB, so the tables are joined as well.
+var listForeignKeyQuery = `
+SELECT
+	rc.constraint_schema,
+	rc.table_name,
+	rc.constraint_name,
+	rc.unique_constraint_schema,
+	rc.referenced_table_name,
+	rc.delete_rule,
+	rc.update_rule,
+	rc.match_option,
+	kcu.column_name,
+	COALESCE(ukcu.column_name, '')
+FROM information_schema.referential_constraints AS rc
+	JOIN information_schema.key_column_usage AS kcu
+	ON kcu.constraint_schema = rc.constraint_schema AND kcu.table_name = rc.table_name AND kcu.constraint_name = rc.constraint_name
+	LEFT JOIN information_schema.key_column_usage AS ukcu
+	ON ukcu.constraint_schema = rc.unique_constraint_schema
+	AND ukcu.table_name = rc.referenced_table_name
+	AND ukcu.constraint_name = rc.unique_constraint_name
+	AND ukcu.ordinal_position = kcu.position_in_unique_constraint` + fmt.Sprintf(`
+WHERE rc.constraint_catalog = current_database() AND rc.constraint_schema NOT IN (%s)
+ORDER BY rc.constraint_schema, rc.table_name, rc.constraint_name, kcu.ordinal_position;`, systemSchemas)
+
+// getForeignKeys gets all foreign keys of a database.
+func getForeignKeys(txn *sql.Tx) (map[db.TableKey][]*storepb.ForeignKeyMetadata, error) {
+	foreignKeysMap := make(map[db.TableKey][]*storepb.ForeignKeyMetadata)
+	rows, err := txn.Query(listForeignKeyQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var last *storepb.ForeignKeyMetadata
+	var lastKey db.TableKey
+	for rows.Next() {
+		fk := &storepb.ForeignKeyMetadata{}
+		var schemaName, tableName, column, referencedColumn string
+		if err := rows.Scan(&schemaName, &tableName, &fk.Name, &fk.ReferencedSchema, &fk.ReferencedTable, &fk.OnDelete, &fk.OnUpdate, &fk.MatchType, &column, &referencedColumn); err != nil {
+			return nil, err
+		}
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		if last == nil || lastKey != key || last.Name != fk.Name {
+			last = fk
+			lastKey = key
+			foreignKeysMap[key] = append(foreignKeysMap[key], fk)
+		}
+		last.Columns = append(last.Columns, column)
+		last.ReferencedColumns = append(last.ReferencedColumns, referencedColumn)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return foreignKeysMap, nil
+}
+
+var listViewQuery = `
+SELECT
+	v.table_schema,
+	v.table_name,
+	v.view_definition,
+	COALESCE(obj_description(t.table_id::OID, 'pg_class'), '')
+FROM information_schema.views AS v
+	JOIN crdb_internal.tables AS t
+	ON t.database_name = v.table_catalog AND t.schema_name = v.table_schema AND t.name = v.table_name` + fmt.Sprintf(`
+WHERE v.table_catalog = current_database() AND v.table_schema NOT IN (%s)
+ORDER BY v.table_schema, v.table_name;`, systemSchemas)
+
+// getViews gets all views of a database.
+func getViews(txn *sql.Tx) (map[string][]*storepb.ViewMetadata, error) {
+	viewMap := make(map[string][]*storepb.ViewMetadata)
+
+	rows, err := txn.Query(listViewQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		view := &storepb.ViewMetadata{}
+		var schemaName string
+		var def sql.NullString
+		if err := rows.Scan(&schemaName, &view.Name, &def, &view.Comment); err != nil {
+			return nil, err
+		}
+		// Return error on NULL view definition.
+		// https://github.com/bytebase/bytebase/issues/343
+		if !def.Valid {
+			return nil, errors.Errorf("schema %q view %q has empty definition; please check whether proper privileges have been granted to Bytebase", schemaName, view.Name)
+		}
+		view.Definition = def.String
+
+		viewMap[schemaName] = append(viewMap[schemaName], view)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return viewMap, nil
+}
+
+var listMaterializedViewQuery = fmt.Sprintf(`
+SELECT schemaname, matviewname, definition
+FROM pg_catalog.pg_matviews
+WHERE schemaname NOT IN (%s)
+ORDER BY schemaname, matviewname;`, systemSchemas)
+
+// getMaterializedViews gets all materialized views of a database.
+func getMaterializedViews(txn *sql.Tx) (map[string][]*storepb.MaterializedViewMetadata, error) {
+	materializedViewMap := make(map[string][]*storepb.MaterializedViewMetadata)
+
+	rows, err := txn.Query(listMaterializedViewQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		materializedView := &storepb.MaterializedViewMetadata{}
+		var schemaName string
+		if err := rows.Scan(&schemaName, &materializedView.Name, &materializedView.Definition); err != nil {
+			return nil, err
+		}
+		materializedViewMap[schemaName] = append(materializedViewMap[schemaName], materializedView)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return materializedViewMap, nil
+}
+
+var listFunctionQuery = fmt.Sprintf(`
+SELECT routine_schema, routine_name, COALESCE(routine_definition, '')
+FROM information_schema.routines
+WHERE routine_catalog = current_database() AND routine_schema NOT IN (%s)
+ORDER BY routine_schema, routine_name;`, systemSchemas)
+
+// getFunctions gets all user-defined functions of a database.
+func getFunctions(txn *sql.Tx) (map[string][]*storepb.FunctionMetadata, error) {
+	functionMap := make(map[string][]*storepb.FunctionMetadata)
+
+	rows, err := txn.Query(listFunctionQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		function := &storepb.FunctionMetadata{}
+		var schemaName string
+		if err := rows.Scan(&schemaName, &function.Name, &function.Definition); err != nil {
+			return nil, err
+		}
+		functionMap[schemaName] = append(functionMap[schemaName], function)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return functionMap, nil
+}
+
+func (driver *Driver) getInstanceRoles(ctx context.Context) ([]*storepb.InstanceRoleMetadata, error) {
+	query := `
+		SELECT r.rolname, r.rolsuper, r.rolcreaterole, r.rolcreatedb, r.rolcanlogin, r.rolvaliduntil
+		FROM pg_catalog.pg_roles r
+		ORDER BY r.rolname;
+	`
+	var instanceRoles []*storepb.InstanceRoleMetadata
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var role string
+		var super, createRole, createDB, canLogin bool
+		var rolValidUntil sql.NullString
+		if err := rows.Scan(&role, &super, &createRole, &createDB, &canLogin, &rolValidUntil); err != nil {
+			return nil, err
+		}
+
+		var attributes []string
+		if super {
+			attributes = append(attributes, "Superuser")
+		}
+		if createRole {
+			attributes = append(attributes, "Create role")
+		}
+		if createDB {
+			attributes = append(attributes, "Create DB")
+		}
+		if !canLogin {
+			attributes = append(attributes, "Cannot login")
+		}
+		if rolValidUntil.Valid {
+			attributes = append(attributes, fmt.Sprintf("Password valid until %s", rolValidUntil.String))
+		}
+
+		instanceRoles = append(instanceRoles, &storepb.InstanceRoleMetadata{
+			Name:  role,
+			Grant: strings.Join(attributes, ", "),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return instanceRoles, nil
+}
+
+// SyncSlowQuery syncs the slow query.
+func (*Driver) SyncSlowQuery(_ context.Context, _ time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
+	return nil, errors.Errorf("not implemented")
+}
+
+// CheckSlowQueryLogEnabled checks if slow query log is enabled.
+func (*Driver) CheckSlowQueryLogEnabled(_ context.Context) error {
+	return errors.Errorf("not implemented")
+}
@@ -334,6 +334,10 @@ type ConnectionConfig struct {
 	// SchemaTenantMode is the Oracle specific mode.
 	// If true, bytebase will treat the schema as a database.
 	SchemaTenantMode bool
+	// AuthenticationType is the authentication type. The password is not used for the cloud IAM authentications.
+	AuthenticationType storepb.DataSourceOptions_AuthenticationType
+	// Region is the AWS region for AWS RDS IAM authentication.
+	Region string
 
 	ConnectionContext ConnectionContext
 }
@@ -370,6 +374,16 @@ type QueryContext struct {
 	CurrentDatabase string
 	// ShareDB is for Redshift.
 	ShareDB bool
+
+	// MaximumExecutionTime is the statement timeout set in the session. No limit enforced if <= 0.
+	// It's set in the MySQL and PostgreSQL sessions, MSSQL limits the lock waiting by it, and other engines rely on the context cancellation.
+	MaximumExecutionTime time.Duration
+	// MaximumResultSize is the maximum size in bytes of the rows returned. No limit enforced if <= 0.
+	MaximumResultSize int64
+	// MaximumEstimatedCost is the maximum cost estimated by EXPLAIN. No limit enforced if <= 0.
+	MaximumEstimatedCost float64
+	// MaximumEstimatedRows is the maximum row count estimated by EXPLAIN. No limit enforced if <= 0.
+	MaximumEstimatedRows int64
 }
 
 // DatabaseRoleMessage is the API message for database role.
@@ -450,6 +464,16 @@ type Driver interface {
 	Restore(ctx context.Context, src io.Reader) error
 }
 
+// CumulativeSlowQueryDriver is implemented by the drivers whose statistics views keep cumulative counters since the
+// statement is cached, such as SQL Server and Oracle. The slow query syncer diffs the consecutive snapshots to get the
+// statistics of each sync interval.
+type CumulativeSlowQueryDriver interface {
+	// SyncSlowQuerySnapshot returns the current cumulative slow query statistics keyed by database name.
+	SyncSlowQuerySnapshot(ctx context.Context) (map[string]*storepb.SlowQueryStatistics, error)
+	// IsSlowQuery returns true if the statistics of a statement between two snapshots are slow.
+	IsSlowQuery(increment *storepb.SlowQueryStatisticsItem) bool
+}
+
 // Register makes a database driver available by the provided type.
 // If Register is called twice with the same name or if driver is nil,
 // it panics.
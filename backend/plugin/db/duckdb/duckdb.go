@@ -0,0 +1,334 @@
+// Package duckdb is the plugin for DuckDB driver.
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	// Import DuckDB driver.
+	_ "github.com/marcboeker/go-duckdb"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	duckdbparser "github.com/bytebase/bytebase/backend/plugin/parser/duckdb"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// databaseFileExtension is the extension of the DuckDB database files.
+	databaseFileExtension = ".duckdb"
+)
+
+var (
+	_ db.Driver = (*Driver)(nil)
+)
+
+func init() {
+	db.Register(storepb.Engine_DUCKDB, newDriver)
+}
+
+// Driver is the DuckDB driver.
+type Driver struct {
+	dir          string
+	db           *sql.DB
+	databaseName string
+}
+
+func newDriver(db.DriverConfig) db.Driver {
+	return &Driver{}
+}
+
+// Open opens a DuckDB driver.
+func (driver *Driver) Open(_ context.Context, _ storepb.Engine, config db.ConnectionConfig) (db.Driver, error) {
+	// Host is the directory (instance) containing all DuckDB database files.
+	if config.Host == "" {
+		return nil, errors.Errorf("host must be set")
+	}
+	driver.dir = config.Host
+
+	// If config.Database is empty, we will get a connection to in-memory database.
+	db, err := createDBConnection(driver.dir, config.Database, config.ReadOnly)
+	if err != nil {
+		return nil, err
+	}
+	driver.db = db
+	driver.databaseName = config.Database
+	return driver, nil
+}
+
+// Close closes the driver.
+func (driver *Driver) Close(context.Context) error {
+	if driver.db != nil {
+		return driver.db.Close()
+	}
+	return nil
+}
+
+// Ping pings the database.
+func (driver *Driver) Ping(ctx context.Context) error {
+	return driver.db.PingContext(ctx)
+}
+
+// GetType returns the database type.
+func (*Driver) GetType() storepb.Engine {
+	return storepb.Engine_DUCKDB
+}
+
+// GetDB gets the database.
+func (driver *Driver) GetDB() *sql.DB {
+	return driver.db
+}
+
+// createDBConnection gets a database connection.
+// If database is empty, we will get a connect to in-memory database.
+func createDBConnection(dir, database string, readOnly bool) (*sql.DB, error) {
+	dsn := ""
+	if database != "" {
+		dsn = filepath.Join(dir, database+databaseFileExtension)
+		if readOnly {
+			dsn += "?access_mode=read_only"
+		}
+	}
+	db, err := sql.Open("duckdb", dsn)
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+func (driver *Driver) getDatabases() ([]string, error) {
+	files, err := os.ReadDir(driver.dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read directory %q", driver.dir)
+	}
+	var databases []string
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), databaseFileExtension) {
+			continue
+		}
+		databases = append(databases, strings.TrimSuffix(file.Name(), databaseFileExtension))
+	}
+	return databases, nil
+}
+
+// Execute executes a SQL statement.
+func (driver *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteOptions) (int64, error) {
+	if opts.CreateDatabase {
+		databaseName, err := getDatabaseInCreateDatabaseStatement(statement)
+		if err != nil {
+			return 0, err
+		}
+		db, err := createDBConnection(driver.dir, databaseName, false /* readOnly */)
+		if err != nil {
+			return 0, err
+		}
+		defer db.Close()
+		// We need to checkpoint to persist the database file.
+		if _, err := db.ExecContext(ctx, "CHECKPOINT;"); err != nil {
+			return 0, err
+		}
+		return 0, nil
+	}
+
+	singleSQLs, err := duckdbparser.SplitSQL(statement)
+	if err != nil {
+		return 0, err
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+	if len(singleSQLs) == 0 {
+		return 0, nil
+	}
+
+	var totalCommands int
+	var chunks [][]base.SingleSQL
+	if opts.ChunkedSubmission && len(statement) <= common.MaxSheetCheckSize {
+		totalCommands = len(singleSQLs)
+		ret, err := util.ChunkedSQLScript(singleSQLs, common.MaxSheetChunksCount)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to chunk sql")
+		}
+		chunks = ret
+	} else {
+		chunks = [][]base.SingleSQL{
+			singleSQLs,
+		}
+	}
+	currentIndex := 0
+
+	tx, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	totalRowsAffected := int64(0)
+	for _, chunk := range chunks {
+		if len(chunk) == 0 {
+			continue
+		}
+		// Start the current chunk.
+		// Set the progress information for the current chunk.
+		if opts.UpdateExecutionStatus != nil {
+			opts.UpdateExecutionStatus(&v1pb.TaskRun_ExecutionDetail{
+				CommandsTotal:     int32(totalCommands),
+				CommandsCompleted: int32(currentIndex),
+				CommandStartPosition: &v1pb.TaskRun_ExecutionDetail_Position{
+					Line:   int32(chunk[0].FirstStatementLine),
+					Column: int32(chunk[0].FirstStatementColumn),
+				},
+				CommandEndPosition: &v1pb.TaskRun_ExecutionDetail_Position{
+					Line:   int32(chunk[len(chunk)-1].LastLine),
+					Column: int32(chunk[len(chunk)-1].LastColumn),
+				},
+			})
+		}
+
+		chunkText, err := util.ConcatChunk(chunk)
+		if err != nil {
+			return 0, err
+		}
+
+		sqlResult, err := tx.ExecContext(ctx, chunkText)
+		if err != nil {
+			return 0, &db.ErrorWithPosition{
+				Err: errors.Wrapf(err, "failed to execute context in a transaction"),
+				Start: &storepb.TaskRunResult_Position{
+					Line:   int32(chunk[0].FirstStatementLine),
+					Column: int32(chunk[0].FirstStatementColumn),
+				},
+				End: &storepb.TaskRunResult_Position{
+					Line:   int32(chunk[len(chunk)-1].LastLine),
+					Column: int32(chunk[len(chunk)-1].LastColumn),
+				},
+			}
+		}
+		rowsAffected, err := sqlResult.RowsAffected()
+		if err != nil {
+			// Since we cannot differentiate DDL and DML yet, we have to ignore the error.
+			slog.Debug("rowsAffected returns error", log.BBError(err))
+		}
+		totalRowsAffected += rowsAffected
+		currentIndex += len(chunk)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return totalRowsAffected, nil
+}
+
+// getDatabaseInCreateDatabaseStatement gets the database name in the fake CREATE DATABASE statement,
+// such as CREATE DATABASE 'hello';
+func getDatabaseInCreateDatabaseStatement(statement string) (string, error) {
+	parts := strings.Split(statement, `'`)
+	if len(parts) != 3 || parts[1] == "" {
+		return "", errors.Errorf("invalid statement %q", statement)
+	}
+	return parts[1], nil
+}
+
+// QueryConn queries a SQL statement in a given connection.
+func (driver *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string, queryContext *db.QueryContext) ([]*v1pb.QueryResult, error) {
+	singleSQLs, err := duckdbparser.SplitSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+	if len(singleSQLs) == 0 {
+		return nil, nil
+	}
+
+	var results []*v1pb.QueryResult
+	for _, singleSQL := range singleSQLs {
+		result, err := driver.querySingleSQL(ctx, conn, singleSQL, queryContext)
+		if err != nil {
+			results = append(results, &v1pb.QueryResult{
+				Error: err.Error(),
+			})
+		} else {
+			results = append(results, result)
+		}
+	}
+
+	return results, nil
+}
+
+func getStatementWithResultLimit(stmt string, limit int) string {
+	return fmt.Sprintf("WITH result AS (%s) SELECT * FROM result LIMIT %d;", stmt, limit)
+}
+
+// isQueryStatement returns true if the statement can be used as a subquery for applying the result limit.
+// The statements such as DESCRIBE, SUMMARIZE and PRAGMA cannot be used as a subquery.
+func isQueryStatement(statement string) bool {
+	if strings.HasPrefix(statement, "(") {
+		return true
+	}
+	fields := strings.Fields(statement)
+	if len(fields) == 0 {
+		return false
+	}
+	switch strings.ToUpper(fields[0]) {
+	case "SELECT", "WITH", "FROM", "VALUES", "TABLE":
+		return true
+	}
+	return false
+}
+
+func (*Driver) querySingleSQL(ctx context.Context, conn *sql.Conn, singleSQL base.SingleSQL, queryContext *db.QueryContext) (*v1pb.QueryResult, error) {
+	statement := strings.Trim(singleSQL.Text, " \n\t;")
+
+	stmt := statement
+	if isQueryStatement(stmt) && queryContext.Limit > 0 {
+		stmt = getStatementWithResultLimit(stmt, queryContext.Limit)
+	}
+
+	startTime := time.Now()
+	result, err := query(ctx, conn, stmt)
+	if err != nil {
+		return nil, err
+	}
+	result.Latency = durationpb.New(time.Since(startTime))
+	result.Statement = statement
+	return result, nil
+}
+
+// RunStatement runs a SQL statement in a given connection.
+func (*Driver) RunStatement(ctx context.Context, conn *sql.Conn, statement string) ([]*v1pb.QueryResult, error) {
+	singleSQLs, err := duckdbparser.SplitSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+	if len(singleSQLs) == 0 {
+		return nil, nil
+	}
+
+	var results []*v1pb.QueryResult
+	for _, singleSQL := range singleSQLs {
+		statement := strings.Trim(singleSQL.Text, " \n\t;")
+		startTime := time.Now()
+		result, err := runStatement(ctx, conn, statement)
+		if err != nil {
+			results = append(results, &v1pb.QueryResult{
+				Error: err.Error(),
+			})
+			continue
+		}
+		result.Latency = durationpb.New(time.Since(startTime))
+		result.Statement = statement
+		results = append(results, result)
+	}
+	return results, nil
+}
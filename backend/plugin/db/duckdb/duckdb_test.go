@@ -0,0 +1,205 @@
+package duckdb
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetDatabaseInCreateDatabaseStatement(t *testing.T) {
+	a := require.New(t)
+	database, err := getDatabaseInCreateDatabaseStatement("CREATE DATABASE 'hello';")
+	a.NoError(err)
+	a.Equal("hello", database)
+
+	_, err = getDatabaseInCreateDatabaseStatement("CREATE DATABASE hello;")
+	a.Error(err)
+	_, err = getDatabaseInCreateDatabaseStatement("CREATE DATABASE '';")
+	a.Error(err)
+}
+
+func TestGetIndexExpressions(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      []string
+	}{
+		{
+			statement: "CREATE INDEX idx ON t(a);",
+			want:      []string{"a"},
+		},
+		{
+			statement: "CREATE UNIQUE INDEX idx ON s.t(a, lower(b), \"c,d\");",
+			want:      []string{"a", "lower(b)", `"c,d"`},
+		},
+		{
+			statement: "CREATE INDEX idx ON t((a + b), substr(c, 1, 2))",
+			want:      []string{"(a + b)", "substr(c, 1, 2)"},
+		},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, getIndexExpressions(test.statement), test.statement)
+	}
+}
+
+func TestParseReferences(t *testing.T) {
+	tests := []struct {
+		constraintText string
+		wantSchema     string
+		wantTable      string
+		wantColumns    []string
+	}{
+		{
+			constraintText: "FOREIGN KEY (a) REFERENCES t(id)",
+			wantSchema:     "main",
+			wantTable:      "t",
+			wantColumns:    []string{"id"},
+		},
+		{
+			constraintText: `FOREIGN KEY (a, b) REFERENCES s."T 1"(id, "Name")`,
+			wantSchema:     "s",
+			wantTable:      "T 1",
+			wantColumns:    []string{"id", "Name"},
+		},
+	}
+	for _, test := range tests {
+		schema, table, columns := parseReferences("main", test.constraintText)
+		require.Equal(t, test.wantSchema, schema, test.constraintText)
+		require.Equal(t, test.wantTable, table, test.constraintText)
+		require.Equal(t, test.wantColumns, columns, test.constraintText)
+	}
+}
+
+func TestFormatDecimal(t *testing.T) {
+	tests := []struct {
+		value int64
+		scale int
+		want  string
+	}{
+		{value: 314, scale: 2, want: "3.14"},
+		{value: -314, scale: 2, want: "-3.14"},
+		{value: 5, scale: 3, want: "0.005"},
+		{value: -5, scale: 1, want: "-0.5"},
+		{value: 42, scale: 0, want: "42"},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, formatDecimal(big.NewInt(test.value), test.scale))
+	}
+}
+
+func TestDriver(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	instanceDriver, err := newDriver(db.DriverConfig{}).Open(ctx, storepb.Engine_DUCKDB, db.ConnectionConfig{Host: dir})
+	a.NoError(err)
+	defer instanceDriver.Close(ctx)
+	for _, database := range []string{"source", "target"} {
+		_, err = instanceDriver.Execute(ctx, "CREATE DATABASE '"+database+"';", db.ExecuteOptions{CreateDatabase: true})
+		a.NoError(err)
+	}
+
+	instance, err := instanceDriver.SyncInstance(ctx)
+	a.NoError(err)
+	a.NotEmpty(instance.Version)
+	var databaseNames []string
+	for _, database := range instance.Databases {
+		databaseNames = append(databaseNames, database.Name)
+	}
+	a.ElementsMatch([]string{"source", "target"}, databaseNames)
+
+	driver, err := newDriver(db.DriverConfig{}).Open(ctx, storepb.Engine_DUCKDB, db.ConnectionConfig{Host: dir, Database: "source"})
+	a.NoError(err)
+	defer driver.Close(ctx)
+	statement := `
+CREATE TABLE author (id INTEGER PRIMARY KEY, name VARCHAR NOT NULL UNIQUE);
+CREATE TABLE book (id INTEGER PRIMARY KEY, author_id INTEGER REFERENCES author(id), price DECIMAL(10, 2), tags VARCHAR[]);
+CREATE INDEX book_price_idx ON book(price);
+CREATE VIEW expensive_book AS SELECT * FROM book WHERE price > 100;
+INSERT INTO author VALUES (1, 'O''Brien'), (2, 'Austen');
+INSERT INTO book VALUES (1, 1, 120.50, ['a', 'b']), (2, 2, 9.99, NULL), (3, NULL, NULL, []);`
+	_, err = driver.Execute(ctx, statement, db.ExecuteOptions{})
+	a.NoError(err)
+
+	schema, err := driver.SyncDBSchema(ctx)
+	a.NoError(err)
+	a.Len(schema.Schemas, 1)
+	a.Equal("main", schema.Schemas[0].Name)
+	tables := map[string]*storepb.TableMetadata{}
+	for _, table := range schema.Schemas[0].Tables {
+		tables[table.Name] = table
+	}
+	a.Len(tables, 2)
+	a.Len(tables["author"].Columns, 2)
+	a.False(tables["author"].Columns[1].Nullable)
+	var authorIndexes []string
+	for _, index := range tables["author"].Indexes {
+		authorIndexes = append(authorIndexes, index.Name)
+	}
+	a.Equal([]string{"author_pkey", "author_name_key"}, authorIndexes)
+	book := tables["book"]
+	a.Len(book.Columns, 4)
+	a.Len(book.Indexes, 2)
+	a.Equal("book_price_idx", book.Indexes[1].Name)
+	a.Equal([]string{"price"}, book.Indexes[1].Expressions)
+	a.Len(book.ForeignKeys, 1)
+	a.Equal("author", book.ForeignKeys[0].ReferencedTable)
+	a.Equal([]string{"id"}, book.ForeignKeys[0].ReferencedColumns)
+	a.Len(schema.Schemas[0].Views, 1)
+	a.Equal("expensive_book", schema.Schemas[0].Views[0].Name)
+
+	conn, err := driver.GetDB().Conn(ctx)
+	a.NoError(err)
+	defer conn.Close()
+	results, err := driver.QueryConn(ctx, conn, "SELECT id, price, tags FROM book ORDER BY id; DESCRIBE book;", &db.QueryContext{Limit: 2})
+	a.NoError(err)
+	a.Len(results, 2)
+	a.Empty(results[0].Error)
+	a.Len(results[0].Rows, 2)
+	a.Equal("120.50", results[0].Rows[0].Values[1].GetStringValue())
+	a.Len(results[0].Rows[0].Values[2].GetValueValue().GetListValue().GetValues(), 2)
+	a.Empty(results[1].Error)
+	a.Len(results[1].Rows, 4)
+
+	results, err = driver.RunStatement(ctx, conn, "CREATE TEMP TABLE counter (n INTEGER); INSERT INTO counter VALUES (1), (2); SELECT * FROM missing;")
+	a.NoError(err)
+	a.Len(results, 3)
+	a.Empty(results[1].Error)
+	a.Equal(int64(2), results[1].Rows[0].Values[0].GetInt64Value())
+	a.NotEmpty(results[2].Error)
+
+	var schemaDump strings.Builder
+	_, err = driver.Dump(ctx, &schemaDump, true /* schemaOnly */)
+	a.NoError(err)
+	a.Contains(schemaDump.String(), "CREATE TABLE author")
+	a.Contains(schemaDump.String(), "CREATE INDEX book_price_idx")
+	a.Contains(schemaDump.String(), "CREATE VIEW expensive_book")
+	a.NotContains(schemaDump.String(), "INSERT INTO")
+	a.Less(strings.Index(schemaDump.String(), "CREATE TABLE author"), strings.Index(schemaDump.String(), "CREATE TABLE book"))
+
+	var dump strings.Builder
+	_, err = driver.Dump(ctx, &dump, false /* schemaOnly */)
+	a.NoError(err)
+	a.Contains(dump.String(), "CREATE TABLE")
+	a.Contains(dump.String(), "O''Brien")
+
+	target, err := newDriver(db.DriverConfig{}).Open(ctx, storepb.Engine_DUCKDB, db.ConnectionConfig{Host: dir, Database: "target"})
+	a.NoError(err)
+	defer target.Close(ctx)
+	a.NoError(target.Restore(ctx, strings.NewReader(dump.String())))
+	var count int
+	a.NoError(target.GetDB().QueryRowContext(ctx, "SELECT count(*) FROM book WHERE tags = ['a', 'b'] AND price = 120.50;").Scan(&count))
+	a.Equal(1, count)
+	a.NoError(target.GetDB().QueryRowContext(ctx, "SELECT count(*) FROM author WHERE name = 'O''Brien';").Scan(&count))
+	a.Equal(1, count)
+	targetSchema, err := target.SyncDBSchema(ctx)
+	a.NoError(err)
+	a.Len(targetSchema.Schemas[0].Tables, 2)
+	a.Len(targetSchema.Schemas[0].Views, 1)
+}
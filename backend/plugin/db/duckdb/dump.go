@@ -0,0 +1,296 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	duckdbparser "github.com/bytebase/bytebase/backend/plugin/parser/duckdb"
+)
+
+var (
+	// copyTableRegexp matches the table in the COPY statements of the load.sql exported by EXPORT DATABASE,
+	// such as COPY s.t FROM '/tmp/export/s_t.csv' (FORMAT 'csv', quote '"', delimiter ',', header 1);
+	copyTableRegexp = regexp.MustCompile(`(?m)^COPY\s+(.+?)\s+FROM\s+'`)
+)
+
+// schemaStatementsQuery returns the DDL statements of the user objects by the object kind in the dependency order,
+// the objects of the same kind are in the creation order.
+// The schemas have no SQL in duckdb_schemas(), so the CREATE SCHEMA statements are built from the names.
+const schemaStatementsQuery = `
+SELECT kind, schema_name, name, sql FROM (
+	SELECT 1 AS kind_order, oid, 'SCHEMA' AS kind, schema_name, schema_name AS name, NULL AS sql
+	FROM duckdb_schemas()
+	WHERE database_name = current_database() AND NOT internal
+	UNION ALL
+	SELECT 2, sequence_oid, 'SEQUENCE', schema_name, sequence_name, sql
+	FROM duckdb_sequences()
+	WHERE database_name = current_database() AND NOT temporary
+	UNION ALL
+	SELECT 3, table_oid, 'TABLE', schema_name, table_name, sql
+	FROM duckdb_tables()
+	WHERE database_name = current_database() AND NOT internal AND NOT temporary
+	UNION ALL
+	SELECT 4, view_oid, 'VIEW', schema_name, view_name, sql
+	FROM duckdb_views()
+	WHERE database_name = current_database() AND NOT internal AND NOT temporary
+	UNION ALL
+	SELECT 5, index_oid, 'INDEX', schema_name, index_name, sql
+	FROM duckdb_indexes()
+	WHERE database_name = current_database() AND sql IS NOT NULL
+)
+ORDER BY kind_order, oid`
+
+const listForeignKeyQuery = `
+SELECT schema_name, table_name, constraint_text
+FROM duckdb_constraints()
+WHERE database_name = current_database() AND constraint_type = 'FOREIGN KEY'`
+
+// Dump dumps the database by EXPORT DATABASE.
+// EXPORT DATABASE writes the schema.sql creating the schemas, sequences, tables, views, indexes and macros in the
+// dependency order, and the load.sql loading the tables in the foreign key order from the data files.
+// The dump is a single SQL script, so we write the schema.sql and then the data as INSERT statements following the
+// table order in the load.sql. The export and the data are read in the same transaction for a consistent snapshot.
+// The schema only dump is built from the DDL in the catalog views, so that no data is exported.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer, schemaOnly bool) (string, error) {
+	if driver.databaseName == "" {
+		return "", errors.Errorf("DuckDB can dump one database only at a time")
+	}
+	if schemaOnly {
+		return "", driver.dumpSchema(ctx, out)
+	}
+
+	dir, err := os.MkdirTemp("", "duckdb-export-")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create the export directory")
+	}
+	defer os.RemoveAll(dir)
+
+	txn, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer txn.Rollback()
+
+	exportStatement := fmt.Sprintf("EXPORT DATABASE '%s' (FORMAT CSV);", strings.ReplaceAll(dir, "'", "''"))
+	if _, err := txn.ExecContext(ctx, exportStatement); err != nil {
+		return "", util.FormatErrorWithQuery(err, exportStatement)
+	}
+
+	schema, err := os.ReadFile(filepath.Join(dir, "schema.sql"))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read the exported schema")
+	}
+	if err := writeStatements(out, string(schema)); err != nil {
+		return "", err
+	}
+
+	load, err := os.ReadFile(filepath.Join(dir, "load.sql"))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read the exported load script")
+	}
+	for _, matches := range copyTableRegexp.FindAllStringSubmatch(string(load), -1) {
+		if err := exportTableData(ctx, txn, matches[1], out); err != nil {
+			return "", err
+		}
+	}
+
+	if err := txn.Commit(); err != nil {
+		return "", err
+	}
+	return "", nil
+}
+
+// dumpSchema writes the DDL statements of the user objects in the database.
+// The tables are sorted by the foreign keys, because a table altered after creation may get a later oid than
+// the tables referencing it.
+func (driver *Driver) dumpSchema(ctx context.Context, out io.Writer) error {
+	txn, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	references := make(map[db.TableKey][]db.TableKey)
+	fkRows, err := txn.QueryContext(ctx, listForeignKeyQuery)
+	if err != nil {
+		return util.FormatErrorWithQuery(err, listForeignKeyQuery)
+	}
+	defer fkRows.Close()
+	for fkRows.Next() {
+		var schemaName, tableName, constraintText string
+		if err := fkRows.Scan(&schemaName, &tableName, &constraintText); err != nil {
+			return err
+		}
+		referencedSchema, referencedTable, _ := parseReferences(schemaName, constraintText)
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		references[key] = append(references[key], db.TableKey{Schema: referencedSchema, Table: referencedTable})
+	}
+	if err := fkRows.Err(); err != nil {
+		return util.FormatErrorWithQuery(err, listForeignKeyQuery)
+	}
+
+	rows, err := txn.QueryContext(ctx, schemaStatementsQuery)
+	if err != nil {
+		return util.FormatErrorWithQuery(err, schemaStatementsQuery)
+	}
+	defer rows.Close()
+
+	var statements []string
+	var tables []db.TableKey
+	tableStatements := make(map[db.TableKey]string)
+	flushTables := func() {
+		visited := make(map[db.TableKey]bool)
+		var visit func(key db.TableKey)
+		visit = func(key db.TableKey) {
+			statement, ok := tableStatements[key]
+			if !ok || visited[key] {
+				return
+			}
+			visited[key] = true
+			for _, referenced := range references[key] {
+				visit(referenced)
+			}
+			statements = append(statements, statement)
+		}
+		for _, key := range tables {
+			visit(key)
+		}
+		tables = nil
+	}
+	for rows.Next() {
+		var kind, schemaName, name string
+		var statement sql.NullString
+		if err := rows.Scan(&kind, &schemaName, &name, &statement); err != nil {
+			return err
+		}
+		if kind == "SCHEMA" {
+			statement = sql.NullString{String: fmt.Sprintf(`CREATE SCHEMA "%s";`, strings.ReplaceAll(name, `"`, `""`)), Valid: true}
+		}
+		if !statement.Valid {
+			continue
+		}
+		text := strings.TrimSpace(statement.String)
+		if !strings.HasSuffix(text, ";") {
+			text += ";"
+		}
+		if kind == "TABLE" {
+			key := db.TableKey{Schema: schemaName, Table: name}
+			tables = append(tables, key)
+			tableStatements[key] = text
+			continue
+		}
+		if len(tables) > 0 {
+			flushTables()
+		}
+		statements = append(statements, text)
+	}
+	if err := rows.Err(); err != nil {
+		return util.FormatErrorWithQuery(err, schemaStatementsQuery)
+	}
+	flushTables()
+	if err := txn.Commit(); err != nil {
+		return err
+	}
+	return writeStatements(out, strings.Join(statements, "\n"))
+}
+
+// writeStatements writes the statements separated by blank lines.
+func writeStatements(out io.Writer, script string) error {
+	singleSQLs, err := duckdbparser.SplitSQL(script)
+	if err != nil {
+		return err
+	}
+	for _, singleSQL := range base.FilterEmptySQL(singleSQLs) {
+		statement := strings.TrimSpace(singleSQL.Text)
+		if !strings.HasSuffix(statement, ";") {
+			statement += ";"
+		}
+		if _, err := io.WriteString(out, statement+"\n\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportTableData writes the data of the table as INSERT statements.
+// The values are cast to VARCHAR by DuckDB, and DuckDB casts the string literals back to the column types on insertion.
+func exportTableData(ctx context.Context, txn *sql.Tx, table string, out io.Writer) error {
+	query := fmt.Sprintf("SELECT COLUMNS(*)::VARCHAR FROM %s;", table)
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+	values := make([]sql.NullString, len(columns))
+	refs := make([]any, len(columns))
+	for i := range values {
+		refs[i] = &values[i]
+	}
+	empty := true
+	for rows.Next() {
+		if err := rows.Scan(refs...); err != nil {
+			return err
+		}
+		tokens := make([]string, len(columns))
+		for i, v := range values {
+			if !v.Valid {
+				tokens[i] = "NULL"
+				continue
+			}
+			tokens[i] = fmt.Sprintf("'%s'", strings.ReplaceAll(v.String, "'", "''"))
+		}
+		stmt := fmt.Sprintf("INSERT INTO %s VALUES (%s);\n", table, strings.Join(tokens, ", "))
+		if _, err := io.WriteString(out, stmt); err != nil {
+			return err
+		}
+		empty = false
+	}
+	if err := rows.Err(); err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	if !empty {
+		if _, err := io.WriteString(out, "\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Restore restores a database from the dump.
+func (driver *Driver) Restore(ctx context.Context, sc io.Reader) error {
+	buf, err := io.ReadAll(sc)
+	if err != nil {
+		return err
+	}
+
+	txn, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	// DuckDB executes the multiple statements in a single call.
+	if _, err := txn.ExecContext(ctx, string(buf)); err != nil {
+		return err
+	}
+	return txn.Commit()
+}
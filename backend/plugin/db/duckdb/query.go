@@ -0,0 +1,229 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	duckdbdriver "github.com/marcboeker/go-duckdb"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// query queries the statement in the connection.
+// We don't use util.Query because the DuckDB driver doesn't support read-only transactions, and returns the
+// nested types such as LIST, STRUCT and MAP which cannot be scanned into sql.NullString.
+func query(ctx context.Context, conn *sql.Conn, statement string) (*v1pb.QueryResult, error) {
+	// DuckDB doesn't support READ ONLY transactions (Error: read-only transactions are not supported).
+	tx, err := conn.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, statement)
+	}
+	defer rows.Close()
+
+	return convertRowsToQueryResult(rows)
+}
+
+// runStatement runs the statement in the connection without a transaction for the admin mode.
+func runStatement(ctx context.Context, conn *sql.Conn, statement string) (*v1pb.QueryResult, error) {
+	if util.IsAffectedRowsStatement(statement) {
+		sqlResult, err := conn.ExecContext(ctx, statement)
+		if err != nil {
+			return nil, err
+		}
+		affectedRows, err := sqlResult.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		return &v1pb.QueryResult{
+			ColumnNames:     []string{"Affected Rows"},
+			ColumnTypeNames: []string{"BIGINT"},
+			Rows: []*v1pb.QueryRow{
+				{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: affectedRows}}}},
+			},
+		}, nil
+	}
+
+	rows, err := conn.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return convertRowsToQueryResult(rows)
+}
+
+func convertRowsToQueryResult(rows *sql.Rows) (*v1pb.QueryResult, error) {
+	columnNames, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+	var columnTypeNames []string
+	for _, v := range columnTypes {
+		// DatabaseTypeName returns the database system name of the column type.
+		// refer: https://pkg.go.dev/database/sql#ColumnType.DatabaseTypeName
+		columnTypeNames = append(columnTypeNames, strings.ToUpper(v.DatabaseTypeName()))
+	}
+
+	var data []*v1pb.QueryRow
+	for rows.Next() {
+		values := make([]any, len(columnNames))
+		scanArgs := make([]any, len(columnNames))
+		for i := range values {
+			scanArgs[i] = &values[i]
+		}
+		if err := rows.Scan(scanArgs...); err != nil {
+			return nil, err
+		}
+
+		var rowData v1pb.QueryRow
+		for i, value := range values {
+			rowValue, err := convertRowValue(columnTypeNames[i], value)
+			if err != nil {
+				return nil, err
+			}
+			rowData.Values = append(rowData.Values, rowValue)
+		}
+		data = append(data, &rowData)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &v1pb.QueryResult{
+		ColumnNames:     columnNames,
+		ColumnTypeNames: columnTypeNames,
+		Rows:            data,
+		Masked:          make([]bool, len(columnNames)),
+		Sensitive:       make([]bool, len(columnNames)),
+	}, nil
+}
+
+// convertRowValue converts the value scanned by the DuckDB driver to the row value.
+func convertRowValue(typeName string, value any) (*v1pb.RowValue, error) {
+	switch v := value.(type) {
+	case nil:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{NullValue: structpb.NullValue_NULL_VALUE}}, nil
+	case bool:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_BoolValue{BoolValue: v}}, nil
+	case int8:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: int32(v)}}, nil
+	case int16:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: int32(v)}}, nil
+	case int32:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: v}}, nil
+	case int64:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: v}}, nil
+	case uint8:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Uint32Value{Uint32Value: uint32(v)}}, nil
+	case uint16:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Uint32Value{Uint32Value: uint32(v)}}, nil
+	case uint32:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Uint32Value{Uint32Value: v}}, nil
+	case uint64:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: v}}, nil
+	case float32:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_FloatValue{FloatValue: v}}, nil
+	case float64:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: v}}, nil
+	case []byte:
+		if typeName == "UUID" {
+			return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: formatValue(typeName, v).(string)}}, nil
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_BytesValue{BytesValue: v}}, nil
+	case []any, map[string]any, duckdbdriver.Map:
+		// LIST, STRUCT and MAP.
+		value, err := structpb.NewValue(formatValue(typeName, v))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert value of type %s", typeName)
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_ValueValue{ValueValue: value}}, nil
+	default:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: fmt.Sprint(formatValue(typeName, v))}}, nil
+	}
+}
+
+// formatValue formats the value into the types supported by structpb.Value, the values without
+// the JSON counterparts such as TIMESTAMP and DECIMAL are formatted as DuckDB does.
+func formatValue(typeName string, value any) any {
+	switch v := value.(type) {
+	case []any:
+		elementTypeName := strings.TrimSuffix(typeName, "[]")
+		list := make([]any, 0, len(v))
+		for _, element := range v {
+			list = append(list, formatValue(elementTypeName, element))
+		}
+		return list
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for key, field := range v {
+			m[key] = formatValue("", field)
+		}
+		return m
+	case duckdbdriver.Map:
+		m := make(map[string]any, len(v))
+		for key, field := range v {
+			m[fmt.Sprint(formatValue("", key))] = formatValue("", field)
+		}
+		return m
+	case time.Time:
+		switch typeName {
+		case "DATE":
+			return v.Format("2006-01-02")
+		case "TIME":
+			return v.Format("15:04:05.999999")
+		}
+		return v.Format("2006-01-02 15:04:05.999999999")
+	case duckdbdriver.Decimal:
+		return formatDecimal(v.Value, int(v.Scale))
+	case *big.Int:
+		return v.String()
+	case duckdbdriver.Interval:
+		return fmt.Sprintf("%d months %d days %d microseconds", v.Months, v.Days, v.Micros)
+	case []byte:
+		if typeName == "UUID" {
+			if id, err := uuid.FromBytes(v); err == nil {
+				return id.String()
+			}
+		}
+		return string(v)
+	}
+	return value
+}
+
+// formatDecimal formats the unscaled value with the scale, such as 314 with scale 2 to "3.14".
+func formatDecimal(value *big.Int, scale int) string {
+	if value == nil {
+		return ""
+	}
+	sign := ""
+	digits := value.String()
+	if value.Sign() < 0 {
+		sign = "-"
+		digits = digits[1:]
+	}
+	if scale <= 0 {
+		return sign + digits
+	}
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return fmt.Sprintf("%s%s.%s", sign, digits[:len(digits)-scale], digits[len(digits)-scale:])
+}
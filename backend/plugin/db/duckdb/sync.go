@@ -0,0 +1,476 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	// referencesRegexp matches the referenced table and columns in the foreign key constraint text,
+	// such as FOREIGN KEY (a) REFERENCES s.t(id).
+	referencesRegexp = regexp.MustCompile(`(?is)REFERENCES\s+(.+?)\s*\((.*)\)\s*$`)
+)
+
+// SyncInstance syncs the instance.
+func (driver *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, error) {
+	version, err := driver.getVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	databaseNames, err := driver.getDatabases()
+	if err != nil {
+		return nil, err
+	}
+
+	var databases []*storepb.DatabaseSchemaMetadata
+	for _, databaseName := range databaseNames {
+		databases = append(databases, &storepb.DatabaseSchemaMetadata{Name: databaseName})
+	}
+
+	return &db.InstanceMetadata{
+		Version:   version,
+		Databases: databases,
+	}, nil
+}
+
+// getVersion gets the version, such as v0.9.2.
+func (driver *Driver) getVersion(ctx context.Context) (string, error) {
+	query := "SELECT version();"
+	var version string
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&version); err != nil {
+		if err == sql.ErrNoRows {
+			return "", common.FormatDBErrorEmptyRowWithQuery(query)
+		}
+		return "", util.FormatErrorWithQuery(err, query)
+	}
+	return strings.TrimPrefix(version, "v"), nil
+}
+
+// SyncDBSchema syncs a single database schema.
+func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchemaMetadata, error) {
+	databases, err := driver.getDatabases()
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, database := range databases {
+		if database == driver.databaseName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, common.Errorf(common.NotFound, "database %q not found", driver.databaseName)
+	}
+
+	txn, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback()
+
+	schemaNames, err := getSchemas(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get schemas from database %q", driver.databaseName)
+	}
+	tableMap, err := getTables(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get tables from database %q", driver.databaseName)
+	}
+	viewMap, err := getViews(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get views from database %q", driver.databaseName)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+
+	databaseMetadata := &storepb.DatabaseSchemaMetadata{
+		Name: driver.databaseName,
+	}
+	for _, schemaName := range schemaNames {
+		tables, ok := tableMap[schemaName]
+		if !ok {
+			tables = []*storepb.TableMetadata{}
+		}
+		views, ok := viewMap[schemaName]
+		if !ok {
+			views = []*storepb.ViewMetadata{}
+		}
+		databaseMetadata.Schemas = append(databaseMetadata.Schemas, &storepb.SchemaMetadata{
+			Name:   schemaName,
+			Tables: tables,
+			Views:  views,
+		})
+	}
+	return databaseMetadata, nil
+}
+
+// The "main" schema is internal in DuckDB, but it's the default schema for the user objects.
+var listSchemaQuery = `
+SELECT schema_name
+FROM duckdb_schemas()
+WHERE database_name = current_database() AND (NOT internal OR schema_name = 'main')
+ORDER BY schema_name;`
+
+func getSchemas(txn *sql.Tx) ([]string, error) {
+	rows, err := txn.Query(listSchemaQuery)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, listSchemaQuery)
+	}
+	defer rows.Close()
+
+	var result []string
+	for rows.Next() {
+		var schemaName string
+		if err := rows.Scan(&schemaName); err != nil {
+			return nil, err
+		}
+		result = append(result, schemaName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, listSchemaQuery)
+	}
+	return result, nil
+}
+
+var listTableQuery = `
+SELECT schema_name, table_name, estimated_size
+FROM duckdb_tables()
+WHERE database_name = current_database() AND NOT internal AND NOT temporary
+ORDER BY schema_name, table_name;`
+
+// getTables gets all tables of a database.
+func getTables(txn *sql.Tx) (map[string][]*storepb.TableMetadata, error) {
+	columnMap, err := getTableColumns(txn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get table columns")
+	}
+	indexMap, err := getIndexes(txn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get indexes")
+	}
+	constraintIndexMap, foreignKeyMap, err := getConstraints(txn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get constraints")
+	}
+
+	tableMap := make(map[string][]*storepb.TableMetadata)
+	rows, err := txn.Query(listTableQuery)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, listTableQuery)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		table := &storepb.TableMetadata{}
+		var schemaName string
+		if err := rows.Scan(&schemaName, &table.Name, &table.RowCount); err != nil {
+			return nil, err
+		}
+		key := db.TableKey{Schema: schemaName, Table: table.Name}
+		table.Columns = columnMap[key]
+		// The indexes backing the PRIMARY KEY and UNIQUE constraints come first.
+		table.Indexes = append(constraintIndexMap[key], indexMap[key]...)
+		table.ForeignKeys = foreignKeyMap[key]
+		if table.ForeignKeys == nil {
+			table.ForeignKeys = []*storepb.ForeignKeyMetadata{}
+		}
+
+		tableMap[schemaName] = append(tableMap[schemaName], table)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, listTableQuery)
+	}
+	return tableMap, nil
+}
+
+var listColumnQuery = `
+SELECT schema_name, table_name, column_name, column_index, column_default, is_nullable, data_type
+FROM duckdb_columns()
+WHERE database_name = current_database() AND NOT internal
+ORDER BY schema_name, table_name, column_index;`
+
+// getTableColumns gets the columns of the tables and views.
+func getTableColumns(txn *sql.Tx) (map[db.TableKey][]*storepb.ColumnMetadata, error) {
+	columnMap := make(map[db.TableKey][]*storepb.ColumnMetadata)
+	rows, err := txn.Query(listColumnQuery)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, listColumnQuery)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		column := &storepb.ColumnMetadata{}
+		var schemaName, tableName string
+		var defaultStr sql.NullString
+		if err := rows.Scan(&schemaName, &tableName, &column.Name, &column.Position, &defaultStr, &column.Nullable, &column.Type); err != nil {
+			return nil, err
+		}
+		if defaultStr.Valid {
+			column.DefaultValue = &storepb.ColumnMetadata_DefaultExpression{DefaultExpression: defaultStr.String}
+		}
+
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		columnMap[key] = append(columnMap[key], column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, listColumnQuery)
+	}
+	return columnMap, nil
+}
+
+var listIndexQuery = `
+SELECT schema_name, table_name, index_name, is_unique, sql
+FROM duckdb_indexes()
+WHERE database_name = current_database()
+ORDER BY schema_name, table_name, index_name;`
+
+// getIndexes gets the indexes created by CREATE INDEX statements.
+func getIndexes(txn *sql.Tx) (map[db.TableKey][]*storepb.IndexMetadata, error) {
+	indexMap := make(map[db.TableKey][]*storepb.IndexMetadata)
+	rows, err := txn.Query(listIndexQuery)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, listIndexQuery)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		index := &storepb.IndexMetadata{
+			Type:    "ART",
+			Visible: true,
+		}
+		var schemaName, tableName string
+		var statement sql.NullString
+		if err := rows.Scan(&schemaName, &tableName, &index.Name, &index.Unique, &statement); err != nil {
+			return nil, err
+		}
+		index.Definition = strings.TrimSpace(statement.String)
+		index.Expressions = getIndexExpressions(index.Definition)
+
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		indexMap[key] = append(indexMap[key], index)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, listIndexQuery)
+	}
+	return indexMap, nil
+}
+
+// getIndexExpressions gets the key expressions in the CREATE INDEX statement, such as
+// CREATE INDEX idx ON t(a, lower(b)); returns ["a", "lower(b)"].
+func getIndexExpressions(statement string) []string {
+	statement = strings.TrimRight(strings.TrimSpace(statement), ";")
+	on := strings.Index(strings.ToUpper(statement), " ON ")
+	if on < 0 {
+		return nil
+	}
+	statement = statement[on:]
+	begin, end := strings.Index(statement, "("), strings.LastIndex(statement, ")")
+	if begin < 0 || end < begin {
+		return nil
+	}
+	return splitTopLevelList(statement[begin+1 : end])
+}
+
+// splitTopLevelList splits the comma-separated list, the commas in the parentheses and quotes are skipped.
+func splitTopLevelList(list string) []string {
+	var result []string
+	depth, start := 0, 0
+	var quote rune
+	for i, c := range list {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == ',' && depth == 0:
+			result = append(result, strings.TrimSpace(list[start:i]))
+			start = i + 1
+		}
+	}
+	if last := strings.TrimSpace(list[start:]); last != "" {
+		result = append(result, last)
+	}
+	return result
+}
+
+var listConstraintQuery = `
+SELECT schema_name, table_name, constraint_type, constraint_text, constraint_column_names
+FROM duckdb_constraints()
+WHERE database_name = current_database() AND constraint_type IN ('PRIMARY KEY', 'UNIQUE', 'FOREIGN KEY')
+ORDER BY schema_name, table_name, constraint_index;`
+
+// getConstraints gets the PRIMARY KEY and UNIQUE constraints as indexes, and the FOREIGN KEY constraints.
+// The constraints are unnamed in DuckDB, so we name them as PostgreSQL does.
+func getConstraints(txn *sql.Tx) (map[db.TableKey][]*storepb.IndexMetadata, map[db.TableKey][]*storepb.ForeignKeyMetadata, error) {
+	indexMap := make(map[db.TableKey][]*storepb.IndexMetadata)
+	foreignKeyMap := make(map[db.TableKey][]*storepb.ForeignKeyMetadata)
+	rows, err := txn.Query(listConstraintQuery)
+	if err != nil {
+		return nil, nil, util.FormatErrorWithQuery(err, listConstraintQuery)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var schemaName, tableName, constraintType, constraintText string
+		var columnList any
+		if err := rows.Scan(&schemaName, &tableName, &constraintType, &constraintText, &columnList); err != nil {
+			return nil, nil, err
+		}
+		columns, err := convertStringList(columnList)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		switch constraintType {
+		case "PRIMARY KEY":
+			indexMap[key] = append(indexMap[key], &storepb.IndexMetadata{
+				Name:        fmt.Sprintf("%s_pkey", tableName),
+				Expressions: columns,
+				Type:        "ART",
+				Unique:      true,
+				Primary:     true,
+				Visible:     true,
+				Definition:  constraintText,
+			})
+		case "UNIQUE":
+			indexMap[key] = append(indexMap[key], &storepb.IndexMetadata{
+				Name:        fmt.Sprintf("%s_%s_key", tableName, strings.Join(columns, "_")),
+				Expressions: columns,
+				Type:        "ART",
+				Unique:      true,
+				Visible:     true,
+				Definition:  constraintText,
+			})
+		case "FOREIGN KEY":
+			foreignKey := &storepb.ForeignKeyMetadata{
+				Name:      fmt.Sprintf("%s_%s_fkey", tableName, strings.Join(columns, "_")),
+				Columns:   columns,
+				OnDelete:  "NO ACTION",
+				OnUpdate:  "NO ACTION",
+				MatchType: "SIMPLE",
+			}
+			foreignKey.ReferencedSchema, foreignKey.ReferencedTable, foreignKey.ReferencedColumns = parseReferences(schemaName, constraintText)
+			foreignKeyMap[key] = append(foreignKeyMap[key], foreignKey)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, util.FormatErrorWithQuery(err, listConstraintQuery)
+	}
+	return indexMap, foreignKeyMap, nil
+}
+
+// parseReferences parses the referenced schema, table and columns in the foreign key constraint text.
+// Foreign keys across schemas are not supported in DuckDB, so the referenced schema defaults to the schema of the table.
+func parseReferences(schemaName string, constraintText string) (string, string, []string) {
+	matches := referencesRegexp.FindStringSubmatch(constraintText)
+	if len(matches) != 3 {
+		return schemaName, "", nil
+	}
+	referencedSchema, referencedTable := schemaName, unquoteIdentifier(matches[1])
+	if parts := splitQualifiedName(matches[1]); len(parts) == 2 {
+		referencedSchema, referencedTable = parts[0], parts[1]
+	}
+	var referencedColumns []string
+	for _, column := range splitTopLevelList(matches[2]) {
+		referencedColumns = append(referencedColumns, unquoteIdentifier(column))
+	}
+	return referencedSchema, referencedTable, referencedColumns
+}
+
+// splitQualifiedName splits the qualified name such as s."t.1" into the unquoted parts.
+func splitQualifiedName(name string) []string {
+	var parts []string
+	inQuote, start := false, 0
+	for i, c := range name {
+		switch {
+		case c == '"':
+			inQuote = !inQuote
+		case c == '.' && !inQuote:
+			parts = append(parts, unquoteIdentifier(name[start:i]))
+			start = i + 1
+		}
+	}
+	return append(parts, unquoteIdentifier(name[start:]))
+}
+
+func unquoteIdentifier(identifier string) string {
+	identifier = strings.TrimSpace(identifier)
+	if len(identifier) >= 2 && strings.HasPrefix(identifier, `"`) && strings.HasSuffix(identifier, `"`) {
+		return strings.ReplaceAll(identifier[1:len(identifier)-1], `""`, `"`)
+	}
+	return identifier
+}
+
+func convertStringList(value any) ([]string, error) {
+	list, ok := value.([]any)
+	if !ok {
+		return nil, errors.Errorf("expecting a list but got %T", value)
+	}
+	var result []string
+	for _, element := range list {
+		s, ok := element.(string)
+		if !ok {
+			return nil, errors.Errorf("expecting a string but got %T", element)
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+var listViewQuery = `
+SELECT schema_name, view_name, sql
+FROM duckdb_views()
+WHERE database_name = current_database() AND NOT internal AND NOT temporary
+ORDER BY schema_name, view_name;`
+
+// getViews gets all views of a database.
+func getViews(txn *sql.Tx) (map[string][]*storepb.ViewMetadata, error) {
+	viewMap := make(map[string][]*storepb.ViewMetadata)
+	rows, err := txn.Query(listViewQuery)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, listViewQuery)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		view := &storepb.ViewMetadata{}
+		var schemaName string
+		var definition sql.NullString
+		if err := rows.Scan(&schemaName, &view.Name, &definition); err != nil {
+			return nil, err
+		}
+		view.Definition = strings.TrimSpace(definition.String)
+		viewMap[schemaName] = append(viewMap[schemaName], view)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, listViewQuery)
+	}
+	return viewMap, nil
+}
+
+// SyncSlowQuery syncs the slow query.
+func (*Driver) SyncSlowQuery(_ context.Context, _ time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
+	return nil, errors.Errorf("not implemented")
+}
+
+// CheckSlowQueryLogEnabled checks if slow query log is enabled.
+func (*Driver) CheckSlowQueryLogEnabled(_ context.Context) error {
+	return errors.Errorf("not implemented")
+}
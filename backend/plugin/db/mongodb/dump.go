@@ -0,0 +1,290 @@
+package mongodb
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// The dump is in the JSON lines of the canonical extended JSON, each line is one of the following entries:
+//
+//	{"collection": <name>, "options": {...}, "indexes": [...]}
+//	{"collection": <name>, "document": {...}}
+//	{"view": <name>, "options": {"viewOn": ..., "pipeline": [...]}}
+//
+// The collections go first with their documents, and then the views.
+const (
+	dumpKeyCollection = "collection"
+	dumpKeyView       = "view"
+	dumpKeyOptions    = "options"
+	dumpKeyIndexes    = "indexes"
+	dumpKeyDocument   = "document"
+
+	// restoreBatchSize is the number of documents inserted in a batch while restoring.
+	restoreBatchSize = 1000
+	// namespaceExistsErrorCode is the error code of creating an existing collection.
+	namespaceExistsErrorCode = 48
+)
+
+// Dump dumps the database in the extended JSON.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer, schemaOnly bool) (string, error) {
+	if driver.databaseName == "" {
+		return "", errors.New("MongoDB can dump one database only at a time")
+	}
+	database := driver.client.Database(driver.databaseName)
+	collectionOptions, err := getCollectionOptions(ctx, database)
+	if err != nil {
+		return "", err
+	}
+	collectionNames, err := database.ListCollectionNames(ctx, bson.M{"type": "collection"})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to list collection names")
+	}
+	sort.Strings(collectionNames)
+	viewNames, err := database.ListCollectionNames(ctx, bson.M{"type": "view"})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to list view names")
+	}
+	sort.Strings(viewNames)
+
+	for _, collectionName := range collectionNames {
+		if systemCollection[collectionName] {
+			continue
+		}
+		collection := database.Collection(collectionName)
+		indexes, err := getIndexSpecifications(ctx, collection)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get indexes of collection %s", collectionName)
+		}
+		options := collectionOptions[collectionName]
+		if options == nil {
+			options = bson.D{}
+		}
+		if err := writeDumpEntry(out, bson.D{
+			{Key: dumpKeyCollection, Value: collectionName},
+			{Key: dumpKeyOptions, Value: options},
+			{Key: dumpKeyIndexes, Value: indexes},
+		}); err != nil {
+			return "", err
+		}
+		if schemaOnly {
+			continue
+		}
+		if err := dumpDocuments(ctx, out, collection); err != nil {
+			return "", errors.Wrapf(err, "failed to dump documents of collection %s", collectionName)
+		}
+	}
+
+	for _, viewName := range viewNames {
+		if err := writeDumpEntry(out, bson.D{
+			{Key: dumpKeyView, Value: viewName},
+			{Key: dumpKeyOptions, Value: append(bson.D{}, collectionOptions[viewName]...)},
+		}); err != nil {
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+func dumpDocuments(ctx context.Context, out io.Writer, collection *mongo.Collection) error {
+	cursor, err := collection.Find(ctx, bson.D{})
+	if err != nil {
+		return errors.Wrap(err, "failed to find documents")
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var document bson.D
+		if err := cursor.Decode(&document); err != nil {
+			return errors.Wrap(err, "failed to decode document")
+		}
+		if err := writeDumpEntry(out, bson.D{
+			{Key: dumpKeyCollection, Value: collection.Name()},
+			{Key: dumpKeyDocument, Value: document},
+		}); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+// getIndexSpecifications returns the index specifications except the default _id index.
+// The version and namespace are dropped because they are set by the server.
+func getIndexSpecifications(ctx context.Context, collection *mongo.Collection) (bson.A, error) {
+	cursor, err := collection.Indexes().List(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list indexes")
+	}
+	defer cursor.Close(ctx)
+	indexes := bson.A{}
+	for cursor.Next(ctx) {
+		var index bson.D
+		if err := cursor.Decode(&index); err != nil {
+			return nil, errors.Wrap(err, "failed to decode index")
+		}
+		if getOption(index, "name") == "_id_" {
+			continue
+		}
+		var specification bson.D
+		for _, e := range index {
+			if e.Key == "v" || e.Key == "ns" {
+				continue
+			}
+			specification = append(specification, e)
+		}
+		indexes = append(indexes, specification)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate indexes")
+	}
+	return indexes, nil
+}
+
+func writeDumpEntry(out io.Writer, entry bson.D) error {
+	bytes, err := bson.MarshalExtJSON(entry, true, false)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal dump entry")
+	}
+	if _, err := out.Write(append(bytes, '\n')); err != nil {
+		return errors.Wrap(err, "failed to write dump entry")
+	}
+	return nil
+}
+
+// Restore restores the backup in the extended JSON read from src.
+func (driver *Driver) Restore(ctx context.Context, src io.Reader) error {
+	if driver.databaseName == "" {
+		return errors.New("MongoDB can restore one database only at a time")
+	}
+	database := driver.client.Database(driver.databaseName)
+	reader := bufio.NewReader(src)
+
+	var batchCollection string
+	var batch []any
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if _, err := database.Collection(batchCollection).InsertMany(ctx, batch); err != nil {
+			return errors.Wrapf(err, "failed to insert documents into collection %s", batchCollection)
+		}
+		batch = nil
+		return nil
+	}
+
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return errors.Wrap(err, "failed to read backup")
+		}
+		if len(line) > 0 && !isBlank(line) {
+			entry, parseErr := parseDumpEntry(line)
+			if parseErr != nil {
+				return errors.Wrapf(parseErr, "invalid backup at line %d", lineNumber)
+			}
+			if entry.document != nil {
+				if entry.collection != batchCollection || len(batch) >= restoreBatchSize {
+					if err := flush(); err != nil {
+						return err
+					}
+					batchCollection = entry.collection
+				}
+				batch = append(batch, entry.document)
+			} else {
+				if err := flush(); err != nil {
+					return err
+				}
+				if err := restoreCollection(ctx, database, entry); err != nil {
+					return err
+				}
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+	return flush()
+}
+
+type dumpEntry struct {
+	collection string
+	view       string
+	options    bson.D
+	indexes    bson.A
+	document   bson.D
+}
+
+func parseDumpEntry(line []byte) (*dumpEntry, error) {
+	var d bson.D
+	if err := bson.UnmarshalExtJSON(line, true, &d); err != nil {
+		return nil, err
+	}
+	entry := &dumpEntry{}
+	for _, e := range d {
+		var ok bool
+		switch e.Key {
+		case dumpKeyCollection:
+			entry.collection, ok = e.Value.(string)
+		case dumpKeyView:
+			entry.view, ok = e.Value.(string)
+		case dumpKeyOptions:
+			entry.options, ok = e.Value.(bson.D)
+		case dumpKeyIndexes:
+			entry.indexes, ok = e.Value.(bson.A)
+		case dumpKeyDocument:
+			entry.document, ok = e.Value.(bson.D)
+			if ok && entry.document == nil {
+				// The empty document.
+				entry.document = bson.D{}
+			}
+		default:
+			return nil, errors.Errorf("unknown key %q", e.Key)
+		}
+		if !ok {
+			return nil, errors.Errorf("invalid value of key %q", e.Key)
+		}
+	}
+	if entry.collection == "" && entry.view == "" {
+		return nil, errors.New("missing collection or view name")
+	}
+	return entry, nil
+}
+
+// restoreCollection creates the collection with its indexes, or the view.
+// The existing collections are reused so that the restore can be applied on a database with the same schema.
+func restoreCollection(ctx context.Context, database *mongo.Database, entry *dumpEntry) error {
+	name := entry.collection
+	if entry.view != "" {
+		name = entry.view
+	}
+	command := bson.D{{Key: "create", Value: name}}
+	command = append(command, entry.options...)
+	if err := database.RunCommand(ctx, command).Err(); err != nil {
+		var commandErr mongo.CommandError
+		if !errors.As(err, &commandErr) || commandErr.Code != namespaceExistsErrorCode {
+			return errors.Wrapf(err, "failed to create %s", name)
+		}
+	}
+	if len(entry.indexes) > 0 {
+		if err := database.RunCommand(ctx, bson.D{
+			{Key: "createIndexes", Value: name},
+			{Key: "indexes", Value: entry.indexes},
+		}).Err(); err != nil {
+			return errors.Wrapf(err, "failed to create indexes of collection %s", name)
+		}
+	}
+	return nil
+}
+
+func isBlank(line []byte) bool {
+	for _, b := range line {
+		if b != ' ' && b != '\t' && b != '\r' && b != '\n' {
+			return false
+		}
+	}
+	return true
+}
@@ -89,8 +89,25 @@ func (*Driver) GetDB() *sql.DB {
 	return nil
 }
 
-// Execute executes a statement, always returns 0 as the number of rows affected because we execute the statement by mongosh, it's hard to catch the row effected number.
+// Execute executes a statement and returns the number of the affected documents.
+// The statements we can parse are executed by the Go driver, otherwise we fall back to mongosh and always return 0
+// as the number of rows affected because it's hard to catch the row effected number from mongosh.
 func (driver *Driver) Execute(ctx context.Context, statement string, _ db.ExecuteOptions) (int64, error) {
+	if statements, err := parseMongoStatements(statement); err == nil && driver.databaseName != "" {
+		var affectedRows int64
+		for _, statement := range statements {
+			rows, err := driver.executeNativeStatement(ctx, statement)
+			affectedRows += rows
+			if err != nil {
+				return affectedRows, errors.Wrapf(err, "failed to execute statement %q", statement.text)
+			}
+		}
+		return affectedRows, nil
+	}
+	return driver.executeByMongosh(ctx, statement)
+}
+
+func (driver *Driver) executeByMongosh(ctx context.Context, statement string) (int64, error) {
 	connectionURI := getMongoDBConnectionURI(driver.connCfg)
 	// For MongoDB, we execute the statement in mongosh, which is a shell for MongoDB.
 	// There are some ways to execute the statement in mongosh:
@@ -130,16 +147,6 @@ func (driver *Driver) Execute(ctx context.Context, statement string, _ db.Execut
 	return 0, nil
 }
 
-// Dump dumps the database.
-func (*Driver) Dump(_ context.Context, _ io.Writer, _ bool) (string, error) {
-	return "", nil
-}
-
-// Restore restores the backup read from src.
-func (*Driver) Restore(_ context.Context, _ io.Reader) error {
-	panic("not implemented")
-}
-
 // getMongoDBConnectionURI returns the MongoDB connection URI.
 // https://www.mongodb.com/docs/manual/reference/connection-string/
 func getMongoDBConnectionURI(connConfig db.ConnectionConfig) string {
@@ -174,6 +181,25 @@ func getMongoDBConnectionURI(connConfig db.ConnectionConfig) string {
 // QueryConn queries a SQL statement in a given connection.
 func (driver *Driver) QueryConn(ctx context.Context, _ *sql.Conn, statement string, queryContext *db.QueryContext) ([]*v1pb.QueryResult, error) {
 	statement = strings.Trim(statement, " \t\n\r\f;")
+	if statements, err := parseMongoStatements(statement); err == nil && driver.databaseName != "" {
+		var limit int64
+		if queryContext != nil && queryContext.Limit > 0 {
+			limit = int64(queryContext.Limit)
+		}
+		var results []*v1pb.QueryResult
+		for _, statement := range statements {
+			if queryContext != nil && queryContext.ReadOnly && !isReadOnlyStatement(statement) {
+				return nil, errors.Errorf("cannot execute %s in read-only mode", statement.method)
+			}
+			result, err := driver.queryNativeStatement(ctx, statement, limit)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, result)
+		}
+		return results, nil
+	}
+
 	simpleStatement := isMongoStatement(statement)
 	startTime := time.Now()
 	connectionURI := getMongoDBConnectionURI(driver.connCfg)
@@ -0,0 +1,509 @@
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// queryNativeStatement runs the statement by the Go driver, the limit is the maximum number of documents to return, 0 means no limit.
+// It returns the documents for the read methods, and the affected rows for the write methods.
+func (driver *Driver) queryNativeStatement(ctx context.Context, statement *mongoStatement, limit int64) (*v1pb.QueryResult, error) {
+	startTime := time.Now()
+	var result *v1pb.QueryResult
+	switch statement.method {
+	case "find", "findOne", "aggregate":
+		documents, err := driver.findDocuments(ctx, statement, limit)
+		if err != nil {
+			return nil, err
+		}
+		result, err = convertDocumentsToQueryResult(documents)
+		if err != nil {
+			return nil, err
+		}
+	case "countDocuments":
+		count, err := driver.countDocuments(ctx, statement)
+		if err != nil {
+			return nil, err
+		}
+		result = &v1pb.QueryResult{
+			ColumnNames:     []string{"count"},
+			ColumnTypeNames: []string{"INT64"},
+			Rows:            []*v1pb.QueryRow{{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: count}}}}},
+		}
+	default:
+		affectedRows, err := driver.executeNativeStatement(ctx, statement)
+		if err != nil {
+			return nil, err
+		}
+		result = &v1pb.QueryResult{
+			ColumnNames:     []string{"Affected Rows"},
+			ColumnTypeNames: []string{"INT"},
+			Rows:            []*v1pb.QueryRow{{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: affectedRows}}}}},
+		}
+	}
+	result.Latency = durationpb.New(time.Since(startTime))
+	result.Statement = statement.text
+	return result, nil
+}
+
+func (driver *Driver) findDocuments(ctx context.Context, statement *mongoStatement, limit int64) ([]bson.D, error) {
+	cursor, limit, err := driver.openCursor(ctx, statement, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var documents []bson.D
+	for cursor.Next(ctx) {
+		var document bson.D
+		if err := cursor.Decode(&document); err != nil {
+			return nil, errors.Wrap(err, "failed to decode document")
+		}
+		documents = append(documents, document)
+		if limit > 0 && int64(len(documents)) >= limit {
+			break
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate documents")
+	}
+	return documents, nil
+}
+
+// drainDocuments iterates the documents of the read statement without buffering them,
+// so that a read statement in a script, such as an aggregation with $out or $merge stages, runs to the end.
+func (driver *Driver) drainDocuments(ctx context.Context, statement *mongoStatement) error {
+	cursor, limit, err := driver.openCursor(ctx, statement, 0)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	var count int64
+	for cursor.Next(ctx) {
+		count++
+		if limit > 0 && count >= limit {
+			break
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return errors.Wrap(err, "failed to iterate documents")
+	}
+	return nil
+}
+
+// openCursor opens the cursor of the read statement, and returns the limit applied by the statement's cursor methods.
+func (driver *Driver) openCursor(ctx context.Context, statement *mongoStatement, limit int64) (*mongo.Cursor, int64, error) {
+	collection := driver.client.Database(driver.databaseName).Collection(statement.collection)
+	var sort, projection any
+	var skip int64
+	cursorLimit := int64(0)
+	for _, call := range statement.cursorMethods {
+		switch call.name {
+		case "sort":
+			if err := requireArguments(call.name, call.args, 1, 1); err != nil {
+				return nil, 0, err
+			}
+			sort = call.args[0]
+		case "projection":
+			if err := requireArguments(call.name, call.args, 1, 1); err != nil {
+				return nil, 0, err
+			}
+			projection = call.args[0]
+		case "skip", "limit":
+			if err := requireArguments(call.name, call.args, 1, 1); err != nil {
+				return nil, 0, err
+			}
+			n, err := toInt64(call.args[0])
+			if err != nil {
+				return nil, 0, errors.Wrapf(err, "invalid argument of %s", call.name)
+			}
+			if call.name == "skip" {
+				skip = n
+			} else {
+				// Negative limit means a single batch in mongosh, we treat it as the absolute value.
+				cursorLimit = max(n, -n)
+			}
+		}
+	}
+	if cursorLimit > 0 && (limit <= 0 || cursorLimit < limit) {
+		limit = cursorLimit
+	}
+
+	var cursor *mongo.Cursor
+	var err error
+	switch statement.method {
+	case "find", "findOne":
+		if err := requireArguments(statement.method, statement.args, 0, 2); err != nil {
+			return nil, 0, err
+		}
+		filter := getArgument(statement.args, 0, bson.D{})
+		if len(statement.args) > 1 {
+			projection = statement.args[1]
+		}
+		if statement.method == "findOne" {
+			limit = 1
+		}
+		opts := options.Find()
+		if sort != nil {
+			opts.SetSort(sort)
+		}
+		if projection != nil {
+			opts.SetProjection(projection)
+		}
+		if skip > 0 {
+			opts.SetSkip(skip)
+		}
+		if limit > 0 {
+			opts.SetLimit(limit)
+		}
+		cursor, err = collection.Find(ctx, filter, opts)
+	case "aggregate":
+		if err := requireArguments(statement.method, statement.args, 0, 2); err != nil {
+			return nil, 0, err
+		}
+		pipeline := getArgument(statement.args, 0, bson.A{})
+		if _, ok := pipeline.(bson.A); !ok {
+			// mongosh accepts the stages as the arguments.
+			pipeline = bson.A(statement.args)
+		}
+		opts := options.Aggregate()
+		if len(statement.args) > 1 {
+			if o, ok := statement.args[1].(bson.D); ok {
+				if v, ok := getOption(o, "allowDiskUse").(bool); ok {
+					opts.SetAllowDiskUse(v)
+				}
+				if v, ok := getOption(o, "maxTimeMS").(int32); ok {
+					opts.SetMaxTime(time.Duration(v) * time.Millisecond)
+				}
+			}
+		}
+		cursor, err = collection.Aggregate(ctx, pipeline, opts)
+	default:
+		return nil, 0, errors.Errorf("unsupported method %q", statement.method)
+	}
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to execute %s", statement.method)
+	}
+	return cursor, limit, nil
+}
+
+func (driver *Driver) countDocuments(ctx context.Context, statement *mongoStatement) (int64, error) {
+	if err := requireArguments(statement.method, statement.args, 0, 1); err != nil {
+		return 0, err
+	}
+	collection := driver.client.Database(driver.databaseName).Collection(statement.collection)
+	count, err := collection.CountDocuments(ctx, getArgument(statement.args, 0, bson.D{}))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count documents")
+	}
+	return count, nil
+}
+
+// executeNativeStatement runs the write statement by the Go driver and returns the number of the affected documents.
+func (driver *Driver) executeNativeStatement(ctx context.Context, statement *mongoStatement) (int64, error) {
+	collection := driver.client.Database(driver.databaseName).Collection(statement.collection)
+	switch statement.method {
+	case "find", "findOne", "aggregate":
+		// The read statements in the script are executed but affect no documents.
+		return 0, driver.drainDocuments(ctx, statement)
+	case "countDocuments":
+		_, err := driver.countDocuments(ctx, statement)
+		return 0, err
+	case "insertOne":
+		if err := requireArguments(statement.method, statement.args, 1, 2); err != nil {
+			return 0, err
+		}
+		if _, err := collection.InsertOne(ctx, statement.args[0]); err != nil {
+			return 0, errors.Wrap(err, "failed to insert document")
+		}
+		return 1, nil
+	case "insertMany":
+		if err := requireArguments(statement.method, statement.args, 1, 2); err != nil {
+			return 0, err
+		}
+		documents, ok := statement.args[0].(bson.A)
+		if !ok {
+			return 0, errors.New("insertMany requires an array of documents")
+		}
+		opts := options.InsertMany()
+		if len(statement.args) > 1 {
+			if o, ok := statement.args[1].(bson.D); ok {
+				if v, ok := getOption(o, "ordered").(bool); ok {
+					opts.SetOrdered(v)
+				}
+			}
+		}
+		result, err := collection.InsertMany(ctx, documents, opts)
+		if err != nil {
+			var affectedRows int64
+			if result != nil {
+				affectedRows = int64(len(result.InsertedIDs))
+			}
+			return affectedRows, errors.Wrap(err, "failed to insert documents")
+		}
+		return int64(len(result.InsertedIDs)), nil
+	case "updateOne", "updateMany", "replaceOne":
+		if err := requireArguments(statement.method, statement.args, 2, 3); err != nil {
+			return 0, err
+		}
+		opts := options.Update()
+		if len(statement.args) > 2 {
+			if o, ok := statement.args[2].(bson.D); ok {
+				if v, ok := getOption(o, "upsert").(bool); ok {
+					opts.SetUpsert(v)
+				}
+				if v, ok := getOption(o, "arrayFilters").(bson.A); ok {
+					opts.SetArrayFilters(options.ArrayFilters{Filters: v})
+				}
+			}
+		}
+		var result *mongo.UpdateResult
+		var err error
+		switch statement.method {
+		case "updateOne":
+			result, err = collection.UpdateOne(ctx, statement.args[0], statement.args[1], opts)
+		case "updateMany":
+			result, err = collection.UpdateMany(ctx, statement.args[0], statement.args[1], opts)
+		default:
+			replaceOptions := options.Replace()
+			if opts.Upsert != nil {
+				replaceOptions.SetUpsert(*opts.Upsert)
+			}
+			result, err = collection.ReplaceOne(ctx, statement.args[0], statement.args[1], replaceOptions)
+		}
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to update documents")
+		}
+		return result.ModifiedCount + result.UpsertedCount, nil
+	case "deleteOne", "deleteMany":
+		if err := requireArguments(statement.method, statement.args, 1, 2); err != nil {
+			return 0, err
+		}
+		var result *mongo.DeleteResult
+		var err error
+		if statement.method == "deleteOne" {
+			result, err = collection.DeleteOne(ctx, statement.args[0])
+		} else {
+			result, err = collection.DeleteMany(ctx, statement.args[0])
+		}
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to delete documents")
+		}
+		return result.DeletedCount, nil
+	case "createIndex":
+		if err := requireArguments(statement.method, statement.args, 1, 2); err != nil {
+			return 0, err
+		}
+		model := mongo.IndexModel{Keys: statement.args[0]}
+		if len(statement.args) > 1 {
+			o, ok := statement.args[1].(bson.D)
+			if !ok {
+				return 0, errors.New("the options of createIndex must be a document")
+			}
+			indexOptions := options.Index()
+			if v, ok := getOption(o, "name").(string); ok {
+				indexOptions.SetName(v)
+			}
+			if v, ok := getOption(o, "unique").(bool); ok {
+				indexOptions.SetUnique(v)
+			}
+			if v, ok := getOption(o, "sparse").(bool); ok {
+				indexOptions.SetSparse(v)
+			}
+			if v, ok := getOption(o, "hidden").(bool); ok {
+				indexOptions.SetHidden(v)
+			}
+			if v := getOption(o, "expireAfterSeconds"); v != nil {
+				seconds, err := toInt64(v)
+				if err != nil {
+					return 0, errors.Wrap(err, "invalid expireAfterSeconds")
+				}
+				indexOptions.SetExpireAfterSeconds(int32(seconds))
+			}
+			if v, ok := getOption(o, "partialFilterExpression").(bson.D); ok {
+				indexOptions.SetPartialFilterExpression(v)
+			}
+			model.Options = indexOptions
+		}
+		if _, err := collection.Indexes().CreateOne(ctx, model); err != nil {
+			return 0, errors.Wrap(err, "failed to create index")
+		}
+		return 0, nil
+	case "drop":
+		if err := requireArguments(statement.method, statement.args, 0, 1); err != nil {
+			return 0, err
+		}
+		if err := collection.Drop(ctx); err != nil {
+			return 0, errors.Wrap(err, "failed to drop collection")
+		}
+		return 0, nil
+	default:
+		return 0, errors.Errorf("unsupported method %q", statement.method)
+	}
+}
+
+func requireArguments(method string, args []any, minCount, maxCount int) error {
+	if len(args) < minCount || len(args) > maxCount {
+		if minCount == maxCount {
+			return errors.Errorf("%s requires %d arguments but got %d", method, minCount, len(args))
+		}
+		return errors.Errorf("%s requires %d to %d arguments but got %d", method, minCount, maxCount, len(args))
+	}
+	return nil
+}
+
+func getArgument(args []any, index int, defaultValue any) any {
+	if index < len(args) && args[index] != nil {
+		return args[index]
+	}
+	return defaultValue
+}
+
+func getOption(opts bson.D, key string) any {
+	for _, e := range opts {
+		if e.Key == key {
+			return e.Value
+		}
+	}
+	return nil
+}
+
+// convertDocumentsToQueryResult converts the documents to the query result, the top level fields are the columns.
+// The column type is the BSON type of the values, or MIXED if the values are in different types.
+func convertDocumentsToQueryResult(documents []bson.D) (*v1pb.QueryResult, error) {
+	columnSet := make(map[string]bool)
+	for _, document := range documents {
+		for _, e := range document {
+			columnSet[e.Key] = true
+		}
+	}
+	columns, columnIndexMap := getOrderedColumns(columnSet)
+	result := &v1pb.QueryResult{
+		ColumnNames:     columns,
+		ColumnTypeNames: make([]string, len(columns)),
+	}
+	for _, document := range documents {
+		values := make([]*v1pb.RowValue, len(columns))
+		for _, e := range document {
+			index := columnIndexMap[e.Key]
+			value, err := convertBSONValue(e.Value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to convert field %q", e.Key)
+			}
+			values[index] = value
+			if typeName := getBSONTypeName(e.Value); typeName != "NULL" {
+				switch result.ColumnTypeNames[index] {
+				case "":
+					result.ColumnTypeNames[index] = typeName
+				case typeName:
+				default:
+					result.ColumnTypeNames[index] = "MIXED"
+				}
+			}
+		}
+		for i := range values {
+			if values[i] == nil {
+				values[i] = &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{}}
+			}
+		}
+		result.Rows = append(result.Rows, &v1pb.QueryRow{Values: values})
+	}
+	for i, typeName := range result.ColumnTypeNames {
+		if typeName == "" {
+			result.ColumnTypeNames[i] = "NULL"
+		}
+	}
+	return result, nil
+}
+
+// convertBSONValue converts the BSON value to the row value, the documents and arrays are in the relaxed extended JSON.
+func convertBSONValue(value any) (*v1pb.RowValue, error) {
+	switch v := value.(type) {
+	case nil, primitive.Null, primitive.Undefined:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{}}, nil
+	case string:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: v}}, nil
+	case bool:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_BoolValue{BoolValue: v}}, nil
+	case int32:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: v}}, nil
+	case int64:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: v}}, nil
+	case float64:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: v}}, nil
+	case primitive.ObjectID:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: v.Hex()}}, nil
+	case primitive.DateTime:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: v.Time().UTC().Format(time.RFC3339Nano)}}, nil
+	case primitive.Decimal128:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: v.String()}}, nil
+	case primitive.Binary:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_BytesValue{BytesValue: v.Data}}, nil
+	default:
+		s, err := marshalExtJSONValue(v)
+		if err != nil {
+			return nil, err
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}, nil
+	}
+}
+
+// marshalExtJSONValue marshals any BSON value to the relaxed extended JSON.
+// The extended JSON marshaller only accepts documents, so we wrap the value in a document.
+func marshalExtJSONValue(value any) (string, error) {
+	bytes, err := bson.MarshalExtJSON(bson.D{{Key: "v", Value: value}}, false, false)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal value to extended JSON")
+	}
+	var wrapper map[string]json.RawMessage
+	if err := json.Unmarshal(bytes, &wrapper); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal extended JSON")
+	}
+	return string(wrapper["v"]), nil
+}
+
+func getBSONTypeName(value any) string {
+	switch value.(type) {
+	case nil, primitive.Null, primitive.Undefined:
+		return "NULL"
+	case string:
+		return "STRING"
+	case bool:
+		return "BOOL"
+	case int32:
+		return "INT32"
+	case int64:
+		return "INT64"
+	case float64:
+		return "DOUBLE"
+	case primitive.ObjectID:
+		return "OBJECTID"
+	case primitive.DateTime:
+		return "DATE"
+	case primitive.Decimal128:
+		return "DECIMAL128"
+	case primitive.Binary:
+		return "BINARY"
+	case bson.D, bson.M:
+		return "DOCUMENT"
+	case bson.A:
+		return "ARRAY"
+	case primitive.Timestamp:
+		return "TIMESTAMP"
+	case primitive.Regex:
+		return "REGEX"
+	default:
+		return "TEXT"
+	}
+}
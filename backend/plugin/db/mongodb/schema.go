@@ -0,0 +1,283 @@
+package mongodb
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// schemaSampleSize is the number of the documents sampled to infer the collection schema.
+	schemaSampleSize = 100
+	// maxSchemaFieldDepth is the maximum depth of the nested fields in the inferred schema.
+	maxSchemaFieldDepth = 8
+	// maxSchemaFieldCount is the maximum number of the fields in the inferred schema.
+	maxSchemaFieldCount = 1000
+)
+
+// sampleDocuments samples the first and the last documents ordered by _id.
+// We don't use $sample because the random sampling makes the inferred schema unstable, which results in false schema drifts.
+func sampleDocuments(ctx context.Context, collection *mongo.Collection) ([]bson.D, error) {
+	var documents []bson.D
+	seen := make(map[string]bool)
+	for _, direction := range []int32{1, -1} {
+		cursor, err := collection.Find(ctx, bson.D{}, options.Find().SetSort(bson.D{{Key: "_id", Value: direction}}).SetLimit(schemaSampleSize/2))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to sample documents")
+		}
+		for cursor.Next(ctx) {
+			var document bson.D
+			if err := cursor.Decode(&document); err != nil {
+				cursor.Close(ctx)
+				return nil, errors.Wrap(err, "failed to decode document")
+			}
+			// The first and the last documents overlap if the collection is small.
+			if id, err := marshalExtJSONValue(getOption(document, "_id")); err == nil {
+				if seen[id] {
+					continue
+				}
+				seen[id] = true
+			}
+			documents = append(documents, document)
+		}
+		err = cursor.Err()
+		cursor.Close(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to iterate documents")
+		}
+	}
+	return documents, nil
+}
+
+type fieldStatistics struct {
+	path string
+	// count is the number of documents containing the field.
+	count int
+	types map[string]bool
+}
+
+// schemaInferrer infers the fields of a collection from the sampled documents.
+type schemaInferrer struct {
+	documentCount int
+	fields        []*fieldStatistics
+	fieldMap      map[string]*fieldStatistics
+}
+
+func newSchemaInferrer() *schemaInferrer {
+	return &schemaInferrer{fieldMap: make(map[string]*fieldStatistics)}
+}
+
+func (i *schemaInferrer) addDocument(document bson.D) {
+	i.documentCount++
+	i.addFields("", document, 0, make(map[string]bool))
+}
+
+// addFields records the fields of the document, the nested fields are in the dot notation.
+// The fields of the documents in an array are recorded with the array path as the prefix like the MongoDB query.
+func (i *schemaInferrer) addFields(prefix string, document bson.D, depth int, seen map[string]bool) {
+	for _, e := range document {
+		path := prefix + e.Key
+		field, ok := i.fieldMap[path]
+		if !ok {
+			if len(i.fields) >= maxSchemaFieldCount {
+				continue
+			}
+			field = &fieldStatistics{path: path, types: make(map[string]bool)}
+			i.fieldMap[path] = field
+			i.fields = append(i.fields, field)
+		}
+		if !seen[path] {
+			seen[path] = true
+			field.count++
+		}
+		field.types[getSchemaTypeName(e.Value)] = true
+
+		if depth+1 >= maxSchemaFieldDepth {
+			continue
+		}
+		switch v := e.Value.(type) {
+		case bson.D:
+			i.addFields(path+".", v, depth+1, seen)
+		case bson.A:
+			for _, element := range v {
+				if d, ok := element.(bson.D); ok {
+					i.addFields(path+".", d, depth+1, seen)
+				}
+			}
+		}
+	}
+}
+
+// columns returns the inferred columns. The type is the observed types joined by "|", and the column is nullable
+// if it is missing in any sampled document or has null values.
+func (i *schemaInferrer) columns() []*storepb.ColumnMetadata {
+	var columns []*storepb.ColumnMetadata
+	for idx, field := range i.fields {
+		var types []string
+		for t := range field.types {
+			if t != "null" {
+				types = append(types, t)
+			}
+		}
+		slices.Sort(types)
+		if len(types) == 0 {
+			types = []string{"null"}
+		}
+		columns = append(columns, &storepb.ColumnMetadata{
+			Name:     field.path,
+			Position: int32(idx + 1),
+			Type:     strings.Join(types, "|"),
+			Nullable: field.count < i.documentCount || field.types["null"],
+		})
+	}
+	return columns
+}
+
+// addJSONSchemaColumns appends the columns declared in the $jsonSchema validator but not observed in the sampled documents.
+func addJSONSchemaColumns(columns []*storepb.ColumnMetadata, jsonSchema bson.D) []*storepb.ColumnMetadata {
+	columnMap := make(map[string]bool)
+	for _, column := range columns {
+		columnMap[column.Name] = true
+	}
+	var walk func(prefix string, schema bson.D, depth int)
+	walk = func(prefix string, schema bson.D, depth int) {
+		required := make(map[string]bool)
+		if r, ok := getOption(schema, "required").(bson.A); ok {
+			for _, name := range r {
+				if s, ok := name.(string); ok {
+					required[s] = true
+				}
+			}
+		}
+		properties, ok := getOption(schema, "properties").(bson.D)
+		if !ok {
+			return
+		}
+		for _, property := range properties {
+			propertySchema, ok := property.Value.(bson.D)
+			if !ok {
+				continue
+			}
+			path := prefix + property.Key
+			if !columnMap[path] && len(columns) < maxSchemaFieldCount {
+				columnMap[path] = true
+				columns = append(columns, &storepb.ColumnMetadata{
+					Name:     path,
+					Position: int32(len(columns) + 1),
+					Type:     getJSONSchemaType(propertySchema),
+					Nullable: !required[property.Key],
+				})
+			}
+			if depth+1 < maxSchemaFieldDepth {
+				walk(path+".", propertySchema, depth+1)
+			}
+		}
+	}
+	walk("", jsonSchema, 0)
+	return columns
+}
+
+// getJSONSchemaType returns the type declared by the bsonType or type keyword.
+func getJSONSchemaType(schema bson.D) string {
+	for _, keyword := range []string{"bsonType", "type"} {
+		switch v := getOption(schema, keyword).(type) {
+		case string:
+			return v
+		case bson.A:
+			var types []string
+			for _, t := range v {
+				if s, ok := t.(string); ok && s != "null" {
+					types = append(types, s)
+				}
+			}
+			slices.Sort(types)
+			if len(types) > 0 {
+				return strings.Join(types, "|")
+			}
+		}
+	}
+	return ""
+}
+
+// getSchemaTypeName returns the BSON type alias used by the $type operator and the $jsonSchema bsonType.
+func getSchemaTypeName(value any) string {
+	switch value.(type) {
+	case nil, primitive.Null:
+		return "null"
+	case primitive.Undefined:
+		return "undefined"
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case int32:
+		return "int"
+	case int64:
+		return "long"
+	case float64:
+		return "double"
+	case primitive.ObjectID:
+		return "objectId"
+	case primitive.DateTime:
+		return "date"
+	case primitive.Decimal128:
+		return "decimal"
+	case primitive.Binary:
+		return "binData"
+	case bson.D, bson.M:
+		return "object"
+	case bson.A:
+		return "array"
+	case primitive.Timestamp:
+		return "timestamp"
+	case primitive.Regex:
+		return "regex"
+	case primitive.JavaScript, primitive.CodeWithScope:
+		return "javascript"
+	case primitive.MinKey:
+		return "minKey"
+	case primitive.MaxKey:
+		return "maxKey"
+	default:
+		return "unknown"
+	}
+}
+
+// getCollectionOptions returns the options of the collections and views, such as validator, viewOn and pipeline.
+func getCollectionOptions(ctx context.Context, database *mongo.Database) (map[string]bson.D, error) {
+	specifications, err := database.ListCollectionSpecifications(ctx, bson.D{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list collection specifications")
+	}
+	result := make(map[string]bson.D)
+	for _, specification := range specifications {
+		var opts bson.D
+		if len(specification.Options) > 0 {
+			if err := bson.Unmarshal(specification.Options, &opts); err != nil {
+				return nil, errors.Wrapf(err, "failed to unmarshal options of collection %s", specification.Name)
+			}
+		}
+		result[specification.Name] = opts
+	}
+	return result, nil
+}
+
+// getValidationOptions returns the validator, validationLevel and validationAction of the collection options.
+func getValidationOptions(opts bson.D) bson.D {
+	var result bson.D
+	for _, e := range opts {
+		switch e.Key {
+		case "validator", "validationLevel", "validationAction":
+			result = append(result, e)
+		}
+	}
+	return result
+}
@@ -0,0 +1,70 @@
+package mongodb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestSchemaInferrer(t *testing.T) {
+	a := require.New(t)
+	inferrer := newSchemaInferrer()
+	inferrer.addDocument(bson.D{
+		{Key: "_id", Value: primitive.NewObjectID()},
+		{Key: "name", Value: "danny"},
+		{Key: "age", Value: int32(13)},
+		{Key: "address", Value: bson.D{{Key: "city", Value: "Shanghai"}}},
+		{Key: "orders", Value: bson.A{bson.D{{Key: "id", Value: int32(1)}}, bson.D{{Key: "id", Value: int32(2)}}}},
+	})
+	inferrer.addDocument(bson.D{
+		{Key: "_id", Value: primitive.NewObjectID()},
+		{Key: "name", Value: nil},
+		{Key: "age", Value: int64(14)},
+	})
+
+	columns := addJSONSchemaColumns(inferrer.columns(), bson.D{
+		{Key: "bsonType", Value: "object"},
+		{Key: "required", Value: bson.A{"name", "email"}},
+		{Key: "properties", Value: bson.D{
+			{Key: "name", Value: bson.D{{Key: "bsonType", Value: "string"}}},
+			{Key: "email", Value: bson.D{{Key: "bsonType", Value: bson.A{"string", "null"}}}},
+		}},
+	})
+	a.Equal([]*storepb.ColumnMetadata{
+		{Name: "_id", Position: 1, Type: "objectId", Nullable: false},
+		{Name: "name", Position: 2, Type: "string", Nullable: true},
+		{Name: "age", Position: 3, Type: "int|long", Nullable: false},
+		{Name: "address", Position: 4, Type: "object", Nullable: true},
+		{Name: "address.city", Position: 5, Type: "string", Nullable: true},
+		{Name: "orders", Position: 6, Type: "array", Nullable: true},
+		{Name: "orders.id", Position: 7, Type: "int", Nullable: true},
+		{Name: "email", Position: 8, Type: "string", Nullable: false},
+	}, columns)
+}
+
+func TestParseDumpEntry(t *testing.T) {
+	a := require.New(t)
+	entry, err := parseDumpEntry([]byte(`{"collection":"users","options":{"validationLevel":"strict"},"indexes":[{"key":{"name":{"$numberInt":"1"}},"name":"name_1"}]}`))
+	a.NoError(err)
+	a.Equal("users", entry.collection)
+	a.Equal(bson.D{{Key: "validationLevel", Value: "strict"}}, entry.options)
+	a.Len(entry.indexes, 1)
+	a.Nil(entry.document)
+
+	entry, err = parseDumpEntry([]byte(`{"collection":"users","document":{"_id":{"$oid":"64c0b8c4e65c51195e0584b2"},"age":{"$numberLong":"13"}}}`))
+	a.NoError(err)
+	id, err := primitive.ObjectIDFromHex("64c0b8c4e65c51195e0584b2")
+	a.NoError(err)
+	a.Equal(bson.D{{Key: "_id", Value: id}, {Key: "age", Value: int64(13)}}, entry.document)
+
+	entry, err = parseDumpEntry([]byte(`{"collection":"users","document":{}}`))
+	a.NoError(err)
+	a.NotNil(entry.document)
+
+	_, err = parseDumpEntry([]byte(`{"unknown":"users"}`))
+	a.Error(err)
+}
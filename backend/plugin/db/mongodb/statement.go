@@ -0,0 +1,648 @@
+package mongodb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// supportedCollectionMethods is the set of the collection methods we can execute by the Go driver.
+var supportedCollectionMethods = map[string]bool{
+	"find":           true,
+	"findOne":        true,
+	"aggregate":      true,
+	"countDocuments": true,
+	"insertOne":      true,
+	"insertMany":     true,
+	"updateOne":      true,
+	"updateMany":     true,
+	"replaceOne":     true,
+	"deleteOne":      true,
+	"deleteMany":     true,
+	"createIndex":    true,
+	"drop":           true,
+}
+
+// isReadOnlyStatement returns true if the statement does not modify the data.
+func isReadOnlyStatement(statement *mongoStatement) bool {
+	switch statement.method {
+	case "find", "findOne", "countDocuments":
+		return true
+	case "aggregate":
+		// The $out and $merge stages write the results to the collection.
+		stages := statement.args
+		if pipeline, ok := getArgument(statement.args, 0, nil).(bson.A); ok {
+			stages = pipeline
+		}
+		for _, stage := range stages {
+			if d, ok := stage.(bson.D); ok && len(d) > 0 && (d[0].Key == "$out" || d[0].Key == "$merge") {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// supportedCursorMethods is the set of the chained cursor methods of find, aggregate and findOne.
+var supportedCursorMethods = map[string]bool{
+	"sort":       true,
+	"skip":       true,
+	"limit":      true,
+	"projection": true,
+	"toArray":    true,
+	"pretty":     true,
+}
+
+// mongoStatement is a mongosh statement in the form of db.<collection>.<method>(<args>).<cursorMethod>(<args>)...
+type mongoStatement struct {
+	text          string
+	collection    string
+	method        string
+	args          []any
+	cursorMethods []*mongoMethodCall
+}
+
+type mongoMethodCall struct {
+	name string
+	args []any
+}
+
+// parseMongoStatements parses the script consisting of the mongosh statements we support.
+// The arguments are parsed into the BSON values, such as bson.D for the objects and bson.A for the arrays.
+// It returns error if any statement is not supported, the caller should fall back to mongosh.
+func parseMongoStatements(script string) ([]*mongoStatement, error) {
+	p := &mongoParser{s: []rune(script)}
+	var statements []*mongoStatement
+	for {
+		p.skipSpaces()
+		if p.eof() {
+			break
+		}
+		if p.peek() == ';' {
+			p.pos++
+			continue
+		}
+		statement, err := p.parseStatement()
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, statement)
+	}
+	if len(statements) == 0 {
+		return nil, errors.New("empty statement")
+	}
+	return statements, nil
+}
+
+type mongoParser struct {
+	s   []rune
+	pos int
+}
+
+func (p *mongoParser) eof() bool {
+	return p.pos >= len(p.s)
+}
+
+func (p *mongoParser) peek() rune {
+	if p.eof() {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+func (p *mongoParser) errorf(format string, args ...any) error {
+	return errors.Errorf("%s at position %d", fmt.Sprintf(format, args...), p.pos)
+}
+
+// skipSpaces skips the whitespaces and the comments.
+func (p *mongoParser) skipSpaces() {
+	for !p.eof() {
+		switch {
+		case unicode.IsSpace(p.peek()):
+			p.pos++
+		case p.hasPrefix("//"):
+			for !p.eof() && p.peek() != '\n' {
+				p.pos++
+			}
+		case p.hasPrefix("/*"):
+			p.pos += 2
+			for !p.eof() && !p.hasPrefix("*/") {
+				p.pos++
+			}
+			p.pos = min(len(p.s), p.pos+2)
+		default:
+			return
+		}
+	}
+}
+
+func (p *mongoParser) hasPrefix(prefix string) bool {
+	return strings.HasPrefix(string(p.s[p.pos:min(len(p.s), p.pos+len(prefix))]), prefix)
+}
+
+func (p *mongoParser) expect(r rune) error {
+	p.skipSpaces()
+	if p.peek() != r {
+		return p.errorf("expect %q", r)
+	}
+	p.pos++
+	return nil
+}
+
+func isIdentifierStart(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r)
+}
+
+func isIdentifierPart(r rune) bool {
+	return isIdentifierStart(r) || unicode.IsDigit(r)
+}
+
+func (p *mongoParser) parseIdentifier() (string, error) {
+	p.skipSpaces()
+	if !isIdentifierStart(p.peek()) {
+		return "", p.errorf("expect identifier")
+	}
+	start := p.pos
+	for !p.eof() && isIdentifierPart(p.peek()) {
+		p.pos++
+	}
+	return string(p.s[start:p.pos]), nil
+}
+
+func (p *mongoParser) parseStatement() (*mongoStatement, error) {
+	start := p.pos
+	db, err := p.parseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+	if db != "db" {
+		return nil, p.errorf("statement must start with db")
+	}
+	if err := p.expect('.'); err != nil {
+		return nil, err
+	}
+	statement := &mongoStatement{}
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+	if name == "getCollection" {
+		args, err := p.parseArguments()
+		if err != nil {
+			return nil, err
+		}
+		if len(args) != 1 {
+			return nil, p.errorf("getCollection requires the collection name")
+		}
+		collection, ok := args[0].(string)
+		if !ok {
+			return nil, p.errorf("getCollection requires the collection name")
+		}
+		statement.collection = collection
+	} else {
+		statement.collection = name
+	}
+
+	if err := p.expect('.'); err != nil {
+		return nil, err
+	}
+	if statement.method, err = p.parseIdentifier(); err != nil {
+		return nil, err
+	}
+	if !supportedCollectionMethods[statement.method] {
+		return nil, p.errorf("unsupported method %q", statement.method)
+	}
+	if statement.args, err = p.parseArguments(); err != nil {
+		return nil, err
+	}
+
+	for {
+		p.skipSpaces()
+		if p.peek() != '.' {
+			break
+		}
+		p.pos++
+		name, err := p.parseIdentifier()
+		if err != nil {
+			return nil, err
+		}
+		if !supportedCursorMethods[name] {
+			return nil, p.errorf("unsupported cursor method %q", name)
+		}
+		args, err := p.parseArguments()
+		if err != nil {
+			return nil, err
+		}
+		statement.cursorMethods = append(statement.cursorMethods, &mongoMethodCall{name: name, args: args})
+	}
+	statement.text = strings.TrimSpace(string(p.s[start:p.pos]))
+
+	// The statement must be followed by a semicolon, a new line or the end of the script.
+	for !p.eof() && (p.peek() == ' ' || p.peek() == '\t' || p.peek() == '\r') {
+		p.pos++
+	}
+	if !p.eof() && p.peek() != ';' && p.peek() != '\n' && !p.hasPrefix("//") && !p.hasPrefix("/*") {
+		return nil, p.errorf("unexpected %q after statement", p.peek())
+	}
+	return statement, nil
+}
+
+// parseArguments parses the parenthesized arguments.
+func (p *mongoParser) parseArguments() (bson.A, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	args := bson.A{}
+	for {
+		p.skipSpaces()
+		if p.peek() == ')' {
+			p.pos++
+			return args, nil
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, value)
+		p.skipSpaces()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ')':
+		default:
+			return nil, p.errorf("expect ',' or ')'")
+		}
+	}
+}
+
+func (p *mongoParser) parseValue() (any, error) {
+	p.skipSpaces()
+	r := p.peek()
+	switch {
+	case r == '{':
+		return p.parseObject()
+	case r == '[':
+		return p.parseArray()
+	case r == '"' || r == '\'':
+		return p.parseString()
+	case r == '-' || r == '+' || r == '.' || unicode.IsDigit(r):
+		return p.parseNumber()
+	case r == '/':
+		return p.parseRegex()
+	case isIdentifierStart(r):
+		return p.parseIdentifierValue()
+	default:
+		return nil, p.errorf("unexpected %q", r)
+	}
+}
+
+func (p *mongoParser) parseObject() (bson.D, error) {
+	if err := p.expect('{'); err != nil {
+		return nil, err
+	}
+	object := bson.D{}
+	for {
+		p.skipSpaces()
+		if p.peek() == '}' {
+			p.pos++
+			return object, nil
+		}
+		var key string
+		var err error
+		switch r := p.peek(); {
+		case r == '"' || r == '\'':
+			key, err = p.parseString()
+		case unicode.IsDigit(r):
+			start := p.pos
+			for !p.eof() && unicode.IsDigit(p.peek()) {
+				p.pos++
+			}
+			key = string(p.s[start:p.pos])
+		default:
+			key, err = p.parseIdentifier()
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(':'); err != nil {
+			return nil, err
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		object = append(object, bson.E{Key: key, Value: value})
+		p.skipSpaces()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case '}':
+		default:
+			return nil, p.errorf("expect ',' or '}'")
+		}
+	}
+}
+
+func (p *mongoParser) parseArray() (bson.A, error) {
+	if err := p.expect('['); err != nil {
+		return nil, err
+	}
+	array := bson.A{}
+	for {
+		p.skipSpaces()
+		if p.peek() == ']' {
+			p.pos++
+			return array, nil
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		array = append(array, value)
+		p.skipSpaces()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ']':
+		default:
+			return nil, p.errorf("expect ',' or ']'")
+		}
+	}
+}
+
+func (p *mongoParser) parseString() (string, error) {
+	p.skipSpaces()
+	quote := p.peek()
+	p.pos++
+	var buf strings.Builder
+	for !p.eof() {
+		r := p.peek()
+		p.pos++
+		switch r {
+		case quote:
+			return buf.String(), nil
+		case '\\':
+			if p.eof() {
+				return "", p.errorf("unterminated string")
+			}
+			escaped := p.peek()
+			p.pos++
+			switch escaped {
+			case 'n':
+				_, _ = buf.WriteRune('\n')
+			case 't':
+				_, _ = buf.WriteRune('\t')
+			case 'r':
+				_, _ = buf.WriteRune('\r')
+			case 'b':
+				_, _ = buf.WriteRune('\b')
+			case 'f':
+				_, _ = buf.WriteRune('\f')
+			case '0':
+				_, _ = buf.WriteRune(0)
+			case 'u':
+				if p.pos+4 > len(p.s) {
+					return "", p.errorf("invalid unicode escape")
+				}
+				code, err := strconv.ParseUint(string(p.s[p.pos:p.pos+4]), 16, 32)
+				if err != nil {
+					return "", p.errorf("invalid unicode escape")
+				}
+				p.pos += 4
+				_, _ = buf.WriteRune(rune(code))
+			default:
+				_, _ = buf.WriteRune(escaped)
+			}
+		default:
+			_, _ = buf.WriteRune(r)
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+// parseNumber parses the number like mongosh, the integers in the int32 range are Int32, otherwise Double.
+func (p *mongoParser) parseNumber() (any, error) {
+	start := p.pos
+	if p.peek() == '-' || p.peek() == '+' {
+		p.pos++
+	}
+	for !p.eof() && (unicode.IsDigit(p.peek()) || strings.ContainsRune(".eE", p.peek()) || ((p.peek() == '-' || p.peek() == '+') && strings.ContainsRune("eE", p.s[p.pos-1]))) {
+		p.pos++
+	}
+	text := string(p.s[start:p.pos])
+	if i, err := strconv.ParseInt(text, 10, 32); err == nil {
+		return int32(i), nil
+	}
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return nil, p.errorf("invalid number %q", text)
+	}
+	return f, nil
+}
+
+func (p *mongoParser) parseRegex() (primitive.Regex, error) {
+	p.pos++
+	var buf strings.Builder
+	inClass := false
+	for {
+		if p.eof() || p.peek() == '\n' {
+			return primitive.Regex{}, p.errorf("unterminated regular expression")
+		}
+		r := p.peek()
+		p.pos++
+		if r == '\\' && !p.eof() {
+			_, _ = buf.WriteRune(r)
+			_, _ = buf.WriteRune(p.peek())
+			p.pos++
+			continue
+		}
+		if r == '[' {
+			inClass = true
+		} else if r == ']' {
+			inClass = false
+		} else if r == '/' && !inClass {
+			break
+		}
+		_, _ = buf.WriteRune(r)
+	}
+	start := p.pos
+	for !p.eof() && unicode.IsLetter(p.peek()) {
+		p.pos++
+	}
+	return primitive.Regex{Pattern: buf.String(), Options: string(p.s[start:p.pos])}, nil
+}
+
+// parseIdentifierValue parses the literals such as true and null, and the constructors such as ObjectId("...").
+func (p *mongoParser) parseIdentifierValue() (any, error) {
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+	switch name {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	case "undefined":
+		return primitive.Undefined{}, nil
+	case "MinKey":
+		p.skipOptionalCall()
+		return primitive.MinKey{}, nil
+	case "MaxKey":
+		p.skipOptionalCall()
+		return primitive.MaxKey{}, nil
+	case "new":
+		return p.parseIdentifierValue()
+	}
+
+	args, err := p.parseArguments()
+	if err != nil {
+		return nil, err
+	}
+	stringArg := func() (string, error) {
+		if len(args) != 1 {
+			return "", p.errorf("%s requires one argument", name)
+		}
+		switch arg := args[0].(type) {
+		case string:
+			return arg, nil
+		case int32:
+			return strconv.FormatInt(int64(arg), 10), nil
+		case float64:
+			return strconv.FormatFloat(arg, 'f', -1, 64), nil
+		default:
+			return "", p.errorf("invalid argument of %s", name)
+		}
+	}
+	switch name {
+	case "ObjectId", "ObjectID":
+		if len(args) == 0 {
+			return primitive.NewObjectID(), nil
+		}
+		s, err := stringArg()
+		if err != nil {
+			return nil, err
+		}
+		id, err := primitive.ObjectIDFromHex(s)
+		if err != nil {
+			return nil, p.errorf("invalid ObjectId %q", s)
+		}
+		return id, nil
+	case "ISODate", "Date":
+		if len(args) == 0 {
+			return primitive.NewDateTimeFromTime(time.Now()), nil
+		}
+		if millis, ok := args[0].(float64); ok {
+			return primitive.DateTime(int64(millis)), nil
+		}
+		if millis, ok := args[0].(int32); ok {
+			return primitive.DateTime(int64(millis)), nil
+		}
+		s, err := stringArg()
+		if err != nil {
+			return nil, err
+		}
+		t, err := parseISODate(s)
+		if err != nil {
+			return nil, p.errorf("invalid date %q", s)
+		}
+		return primitive.NewDateTimeFromTime(t), nil
+	case "NumberInt", "Int32":
+		s, err := stringArg()
+		if err != nil {
+			return nil, err
+		}
+		i, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return nil, p.errorf("invalid NumberInt %q", s)
+		}
+		return int32(i), nil
+	case "NumberLong", "Long":
+		s, err := stringArg()
+		if err != nil {
+			return nil, err
+		}
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, p.errorf("invalid NumberLong %q", s)
+		}
+		return i, nil
+	case "NumberDecimal", "Decimal128":
+		s, err := stringArg()
+		if err != nil {
+			return nil, err
+		}
+		d, err := primitive.ParseDecimal128(s)
+		if err != nil {
+			return nil, p.errorf("invalid NumberDecimal %q", s)
+		}
+		return d, nil
+	case "Timestamp":
+		if len(args) != 2 {
+			return nil, p.errorf("Timestamp requires two arguments")
+		}
+		t, err := toInt64(args[0])
+		if err != nil {
+			return nil, p.errorf("invalid Timestamp")
+		}
+		i, err := toInt64(args[1])
+		if err != nil {
+			return nil, p.errorf("invalid Timestamp")
+		}
+		return primitive.Timestamp{T: uint32(t), I: uint32(i)}, nil
+	default:
+		return nil, p.errorf("unsupported constructor %q", name)
+	}
+}
+
+func (p *mongoParser) skipOptionalCall() {
+	p.skipSpaces()
+	if p.hasPrefix("()") {
+		p.pos += 2
+	}
+}
+
+var isoDateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// parseISODate parses the date string, the date without the time zone is in UTC like mongosh.
+func parseISODate(s string) (time.Time, error) {
+	for _, layout := range isoDateLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.Errorf("invalid date %q", s)
+}
+
+// toInt64 converts the numeric BSON value to int64.
+func toInt64(v any) (int64, error) {
+	switch n := v.(type) {
+	case int32:
+		return int64(n), nil
+	case int64:
+		return n, nil
+	case float64:
+		if n != float64(int64(n)) {
+			return 0, errors.Errorf("%v is not an integer", n)
+		}
+		return int64(n), nil
+	default:
+		return 0, errors.Errorf("%v is not a number", v)
+	}
+}
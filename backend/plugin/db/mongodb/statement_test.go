@@ -0,0 +1,129 @@
+package mongodb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestParseMongoStatements(t *testing.T) {
+	objectID, err := primitive.ObjectIDFromHex("64c0b8c4e65c51195e0584b2")
+	require.NoError(t, err)
+
+	tests := []struct {
+		script string
+		want   []*mongoStatement
+	}{
+		{
+			script: `db.users.find({age: {$gt: 18}, name: 'danny'}, {_id: 0}).sort({age: -1}).limit(10)`,
+			want: []*mongoStatement{{
+				text:       `db.users.find({age: {$gt: 18}, name: 'danny'}, {_id: 0}).sort({age: -1}).limit(10)`,
+				collection: "users",
+				method:     "find",
+				args: bson.A{
+					bson.D{{Key: "age", Value: bson.D{{Key: "$gt", Value: int32(18)}}}, {Key: "name", Value: "danny"}},
+					bson.D{{Key: "_id", Value: int32(0)}},
+				},
+				cursorMethods: []*mongoMethodCall{
+					{name: "sort", args: bson.A{bson.D{{Key: "age", Value: int32(-1)}}}},
+					{name: "limit", args: bson.A{int32(10)}},
+				},
+			}},
+		},
+		{
+			script: `db.getCollection("my-coll").insertOne({_id: ObjectId("64c0b8c4e65c51195e0584b2"), "score": 1.5, big: NumberLong("9007199254740993"), created: ISODate("2024-01-02T03:04:05Z"), tags: ["a", "b",], re: /^ab\/c/i, none: null});
+// comment
+db.users.deleteMany({})`,
+			want: []*mongoStatement{
+				{
+					text:       `db.getCollection("my-coll").insertOne({_id: ObjectId("64c0b8c4e65c51195e0584b2"), "score": 1.5, big: NumberLong("9007199254740993"), created: ISODate("2024-01-02T03:04:05Z"), tags: ["a", "b",], re: /^ab\/c/i, none: null})`,
+					collection: "my-coll",
+					method:     "insertOne",
+					args: bson.A{bson.D{
+						{Key: "_id", Value: objectID},
+						{Key: "score", Value: 1.5},
+						{Key: "big", Value: int64(9007199254740993)},
+						{Key: "created", Value: primitive.NewDateTimeFromTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))},
+						{Key: "tags", Value: bson.A{"a", "b"}},
+						{Key: "re", Value: primitive.Regex{Pattern: `^ab\/c`, Options: "i"}},
+						{Key: "none", Value: nil},
+					}},
+				},
+				{
+					text:       `db.users.deleteMany({})`,
+					collection: "users",
+					method:     "deleteMany",
+					args:       bson.A{bson.D{}},
+				},
+			},
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := parseMongoStatements(test.script)
+		a.NoError(err, test.script)
+		a.Equal(test.want, got, test.script)
+	}
+}
+
+func TestParseMongoStatementsUnsupported(t *testing.T) {
+	tests := []string{
+		`show collections`,
+		`db.users.find().forEach(printjson)`,
+		`db.users.renameCollection("people")`,
+		`db.users.find({a: 1}) db.users.find()`,
+		`var a = 1; db.users.find({a: a})`,
+		`db.users.find({a: 1`,
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		_, err := parseMongoStatements(test)
+		a.Error(err, test)
+	}
+}
+
+func TestConvertDocumentsToQueryResult(t *testing.T) {
+	objectID, err := primitive.ObjectIDFromHex("64c0b8c4e65c51195e0584b2")
+	require.NoError(t, err)
+	documents := []bson.D{
+		{
+			{Key: "_id", Value: objectID},
+			{Key: "name", Value: "danny"},
+			{Key: "age", Value: int32(13)},
+			{Key: "tree", Value: bson.D{{Key: "a", Value: "a"}, {Key: "b", Value: int32(1)}}},
+		},
+		{
+			{Key: "_id", Value: objectID},
+			{Key: "age", Value: 13.5},
+			{Key: "groups", Value: bson.A{"basketball", int64(2)}},
+		},
+	}
+
+	a := require.New(t)
+	result, err := convertDocumentsToQueryResult(documents)
+	a.NoError(err)
+	a.Equal([]string{"_id", "age", "groups", "name", "tree"}, result.ColumnNames)
+	a.Equal([]string{"OBJECTID", "MIXED", "ARRAY", "STRING", "DOCUMENT"}, result.ColumnTypeNames)
+	a.Len(result.Rows, 2)
+	a.Equal([]*v1pb.RowValue{
+		{Kind: &v1pb.RowValue_StringValue{StringValue: "64c0b8c4e65c51195e0584b2"}},
+		{Kind: &v1pb.RowValue_Int32Value{Int32Value: 13}},
+		{Kind: &v1pb.RowValue_NullValue{}},
+		{Kind: &v1pb.RowValue_StringValue{StringValue: "danny"}},
+		{Kind: &v1pb.RowValue_StringValue{StringValue: `{"a":"a","b":1}`}},
+	}, result.Rows[0].Values)
+	a.Equal([]*v1pb.RowValue{
+		{Kind: &v1pb.RowValue_StringValue{StringValue: "64c0b8c4e65c51195e0584b2"}},
+		{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: 13.5}},
+		{Kind: &v1pb.RowValue_StringValue{StringValue: `["basketball",2]`}},
+		{Kind: &v1pb.RowValue_NullValue{}},
+		{Kind: &v1pb.RowValue_NullValue{}},
+	}, result.Rows[1].Values)
+}
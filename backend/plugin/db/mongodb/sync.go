@@ -99,6 +99,10 @@ func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchema
 		return nil, errors.Wrap(err, "failed to list collection names")
 	}
 	sort.Strings(collectionList)
+	collectionOptions, err := getCollectionOptions(ctx, database)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, collectionName := range collectionList {
 		if systemCollection[collectionName] {
@@ -141,12 +145,37 @@ func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchema
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to get index schema of collection %s", collectionName)
 		}
+		// Infer the columns from the sampled documents and the $jsonSchema validator.
+		documents, err := sampleDocuments(ctx, collection)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to sample documents of collection %s", collectionName)
+		}
+		inferrer := newSchemaInferrer()
+		for _, document := range documents {
+			inferrer.addDocument(document)
+		}
+		columns := inferrer.columns()
+		var createOptions string
+		if validationOptions := getValidationOptions(collectionOptions[collectionName]); len(validationOptions) > 0 {
+			if validator, ok := getOption(validationOptions, "validator").(bson.D); ok {
+				if jsonSchema, ok := getOption(validator, "$jsonSchema").(bson.D); ok {
+					columns = addJSONSchemaColumns(columns, jsonSchema)
+				}
+			}
+			bytes, err := bson.MarshalExtJSON(validationOptions, false, false)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to marshal validator of collection %s", collectionName)
+			}
+			createOptions = string(bytes)
+		}
 		schemaMetadata.Tables = append(schemaMetadata.Tables, &storepb.TableMetadata{
-			Name:      collectionName,
-			RowCount:  count,
-			DataSize:  dataSize64,
-			IndexSize: totalIndexSize64,
-			Indexes:   indexes,
+			Name:          collectionName,
+			Columns:       columns,
+			RowCount:      count,
+			DataSize:      dataSize64,
+			IndexSize:     totalIndexSize64,
+			Indexes:       indexes,
+			CreateOptions: createOptions,
 		})
 	}
 
@@ -154,8 +183,18 @@ func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchema
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to list view names")
 	}
+	sort.Strings(viewList)
 	for _, viewName := range viewList {
-		schemaMetadata.Views = append(schemaMetadata.Views, &storepb.ViewMetadata{Name: viewName})
+		view := &storepb.ViewMetadata{Name: viewName}
+		if opts := collectionOptions[viewName]; len(opts) > 0 {
+			// The definition is the viewOn and pipeline options in the extended JSON.
+			bytes, err := bson.MarshalExtJSON(opts, false, false)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to marshal definition of view %s", viewName)
+			}
+			view.Definition = string(bytes)
+		}
+		schemaMetadata.Views = append(schemaMetadata.Views, view)
 	}
 
 	return &storepb.DatabaseSchemaMetadata{
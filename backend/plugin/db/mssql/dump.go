@@ -2,12 +2,56 @@ package mssql
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
 )
 
 // Dump dumps the database.
-func (*Driver) Dump(_ context.Context, _ io.Writer, _ bool) (string, error) {
-	// TODO(d): implement it.
+// The tables, including the columns, the constraints and the indexes, are dumped first, followed by the views, the functions and the procedures.
+// Each view, function and procedure is in its own batch separated by GO.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer, _ bool) (string, error) {
+	// The driver doesn't support the read-only transactions.
+	txn, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer txn.Rollback()
+
+	tables, err := getDumpTables(ctx, txn)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to dump tables from database %q", driver.databaseName)
+	}
+	modules, err := getDumpModules(ctx, txn)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to dump views, functions and procedures from database %q", driver.databaseName)
+	}
+	if err := txn.Commit(); err != nil {
+		return "", err
+	}
+
+	for _, table := range tables {
+		if _, err := io.WriteString(out, table.String()); err != nil {
+			return "", err
+		}
+	}
+	if len(tables) > 0 && len(modules) > 0 {
+		if _, err := io.WriteString(out, "GO\n\n"); err != nil {
+			return "", err
+		}
+	}
+	for _, module := range modules {
+		if _, err := fmt.Fprintf(out, "%s\nGO\n\n", module); err != nil {
+			return "", err
+		}
+	}
+	// TODO(d): dump the data.
 	return "", nil
 }
 
@@ -16,3 +60,479 @@ func (*Driver) Restore(_ context.Context, _ io.Reader) (err error) {
 	// TODO(d): implement it.
 	return nil
 }
+
+type dumpTable struct {
+	schema      string
+	name        string
+	columns     []*dumpColumn
+	constraints []*dumpConstraint
+	foreignKeys []*dumpConstraint
+	indexes     []*dumpIndex
+}
+
+type dumpColumn struct {
+	name         string
+	typ          string
+	computed     string
+	persisted    bool
+	nullable     bool
+	identity     string
+	collation    string
+	defaultName  string
+	defaultValue string
+}
+
+type dumpConstraint struct {
+	name       string
+	definition string
+}
+
+type dumpIndex struct {
+	name      string
+	unique    bool
+	clustered bool
+	columns   []string
+	included  []string
+	filter    string
+}
+
+// String returns the CREATE TABLE statement followed by the CREATE INDEX statements of the table.
+func (t *dumpTable) String() string {
+	var buf strings.Builder
+	tableName := fmt.Sprintf("%s.%s", quoteIdentifier(t.schema), quoteIdentifier(t.name))
+	_, _ = fmt.Fprintf(&buf, "CREATE TABLE %s (\n", tableName)
+	var items []string
+	for _, column := range t.columns {
+		items = append(items, column.String())
+	}
+	for _, constraint := range t.constraints {
+		items = append(items, fmt.Sprintf("CONSTRAINT %s %s", quoteIdentifier(constraint.name), constraint.definition))
+	}
+	for _, fk := range t.foreignKeys {
+		items = append(items, fmt.Sprintf("CONSTRAINT %s %s", quoteIdentifier(fk.name), fk.definition))
+	}
+	for i, item := range items {
+		_, _ = buf.WriteString("    ")
+		_, _ = buf.WriteString(item)
+		if i != len(items)-1 {
+			_, _ = buf.WriteString(",")
+		}
+		_, _ = buf.WriteString("\n")
+	}
+	_, _ = buf.WriteString(");\n\n")
+
+	for _, index := range t.indexes {
+		_, _ = buf.WriteString("CREATE ")
+		if index.unique {
+			_, _ = buf.WriteString("UNIQUE ")
+		}
+		if index.clustered {
+			_, _ = buf.WriteString("CLUSTERED ")
+		} else {
+			_, _ = buf.WriteString("NONCLUSTERED ")
+		}
+		_, _ = fmt.Fprintf(&buf, "INDEX %s ON %s (%s)", quoteIdentifier(index.name), tableName, strings.Join(index.columns, ", "))
+		if len(index.included) > 0 {
+			_, _ = fmt.Fprintf(&buf, " INCLUDE (%s)", strings.Join(index.included, ", "))
+		}
+		if index.filter != "" {
+			_, _ = fmt.Fprintf(&buf, " WHERE %s", index.filter)
+		}
+		_, _ = buf.WriteString(";\n\n")
+	}
+	return buf.String()
+}
+
+func (c *dumpColumn) String() string {
+	if c.computed != "" {
+		s := fmt.Sprintf("%s AS %s", quoteIdentifier(c.name), c.computed)
+		if c.persisted {
+			s += " PERSISTED"
+		}
+		return s
+	}
+	parts := []string{quoteIdentifier(c.name), c.typ}
+	if c.collation != "" {
+		parts = append(parts, "COLLATE", c.collation)
+	}
+	if c.identity != "" {
+		parts = append(parts, c.identity)
+	}
+	if c.nullable {
+		parts = append(parts, "NULL")
+	} else {
+		parts = append(parts, "NOT NULL")
+	}
+	if c.defaultValue != "" {
+		parts = append(parts, "CONSTRAINT", quoteIdentifier(c.defaultName), "DEFAULT", c.defaultValue)
+	}
+	return strings.Join(parts, " ")
+}
+
+func quoteIdentifier(s string) string {
+	return fmt.Sprintf("[%s]", strings.ReplaceAll(s, "]", "]]"))
+}
+
+// getColumnType returns the column type in the CREATE TABLE form from the sys.columns attributes.
+func getColumnType(typ string, maxLength, precision, scale int) string {
+	switch strings.ToLower(typ) {
+	case "varchar", "char", "varbinary", "binary":
+		if maxLength == -1 {
+			return fmt.Sprintf("%s(max)", typ)
+		}
+		return fmt.Sprintf("%s(%d)", typ, maxLength)
+	case "nvarchar", "nchar":
+		// The max_length of the unicode types is in bytes.
+		if maxLength == -1 {
+			return fmt.Sprintf("%s(max)", typ)
+		}
+		return fmt.Sprintf("%s(%d)", typ, maxLength/2)
+	case "decimal", "numeric":
+		return fmt.Sprintf("%s(%d,%d)", typ, precision, scale)
+	case "datetime2", "datetimeoffset", "time":
+		return fmt.Sprintf("%s(%d)", typ, scale)
+	default:
+		return typ
+	}
+}
+
+// getDumpModules returns the terminated definitions of the views, the functions and the procedures in the creation order.
+// The encrypted modules are skipped because their definitions are not available.
+func getDumpModules(ctx context.Context, txn *sql.Tx) ([]string, error) {
+	query := `
+		SELECT ISNULL(m.definition, '')
+		FROM sys.sql_modules m
+		INNER JOIN sys.objects o ON o.object_id = m.object_id
+		WHERE o.is_ms_shipped = 0 AND o.type IN ('V', 'FN', 'IF', 'TF', 'P')
+		ORDER BY o.create_date, o.object_id;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	var modules []string
+	for rows.Next() {
+		var definition string
+		if err := rows.Scan(&definition); err != nil {
+			return nil, err
+		}
+		definition = strings.TrimSpace(definition)
+		if definition == "" {
+			continue
+		}
+		// Terminate the definition, otherwise the following GO could be parsed as a part of it.
+		if !strings.HasSuffix(definition, ";") {
+			definition += ";"
+		}
+		modules = append(modules, definition)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return modules, nil
+}
+
+func getDumpTables(ctx context.Context, txn *sql.Tx) ([]*dumpTable, error) {
+	tableMap := make(map[int]*dumpTable)
+	var tableIDs []int
+	query := `
+		SELECT t.object_id, SCHEMA_NAME(t.schema_id), t.name
+		FROM sys.tables t
+		WHERE t.is_ms_shipped = 0
+		ORDER BY 2, 3;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id int
+		table := &dumpTable{}
+		if err := rows.Scan(&id, &table.schema, &table.name); err != nil {
+			return nil, err
+		}
+		tableMap[id] = table
+		tableIDs = append(tableIDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+
+	if err := dumpColumns(ctx, txn, tableMap); err != nil {
+		return nil, errors.Wrap(err, "failed to dump columns")
+	}
+	if err := dumpIndexes(ctx, txn, tableMap); err != nil {
+		return nil, errors.Wrap(err, "failed to dump indexes")
+	}
+	if err := dumpForeignKeys(ctx, txn, tableMap); err != nil {
+		return nil, errors.Wrap(err, "failed to dump foreign keys")
+	}
+	if err := dumpCheckConstraints(ctx, txn, tableMap); err != nil {
+		return nil, errors.Wrap(err, "failed to dump check constraints")
+	}
+
+	var tables []*dumpTable
+	for _, id := range tableIDs {
+		tables = append(tables, tableMap[id])
+	}
+	return tables, nil
+}
+
+func dumpColumns(ctx context.Context, txn *sql.Tx, tableMap map[int]*dumpTable) error {
+	query := `
+		SELECT
+			c.object_id,
+			c.name,
+			TYPE_NAME(c.user_type_id),
+			c.max_length,
+			c.precision,
+			c.scale,
+			c.is_nullable,
+			CASE WHEN c.collation_name <> CONVERT(sysname, DATABASEPROPERTYEX(DB_NAME(), 'Collation')) THEN c.collation_name ELSE '' END,
+			ISNULL(CONVERT(bigint, ic.seed_value), 0),
+			ISNULL(CONVERT(bigint, ic.increment_value), 0),
+			c.is_identity,
+			ISNULL(cc.definition, ''),
+			ISNULL(cc.is_persisted, 0),
+			ISNULL(dc.name, ''),
+			ISNULL(dc.definition, '')
+		FROM sys.columns c
+		INNER JOIN sys.tables t ON t.object_id = c.object_id
+		LEFT JOIN sys.identity_columns ic ON ic.object_id = c.object_id AND ic.column_id = c.column_id
+		LEFT JOIN sys.computed_columns cc ON cc.object_id = c.object_id AND cc.column_id = c.column_id
+		LEFT JOIN sys.default_constraints dc ON dc.parent_object_id = c.object_id AND dc.parent_column_id = c.column_id
+		WHERE t.is_ms_shipped = 0
+		ORDER BY c.object_id, c.column_id;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, maxLength, precision, scale int
+		var seed, increment int64
+		var isIdentity bool
+		var typ string
+		column := &dumpColumn{}
+		if err := rows.Scan(&id, &column.name, &typ, &maxLength, &precision, &scale, &column.nullable, &column.collation, &seed, &increment, &isIdentity, &column.computed, &column.persisted, &column.defaultName, &column.defaultValue); err != nil {
+			return err
+		}
+		table, ok := tableMap[id]
+		if !ok {
+			continue
+		}
+		column.typ = getColumnType(typ, maxLength, precision, scale)
+		if isIdentity {
+			column.identity = fmt.Sprintf("IDENTITY(%d,%d)", seed, increment)
+		}
+		table.columns = append(table.columns, column)
+	}
+	return rows.Err()
+}
+
+// dumpIndexes dumps the primary keys, the unique constraints and the rowstore indexes.
+func dumpIndexes(ctx context.Context, txn *sql.Tx, tableMap map[int]*dumpTable) error {
+	query := `
+		SELECT
+			i.object_id,
+			i.name,
+			i.is_primary_key,
+			i.is_unique_constraint,
+			i.is_unique,
+			i.type,
+			ISNULL(i.filter_definition, ''),
+			COL_NAME(ic.object_id, ic.column_id),
+			ic.is_descending_key,
+			ic.is_included_column
+		FROM sys.indexes i
+		INNER JOIN sys.tables t ON t.object_id = i.object_id
+		INNER JOIN sys.index_columns ic ON ic.object_id = i.object_id AND ic.index_id = i.index_id
+		WHERE t.is_ms_shipped = 0 AND i.type IN (1, 2) AND i.is_hypothetical = 0
+		ORDER BY i.object_id, i.index_id, ic.is_included_column, ic.key_ordinal, ic.index_column_id;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	type indexKey struct {
+		id   int
+		name string
+	}
+	indexMap := make(map[indexKey]*dumpIndex)
+	constraintMap := make(map[indexKey]*dumpConstraint)
+	var keys []indexKey
+	for rows.Next() {
+		var id, typ int
+		var name, filter, column string
+		var primary, uniqueConstraint, unique, descending, included bool
+		if err := rows.Scan(&id, &name, &primary, &uniqueConstraint, &unique, &typ, &filter, &column, &descending, &included); err != nil {
+			return err
+		}
+		key := indexKey{id: id, name: name}
+		index, ok := indexMap[key]
+		if !ok {
+			index = &dumpIndex{name: name, unique: unique, clustered: typ == 1, filter: filter}
+			indexMap[key] = index
+			keys = append(keys, key)
+			if primary || uniqueConstraint {
+				constraintMap[key] = &dumpConstraint{name: name}
+				if primary {
+					constraintMap[key].definition = "PRIMARY KEY"
+				} else {
+					constraintMap[key].definition = "UNIQUE"
+				}
+			}
+		}
+		column = quoteIdentifier(column)
+		if included {
+			index.included = append(index.included, column)
+			continue
+		}
+		if descending {
+			column += " DESC"
+		}
+		index.columns = append(index.columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+
+	for _, key := range keys {
+		table, ok := tableMap[key.id]
+		if !ok {
+			continue
+		}
+		index := indexMap[key]
+		if constraint, ok := constraintMap[key]; ok {
+			clustered := "NONCLUSTERED"
+			if index.clustered {
+				clustered = "CLUSTERED"
+			}
+			constraint.definition = fmt.Sprintf("%s %s (%s)", constraint.definition, clustered, strings.Join(index.columns, ", "))
+			table.constraints = append(table.constraints, constraint)
+			continue
+		}
+		table.indexes = append(table.indexes, index)
+	}
+	return nil
+}
+
+func dumpForeignKeys(ctx context.Context, txn *sql.Tx, tableMap map[int]*dumpTable) error {
+	query := `
+		SELECT
+			fk.parent_object_id,
+			fk.name,
+			OBJECT_SCHEMA_NAME(fk.referenced_object_id),
+			OBJECT_NAME(fk.referenced_object_id),
+			fk.delete_referential_action_desc,
+			fk.update_referential_action_desc,
+			COL_NAME(fkc.parent_object_id, fkc.parent_column_id),
+			COL_NAME(fkc.referenced_object_id, fkc.referenced_column_id)
+		FROM sys.foreign_keys fk
+		INNER JOIN sys.foreign_key_columns fkc ON fkc.constraint_object_id = fk.object_id
+		ORDER BY fk.parent_object_id, fk.name, fkc.constraint_column_id;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	type foreignKey struct {
+		id               int
+		name             string
+		referencedTable  string
+		onDelete         string
+		onUpdate         string
+		columns          []string
+		referencedColumn []string
+	}
+	var foreignKeys []*foreignKey
+	for rows.Next() {
+		var id int
+		var name, referencedSchema, referencedTable, onDelete, onUpdate, column, referencedColumn string
+		if err := rows.Scan(&id, &name, &referencedSchema, &referencedTable, &onDelete, &onUpdate, &column, &referencedColumn); err != nil {
+			return err
+		}
+		if len(foreignKeys) == 0 || foreignKeys[len(foreignKeys)-1].id != id || foreignKeys[len(foreignKeys)-1].name != name {
+			foreignKeys = append(foreignKeys, &foreignKey{
+				id:              id,
+				name:            name,
+				referencedTable: fmt.Sprintf("%s.%s", quoteIdentifier(referencedSchema), quoteIdentifier(referencedTable)),
+				onDelete:        strings.ReplaceAll(onDelete, "_", " "),
+				onUpdate:        strings.ReplaceAll(onUpdate, "_", " "),
+			})
+		}
+		fk := foreignKeys[len(foreignKeys)-1]
+		fk.columns = append(fk.columns, quoteIdentifier(column))
+		fk.referencedColumn = append(fk.referencedColumn, quoteIdentifier(referencedColumn))
+	}
+	if err := rows.Err(); err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+
+	for _, fk := range foreignKeys {
+		table, ok := tableMap[fk.id]
+		if !ok {
+			continue
+		}
+		definition := fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s (%s)", strings.Join(fk.columns, ", "), fk.referencedTable, strings.Join(fk.referencedColumn, ", "))
+		if fk.onDelete != "NO ACTION" {
+			definition += " ON DELETE " + fk.onDelete
+		}
+		if fk.onUpdate != "NO ACTION" {
+			definition += " ON UPDATE " + fk.onUpdate
+		}
+		table.foreignKeys = append(table.foreignKeys, &dumpConstraint{name: fk.name, definition: definition})
+	}
+	return nil
+}
+
+func dumpCheckConstraints(ctx context.Context, txn *sql.Tx, tableMap map[int]*dumpTable) error {
+	query := `
+		SELECT parent_object_id, name, definition
+		FROM sys.check_constraints
+		ORDER BY parent_object_id, name;`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	var checks []*dumpConstraint
+	var ids []int
+	for rows.Next() {
+		var id int
+		check := &dumpConstraint{}
+		if err := rows.Scan(&id, &check.name, &check.definition); err != nil {
+			return err
+		}
+		check.definition = fmt.Sprintf("CHECK %s", check.definition)
+		checks = append(checks, check)
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	for i, check := range checks {
+		if table, ok := tableMap[ids[i]]; ok {
+			table.constraints = append(table.constraints, check)
+		}
+	}
+	for _, table := range tableMap {
+		sort.SliceStable(table.constraints, func(i, j int) bool {
+			return constraintOrder(table.constraints[i]) < constraintOrder(table.constraints[j])
+		})
+	}
+	return nil
+}
+
+func constraintOrder(constraint *dumpConstraint) int {
+	switch {
+	case strings.HasPrefix(constraint.definition, "PRIMARY KEY"):
+		return 0
+	case strings.HasPrefix(constraint.definition, "UNIQUE"):
+		return 1
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,152 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCatalog is a database/sql driver that answers the catalog queries of Dump.
+// It doesn't implement driver.ConnBeginTx, so the transactions with the options fail like the real driver.
+type fakeCatalog struct {
+	// rows is the result rows keyed by a distinct substring of the query.
+	rows map[string][][]driver.Value
+}
+
+func (c *fakeCatalog) Open(string) (driver.Conn, error) {
+	return &fakeCatalogConn{catalog: c}, nil
+}
+
+type fakeCatalogConn struct {
+	catalog *fakeCatalog
+}
+
+func (*fakeCatalogConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (*fakeCatalogConn) Close() error {
+	return nil
+}
+
+func (*fakeCatalogConn) Begin() (driver.Tx, error) {
+	return fakeCatalogTx{}, nil
+}
+
+func (c *fakeCatalogConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	for key, values := range c.catalog.rows {
+		if strings.Contains(query, key) {
+			return &fakeCatalogRows{values: values}, nil
+		}
+	}
+	return nil, errors.Errorf("unexpected query %q", query)
+}
+
+type fakeCatalogTx struct{}
+
+func (fakeCatalogTx) Commit() error {
+	return nil
+}
+
+func (fakeCatalogTx) Rollback() error {
+	return nil
+}
+
+type fakeCatalogRows struct {
+	values [][]driver.Value
+}
+
+func (r *fakeCatalogRows) Columns() []string {
+	if len(r.values) == 0 {
+		// The number of columns doesn't matter for the empty result.
+		return []string{"c"}
+	}
+	return make([]string, len(r.values[0]))
+}
+
+func (*fakeCatalogRows) Close() error {
+	return nil
+}
+
+func (r *fakeCatalogRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func TestDump(t *testing.T) {
+	a := require.New(t)
+	sql.Register("mssql-fake-catalog", &fakeCatalog{
+		rows: map[string][][]driver.Value{
+			"FROM sys.tables t\n\t\tWHERE": {
+				{int64(1), "dbo", "author"},
+				{int64(2), "dbo", "book"},
+			},
+			"FROM sys.columns c": {
+				{int64(1), "id", "int", int64(4), int64(10), int64(0), false, "", int64(1), int64(1), true, "", false, "", ""},
+				{int64(1), "name", "nvarchar", int64(200), int64(0), int64(0), true, "", int64(0), int64(0), false, "", false, "", ""},
+				{int64(2), "id", "int", int64(4), int64(10), int64(0), false, "", int64(0), int64(0), false, "", false, "", ""},
+				{int64(2), "author_id", "int", int64(4), int64(10), int64(0), false, "", int64(0), int64(0), false, "", false, "DF_book_author", "((0))"},
+			},
+			"FROM sys.indexes i": {
+				{int64(1), "PK_author", true, false, true, int64(1), "", "id", false, false},
+				{int64(2), "PK_book", true, false, true, int64(1), "", "id", false, false},
+				{int64(2), "IX_book_author", false, false, false, int64(2), "", "author_id", true, false},
+			},
+			"FROM sys.foreign_keys fk": {
+				{int64(2), "FK_book_author", "dbo", "author", "CASCADE", "NO_ACTION", "author_id", "id"},
+			},
+			"FROM sys.check_constraints": {},
+			"FROM sys.sql_modules m": {
+				{"CREATE VIEW dbo.book_author AS SELECT b.id, a.name FROM dbo.book b JOIN dbo.author a ON a.id = b.author_id"},
+				{""},
+				{"\nCREATE PROCEDURE dbo.get_book @id int AS\nBEGIN\n  SELECT * FROM dbo.book WHERE id = @id;\nEND\n"},
+			},
+		},
+	})
+	db, err := sql.Open("mssql-fake-catalog", "")
+	a.NoError(err)
+	driver := &Driver{db: db, databaseName: "library"}
+	defer driver.Close(context.Background())
+
+	var buf strings.Builder
+	_, err = driver.Dump(context.Background(), &buf, true /* schemaOnly */)
+	a.NoError(err)
+	want := `CREATE TABLE [dbo].[author] (
+    [id] int IDENTITY(1,1) NOT NULL,
+    [name] nvarchar(100) NULL,
+    CONSTRAINT [PK_author] PRIMARY KEY CLUSTERED ([id])
+);
+
+CREATE TABLE [dbo].[book] (
+    [id] int NOT NULL,
+    [author_id] int NOT NULL CONSTRAINT [DF_book_author] DEFAULT ((0)),
+    CONSTRAINT [PK_book] PRIMARY KEY CLUSTERED ([id]),
+    CONSTRAINT [FK_book_author] FOREIGN KEY ([author_id]) REFERENCES [dbo].[author] ([id]) ON DELETE CASCADE
+);
+
+CREATE NONCLUSTERED INDEX [IX_book_author] ON [dbo].[book] ([author_id] DESC);
+
+GO
+
+CREATE VIEW dbo.book_author AS SELECT b.id, a.name FROM dbo.book b JOIN dbo.author a ON a.id = b.author_id;
+GO
+
+CREATE PROCEDURE dbo.get_book @id int AS
+BEGIN
+  SELECT * FROM dbo.book WHERE id = @id;
+END;
+GO
+
+`
+	a.Equal(want, buf.String())
+}
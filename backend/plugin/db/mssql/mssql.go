@@ -11,8 +11,7 @@ import (
 	"strings"
 	"time"
 
-	// Import go-ora Oracle driver.
-	_ "github.com/microsoft/go-mssqldb"
+	mssqldb "github.com/microsoft/go-mssqldb"
 	_ "github.com/microsoft/go-mssqldb/integratedauth/krb5"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -46,7 +45,7 @@ func newDriver(db.DriverConfig) db.Driver {
 }
 
 // Open opens a MSSQL driver.
-func (driver *Driver) Open(_ context.Context, _ storepb.Engine, config db.ConnectionConfig) (db.Driver, error) {
+func (driver *Driver) Open(ctx context.Context, dbType storepb.Engine, config db.ConnectionConfig) (db.Driver, error) {
 	query := url.Values{}
 	query.Add("app name", "Bytebase")
 	if config.Database != "" {
@@ -62,10 +61,25 @@ func (driver *Driver) Open(_ context.Context, _ storepb.Engine, config db.Connec
 		Host:     fmt.Sprintf("%s:%s", config.Host, config.Port),
 		RawQuery: query.Encode(),
 	}
-	db, err := sql.Open("sqlserver", u.String())
+	tokenProvider, err := util.GetTokenProvider(ctx, dbType, config)
 	if err != nil {
 		return nil, err
 	}
+	var db *sql.DB
+	if tokenProvider != nil {
+		// The access token is used instead of the username and password, and it's refreshed for every new connection.
+		u.User = nil
+		connector, err := mssqldb.NewConnectorWithAccessTokenProvider(u.String(), tokenProvider)
+		if err != nil {
+			return nil, err
+		}
+		db = sql.OpenDB(connector)
+	} else {
+		db, err = sql.Open("sqlserver", u.String())
+		if err != nil {
+			return nil, err
+		}
+	}
 	driver.db = db
 	driver.databaseName = config.Database
 	return driver, nil
@@ -190,6 +204,14 @@ func (driver *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement s
 		return nil, nil
 	}
 
+	if queryContext.MaximumExecutionTime > 0 {
+		reset, err := setMaximumExecutionTime(ctx, conn, queryContext.MaximumExecutionTime)
+		if err != nil {
+			return nil, err
+		}
+		defer reset()
+	}
+
 	var results []*v1pb.QueryResult
 	for _, singleSQL := range singleSQLs {
 		result, err := driver.querySingleSQL(ctx, conn, singleSQL, queryContext)
@@ -218,6 +240,16 @@ func (*Driver) querySingleSQL(ctx context.Context, conn *sql.Conn, singleSQL bas
 		}
 	}
 
+	if util.NeedQueryEstimate(queryContext, statement) {
+		estimate, err := estimateQuery(ctx, conn, stmt)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to estimate the query")
+		}
+		if err := util.CheckQueryEstimate(estimate, queryContext); err != nil {
+			return nil, err
+		}
+	}
+
 	startTime := time.Now()
 	result, err := util.Query(ctx, storepb.Engine_MSSQL, conn, stmt, queryContext)
 	if err != nil {
@@ -0,0 +1,101 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+// setMaximumExecutionTime sets the LOCK_TIMEOUT of the session, and returns the function to reset it.
+// MSSQL has no statement timeout setting, so the running query is canceled by the context, and the lock waiting is limited by LOCK_TIMEOUT.
+func setMaximumExecutionTime(ctx context.Context, conn *sql.Conn, timeout time.Duration) (func(), error) {
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("SET LOCK_TIMEOUT %d", timeout.Milliseconds())); err != nil {
+		return nil, errors.Wrapf(err, "failed to set LOCK_TIMEOUT")
+	}
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), "SET LOCK_TIMEOUT -1"); err != nil {
+			slog.Warn("failed to reset LOCK_TIMEOUT", log.BBError(err))
+			util.DiscardConn(conn)
+		}
+	}, nil
+}
+
+// estimateQuery estimates the cost and rows of the statement by the estimated execution plan.
+// The statement is not executed while SHOWPLAN_XML is on. If SHOWPLAN_XML fails to be turned off, the connection is
+// discarded, otherwise the later statements on the connection would silently do nothing.
+func estimateQuery(ctx context.Context, conn *sql.Conn, statement string) (_ *util.QueryEstimate, err error) {
+	// SET SHOWPLAN_XML must be the only statement in the batch.
+	if _, err := conn.ExecContext(ctx, "SET SHOWPLAN_XML ON"); err != nil {
+		return nil, errors.Wrapf(err, "failed to set SHOWPLAN_XML")
+	}
+	defer func() {
+		if _, resetErr := conn.ExecContext(context.Background(), "SET SHOWPLAN_XML OFF"); resetErr != nil {
+			util.DiscardConn(conn)
+			if err == nil {
+				err = errors.Wrapf(resetErr, "failed to reset SHOWPLAN_XML")
+			}
+		}
+	}()
+	var plan string
+	if err := conn.QueryRowContext(ctx, statement).Scan(&plan); err != nil {
+		return nil, err
+	}
+	return parseShowPlanXML(plan)
+}
+
+// parseShowPlanXML parses the estimate from the SHOWPLAN_XML output.
+// The cost and rows are the largest StatementSubTreeCost and StatementEstRows of the statements in the plan.
+func parseShowPlanXML(plan string) (*util.QueryEstimate, error) {
+	decoder := xml.NewDecoder(strings.NewReader(plan))
+	// The plan is decoded to UTF-8 by the driver, but the declaration may still be utf-16.
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	estimate := &util.QueryEstimate{}
+	found := false
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the plan")
+		}
+		element, ok := token.(xml.StartElement)
+		if !ok || element.Name.Local != "StmtSimple" {
+			continue
+		}
+		found = true
+		for _, attr := range element.Attr {
+			switch attr.Name.Local {
+			case "StatementSubTreeCost":
+				cost, err := strconv.ParseFloat(attr.Value, 64)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to parse StatementSubTreeCost %q", attr.Value)
+				}
+				estimate.Cost = max(estimate.Cost, cost)
+			case "StatementEstRows":
+				rows, err := strconv.ParseFloat(attr.Value, 64)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to parse StatementEstRows %q", attr.Value)
+				}
+				estimate.Rows = max(estimate.Rows, int64(rows))
+			}
+		}
+	}
+	if !found {
+		return nil, errors.New("statement not found in the plan")
+	}
+	return estimate, nil
+}
@@ -0,0 +1,35 @@
+package mssql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+func TestParseShowPlanXML(t *testing.T) {
+	a := require.New(t)
+	plan := `<?xml version="1.0" encoding="utf-16"?>
+<ShowPlanXML xmlns="http://schemas.microsoft.com/sqlserver/2004/07/showplan" Version="1.564" Build="16.0.1000.6">
+  <BatchSequence>
+    <Batch>
+      <Statements>
+        <StmtSimple StatementText="SELECT TOP 1000 * FROM t" StatementId="1" StatementCompId="1" StatementType="SELECT" StatementSubTreeCost="12.4865" StatementEstRows="1000">
+          <QueryPlan CachedPlanSize="16">
+            <RelOp NodeId="0" PhysicalOp="Top" EstimateRows="1000" EstimatedTotalSubtreeCost="12.4865"></RelOp>
+          </QueryPlan>
+        </StmtSimple>
+      </Statements>
+    </Batch>
+  </BatchSequence>
+</ShowPlanXML>`
+	estimate, err := parseShowPlanXML(plan)
+	a.NoError(err)
+	a.Equal(&util.QueryEstimate{Cost: 12.4865, Rows: 1000}, estimate)
+
+	_, err = parseShowPlanXML(`<ShowPlanXML></ShowPlanXML>`)
+	a.Error(err)
+	_, err = parseShowPlanXML(`<ShowPlanXML><StmtSimple StatementEstRows="abc"/></ShowPlanXML>`)
+	a.Error(err)
+}
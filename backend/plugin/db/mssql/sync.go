@@ -9,12 +9,22 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/util"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
+var systemDatabases = map[string]bool{
+	"master":  true,
+	"model":   true,
+	"msdb":    true,
+	"tempdb":  true,
+	"rdscore": true,
+}
+
 // SyncInstance syncs the instance.
 func (driver *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, error) {
 	var version, fullVersion string
@@ -325,12 +335,189 @@ func getViews(txn *sql.Tx) (map[string][]*storepb.ViewMetadata, error) {
 	return viewMap, nil
 }
 
-// SyncSlowQuery syncs the slow query.
-func (*Driver) SyncSlowQuery(_ context.Context, _ time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
-	return nil, errors.Errorf("not implemented")
+// slowQueryThresholdMicroseconds is the minimum maximum elapsed time of a slow query in microseconds.
+const slowQueryThresholdMicroseconds = 1000000
+
+// SyncSlowQuery syncs the slow query statistics of the log date from Query Store.
+// The databases without Query Store enabled are synced by SyncSlowQuerySnapshot.
+func (driver *Driver) SyncSlowQuery(ctx context.Context, logDateTs time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
+	startTime := logDateTs.UTC().Truncate(24 * time.Hour)
+	endTime := startTime.AddDate(0, 0, 1)
+
+	queryStoreDatabases, err := driver.getQueryStoreDatabases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := util.NewSlowQueryStatisticsBuilder(storepb.Engine_MSSQL)
+	for _, database := range queryStoreDatabases {
+		if err := driver.syncQueryStoreSlowQuery(ctx, builder, database, startTime, endTime); err != nil {
+			return nil, err
+		}
+	}
+	return builder.Build(), nil
+}
+
+// SyncSlowQuerySnapshot returns the cumulative slow query statistics of the databases without Query Store enabled.
+// The statistics come from sys.dm_exec_query_stats, which only covers the cached plans and accumulates since the plan is cached.
+func (driver *Driver) SyncSlowQuerySnapshot(ctx context.Context) (map[string]*storepb.SlowQueryStatistics, error) {
+	queryStoreDatabases, err := driver.getQueryStoreDatabases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queryStoreDatabaseMap := make(map[string]bool)
+	for _, database := range queryStoreDatabases {
+		queryStoreDatabaseMap[database] = true
+	}
+
+	// The max_elapsed_time only grows while the plan is cached, so a statement stays in the snapshots once it's slow.
+	query := fmt.Sprintf(`
+		SELECT
+			DB_NAME(CONVERT(INT, pa.value)),
+			MIN(SUBSTRING(st.text, (qs.statement_start_offset / 2) + 1, ((CASE qs.statement_end_offset WHEN -1 THEN DATALENGTH(st.text) ELSE qs.statement_end_offset END - qs.statement_start_offset) / 2) + 1)),
+			SUM(qs.execution_count),
+			MAX(qs.last_execution_time),
+			SUM(qs.total_elapsed_time),
+			MAX(qs.max_elapsed_time),
+			SUM(qs.total_rows),
+			MAX(qs.max_rows),
+			SUM(qs.total_logical_reads),
+			MAX(qs.max_logical_reads)
+		FROM sys.dm_exec_query_stats qs
+			CROSS APPLY sys.dm_exec_sql_text(qs.sql_handle) st
+			CROSS APPLY sys.dm_exec_plan_attributes(qs.plan_handle) pa
+		WHERE pa.attribute = 'dbid'
+		GROUP BY pa.value, qs.query_hash
+		HAVING MAX(qs.max_elapsed_time) >= %d`, slowQueryThresholdMicroseconds)
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	builder := util.NewSlowQueryStatisticsBuilder(storepb.Engine_MSSQL)
+	for rows.Next() {
+		var database sql.NullString
+		var item slowQueryRow
+		if err := rows.Scan(&database, &item.text, &item.count, &item.latestTime, &item.totalTime, &item.maxTime, &item.totalRows, &item.maxRows, &item.totalReads, &item.maxReads); err != nil {
+			return nil, err
+		}
+		if !database.Valid || queryStoreDatabaseMap[database.String] || systemDatabases[database.String] {
+			continue
+		}
+		builder.Add(database.String, item.toStatisticsItem())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+
+	return builder.Build(), nil
+}
+
+// IsSlowQuery returns true if the maximum elapsed time since the plan is cached reaches the threshold.
+// It's the same condition as the snapshot, because the maximum cannot be diffed.
+func (*Driver) IsSlowQuery(increment *storepb.SlowQueryStatisticsItem) bool {
+	return increment.MaximumQueryTime.AsDuration() >= slowQueryThresholdMicroseconds*time.Microsecond
+}
+
+func (driver *Driver) getQueryStoreDatabases(ctx context.Context) ([]string, error) {
+	query := `SELECT name FROM sys.databases WHERE is_query_store_on = 1 AND state = 0`
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	var databases []string
+	for rows.Next() {
+		var database string
+		if err := rows.Scan(&database); err != nil {
+			return nil, err
+		}
+		if systemDatabases[database] {
+			continue
+		}
+		databases = append(databases, database)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return databases, nil
 }
 
-// CheckSlowQueryLogEnabled checks if slow query log is enabled.
-func (*Driver) CheckSlowQueryLogEnabled(_ context.Context) error {
-	return errors.Errorf("not implemented")
+func (driver *Driver) syncQueryStoreSlowQuery(ctx context.Context, builder *util.SlowQueryStatisticsBuilder, database string, startTime, endTime time.Time) error {
+	quotedDatabase := fmt.Sprintf("[%s]", strings.ReplaceAll(database, "]", "]]"))
+	// The durations in Query Store are in microseconds, and the statistics are aggregated by runtime stats interval.
+	query := fmt.Sprintf(`
+		SELECT
+			MIN(qt.query_sql_text),
+			SUM(rs.count_executions),
+			MAX(rs.last_execution_time),
+			SUM(rs.avg_duration * rs.count_executions),
+			MAX(rs.max_duration),
+			SUM(rs.avg_rowcount * rs.count_executions),
+			MAX(rs.max_rowcount),
+			SUM(rs.avg_logical_io_reads * rs.count_executions),
+			MAX(rs.max_logical_io_reads)
+		FROM %[1]s.sys.query_store_runtime_stats rs
+			JOIN %[1]s.sys.query_store_runtime_stats_interval rsi ON rs.runtime_stats_interval_id = rsi.runtime_stats_interval_id
+			JOIN %[1]s.sys.query_store_plan p ON rs.plan_id = p.plan_id
+			JOIN %[1]s.sys.query_store_query q ON p.query_id = q.query_id
+			JOIN %[1]s.sys.query_store_query_text qt ON q.query_text_id = qt.query_text_id
+		WHERE rsi.start_time >= @p1 AND rsi.start_time < @p2
+		GROUP BY q.query_hash
+		HAVING MAX(rs.max_duration) >= %[2]d`, quotedDatabase, slowQueryThresholdMicroseconds)
+	rows, err := driver.db.QueryContext(ctx, query, startTime, endTime)
+	if err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var item slowQueryRow
+		var totalTime, totalRows, totalReads float64
+		if err := rows.Scan(&item.text, &item.count, &item.latestTime, &totalTime, &item.maxTime, &totalRows, &item.maxRows, &totalReads, &item.maxReads); err != nil {
+			return err
+		}
+		item.totalTime, item.totalRows, item.totalReads = int64(totalTime), int64(totalRows), int64(totalReads)
+		builder.Add(database, item.toStatisticsItem())
+	}
+	if err := rows.Err(); err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	return nil
+}
+
+// slowQueryRow is the aggregated statistics row of a query, the durations are in microseconds.
+type slowQueryRow struct {
+	text       string
+	count      int64
+	latestTime time.Time
+	totalTime  int64
+	maxTime    int64
+	totalRows  int64
+	maxRows    int64
+	totalReads int64
+	maxReads   int64
+}
+
+func (r *slowQueryRow) toStatisticsItem() *storepb.SlowQueryStatisticsItem {
+	return &storepb.SlowQueryStatisticsItem{
+		SqlFingerprint:      strings.TrimSpace(r.text),
+		Count:               util.ClampInt32(r.count),
+		LatestLogTime:       timestamppb.New(r.latestTime),
+		TotalQueryTime:      durationpb.New(time.Duration(r.totalTime) * time.Microsecond),
+		MaximumQueryTime:    durationpb.New(time.Duration(r.maxTime) * time.Microsecond),
+		TotalRowsSent:       util.ClampInt32(r.totalRows),
+		MaximumRowsSent:     util.ClampInt32(r.maxRows),
+		TotalRowsExamined:   util.ClampInt32(r.totalReads),
+		MaximumRowsExamined: util.ClampInt32(r.maxReads),
+	}
+}
+
+// CheckSlowQueryLogEnabled checks if the query statistics are accessible, which requires the VIEW SERVER STATE permission.
+func (driver *Driver) CheckSlowQueryLogEnabled(ctx context.Context) error {
+	query := `SELECT COUNT(*) FROM sys.dm_exec_query_stats`
+	var count int64
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&count); err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	return nil
 }
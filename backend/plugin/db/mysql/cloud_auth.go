@@ -0,0 +1,62 @@
+package mysql
+
+import (
+	"context"
+	sqldriver "database/sql/driver"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+// tokenConnector connects with the token of the cloud IAM authentication as the password.
+// The token is short-lived, so we get a token for every new connection.
+type tokenConnector struct {
+	cfg           *mysql.Config
+	tokenProvider util.TokenProvider
+}
+
+func newTokenConnector(dsn string, tokenProvider util.TokenProvider) (*tokenConnector, error) {
+	// The TLS config is resolved while parsing the DSN.
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &tokenConnector{cfg: cfg, tokenProvider: tokenProvider}, nil
+}
+
+// Connect implements the driver.Connector interface.
+func (c *tokenConnector) Connect(ctx context.Context) (sqldriver.Conn, error) {
+	token, err := c.tokenProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cfg := c.cfg.Clone()
+	cfg.Passwd = token
+	connector, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return connector.Connect(ctx)
+}
+
+// Driver implements the driver.Connector interface.
+func (*tokenConnector) Driver() sqldriver.Driver {
+	return &mysql.MySQLDriver{}
+}
+
+// getClientAuth returns the password, and the extra arguments and environment variables of the mysql client programs,
+// such as mysql and mysqlbinlog.
+// With the cloud IAM authentication, the password is a fresh token sent by the cleartext authentication plugin,
+// so the client programs must enable the plugin and require TLS.
+func (driver *Driver) getClientAuth(ctx context.Context) (string, []string, []string, error) {
+	if driver.tokenProvider == nil {
+		return driver.connCfg.Password, nil, nil, nil
+	}
+	token, err := driver.tokenProvider(ctx)
+	if err != nil {
+		return "", nil, nil, errors.Wrap(err, "failed to get the authentication token")
+	}
+	return token, []string{"--ssl-mode=REQUIRED"}, []string{"LIBMYSQL_ENABLE_CLEARTEXT_PLUGIN=1"}, nil
+}
@@ -0,0 +1,32 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+)
+
+func TestGetClientAuth(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	driver := &Driver{connCfg: db.ConnectionConfig{Password: "secret"}}
+	password, args, env, err := driver.getClientAuth(ctx)
+	a.NoError(err)
+	a.Equal("secret", password)
+	a.Empty(args)
+	a.Empty(env)
+
+	// The token is used as the password for the cloud IAM authentication, which has no stored password.
+	driver = &Driver{tokenProvider: func(context.Context) (string, error) {
+		return "token", nil
+	}}
+	password, args, env, err = driver.getClientAuth(ctx)
+	a.NoError(err)
+	a.Equal("token", password)
+	a.Equal([]string{"--ssl-mode=REQUIRED"}, args)
+	a.Equal([]string{"LIBMYSQL_ENABLE_CLEARTEXT_PLUGIN=1"}, env)
+}
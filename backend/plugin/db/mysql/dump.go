@@ -893,10 +893,16 @@ func (driver *Driver) restoreImpl(ctx context.Context, backup io.Reader, databas
 	if driver.connCfg.Port != "" {
 		mysqlArgs = append(mysqlArgs, "--port", driver.connCfg.Port)
 	}
-	if driver.connCfg.Password != "" {
-		mysqlArgs = append(mysqlArgs, fmt.Sprintf("--password=%s", driver.connCfg.Password))
+	password, authArgs, authEnv, err := driver.getClientAuth(ctx)
+	if err != nil {
+		return err
+	}
+	mysqlArgs = append(mysqlArgs, authArgs...)
+	if password != "" {
+		mysqlArgs = append(mysqlArgs, fmt.Sprintf("--password=%s", password))
 	}
 	mysqlCmd := exec.CommandContext(ctx, mysqlutil.GetPath(mysqlutil.MySQL, driver.dbBinDir), mysqlArgs...)
+	mysqlCmd.Env = append(mysqlCmd.Env, authEnv...)
 
 	var stderr bytes.Buffer
 	countingReader := common.NewCountingReader(backup)
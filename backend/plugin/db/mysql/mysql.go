@@ -51,6 +51,8 @@ type Driver struct {
 	db            *sql.DB
 	databaseName  string
 	sshClient     *ssh.Client
+	// tokenProvider is set for the cloud IAM authentications.
+	tokenProvider util.TokenProvider
 
 	replayedBinlogBytes *common.CountingReader
 	restoredBackupBytes *common.CountingReader
@@ -64,7 +66,7 @@ func newDriver(dc db.DriverConfig) db.Driver {
 }
 
 // Open opens a MySQL driver.
-func (driver *Driver) Open(_ context.Context, dbType storepb.Engine, connCfg db.ConnectionConfig) (db.Driver, error) {
+func (driver *Driver) Open(ctx context.Context, dbType storepb.Engine, connCfg db.ConnectionConfig) (db.Driver, error) {
 	protocol := "tcp"
 	if strings.HasPrefix(connCfg.Host, "/") {
 		protocol = "unix"
@@ -99,11 +101,34 @@ func (driver *Driver) Open(_ context.Context, dbType storepb.Engine, connCfg db.
 		params = append(params, fmt.Sprintf("tls=%s", tlsKey))
 	}
 
-	dsn := fmt.Sprintf("%s:%s@%s(%s:%s)/%s?%s", connCfg.Username, connCfg.Password, protocol, connCfg.Host, connCfg.Port, connCfg.Database, strings.Join(params, "&"))
-	db, err := sql.Open("mysql", dsn)
+	tokenProvider, err := util.GetTokenProvider(ctx, dbType, connCfg)
 	if err != nil {
 		return nil, err
 	}
+	if tokenProvider != nil {
+		// The cloud IAM authentications send the token with the cleartext authentication plugin, so TLS is required.
+		// "preferred" falls back to plaintext, so we use "true" which requires TLS and verifies the server certificate
+		// with the system CAs, unless the CA is configured in the data source.
+		params = append(params, "allowCleartextPasswords=true")
+		if tlsConfig == nil {
+			params = append(params, "tls=true")
+		}
+	}
+
+	dsn := fmt.Sprintf("%s:%s@%s(%s:%s)/%s?%s", connCfg.Username, connCfg.Password, protocol, connCfg.Host, connCfg.Port, connCfg.Database, strings.Join(params, "&"))
+	var db *sql.DB
+	if tokenProvider != nil {
+		connector, err := newTokenConnector(dsn, tokenProvider)
+		if err != nil {
+			return nil, err
+		}
+		db = sql.OpenDB(connector)
+	} else {
+		db, err = sql.Open("mysql", dsn)
+		if err != nil {
+			return nil, err
+		}
+	}
 	driver.dbType = dbType
 	driver.db = db
 	// TODO(d): remove the work-around once we have clean-up the migration connection hack.
@@ -113,6 +138,7 @@ func (driver *Driver) Open(_ context.Context, dbType storepb.Engine, connCfg db.
 	driver.connectionCtx = connCfg.ConnectionContext
 	driver.connCfg = connCfg
 	driver.databaseName = connCfg.Database
+	driver.tokenProvider = tokenProvider
 
 	return driver, nil
 }
@@ -321,6 +347,13 @@ func (driver *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement s
 		return nil, err
 	}
 	slog.Debug("connectionID", slog.String("connectionID", connectionID))
+	if queryContext.MaximumExecutionTime > 0 {
+		reset, err := setMaximumExecutionTime(ctx, conn, driver.dbType, driver.connectionCtx.EngineVersion, queryContext.MaximumExecutionTime)
+		if err != nil {
+			return nil, err
+		}
+		defer reset()
+	}
 	var results []*v1pb.QueryResult
 	for _, singleSQL := range singleSQLs {
 		result, err := driver.querySingleSQL(ctx, conn, singleSQL, queryContext)
@@ -381,6 +414,16 @@ func (driver *Driver) querySingleSQL(ctx context.Context, conn *sql.Conn, single
 		}
 	}
 
+	if util.NeedQueryEstimate(queryContext, statement) {
+		estimate, err := estimateQuery(ctx, conn, driver.dbType, stmt)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to estimate the query")
+		}
+		if err := util.CheckQueryEstimate(estimate, queryContext); err != nil {
+			return nil, err
+		}
+	}
+
 	startTime := time.Now()
 	result, err := util.Query(ctx, driver.dbType, conn, stmt, queryContext)
 	if err != nil {
@@ -184,14 +184,20 @@ func (driver *Driver) replayBinlogFromDir(ctx context.Context, originalDatabase,
 	if driver.connCfg.Port != "" {
 		mysqlArgs = append(mysqlArgs, "--port", driver.connCfg.Port)
 	}
-	if driver.connCfg.Password != "" {
+	password, authArgs, authEnv, err := driver.getClientAuth(ctx)
+	if err != nil {
+		return err
+	}
+	mysqlArgs = append(mysqlArgs, authArgs...)
+	if password != "" {
 		// The --password parameter of mysql/mysqlbinlog does not support the "--password PASSWORD" format (split by space).
 		// If provided like that, the program will hang.
-		mysqlArgs = append(mysqlArgs, fmt.Sprintf("--password=%s", driver.connCfg.Password))
+		mysqlArgs = append(mysqlArgs, fmt.Sprintf("--password=%s", password))
 	}
 
 	mysqlbinlogCmd := exec.CommandContext(ctx, mysqlutil.GetPath(mysqlutil.MySQLBinlog, driver.dbBinDir), mysqlbinlogArgs...)
 	mysqlCmd := exec.CommandContext(ctx, mysqlutil.GetPath(mysqlutil.MySQL, driver.dbBinDir), mysqlArgs...)
+	mysqlCmd.Env = append(mysqlCmd.Env, authEnv...)
 	slog.Debug("Start replay binlog commands.",
 		slog.String("mysqlbinlog", mysqlbinlogCmd.String()),
 		slog.String("mysql", mysqlCmd.String()))
@@ -728,12 +734,19 @@ func (driver *Driver) downloadBinlogFile(ctx context.Context, binlogFileToDownlo
 		args = append(args, "--port", driver.connCfg.Port)
 	}
 
+	password, authArgs, authEnv, err := driver.getClientAuth(ctx)
+	if err != nil {
+		return err
+	}
+	args = append(args, authArgs...)
+
 	cmd := exec.CommandContext(ctx, mysqlutil.GetPath(mysqlutil.MySQLBinlog, driver.dbBinDir), args...)
 	// We cannot set password as a flag. Otherwise, there is warning message
 	// "mysqlbinlog: [Warning] Using a password on the command line interface can be insecure."
-	if driver.connCfg.Password != "" {
-		cmd.Env = append(cmd.Env, fmt.Sprintf("MYSQL_PWD=%s", driver.connCfg.Password))
+	if password != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("MYSQL_PWD=%s", password))
 	}
+	cmd.Env = append(cmd.Env, authEnv...)
 	cmd.Stdout = os.Stderr
 	cmd.Stderr = os.Stderr
 
@@ -80,11 +80,17 @@ func (driver *Driver) GenerateRollbackSQL(ctx context.Context, binlogSizeLimit i
 	if driver.connCfg.Port != "" {
 		args = append(args, "--port", driver.connCfg.Port)
 	}
+	password, authArgs, authEnv, err := driver.getClientAuth(ctx)
+	if err != nil {
+		return "", err
+	}
+	args = append(args, authArgs...)
 	cmd := exec.CommandContext(ctx, mysqlutil.GetPath(mysqlutil.MySQLBinlog, driver.dbBinDir), args...)
 	slog.Debug("mysqlbinlog", slog.String("command", cmd.String()))
-	if driver.connCfg.Password != "" {
-		cmd.Env = append(cmd.Env, fmt.Sprintf("MYSQL_PWD=%s", driver.connCfg.Password))
+	if password != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("MYSQL_PWD=%s", password))
 	}
+	cmd.Env = append(cmd.Env, authEnv...)
 	pr, err := cmd.StdoutPipe()
 	if err != nil {
 		return "", errors.Wrap(err, "failed to create stdout pipe")
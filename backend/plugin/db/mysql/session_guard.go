@@ -0,0 +1,201 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// setMaximumExecutionTime sets the statement timeout of the session, and returns the function to reset it.
+// The connection is returned to the pool after the query, so the timeout must be reset, or the connection is discarded.
+// It's a no-op if the server version doesn't support the statement timeout variable.
+func setMaximumExecutionTime(ctx context.Context, conn *sql.Conn, dbType storepb.Engine, version string, timeout time.Duration) (func(), error) {
+	if !supportMaximumExecutionTime(dbType, version) {
+		slog.Debug("skip setting the statement timeout for the unsupported version", slog.String("version", version))
+		return func() {}, nil
+	}
+	var variable, value string
+	switch dbType {
+	case storepb.Engine_MARIADB:
+		// max_statement_time is in seconds.
+		variable, value = "max_statement_time", strconv.FormatFloat(timeout.Seconds(), 'f', 3, 64)
+	case storepb.Engine_OCEANBASE:
+		// ob_query_timeout is in microseconds.
+		variable, value = "ob_query_timeout", strconv.FormatInt(timeout.Microseconds(), 10)
+	default:
+		// max_execution_time is in milliseconds, and applies to the read-only SELECT statements.
+		variable, value = "max_execution_time", strconv.FormatInt(timeout.Milliseconds(), 10)
+	}
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("SET SESSION %s = %s", variable, value)); err != nil {
+		return nil, errors.Wrapf(err, "failed to set %s", variable)
+	}
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), fmt.Sprintf("SET SESSION %s = DEFAULT", variable)); err != nil {
+			slog.Warn("failed to reset the session variable", slog.String("variable", variable), log.BBError(err))
+			util.DiscardConn(conn)
+		}
+	}, nil
+}
+
+// supportMaximumExecutionTime returns whether the server version supports the statement timeout variable.
+// max_execution_time is introduced in MySQL 5.7.8, and max_statement_time is introduced in MariaDB 10.1.1.
+// The unknown versions are treated as supported.
+func supportMaximumExecutionTime(dbType storepb.Engine, version string) bool {
+	var minVersion semver.Version
+	switch dbType {
+	case storepb.Engine_MYSQL:
+		minVersion = semver.Version{Major: 5, Minor: 7, Patch: 8}
+	case storepb.Engine_MARIADB:
+		minVersion = semver.Version{Major: 10, Minor: 1, Patch: 1}
+	default:
+		return true
+	}
+	v, err := semver.Make(version)
+	if err != nil {
+		return true
+	}
+	return v.GTE(minVersion)
+}
+
+// estimateQuery estimates the cost and rows of the statement by EXPLAIN.
+// It returns nil if the estimation is not supported for the engine.
+func estimateQuery(ctx context.Context, conn *sql.Conn, dbType storepb.Engine, statement string) (*util.QueryEstimate, error) {
+	switch dbType {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB:
+		var plan string
+		if err := conn.QueryRowContext(ctx, fmt.Sprintf("EXPLAIN FORMAT=JSON %s", statement)).Scan(&plan); err != nil {
+			return nil, err
+		}
+		return parseMySQLExplainJSON(plan)
+	case storepb.Engine_TIDB:
+		return estimateTiDBQuery(ctx, conn, statement)
+	default:
+		return nil, nil
+	}
+}
+
+// parseMySQLExplainJSON parses the estimate from the EXPLAIN FORMAT=JSON output.
+// The cost is the largest query_cost, and the rows is the largest rows_produced_per_join, or rows for MariaDB, in the plan.
+func parseMySQLExplainJSON(plan string) (*util.QueryEstimate, error) {
+	decoder := json.NewDecoder(strings.NewReader(plan))
+	decoder.UseNumber()
+	var node any
+	if err := decoder.Decode(&node); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the plan")
+	}
+	estimate := &util.QueryEstimate{}
+	var walk func(node any) error
+	walk = func(node any) error {
+		switch node := node.(type) {
+		case map[string]any:
+			for key, value := range node {
+				switch key {
+				case "query_cost":
+					cost, err := parseExplainNumber(value)
+					if err != nil {
+						return errors.Wrapf(err, "failed to parse %s", key)
+					}
+					estimate.Cost = max(estimate.Cost, cost)
+				case "rows_produced_per_join", "rows":
+					rows, err := parseExplainNumber(value)
+					if err != nil {
+						return errors.Wrapf(err, "failed to parse %s", key)
+					}
+					estimate.Rows = max(estimate.Rows, int64(rows))
+				default:
+					if err := walk(value); err != nil {
+						return err
+					}
+				}
+			}
+		case []any:
+			for _, value := range node {
+				if err := walk(value); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := walk(node); err != nil {
+		return nil, err
+	}
+	return estimate, nil
+}
+
+// parseExplainNumber parses the number in the plan, which is a string for the costs in MySQL.
+func parseExplainNumber(value any) (float64, error) {
+	switch value := value.(type) {
+	case json.Number:
+		return value.Float64()
+	case string:
+		return strconv.ParseFloat(value, 64)
+	default:
+		return 0, errors.Errorf("unexpected value %v", value)
+	}
+}
+
+// estimateTiDBQuery estimates the statement by the root operator of EXPLAIN FORMAT='verbose'.
+func estimateTiDBQuery(ctx context.Context, conn *sql.Conn, statement string) (*util.QueryEstimate, error) {
+	rows, err := conn.QueryContext(ctx, fmt.Sprintf("EXPLAIN FORMAT='verbose' %s", statement))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("empty plan")
+	}
+	values := make([]sql.NullString, len(columns))
+	refs := make([]any, len(columns))
+	for i := range values {
+		refs[i] = &values[i]
+	}
+	if err := rows.Scan(refs...); err != nil {
+		return nil, err
+	}
+	return parseTiDBExplainRow(columns, values)
+}
+
+// parseTiDBExplainRow parses the estimate from the root operator row of TiDB EXPLAIN.
+func parseTiDBExplainRow(columns []string, values []sql.NullString) (*util.QueryEstimate, error) {
+	estimate := &util.QueryEstimate{}
+	for i, column := range columns {
+		if !values[i].Valid {
+			continue
+		}
+		switch column {
+		case "estCost":
+			cost, err := strconv.ParseFloat(values[i].String, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse estCost %q", values[i].String)
+			}
+			estimate.Cost = cost
+		case "estRows":
+			rows, err := strconv.ParseFloat(values[i].String, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse estRows %q", values[i].String)
+			}
+			estimate.Rows = int64(rows)
+		}
+	}
+	return estimate, nil
+}
@@ -0,0 +1,90 @@
+package mysql
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestParseMySQLExplainJSON(t *testing.T) {
+	tests := []struct {
+		plan string
+		want *util.QueryEstimate
+	}{
+		{
+			// MySQL 8.0 join.
+			plan: `{
+  "query_block": {
+    "select_id": 1,
+    "cost_info": {"query_cost": "1250.75"},
+    "nested_loop": [
+      {"table": {"table_name": "a", "rows_examined_per_scan": 1000, "rows_produced_per_join": 1000, "cost_info": {"read_cost": "1.00"}}},
+      {"table": {"table_name": "b", "rows_examined_per_scan": 1, "rows_produced_per_join": 500}}
+    ]
+  }
+}`,
+			want: &util.QueryEstimate{Cost: 1250.75, Rows: 1000},
+		},
+		{
+			// MariaDB doesn't report the cost.
+			plan: `{"query_block": {"select_id": 1, "table": {"table_name": "t", "access_type": "ALL", "rows": 42, "filtered": 100}}}`,
+			want: &util.QueryEstimate{Cost: 0, Rows: 42},
+		},
+		{
+			plan: `{"query_block": {"select_id": 1, "message": "No tables used"}}`,
+			want: &util.QueryEstimate{},
+		},
+	}
+	for _, test := range tests {
+		got, err := parseMySQLExplainJSON(test.plan)
+		require.NoError(t, err)
+		require.Equal(t, test.want, got)
+	}
+
+	_, err := parseMySQLExplainJSON(`{"query_block": {"cost_info": {"query_cost": "abc"}}}`)
+	require.Error(t, err)
+}
+
+func TestParseTiDBExplainRow(t *testing.T) {
+	a := require.New(t)
+	columns := []string{"id", "estRows", "estCost", "task", "access object", "operator info"}
+	values := []sql.NullString{
+		{String: "TableReader_5", Valid: true},
+		{String: "10000.00", Valid: true},
+		{String: "177906.67", Valid: true},
+		{String: "root", Valid: true},
+		{},
+		{String: "data:TableFullScan_4", Valid: true},
+	}
+	estimate, err := parseTiDBExplainRow(columns, values)
+	a.NoError(err)
+	a.Equal(&util.QueryEstimate{Cost: 177906.67, Rows: 10000}, estimate)
+
+	values[1] = sql.NullString{String: "N/A", Valid: true}
+	_, err = parseTiDBExplainRow(columns, values)
+	a.Error(err)
+}
+
+func TestSupportMaximumExecutionTime(t *testing.T) {
+	tests := []struct {
+		dbType  storepb.Engine
+		version string
+		want    bool
+	}{
+		{dbType: storepb.Engine_MYSQL, version: "5.6.51", want: false},
+		{dbType: storepb.Engine_MYSQL, version: "5.7.7", want: false},
+		{dbType: storepb.Engine_MYSQL, version: "5.7.8", want: true},
+		{dbType: storepb.Engine_MYSQL, version: "8.0.36", want: true},
+		{dbType: storepb.Engine_MYSQL, version: "", want: true},
+		{dbType: storepb.Engine_MARIADB, version: "10.0.38", want: false},
+		{dbType: storepb.Engine_MARIADB, version: "10.6.16", want: true},
+		{dbType: storepb.Engine_OCEANBASE, version: "4.2.1", want: true},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, supportMaximumExecutionTime(test.dbType, test.version), "%s %s", test.dbType, test.version)
+	}
+}
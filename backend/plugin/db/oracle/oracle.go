@@ -175,6 +175,7 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 func (driver *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string, queryContext *db.QueryContext) ([]*v1pb.QueryResult, error) {
 	// Oracle does not support transaction isolation level for read-only queries.
 	queryContext.ReadOnly = false
+	// Oracle has no statement timeout setting, and the MaximumExecutionTime is enforced by the context cancellation.
 
 	singleSQLs, err := plsqlparser.SplitSQL(statement)
 	if err != nil {
@@ -249,6 +250,16 @@ func (driver *Driver) querySingleSQL(ctx context.Context, conn *sql.Conn, single
 		}
 	}
 
+	if util.NeedQueryEstimate(queryContext, statement) {
+		estimate, err := estimateQuery(ctx, conn, stmt)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to estimate the query")
+		}
+		if err := util.CheckQueryEstimate(estimate, queryContext); err != nil {
+			return nil, err
+		}
+	}
+
 	startTime := time.Now()
 	result, err := util.Query(ctx, storepb.Engine_ORACLE, conn, stmt, queryContext)
 	if err != nil {
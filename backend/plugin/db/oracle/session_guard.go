@@ -0,0 +1,59 @@
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sijms/go-ora/v2/network"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+// errCodeReadOnlyDatabase is ORA-16000, the database or pluggable database is open for read-only access.
+const errCodeReadOnlyDatabase = 16000
+
+// estimateQuery estimates the cost and rows of the statement by EXPLAIN PLAN.
+// The plan is written to the session private PLAN_TABLE, and deleted after reading the root operation.
+// EXPLAIN PLAN can't write on the standby or read-only databases, so the estimation is skipped and nil is returned.
+func estimateQuery(ctx context.Context, conn *sql.Conn, statement string) (*util.QueryEstimate, error) {
+	var role string
+	if err := conn.QueryRowContext(ctx, "SELECT SYS_CONTEXT('USERENV', 'DATABASE_ROLE') FROM DUAL").Scan(&role); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the database role")
+	}
+	if role != "PRIMARY" {
+		slog.Debug("skip the query estimation on the non-primary database", slog.String("role", role))
+		return nil, nil
+	}
+
+	statementID := fmt.Sprintf("bb_%d", time.Now().UnixNano())
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("EXPLAIN PLAN SET STATEMENT_ID = '%s' FOR %s", statementID, statement)); err != nil {
+		var oracleErr *network.OracleError
+		if errors.As(err, &oracleErr) && oracleErr.ErrCode == errCodeReadOnlyDatabase {
+			slog.Debug("skip the query estimation on the read-only database", log.BBError(err))
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), "DELETE FROM PLAN_TABLE WHERE STATEMENT_ID = :1", statementID); err != nil {
+			slog.Warn("failed to delete the plan", slog.String("statementID", statementID), log.BBError(err))
+		}
+	}()
+
+	var cost, cardinality sql.NullFloat64
+	if err := conn.QueryRowContext(ctx, "SELECT COST, CARDINALITY FROM PLAN_TABLE WHERE STATEMENT_ID = :1 AND ID = 0", statementID).Scan(&cost, &cardinality); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.New("empty plan")
+		}
+		return nil, err
+	}
+	return &util.QueryEstimate{
+		Cost: cost.Float64,
+		Rows: int64(cardinality.Float64),
+	}, nil
+}
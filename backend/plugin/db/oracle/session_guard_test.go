@@ -0,0 +1,163 @@
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sijms/go-ora/v2/network"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+// fakeDatabase is a database/sql driver that answers the statements used by estimateQuery.
+type fakeDatabase struct {
+	mu sync.Mutex
+	// role is the database role returned by SYS_CONTEXT.
+	role string
+	// explainErr is returned by EXPLAIN PLAN if not nil.
+	explainErr error
+	// executed is the executed statements.
+	executed []string
+}
+
+func (d *fakeDatabase) Open(string) (driver.Conn, error) {
+	return &fakeConn{database: d}, nil
+}
+
+type fakeConn struct {
+	database *fakeDatabase
+}
+
+func (*fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (*fakeConn) Close() error {
+	return nil
+}
+
+func (*fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.database.mu.Lock()
+	defer c.database.mu.Unlock()
+	c.database.executed = append(c.database.executed, query)
+	if strings.HasPrefix(query, "EXPLAIN PLAN") && c.database.explainErr != nil {
+		return nil, c.database.explainErr
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	c.database.mu.Lock()
+	defer c.database.mu.Unlock()
+	c.database.executed = append(c.database.executed, query)
+	switch {
+	case strings.Contains(query, "DATABASE_ROLE"):
+		return &fakeRows{columns: []string{"ROLE"}, values: [][]driver.Value{{c.database.role}}}, nil
+	case strings.Contains(query, "FROM PLAN_TABLE"):
+		return &fakeRows{columns: []string{"COST", "CARDINALITY"}, values: [][]driver.Value{{float64(12), float64(3400)}}}, nil
+	default:
+		return nil, errors.Errorf("unexpected query %q", query)
+	}
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (*fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+var registerFakeDatabase sync.Once
+
+func TestEstimateQuery(t *testing.T) {
+	database := &fakeDatabase{}
+	registerFakeDatabase.Do(func() {
+		sql.Register("oracle-fake", database)
+	})
+
+	tests := []struct {
+		name       string
+		role       string
+		explainErr error
+		want       *util.QueryEstimate
+		wantErr    bool
+		wantPlan   bool
+	}{
+		{
+			name:     "primary",
+			role:     "PRIMARY",
+			want:     &util.QueryEstimate{Cost: 12, Rows: 3400},
+			wantPlan: true,
+		},
+		{
+			name: "physical standby",
+			role: "PHYSICAL STANDBY",
+		},
+		{
+			name:       "read-only primary",
+			role:       "PRIMARY",
+			explainErr: &network.OracleError{ErrCode: errCodeReadOnlyDatabase, ErrMsg: "ORA-16000: database or pluggable database open for read-only access"},
+			wantPlan:   true,
+		},
+		{
+			name:       "explain error",
+			role:       "PRIMARY",
+			explainErr: &network.OracleError{ErrCode: 942, ErrMsg: "ORA-00942: table or view does not exist"},
+			wantErr:    true,
+			wantPlan:   true,
+		},
+	}
+
+	db, err := sql.Open("oracle-fake", "")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	for _, test := range tests {
+		database.role, database.explainErr, database.executed = test.role, test.explainErr, nil
+
+		conn, err := db.Conn(ctx)
+		require.NoError(t, err)
+		got, err := estimateQuery(ctx, conn, "SELECT * FROM t")
+		require.NoError(t, conn.Close())
+		if test.wantErr {
+			require.Error(t, err, test.name)
+		} else {
+			require.NoError(t, err, test.name)
+		}
+		require.Equal(t, test.want, got, test.name)
+
+		explained := false
+		for _, statement := range database.executed {
+			if strings.HasPrefix(statement, "EXPLAIN PLAN") {
+				explained = true
+			}
+		}
+		require.Equal(t, test.wantPlan, explained, test.name)
+	}
+}
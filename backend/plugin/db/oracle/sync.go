@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/util"
@@ -549,12 +551,114 @@ func getViews(txn *sql.Tx, schemaName string) (map[string][]*storepb.ViewMetadat
 	return viewMap, nil
 }
 
-// SyncSlowQuery syncs the slow query.
+// slowQueryThresholdMicroseconds is the minimum average elapsed time of a slow query in microseconds.
+const slowQueryThresholdMicroseconds = 1000000
+
+// SyncSlowQuery returns no statistics, because V$SQLSTATS keeps the cumulative statistics since the SQL is loaded
+// into the shared pool rather than the statistics of a log date. The statistics are synced by SyncSlowQuerySnapshot.
 func (*Driver) SyncSlowQuery(_ context.Context, _ time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
-	return nil, errors.Errorf("not implemented")
+	return map[string]*storepb.SlowQueryStatistics{}, nil
 }
 
-// CheckSlowQueryLogEnabled checks if slow query log is enabled.
-func (*Driver) CheckSlowQueryLogEnabled(_ context.Context) error {
-	return errors.Errorf("not implemented")
+// SyncSlowQuerySnapshot returns the cumulative statistics of every SQL from V$SQLSTATS.
+// The SQLs are grouped by the parsing schema in the schema tenant mode, and by the connected database otherwise.
+// V$SQLSTATS has no maximum elapsed time, rows or buffer gets per execution, so the maximums are left empty.
+// The threshold is applied to the increments by IsSlowQuery rather than here, because a SQL whose lifetime average
+// drops below the threshold would leave the snapshot, and its cumulative statistics would be counted again on return.
+func (driver *Driver) SyncSlowQuerySnapshot(ctx context.Context) (map[string]*storepb.SlowQueryStatistics, error) {
+	var currentDatabase string
+	if !driver.schemaTenantMode {
+		database, err := driver.getCurrentDatabaseName(ctx)
+		if err != nil {
+			return nil, err
+		}
+		currentDatabase = database
+	}
+
+	// The elapsed time in V$SQLSTATS is in microseconds.
+	// The SQLs with the same force matching signature only differ in literals.
+	// V$SQL has a row per child cursor, so the parsing schema is picked per SQL_ID to avoid counting a SQL more than once.
+	query := fmt.Sprintf(`
+		SELECT
+			sch.PARSING_SCHEMA_NAME,
+			MIN(st.SQL_TEXT),
+			SUM(st.EXECUTIONS),
+			MAX(st.LAST_ACTIVE_TIME),
+			SUM(st.ELAPSED_TIME),
+			SUM(st.ROWS_PROCESSED),
+			SUM(st.BUFFER_GETS)
+		FROM V$SQLSTATS st
+			JOIN (SELECT SQL_ID, MIN(PARSING_SCHEMA_NAME) AS PARSING_SCHEMA_NAME FROM V$SQL GROUP BY SQL_ID) sch ON st.SQL_ID = sch.SQL_ID
+		WHERE st.EXECUTIONS > 0
+			AND sch.PARSING_SCHEMA_NAME NOT IN (%s)
+		GROUP BY sch.PARSING_SCHEMA_NAME, CASE WHEN st.FORCE_MATCHING_SIGNATURE = 0 THEN st.SQL_ID ELSE TO_CHAR(st.FORCE_MATCHING_SIGNATURE) END`, systemSchema)
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	builder := util.NewSlowQueryStatisticsBuilder(storepb.Engine_ORACLE)
+	for rows.Next() {
+		var schemaName, text string
+		var count, totalTime, totalRows, totalReads float64
+		var latestTime time.Time
+		if err := rows.Scan(&schemaName, &text, &count, &latestTime, &totalTime, &totalRows, &totalReads); err != nil {
+			return nil, err
+		}
+		database := currentDatabase
+		if driver.schemaTenantMode {
+			database = schemaName
+		}
+		builder.Add(database, &storepb.SlowQueryStatisticsItem{
+			SqlFingerprint:    strings.TrimSpace(text),
+			Count:             util.ClampInt32(int64(count)),
+			LatestLogTime:     timestamppb.New(latestTime),
+			TotalQueryTime:    durationpb.New(time.Duration(totalTime) * time.Microsecond),
+			MaximumQueryTime:  durationpb.New(0),
+			TotalRowsSent:     util.ClampInt32(int64(totalRows)),
+			TotalRowsExamined: util.ClampInt32(int64(totalReads)),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+
+	return builder.Build(), nil
+}
+
+// IsSlowQuery returns true if the average elapsed time of the executions between two snapshots reaches the threshold.
+func (*Driver) IsSlowQuery(increment *storepb.SlowQueryStatisticsItem) bool {
+	if increment.Count <= 0 {
+		return false
+	}
+	return increment.TotalQueryTime.AsDuration()/time.Duration(increment.Count) >= slowQueryThresholdMicroseconds*time.Microsecond
+}
+
+// getCurrentDatabaseName returns the name of the connected container, which is the database name in the non schema tenant mode.
+func (driver *Driver) getCurrentDatabaseName(ctx context.Context) (string, error) {
+	query := `SELECT CASE WHEN SYS_CONTEXT('USERENV', 'CON_NAME') = 'CDB$ROOT' THEN (SELECT NAME FROM V$DATABASE) ELSE SYS_CONTEXT('USERENV', 'CON_NAME') END FROM DUAL`
+	var database string
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&database); err != nil {
+		// CON_NAME is not available before Oracle 12c.
+		query = `SELECT NAME FROM V$DATABASE`
+		if err := driver.db.QueryRowContext(ctx, query).Scan(&database); err != nil {
+			return "", util.FormatErrorWithQuery(err, query)
+		}
+	}
+	return database, nil
+}
+
+// CheckSlowQueryLogEnabled checks if V$SQLSTATS and V$SQL are accessible.
+func (driver *Driver) CheckSlowQueryLogEnabled(ctx context.Context) error {
+	for _, query := range []string{
+		`SELECT COUNT(*) FROM V$SQLSTATS WHERE ROWNUM <= 1`,
+		`SELECT COUNT(*) FROM V$SQL WHERE ROWNUM <= 1`,
+	} {
+		var count int64
+		if err := driver.db.QueryRowContext(ctx, query).Scan(&count); err != nil {
+			return util.FormatErrorWithQuery(err, query)
+		}
+	}
+	return nil
 }
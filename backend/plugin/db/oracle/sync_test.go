@@ -0,0 +1,36 @@
+package oracle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestIsSlowQuery(t *testing.T) {
+	a := require.New(t)
+	driver := &Driver{}
+	snapshot := func(count int32, totalTime time.Duration) *storepb.SlowQueryStatistics {
+		return &storepb.SlowQueryStatistics{
+			Items: []*storepb.SlowQueryStatisticsItem{
+				{SqlFingerprint: "SELECT * FROM t", Count: count, TotalQueryTime: durationpb.New(totalTime)},
+			},
+		}
+	}
+
+	// The lifetime average is 0.5s, but the executions between the snapshots average 2s.
+	increments := util.DiffSlowQueryStatistics(snapshot(100, 50*time.Second), snapshot(110, 70*time.Second))
+	a.Len(increments.Items, 1)
+	a.True(driver.IsSlowQuery(increments.Items[0]))
+
+	// The lifetime average is 1.5s, but the executions between the snapshots average 0.1s.
+	increments = util.DiffSlowQueryStatistics(snapshot(10, 15*time.Second), snapshot(20, 16*time.Second))
+	a.Len(increments.Items, 1)
+	a.False(driver.IsSlowQuery(increments.Items[0]))
+
+	a.False(driver.IsSlowQuery(&storepb.SlowQueryStatisticsItem{TotalQueryTime: durationpb.New(time.Second)}))
+}
@@ -68,9 +68,17 @@ func (driver *Driver) Dump(ctx context.Context, out io.Writer, schemaOnly bool)
 }
 
 func (driver *Driver) dumpOneDatabaseWithPgDump(ctx context.Context, database string, out io.Writer, schemaOnly bool) error {
+	password := driver.config.Password
+	if driver.tokenProvider != nil {
+		token, err := driver.tokenProvider(ctx)
+		if err != nil {
+			return err
+		}
+		password = token
+	}
 	var args []string
 	args = append(args, fmt.Sprintf("--username=%s", driver.config.Username))
-	if driver.config.Password == "" {
+	if password == "" {
 		args = append(args, "--no-password")
 	}
 	if driver.sshClient == nil {
@@ -106,7 +114,7 @@ func (driver *Driver) dumpOneDatabaseWithPgDump(ctx context.Context, database st
 	dumpSuccess := false
 	var errs error
 	for _, sslCA := range sslCAs {
-		if err := driver.execPgDump(ctx, args, out, sslCA); err != nil {
+		if err := driver.execPgDump(ctx, args, out, sslCA, password); err != nil {
 			errs = multierr.Append(errs, err)
 			slog.Warn("Failed to exec pg_dump", log.BBError(err))
 		} else {
@@ -121,12 +129,12 @@ func (driver *Driver) dumpOneDatabaseWithPgDump(ctx context.Context, database st
 	return nil
 }
 
-func (driver *Driver) execPgDump(ctx context.Context, args []string, out io.Writer, sslCA string) error {
+func (driver *Driver) execPgDump(ctx context.Context, args []string, out io.Writer, sslCA, password string) error {
 	pgDumpPath := filepath.Join(driver.dbBinDir, "pg_dump")
 	cmd := exec.CommandContext(ctx, pgDumpPath, args...)
 	// Unlike MySQL, PostgreSQL does not support specifying commands in commands, we can do this by means of environment variables.
-	if driver.config.Password != "" {
-		cmd.Env = append(cmd.Env, fmt.Sprintf("PGPASSWORD=%s", driver.config.Password))
+	if password != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("PGPASSWORD=%s", password))
 	}
 	if driver.config.TLSConfig.SslCert != "" {
 		cmd.Env = append(cmd.Env, fmt.Sprintf("PGSSLCERT=%s", driver.config.TLSConfig.SslCert))
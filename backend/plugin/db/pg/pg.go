@@ -52,8 +52,10 @@ type Driver struct {
 	// connectionString is the connection string registered by pgx.
 	// Unregister connectionString if we don't need it.
 	connectionString string
-	databaseName     string
-	connectionCtx    db.ConnectionContext
+	// tokenProvider is set for the cloud IAM authentications.
+	tokenProvider util.TokenProvider
+	databaseName  string
+	connectionCtx db.ConnectionContext
 }
 
 func newDriver(config db.DriverConfig) db.Driver {
@@ -63,7 +65,7 @@ func newDriver(config db.DriverConfig) db.Driver {
 }
 
 // Open opens a Postgres driver.
-func (driver *Driver) Open(ctx context.Context, _ storepb.Engine, config db.ConnectionConfig) (db.Driver, error) {
+func (driver *Driver) Open(ctx context.Context, dbType storepb.Engine, config db.ConnectionConfig) (db.Driver, error) {
 	// Require username for Postgres, as the guessDSN 1st guess is to use the username as the connecting database
 	// if database name is not explicitly specified.
 	if config.Username == "" {
@@ -121,6 +123,17 @@ func (driver *Driver) Open(ctx context.Context, _ storepb.Engine, config db.Conn
 	if config.ReadOnly {
 		connConfig.RuntimeParams["default_transaction_read_only"] = "true"
 	}
+	tokenProvider, err := util.GetTokenProvider(ctx, dbType, config)
+	if err != nil {
+		return nil, err
+	}
+	if tokenProvider != nil {
+		token, err := tokenProvider(ctx)
+		if err != nil {
+			return nil, err
+		}
+		connConfig.Config.Password = token
+	}
 
 	driver.databaseName = config.Database
 	if config.Database == "" {
@@ -132,11 +145,25 @@ func (driver *Driver) Open(ctx context.Context, _ storepb.Engine, config db.Conn
 		driver.databaseName = databaseName
 	}
 	driver.config = config
+	driver.tokenProvider = tokenProvider
 
-	driver.connectionString = stdlib.RegisterConnConfig(connConfig)
-	db, err := sql.Open(driverName, driver.connectionString)
-	if err != nil {
-		return nil, err
+	var db *sql.DB
+	if tokenProvider != nil {
+		// The token is short-lived, so we get a token for every new connection.
+		db = stdlib.OpenDB(*connConfig, stdlib.OptionBeforeConnect(func(ctx context.Context, cfg *pgx.ConnConfig) error {
+			token, err := tokenProvider(ctx)
+			if err != nil {
+				return err
+			}
+			cfg.Password = token
+			return nil
+		}))
+	} else {
+		driver.connectionString = stdlib.RegisterConnConfig(connConfig)
+		db, err = sql.Open(driverName, driver.connectionString)
+		if err != nil {
+			return nil, err
+		}
 	}
 	driver.db = db
 	if config.ConnectionContext.UseDatabaseOwner {
@@ -521,6 +548,14 @@ func (driver *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement s
 		return nil, nil
 	}
 
+	if queryContext.MaximumExecutionTime > 0 {
+		reset, err := setMaximumExecutionTime(ctx, conn, queryContext.MaximumExecutionTime)
+		if err != nil {
+			return nil, err
+		}
+		defer reset()
+	}
+
 	var results []*v1pb.QueryResult
 	for _, singleSQL := range singleSQLs {
 		result, err := driver.querySingleSQL(ctx, conn, singleSQL, queryContext)
@@ -551,6 +586,16 @@ func (*Driver) querySingleSQL(ctx context.Context, conn *sql.Conn, singleSQL bas
 		stmt = getStatementWithResultLimit(stmt, queryContext.Limit)
 	}
 
+	if util.NeedQueryEstimate(queryContext, statement) {
+		estimate, err := estimateQuery(ctx, conn, stmt)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to estimate the query")
+		}
+		if err := util.CheckQueryEstimate(estimate, queryContext); err != nil {
+			return nil, err
+		}
+	}
+
 	startTime := time.Now()
 	result, err := util.QueryV2(ctx, conn, stmt, queryContext)
 	if err != nil {
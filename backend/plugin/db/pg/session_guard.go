@@ -0,0 +1,58 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+// setMaximumExecutionTime sets the statement_timeout of the session, and returns the function to reset it.
+// The connection is returned to the pool after the query, so the timeout must be reset, or the connection is discarded.
+func setMaximumExecutionTime(ctx context.Context, conn *sql.Conn, timeout time.Duration) (func(), error) {
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("SET statement_timeout = %d", timeout.Milliseconds())); err != nil {
+		return nil, errors.Wrapf(err, "failed to set statement_timeout")
+	}
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), "RESET statement_timeout"); err != nil {
+			slog.Warn("failed to reset statement_timeout", log.BBError(err))
+			util.DiscardConn(conn)
+		}
+	}, nil
+}
+
+// estimateQuery estimates the cost and rows of the statement by EXPLAIN.
+func estimateQuery(ctx context.Context, conn *sql.Conn, statement string) (*util.QueryEstimate, error) {
+	var plan string
+	if err := conn.QueryRowContext(ctx, fmt.Sprintf("EXPLAIN (FORMAT JSON) %s", statement)).Scan(&plan); err != nil {
+		return nil, err
+	}
+	return parseExplainJSON(plan)
+}
+
+// parseExplainJSON parses the estimate from the top plan node of the EXPLAIN (FORMAT JSON) output.
+func parseExplainJSON(plan string) (*util.QueryEstimate, error) {
+	var explains []struct {
+		Plan struct {
+			TotalCost float64 `json:"Total Cost"`
+			PlanRows  float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(plan), &explains); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the plan")
+	}
+	if len(explains) == 0 {
+		return nil, errors.New("empty plan")
+	}
+	return &util.QueryEstimate{
+		Cost: explains[0].Plan.TotalCost,
+		Rows: int64(explains[0].Plan.PlanRows),
+	}, nil
+}
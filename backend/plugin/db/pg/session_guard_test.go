@@ -0,0 +1,35 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+func TestParseExplainJSON(t *testing.T) {
+	a := require.New(t)
+	plan := `[
+  {
+    "Plan": {
+      "Node Type": "Limit",
+      "Startup Cost": 0.00,
+      "Total Cost": 1834.52,
+      "Plan Rows": 1000,
+      "Plan Width": 244,
+      "Plans": [
+        {"Node Type": "Seq Scan", "Relation Name": "t", "Total Cost": 183452.00, "Plan Rows": 100000}
+      ]
+    }
+  }
+]`
+	estimate, err := parseExplainJSON(plan)
+	a.NoError(err)
+	a.Equal(&util.QueryEstimate{Cost: 1834.52, Rows: 1000}, estimate)
+
+	_, err = parseExplainJSON(`[]`)
+	a.Error(err)
+	_, err = parseExplainJSON(`not json`)
+	a.Error(err)
+}
@@ -904,7 +904,7 @@ func (driver *Driver) SyncSlowQuery(ctx context.Context, _ time.Time) (map[strin
 		return nil, util.FormatErrorWithQuery(err, getNow)
 	}
 
-	result := make(map[string]*storepb.SlowQueryStatistics)
+	builder := util.NewSlowQueryStatisticsBuilder(storepb.Engine_POSTGRES)
 	version, err := driver.getPGStatStatementsVersion(ctx)
 	if err != nil {
 		return nil, err
@@ -962,24 +962,15 @@ func (driver *Driver) SyncSlowQuery(ctx context.Context, _ time.Time) (map[strin
 		if err := slowQueryStatisticsRows.Scan(&database, &fingerprint, &calls, &totalExecTime, &maxExecTime, &rows); err != nil {
 			return nil, err
 		}
-		if len(fingerprint) > db.SlowQueryMaxLen {
-			fingerprint, _ = common.TruncateString(fingerprint, db.SlowQueryMaxLen)
-		}
-		item := storepb.SlowQueryStatisticsItem{
+		// The query of pg_stat_statements is normalized already, the builder further collapses the IN and VALUES lists.
+		builder.Add(database, &storepb.SlowQueryStatisticsItem{
 			SqlFingerprint:   fingerprint,
 			Count:            calls,
 			LatestLogTime:    timestamppb.New(now.UTC()),
 			TotalQueryTime:   durationpb.New(time.Duration(totalExecTime * float64(time.Millisecond))),
 			MaximumQueryTime: durationpb.New(time.Duration(maxExecTime * float64(time.Millisecond))),
 			TotalRowsSent:    rows,
-		}
-		if statistics, exists := result[database]; exists {
-			statistics.Items = append(statistics.Items, &item)
-		} else {
-			result[database] = &storepb.SlowQueryStatistics{
-				Items: []*storepb.SlowQueryStatisticsItem{&item},
-			}
-		}
+		})
 	}
 	if err := slowQueryStatisticsRows.Err(); err != nil {
 		return nil, err
@@ -989,7 +980,7 @@ func (driver *Driver) SyncSlowQuery(ctx context.Context, _ time.Time) (map[strin
 	if _, err := driver.db.ExecContext(ctx, reset); err != nil {
 		return nil, util.FormatErrorWithQuery(err, reset)
 	}
-	return result, nil
+	return builder.Build(), nil
 }
 
 // CheckSlowQueryLogEnabled checks if slow query log is enabled.
@@ -0,0 +1,282 @@
+package redis
+
+import (
+	"context"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// keyspaceSampleSize is the maximum number of the keys sampled from the keyspace.
+	keyspaceSampleSize = 10000
+	// scanBatchSize is the COUNT hint of SCAN and the batch size of inspecting keys in a pipeline.
+	scanBatchSize = 1000
+	// maxKeyPatternCount is the maximum number of the key patterns, the least common patterns are folded into "*".
+	maxKeyPatternCount = 500
+	// keyPatternDelimiter is the conventional delimiter of the key segments.
+	keyPatternDelimiter = ":"
+)
+
+var (
+	uuidRegexp   = regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{12}$`)
+	hexRegexp    = regexp.MustCompile(`^[0-9a-fA-F]{8,}$`)
+	digitsRegexp = regexp.MustCompile(`[0-9]+`)
+)
+
+// keySample is the inspected sampled key.
+type keySample struct {
+	key  string
+	tp   string
+	ttl  time.Duration
+	size int64
+}
+
+// syncKeyspace samples the keyspace by SCAN, and groups the sampled keys by the key patterns.
+func (d *Driver) syncKeyspace(ctx context.Context) ([]*storepb.KeyPatternMetadata, error) {
+	dbSize, err := d.rdb.DBSize(ctx).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get database size")
+	}
+	if dbSize == 0 {
+		return nil, nil
+	}
+	keys, err := d.scanKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	samples, err := d.inspectKeys(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	return groupKeyPatterns(samples, dbSize), nil
+}
+
+// scanKeys scans up to keyspaceSampleSize keys. For Redis cluster, the keys are scanned on every master evenly.
+func (d *Driver) scanKeys(ctx context.Context) ([]string, error) {
+	clusterClient, ok := d.rdb.(*redis.ClusterClient)
+	if !ok {
+		return scanNodeKeys(ctx, d.rdb, keyspaceSampleSize)
+	}
+
+	var masterCount int
+	var mu sync.Mutex
+	if err := clusterClient.ForEachMaster(ctx, func(context.Context, *redis.Client) error {
+		mu.Lock()
+		defer mu.Unlock()
+		masterCount++
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to list cluster masters")
+	}
+	if masterCount == 0 {
+		return nil, nil
+	}
+	limit := (keyspaceSampleSize + masterCount - 1) / masterCount
+
+	var keys []string
+	if err := clusterClient.ForEachMaster(ctx, func(ctx context.Context, client *redis.Client) error {
+		nodeKeys, err := scanNodeKeys(ctx, client, limit)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		keys = append(keys, nodeKeys...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func scanNodeKeys(ctx context.Context, client redis.Cmdable, limit int) ([]string, error) {
+	var keys []string
+	var cursor uint64
+	for {
+		batch, next, err := client.Scan(ctx, cursor, "", scanBatchSize).Result()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan keys")
+		}
+		keys = append(keys, batch...)
+		cursor = next
+		if cursor == 0 || len(keys) >= limit {
+			break
+		}
+	}
+	if len(keys) > limit {
+		keys = keys[:limit]
+	}
+	return keys, nil
+}
+
+// inspectKeys gets the type, TTL and memory usage of the keys in pipelines.
+// The keys deleted during the sampling are skipped.
+func (d *Driver) inspectKeys(ctx context.Context, keys []string) ([]*keySample, error) {
+	var samples []*keySample
+	for start := 0; start < len(keys); start += scanBatchSize {
+		end := min(start+scanBatchSize, len(keys))
+		batch := keys[start:end]
+
+		pipeline := d.rdb.Pipeline()
+		typeCmds := make([]*redis.StatusCmd, len(batch))
+		ttlCmds := make([]*redis.DurationCmd, len(batch))
+		memoryCmds := make([]*redis.IntCmd, len(batch))
+		for i, key := range batch {
+			typeCmds[i] = pipeline.Type(ctx, key)
+			ttlCmds[i] = pipeline.PTTL(ctx, key)
+			memoryCmds[i] = pipeline.MemoryUsage(ctx, key)
+		}
+		// The errors are checked for each command, because MEMORY USAGE may be disabled by the cloud vendors.
+		_, _ = pipeline.Exec(ctx)
+
+		for i, key := range batch {
+			tp, err := typeCmds[i].Result()
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get type of key %q", key)
+			}
+			if tp == "none" {
+				continue
+			}
+			ttl, err := ttlCmds[i].Result()
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get TTL of key %q", key)
+			}
+			if ttl == -2 {
+				continue
+			}
+			size, err := memoryCmds[i].Result()
+			if err != nil {
+				size = 0
+			}
+			samples = append(samples, &keySample{key: key, tp: tp, ttl: ttl, size: size})
+		}
+	}
+	return samples, nil
+}
+
+// groupKeyPatterns groups the sampled keys by the key patterns.
+// The key count and the memory usage are estimated by the ratio of the database size to the sample size.
+func groupKeyPatterns(samples []*keySample, dbSize int64) []*storepb.KeyPatternMetadata {
+	if len(samples) == 0 {
+		return nil
+	}
+	patternMap := make(map[string]*storepb.KeyPatternMetadata)
+	var patterns []*storepb.KeyPatternMetadata
+	for _, sample := range samples {
+		name := getKeyPattern(sample.key)
+		pattern, ok := patternMap[name]
+		if !ok {
+			pattern = &storepb.KeyPatternMetadata{
+				Pattern:         name,
+				ExampleKey:      sample.key,
+				TtlDistribution: &storepb.KeyPatternMetadata_TTLDistribution{},
+			}
+			patternMap[name] = pattern
+			patterns = append(patterns, pattern)
+		}
+		mergeKeySample(pattern, sample)
+	}
+
+	if len(patterns) > maxKeyPatternCount {
+		// Keep the most common patterns, and fold the others into "*".
+		var other *storepb.KeyPatternMetadata
+		patterns = slices.DeleteFunc(patterns, func(p *storepb.KeyPatternMetadata) bool {
+			if p.Pattern == "*" {
+				other = p
+				return true
+			}
+			return false
+		})
+		if other == nil {
+			other = &storepb.KeyPatternMetadata{Pattern: "*", TtlDistribution: &storepb.KeyPatternMetadata_TTLDistribution{}}
+		}
+		sort.SliceStable(patterns, func(i, j int) bool {
+			return patterns[i].SampleCount > patterns[j].SampleCount
+		})
+		for _, pattern := range patterns[maxKeyPatternCount-1:] {
+			mergeKeyPattern(other, pattern)
+		}
+		patterns = append(patterns[:maxKeyPatternCount-1], other)
+	}
+
+	ratio := 1.0
+	if dbSize > int64(len(samples)) {
+		ratio = float64(dbSize) / float64(len(samples))
+	}
+	for _, pattern := range patterns {
+		slices.Sort(pattern.Types)
+		pattern.KeyCount = int64(math.Round(float64(pattern.SampleCount) * ratio))
+		pattern.MemoryUsage = int64(math.Round(float64(pattern.MemoryUsage) * ratio))
+	}
+	sort.Slice(patterns, func(i, j int) bool {
+		return patterns[i].Pattern < patterns[j].Pattern
+	})
+	return patterns
+}
+
+func mergeKeySample(pattern *storepb.KeyPatternMetadata, sample *keySample) {
+	pattern.SampleCount++
+	pattern.MemoryUsage += sample.size
+	if !slices.Contains(pattern.Types, sample.tp) {
+		pattern.Types = append(pattern.Types, sample.tp)
+	}
+	if sample.key < pattern.ExampleKey {
+		pattern.ExampleKey = sample.key
+	}
+	distribution := pattern.TtlDistribution
+	switch {
+	case sample.ttl < 0:
+		distribution.NoExpiry++
+	case sample.ttl <= time.Hour:
+		distribution.WithinHour++
+	case sample.ttl <= 24*time.Hour:
+		distribution.WithinDay++
+	case sample.ttl <= 7*24*time.Hour:
+		distribution.WithinWeek++
+	default:
+		distribution.OverWeek++
+	}
+}
+
+func mergeKeyPattern(dst, src *storepb.KeyPatternMetadata) {
+	dst.SampleCount += src.SampleCount
+	dst.MemoryUsage += src.MemoryUsage
+	for _, tp := range src.Types {
+		if !slices.Contains(dst.Types, tp) {
+			dst.Types = append(dst.Types, tp)
+		}
+	}
+	if dst.ExampleKey == "" || src.ExampleKey < dst.ExampleKey {
+		dst.ExampleKey = src.ExampleKey
+	}
+	dst.TtlDistribution.NoExpiry += src.TtlDistribution.NoExpiry
+	dst.TtlDistribution.WithinHour += src.TtlDistribution.WithinHour
+	dst.TtlDistribution.WithinDay += src.TtlDistribution.WithinDay
+	dst.TtlDistribution.WithinWeek += src.TtlDistribution.WithinWeek
+	dst.TtlDistribution.OverWeek += src.TtlDistribution.OverWeek
+}
+
+// getKeyPattern returns the pattern of the key by replacing the variable segments with "*".
+// The segments are separated by ":", the segment is variable if it's a UUID or a hex string such as a hash,
+// otherwise the digits in the segment are replaced, e.g. "user:1001:profile" is grouped into "user:*:profile".
+func getKeyPattern(key string) string {
+	segments := strings.Split(key, keyPatternDelimiter)
+	for i, segment := range segments {
+		if uuidRegexp.MatchString(segment) || (hexRegexp.MatchString(segment) && digitsRegexp.MatchString(segment)) {
+			segments[i] = "*"
+			continue
+		}
+		segments[i] = digitsRegexp.ReplaceAllString(segment, "*")
+	}
+	return strings.Join(segments, keyPatternDelimiter)
+}
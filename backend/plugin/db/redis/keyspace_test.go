@@ -0,0 +1,60 @@
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetKeyPattern(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{key: "user:1001:profile", want: "user:*:profile"},
+		{key: "session:3f2a1b4c-9d8e-4f70-a1b2-c3d4e5f6a7b8", want: "session:*"},
+		{key: "cache:5d41402abc4b2a76b9719d911017c592", want: "cache:*"},
+		{key: "order2024", want: "order*"},
+		{key: "config", want: "config"},
+		{key: "feed:deadbeef", want: "feed:deadbeef"},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, getKeyPattern(test.key), test.key)
+	}
+}
+
+func TestGroupKeyPatterns(t *testing.T) {
+	samples := []*keySample{
+		{key: "user:2", tp: "hash", ttl: -1, size: 100},
+		{key: "user:1", tp: "hash", ttl: 30 * time.Minute, size: 120},
+		{key: "user:3", tp: "string", ttl: 48 * time.Hour, size: 60},
+		{key: "config", tp: "string", ttl: -1, size: 40},
+	}
+
+	a := require.New(t)
+	a.Equal([]*storepb.KeyPatternMetadata{
+		{
+			Pattern:         "config",
+			Types:           []string{"string"},
+			SampleCount:     1,
+			KeyCount:        10,
+			MemoryUsage:     400,
+			ExampleKey:      "config",
+			TtlDistribution: &storepb.KeyPatternMetadata_TTLDistribution{NoExpiry: 1},
+		},
+		{
+			Pattern:         "user:*",
+			Types:           []string{"hash", "string"},
+			SampleCount:     3,
+			KeyCount:        30,
+			MemoryUsage:     2800,
+			ExampleKey:      "user:1",
+			TtlDistribution: &storepb.KeyPatternMetadata_TTLDistribution{NoExpiry: 1, WithinHour: 1, WithinWeek: 1},
+		},
+	}, groupKeyPatterns(samples, 40))
+}
@@ -9,7 +9,6 @@ import (
 	"log/slog"
 	"net"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -21,6 +20,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	redisparser "github.com/bytebase/bytebase/backend/plugin/parser/redis"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
@@ -148,21 +148,14 @@ func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteO
 		return 0, errors.New("redis: cannot create database")
 	}
 
-	lines := strings.Split(statement, "\n")
-	for i := range lines {
-		lines[i] = strings.Trim(lines[i], " \n\t\r")
+	commands, err := redisparser.ParseRedisCommands(statement)
+	if err != nil {
+		return 0, err
 	}
 
 	if _, err := d.rdb.Pipelined(ctx, func(p redis.Pipeliner) error {
-		for _, line := range lines {
-			if line == "" {
-				continue
-			}
-			var input []any
-			for _, s := range strings.Split(line, " ") {
-				input = append(input, s)
-			}
-			_ = p.Do(ctx, input...)
+		for _, command := range commands {
+			_ = p.Do(ctx, command.Input()...)
 		}
 		return nil
 	}); err != nil && err != redis.Nil {
@@ -190,25 +183,22 @@ func (*Driver) Restore(context.Context, io.Reader) error {
 // QueryConn queries a SQL statement in a given connection.
 func (d *Driver) QueryConn(ctx context.Context, _ *sql.Conn, statement string, _ *db.QueryContext) ([]*v1pb.QueryResult, error) {
 	startTime := time.Now()
-	lines := strings.Split(statement, "\n")
-	for i := range lines {
-		lines[i] = strings.Trim(lines[i], " \n\t\r")
+	commands, err := redisparser.ParseRedisCommands(statement)
+	if err != nil {
+		return nil, err
+	}
+	for _, command := range commands {
+		if redisparser.ClassifyCommand(command) == redisparser.CommandTypeDangerous {
+			return nil, errors.Errorf("dangerous command %q at line %d is not allowed in SQL Editor, please run it in an issue", command.Name, command.Line)
+		}
 	}
 
 	var data []*v1pb.QueryRow
 	var cmds []*redis.Cmd
 
 	if _, err := d.rdb.Pipelined(ctx, func(p redis.Pipeliner) error {
-		for _, line := range lines {
-			if line == "" {
-				continue
-			}
-			var input []any
-			for _, s := range strings.Split(line, " ") {
-				input = append(input, s)
-			}
-			cmd := p.Do(ctx, input...)
-			cmds = append(cmds, cmd)
+		for _, command := range commands {
+			cmds = append(cmds, p.Do(ctx, command.Input()...))
 		}
 		return nil
 	}); err != nil && err != redis.Nil {
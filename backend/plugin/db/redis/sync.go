@@ -53,8 +53,21 @@ func (d *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, error)
 }
 
 // SyncDBSchema syncs a single database schema.
-func (d *Driver) SyncDBSchema(context.Context) (*storepb.DatabaseSchemaMetadata, error) {
-	return &storepb.DatabaseSchemaMetadata{Name: d.databaseName}, nil
+// Redis is schemaless, so we sample the keyspace and group the keys by the key patterns instead.
+func (d *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchemaMetadata, error) {
+	keyPatterns, err := d.syncKeyspace(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sync keyspace")
+	}
+	return &storepb.DatabaseSchemaMetadata{
+		Name: d.databaseName,
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name:        "",
+				KeyPatterns: keyPatterns,
+			},
+		},
+	}, nil
 }
 
 func (d *Driver) getVersion(ctx context.Context) (string, error) {
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -572,12 +574,71 @@ func (driver *Driver) getTableSchema(ctx context.Context, database string) (map[
 	return tableMap, viewMap, nil
 }
 
-// SyncSlowQuery syncs the slow query.
-func (*Driver) SyncSlowQuery(_ context.Context, _ time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
-	return nil, errors.Errorf("not implemented")
+// slowQueryThresholdMilliseconds is the minimum elapsed time of a slow query in milliseconds.
+const slowQueryThresholdMilliseconds = 1000
+
+// SyncSlowQuery syncs the slow query statistics of the log date from SNOWFLAKE.ACCOUNT_USAGE.QUERY_HISTORY.
+// The queries with the same parameterized hash only differ in literals.
+func (driver *Driver) SyncSlowQuery(ctx context.Context, logDateTs time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
+	startTime := logDateTs.UTC().Truncate(24 * time.Hour)
+	endTime := startTime.AddDate(0, 0, 1)
+
+	// The elapsed time in QUERY_HISTORY is in milliseconds.
+	query := fmt.Sprintf(`
+		SELECT
+			DATABASE_NAME,
+			ANY_VALUE(QUERY_TEXT),
+			COUNT(*),
+			MAX(END_TIME),
+			SUM(TOTAL_ELAPSED_TIME),
+			MAX(TOTAL_ELAPSED_TIME),
+			SUM(ROWS_PRODUCED),
+			MAX(ROWS_PRODUCED)
+		FROM SNOWFLAKE.ACCOUNT_USAGE.QUERY_HISTORY
+		WHERE START_TIME >= ?
+			AND START_TIME < ?
+			AND TOTAL_ELAPSED_TIME >= %d
+			AND EXECUTION_STATUS = 'SUCCESS'
+			AND DATABASE_NAME IS NOT NULL
+		GROUP BY DATABASE_NAME, COALESCE(QUERY_PARAMETERIZED_HASH, QUERY_HASH, QUERY_ID)`, slowQueryThresholdMilliseconds)
+	rows, err := driver.db.QueryContext(ctx, query, startTime, endTime)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	builder := util.NewSlowQueryStatisticsBuilder(storepb.Engine_SNOWFLAKE)
+	for rows.Next() {
+		var database, text string
+		var count, totalTime, maxTime int64
+		var totalRows, maxRows sql.NullInt64
+		var latestTime time.Time
+		if err := rows.Scan(&database, &text, &count, &latestTime, &totalTime, &maxTime, &totalRows, &maxRows); err != nil {
+			return nil, err
+		}
+		builder.Add(database, &storepb.SlowQueryStatisticsItem{
+			SqlFingerprint:   strings.TrimSpace(text),
+			Count:            util.ClampInt32(count),
+			LatestLogTime:    timestamppb.New(latestTime),
+			TotalQueryTime:   durationpb.New(time.Duration(totalTime) * time.Millisecond),
+			MaximumQueryTime: durationpb.New(time.Duration(maxTime) * time.Millisecond),
+			TotalRowsSent:    util.ClampInt32(totalRows.Int64),
+			MaximumRowsSent:  util.ClampInt32(maxRows.Int64),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+
+	return builder.Build(), nil
 }
 
-// CheckSlowQueryLogEnabled checks if slow query log is enabled.
-func (*Driver) CheckSlowQueryLogEnabled(_ context.Context) error {
-	return errors.Errorf("not implemented")
+// CheckSlowQueryLogEnabled checks if SNOWFLAKE.ACCOUNT_USAGE.QUERY_HISTORY is accessible.
+func (driver *Driver) CheckSlowQueryLogEnabled(ctx context.Context) error {
+	query := `SELECT COUNT(*) FROM (SELECT QUERY_ID FROM SNOWFLAKE.ACCOUNT_USAGE.QUERY_HISTORY LIMIT 1)`
+	var count int64
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&count); err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	return nil
 }
@@ -23,7 +23,6 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/util"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
-	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
@@ -734,7 +733,7 @@ func analyzeSlowLog(engine storepb.Engine, logs []*slowLog) (map[string]*storepb
 
 	for _, log := range logs {
 		databaseList := extractDatabase(engine, log.database, log.details.SqlText)
-		fingerprint, err := mysqlparser.GetFingerprint(log.details.SqlText)
+		fingerprint, err := base.GetFingerprint(engine, log.details.SqlText)
 		if err != nil {
 			return nil, errors.Wrapf(err, "get sql fingerprint failed, sql: %s", log.details.SqlText)
 		}
@@ -0,0 +1,63 @@
+package util
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// IsNativeCancelSupported returns true if the running query of the engine can be canceled from another connection.
+// MSSQL isn't supported because KILL terminates the whole session and requires ALTER ANY CONNECTION,
+// and the driver already sends the attention signal to cancel the query once the context is done.
+func IsNativeCancelSupported(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_POSTGRES, storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetSessionID returns the engine session ID of the connection, which is used to cancel the running query by CancelSessionQuery.
+func GetSessionID(ctx context.Context, engine storepb.Engine, conn *sql.Conn) (string, error) {
+	var query string
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		query = "SELECT pg_backend_pid()"
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE:
+		query = "SELECT CONNECTION_ID()"
+	default:
+		return "", errors.Errorf("native cancel is not supported for %s", engine)
+	}
+	var id int64
+	if err := conn.QueryRowContext(ctx, query).Scan(&id); err != nil {
+		return "", errors.Wrapf(err, "failed to get session ID")
+	}
+	return strconv.FormatInt(id, 10), nil
+}
+
+// CancelSessionQuery cancels the running query of the session by the engine native cancellation from another connection.
+func CancelSessionQuery(ctx context.Context, engine storepb.Engine, db *sql.DB, sessionID string) error {
+	// The session ID must be an integer, and we format it back to avoid injection because KILL doesn't accept placeholders.
+	id, err := strconv.ParseInt(sessionID, 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid session ID %q", sessionID)
+	}
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		_, err = db.ExecContext(ctx, "SELECT pg_cancel_backend($1)", id)
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE:
+		_, err = db.ExecContext(ctx, fmt.Sprintf("KILL QUERY %d", id))
+	default:
+		return errors.Errorf("native cancel is not supported for %s", engine)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to cancel the query of session %d", id)
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// awsRDSTokenExpiry is the maximum lifetime of the AWS RDS IAM authentication token.
+	awsRDSTokenExpiry = 15 * time.Minute
+	// emptyPayloadHash is the SHA256 hash of the empty payload used to presign the AWS RDS connect request.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	googleCloudSQLLoginScope = "https://www.googleapis.com/auth/sqlservice.login"
+	azureSQLScope            = "https://database.windows.net/.default"
+	azureOSSRDBMSScope       = "https://ossrdbms-aad.database.windows.net/.default"
+)
+
+var (
+	// rdsHostRegexp matches the region in the RDS endpoint, such as mydb.123456789012.us-east-1.rds.amazonaws.com.
+	rdsHostRegexp = regexp.MustCompile(`\.([a-z0-9-]+)\.rds\.amazonaws\.com(\.cn)?$`)
+
+	// The credential loaders read the credentials from the host environment,
+	// such as the environment variables, the shared config files and the metadata service of the cloud VM.
+	// They are replaced in tests.
+	loadAWSCredentials = func(ctx context.Context) (aws.CredentialsProvider, error) {
+		cfg, err := awsconfig.LoadDefaultConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return cfg.Credentials, nil
+	}
+	loadGoogleTokenSource = func(ctx context.Context, scope string) (oauth2.TokenSource, error) {
+		credentials, err := google.FindDefaultCredentials(ctx, scope)
+		if err != nil {
+			return nil, err
+		}
+		return credentials.TokenSource, nil
+	}
+	loadAzureCredential = func() (azcore.TokenCredential, error) {
+		return azidentity.NewDefaultAzureCredential(nil)
+	}
+)
+
+// TokenProvider returns the token used as the password of a new connection.
+// The cloud IAM tokens are short-lived, so the drivers get a token for every new connection.
+// The credentials and tokens are cached by the cloud SDKs and refreshed before they expire.
+type TokenProvider func(ctx context.Context) (string, error)
+
+// IsCloudIAMAuthentication returns true if the authentication uses the token of the cloud IAM instead of the password.
+func IsCloudIAMAuthentication(authenticationType storepb.DataSourceOptions_AuthenticationType) bool {
+	switch authenticationType {
+	case storepb.DataSourceOptions_AWS_RDS_IAM, storepb.DataSourceOptions_GOOGLE_CLOUD_SQL_IAM, storepb.DataSourceOptions_AZURE_IAM:
+		return true
+	default:
+		return false
+	}
+}
+
+// CheckAuthenticationType checks if the engine supports the authentication type.
+func CheckAuthenticationType(engine storepb.Engine, authenticationType storepb.DataSourceOptions_AuthenticationType) error {
+	var engines []storepb.Engine
+	switch authenticationType {
+	case storepb.DataSourceOptions_AUTHENTICATION_UNSPECIFIED, storepb.DataSourceOptions_PASSWORD:
+		return nil
+	case storepb.DataSourceOptions_AWS_RDS_IAM:
+		engines = []storepb.Engine{storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_POSTGRES}
+	case storepb.DataSourceOptions_GOOGLE_CLOUD_SQL_IAM:
+		engines = []storepb.Engine{storepb.Engine_MYSQL, storepb.Engine_POSTGRES}
+	case storepb.DataSourceOptions_AZURE_IAM:
+		engines = []storepb.Engine{storepb.Engine_MYSQL, storepb.Engine_POSTGRES, storepb.Engine_MSSQL}
+	default:
+		return errors.Errorf("unsupported authentication type %s", authenticationType)
+	}
+	for _, e := range engines {
+		if e == engine {
+			return nil
+		}
+	}
+	return errors.Errorf("authentication type %s is not supported for %s", authenticationType, engine)
+}
+
+// GetTokenProvider returns the token provider of the cloud IAM authentication.
+// It returns nil for the password authentication.
+func GetTokenProvider(ctx context.Context, engine storepb.Engine, config db.ConnectionConfig) (TokenProvider, error) {
+	if !IsCloudIAMAuthentication(config.AuthenticationType) {
+		return nil, nil
+	}
+	if err := CheckAuthenticationType(engine, config.AuthenticationType); err != nil {
+		return nil, err
+	}
+
+	switch config.AuthenticationType {
+	case storepb.DataSourceOptions_AWS_RDS_IAM:
+		region := config.Region
+		if region == "" {
+			region = GetRDSRegion(config.Host)
+		}
+		if region == "" {
+			return nil, errors.Errorf("region is required for AWS RDS IAM authentication with host %q", config.Host)
+		}
+		credentials, err := loadAWSCredentials(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load AWS credentials")
+		}
+		endpoint := fmt.Sprintf("%s:%s", config.Host, config.Port)
+		return func(ctx context.Context) (string, error) {
+			return BuildAWSRDSAuthToken(ctx, endpoint, region, config.Username, credentials, time.Now())
+		}, nil
+	case storepb.DataSourceOptions_GOOGLE_CLOUD_SQL_IAM:
+		tokenSource, err := loadGoogleTokenSource(ctx, googleCloudSQLLoginScope)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find Google default credentials")
+		}
+		return func(context.Context) (string, error) {
+			token, err := tokenSource.Token()
+			if err != nil {
+				return "", errors.Wrap(err, "failed to get Google access token")
+			}
+			return token.AccessToken, nil
+		}, nil
+	case storepb.DataSourceOptions_AZURE_IAM:
+		credential, err := loadAzureCredential()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load Azure credentials")
+		}
+		scope := azureOSSRDBMSScope
+		if engine == storepb.Engine_MSSQL {
+			scope = azureSQLScope
+		}
+		return func(ctx context.Context) (string, error) {
+			token, err := credential.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{scope}})
+			if err != nil {
+				return "", errors.Wrap(err, "failed to get Azure access token")
+			}
+			return token.Token, nil
+		}, nil
+	}
+	return nil, nil
+}
+
+// GetRDSRegion returns the region parsed from the RDS endpoint, or empty string if the host is not an RDS endpoint.
+func GetRDSRegion(host string) string {
+	matches := rdsHostRegexp.FindStringSubmatch(strings.ToLower(host))
+	if len(matches) < 2 {
+		return ""
+	}
+	return matches[1]
+}
+
+// BuildAWSRDSAuthToken builds the AWS RDS IAM authentication token, which is the presigned URL of the connect action without the scheme.
+// It's the same as the BuildAuthToken in github.com/aws/aws-sdk-go-v2/feature/rds/auth.
+func BuildAWSRDSAuthToken(ctx context.Context, endpoint, region, dbUser string, credentials aws.CredentialsProvider, signingTime time.Time) (string, error) {
+	creds, err := credentials.Retrieve(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to retrieve AWS credentials")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+endpoint+"/", nil)
+	if err != nil {
+		return "", err
+	}
+	values := req.URL.Query()
+	values.Set("Action", "connect")
+	values.Set("DBUser", dbUser)
+	values.Set("X-Amz-Expires", fmt.Sprintf("%d", int(awsRDSTokenExpiry.Seconds())))
+	req.URL.RawQuery = values.Encode()
+
+	signedURL, _, err := v4.NewSigner().PresignHTTP(ctx, creds, req, emptyPayloadHash, "rds-db", region, signingTime)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to presign the AWS RDS connect request")
+	}
+	return strings.TrimPrefix(signedURL, "https://"), nil
+}
@@ -0,0 +1,143 @@
+package util
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetRDSRegion(t *testing.T) {
+	tests := []struct {
+		host string
+		want string
+	}{
+		{host: "mydb.123456789012.us-east-1.rds.amazonaws.com", want: "us-east-1"},
+		{host: "mycluster.cluster-ro-abc.eu-west-2.rds.amazonaws.com", want: "eu-west-2"},
+		{host: "mydb.abc.cn-north-1.rds.amazonaws.com.cn", want: "cn-north-1"},
+		{host: "MyDB.abc.AP-SOUTHEAST-1.RDS.AMAZONAWS.COM", want: "ap-southeast-1"},
+		{host: "localhost", want: ""},
+		{host: "rds.amazonaws.com", want: ""},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, GetRDSRegion(test.host), test.host)
+	}
+}
+
+func TestBuildAWSRDSAuthToken(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	provider := credentials.NewStaticCredentialsProvider("AKIDEXAMPLE", "SECRET", "")
+	signingTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	token, err := BuildAWSRDSAuthToken(ctx, "mydb.abc.us-east-1.rds.amazonaws.com:5432", "us-east-1", "bytebase", provider, signingTime)
+	a.NoError(err)
+	u, err := url.Parse("https://" + token)
+	a.NoError(err)
+	a.Equal("mydb.abc.us-east-1.rds.amazonaws.com:5432", u.Host)
+	query := u.Query()
+	a.Equal("connect", query.Get("Action"))
+	a.Equal("bytebase", query.Get("DBUser"))
+	a.Equal("900", query.Get("X-Amz-Expires"))
+	a.Equal("AWS4-HMAC-SHA256", query.Get("X-Amz-Algorithm"))
+	a.Equal("AKIDEXAMPLE/20240102/us-east-1/rds-db/aws4_request", query.Get("X-Amz-Credential"))
+	a.Equal("20240102T030405Z", query.Get("X-Amz-Date"))
+	a.Equal("host", query.Get("X-Amz-SignedHeaders"))
+	a.Len(query.Get("X-Amz-Signature"), 64)
+
+	// The token is deterministic for the same input, and changes with the credentials.
+	again, err := BuildAWSRDSAuthToken(ctx, "mydb.abc.us-east-1.rds.amazonaws.com:5432", "us-east-1", "bytebase", provider, signingTime)
+	a.NoError(err)
+	a.Equal(token, again)
+	other, err := BuildAWSRDSAuthToken(ctx, "mydb.abc.us-east-1.rds.amazonaws.com:5432", "us-east-1", "bytebase", credentials.NewStaticCredentialsProvider("AKIDEXAMPLE", "ROTATED", ""), signingTime)
+	a.NoError(err)
+	a.NotEqual(token, other)
+}
+
+type fakeTokenSource struct {
+	count int
+}
+
+func (s *fakeTokenSource) Token() (*oauth2.Token, error) {
+	s.count++
+	return &oauth2.Token{AccessToken: "google-token"}, nil
+}
+
+type fakeAzureCredential struct {
+	scopes []string
+}
+
+func (c *fakeAzureCredential) GetToken(_ context.Context, options policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	c.scopes = options.Scopes
+	return azcore.AccessToken{Token: "azure-token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func TestGetTokenProvider(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	originalAWS, originalGoogle, originalAzure := loadAWSCredentials, loadGoogleTokenSource, loadAzureCredential
+	t.Cleanup(func() {
+		loadAWSCredentials, loadGoogleTokenSource, loadAzureCredential = originalAWS, originalGoogle, originalAzure
+	})
+
+	provider, err := GetTokenProvider(ctx, storepb.Engine_MYSQL, db.ConnectionConfig{Password: "secret"})
+	a.NoError(err)
+	a.Nil(provider)
+	_, err = GetTokenProvider(ctx, storepb.Engine_ORACLE, db.ConnectionConfig{AuthenticationType: storepb.DataSourceOptions_AZURE_IAM})
+	a.ErrorContains(err, "not supported")
+
+	loadAWSCredentials = func(context.Context) (aws.CredentialsProvider, error) {
+		return credentials.NewStaticCredentialsProvider("AKIDEXAMPLE", "SECRET", ""), nil
+	}
+	_, err = GetTokenProvider(ctx, storepb.Engine_POSTGRES, db.ConnectionConfig{AuthenticationType: storepb.DataSourceOptions_AWS_RDS_IAM, Host: "localhost"})
+	a.ErrorContains(err, "region is required")
+	provider, err = GetTokenProvider(ctx, storepb.Engine_POSTGRES, db.ConnectionConfig{AuthenticationType: storepb.DataSourceOptions_AWS_RDS_IAM, Host: "localhost", Port: "5432", Username: "bytebase", Region: "us-west-2"})
+	a.NoError(err)
+	token, err := provider(ctx)
+	a.NoError(err)
+	a.Contains(token, "localhost:5432/?Action=connect&DBUser=bytebase")
+	a.Contains(token, "%2Fus-west-2%2Frds-db%2F")
+
+	tokenSource := &fakeTokenSource{}
+	var googleScope string
+	loadGoogleTokenSource = func(_ context.Context, scope string) (oauth2.TokenSource, error) {
+		googleScope = scope
+		return tokenSource, nil
+	}
+	provider, err = GetTokenProvider(ctx, storepb.Engine_MYSQL, db.ConnectionConfig{AuthenticationType: storepb.DataSourceOptions_GOOGLE_CLOUD_SQL_IAM})
+	a.NoError(err)
+	a.Equal(googleCloudSQLLoginScope, googleScope)
+	// The provider gets a token for every new connection.
+	for i := 0; i < 2; i++ {
+		token, err = provider(ctx)
+		a.NoError(err)
+		a.Equal("google-token", token)
+	}
+	a.Equal(2, tokenSource.count)
+
+	credential := &fakeAzureCredential{}
+	loadAzureCredential = func() (azcore.TokenCredential, error) {
+		return credential, nil
+	}
+	provider, err = GetTokenProvider(ctx, storepb.Engine_MSSQL, db.ConnectionConfig{AuthenticationType: storepb.DataSourceOptions_AZURE_IAM})
+	a.NoError(err)
+	token, err = provider(ctx)
+	a.NoError(err)
+	a.Equal("azure-token", token)
+	a.Equal([]string{azureSQLScope}, credential.scopes)
+	provider, err = GetTokenProvider(ctx, storepb.Engine_POSTGRES, db.ConnectionConfig{AuthenticationType: storepb.DataSourceOptions_AZURE_IAM})
+	a.NoError(err)
+	_, err = provider(ctx)
+	a.NoError(err)
+	a.Equal([]string{azureOSSRDBMSScope}, credential.scopes)
+}
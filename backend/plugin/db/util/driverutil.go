@@ -4,12 +4,14 @@ package util
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"log/slog"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/bytebase/bytebase/backend/common/log"
@@ -26,6 +28,15 @@ func FormatErrorWithQuery(err error, query string) error {
 	return errors.Wrapf(err, "failed to execute query %q", query)
 }
 
+// DiscardConn closes the underlying connection of conn instead of returning it to the connection pool.
+// It's used when the session state of the connection cannot be reset, e.g. a session variable fails to be reset,
+// so that the state doesn't leak into the later reuse of the connection.
+func DiscardConn(conn *sql.Conn) {
+	_ = conn.Raw(func(any) error {
+		return driver.ErrBadConn
+	})
+}
+
 // QueryV2 is a copy of Query, but do not mask the data(use none masker).
 func QueryV2(ctx context.Context, conn *sql.Conn, statement string, queryContext *db.QueryContext) (*v1pb.QueryResult, error) {
 	tx, err := conn.BeginTx(ctx, &sql.TxOptions{ReadOnly: queryContext.ReadOnly})
@@ -63,7 +74,7 @@ func QueryV2(ctx context.Context, conn *sql.Conn, statement string, queryContext
 		columnTypeNames = append(columnTypeNames, strings.ToUpper(v.DatabaseTypeName()))
 	}
 
-	data, err := readRows(rows, columnTypeNames, fieldMasker)
+	data, err := readRows(rows, columnTypeNames, fieldMasker, queryContext.MaximumResultSize)
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +148,7 @@ func Query(ctx context.Context, dbType storepb.Engine, conn *sql.Conn, statement
 		columnTypeNames = append(columnTypeNames, strings.ToUpper(v.DatabaseTypeName()))
 	}
 
-	data, err := readRows(rows, columnTypeNames, fieldMasker)
+	data, err := readRows(rows, columnTypeNames, fieldMasker, queryContext.MaximumResultSize)
 	if err != nil {
 		return nil, err
 	}
@@ -249,7 +260,7 @@ func rowsToQueryResult(rows *sql.Rows) (*v1pb.QueryResult, error) {
 		maskers = append(maskers, masker.NewNoneMasker())
 	}
 
-	data, err := readRows(rows, columnTypeNames, maskers)
+	data, err := readRows(rows, columnTypeNames, maskers, 0 /* maximumResultSize */)
 	if err != nil {
 		return nil, err
 	}
@@ -265,8 +276,10 @@ func rowsToQueryResult(rows *sql.Rows) (*v1pb.QueryResult, error) {
 	}, nil
 }
 
-func readRows(rows *sql.Rows, columnTypeNames []string, fieldMasker []masker.Masker) ([]*v1pb.QueryRow, error) {
+// readRows reads the rows, and returns ErrResultSizeExceeded once the size of the rows read exceeds the maximumResultSize if it's positive.
+func readRows(rows *sql.Rows, columnTypeNames []string, fieldMasker []masker.Masker, maximumResultSize int64) ([]*v1pb.QueryRow, error) {
 	var data []*v1pb.QueryRow
+	var size int64
 	if len(columnTypeNames) == 0 {
 		// No rows.
 		// The oracle driver will panic if there is no rows such as EXPLAIN PLAN FOR statement.
@@ -307,6 +320,12 @@ func readRows(rows *sql.Rows, columnTypeNames []string, fieldMasker []masker.Mas
 			}))
 		}
 
+		if maximumResultSize > 0 {
+			size += int64(proto.Size(&rowData))
+			if size > maximumResultSize {
+				return nil, errors.Wrapf(ErrResultSizeExceeded, "the maximum is %d bytes", maximumResultSize)
+			}
+		}
 		data = append(data, &rowData)
 	}
 
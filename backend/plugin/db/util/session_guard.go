@@ -0,0 +1,49 @@
+package util
+
+import (
+	"regexp"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+)
+
+var (
+	// ErrResultSizeExceeded is the error that the query result size exceeds the maximum result size.
+	ErrResultSizeExceeded = errors.New("query result size exceeds the limit")
+	// ErrEstimateExceeded is the error that the estimated cost or rows of the query exceeds the limit.
+	ErrEstimateExceeded = errors.New("query estimate exceeds the limit")
+
+	// estimableStatementRegexp matches the SELECT statements with the leading comments and parentheses.
+	estimableStatementRegexp = regexp.MustCompile(`(?is)^(\s|--[^\n]*(\n|$)|/\*.*?\*/|\()*(SELECT|WITH)\b`)
+)
+
+// QueryEstimate is the cost and row count of a query estimated by EXPLAIN.
+type QueryEstimate struct {
+	// Cost is in the engine specific unit.
+	Cost float64
+	Rows int64
+}
+
+// NeedQueryEstimate returns true if the statement should be estimated by EXPLAIN before running.
+// Only the SELECT statements are estimated, because EXPLAIN is not supported for other statements such as SHOW.
+func NeedQueryEstimate(queryContext *db.QueryContext, statement string) bool {
+	if queryContext == nil || (queryContext.MaximumEstimatedCost <= 0 && queryContext.MaximumEstimatedRows <= 0) {
+		return false
+	}
+	return estimableStatementRegexp.MatchString(statement)
+}
+
+// CheckQueryEstimate returns ErrEstimateExceeded if the estimate exceeds the limits in the query context.
+func CheckQueryEstimate(estimate *QueryEstimate, queryContext *db.QueryContext) error {
+	if estimate == nil {
+		return nil
+	}
+	if queryContext.MaximumEstimatedCost > 0 && estimate.Cost > queryContext.MaximumEstimatedCost {
+		return errors.Wrapf(ErrEstimateExceeded, "the estimated cost %.2f exceeds the maximum %.2f", estimate.Cost, queryContext.MaximumEstimatedCost)
+	}
+	if queryContext.MaximumEstimatedRows > 0 && estimate.Rows > queryContext.MaximumEstimatedRows {
+		return errors.Wrapf(ErrEstimateExceeded, "the estimated rows %d exceeds the maximum %d", estimate.Rows, queryContext.MaximumEstimatedRows)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+)
+
+func TestNeedQueryEstimate(t *testing.T) {
+	guarded := &db.QueryContext{MaximumEstimatedRows: 100}
+	tests := []struct {
+		queryContext *db.QueryContext
+		statement    string
+		want         bool
+	}{
+		{queryContext: &db.QueryContext{}, statement: "SELECT * FROM t", want: false},
+		{queryContext: guarded, statement: "SELECT * FROM t", want: true},
+		{queryContext: guarded, statement: "  select * from t", want: true},
+		{queryContext: &db.QueryContext{MaximumEstimatedCost: 10}, statement: "WITH a AS (SELECT 1) SELECT * FROM a", want: true},
+		{queryContext: guarded, statement: "-- comment\n/* block\ncomment */ (SELECT 1)", want: true},
+		{queryContext: guarded, statement: "SHOW TABLES", want: false},
+		{queryContext: guarded, statement: "EXPLAIN SELECT 1", want: false},
+		{queryContext: guarded, statement: "SELECTED", want: false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, NeedQueryEstimate(test.queryContext, test.statement), test.statement)
+	}
+}
+
+func TestCheckQueryEstimate(t *testing.T) {
+	a := require.New(t)
+	queryContext := &db.QueryContext{MaximumEstimatedCost: 1000, MaximumEstimatedRows: 100}
+
+	a.NoError(CheckQueryEstimate(nil, queryContext))
+	a.NoError(CheckQueryEstimate(&QueryEstimate{Cost: 1000, Rows: 100}, queryContext))
+	err := CheckQueryEstimate(&QueryEstimate{Cost: 1000.5, Rows: 1}, queryContext)
+	a.ErrorIs(err, ErrEstimateExceeded)
+	a.ErrorContains(err, "estimated cost 1000.50")
+	err = CheckQueryEstimate(&QueryEstimate{Cost: 1, Rows: 101}, queryContext)
+	a.ErrorIs(err, ErrEstimateExceeded)
+	a.ErrorContains(err, "estimated rows 101")
+	a.NoError(CheckQueryEstimate(&QueryEstimate{Cost: 1e9, Rows: 1e9}, &db.QueryContext{}))
+}
@@ -0,0 +1,174 @@
+package util
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// SlowQueryStatisticsBuilder normalizes the slow query statistics items collected from the engine statistics views
+// by database and SQL fingerprint. The items with the same fingerprint in a database are merged into one.
+type SlowQueryStatisticsBuilder struct {
+	engine storepb.Engine
+	// databases is the map from database name to the map from fingerprint to the statistics item.
+	databases map[string]map[string]*storepb.SlowQueryStatisticsItem
+}
+
+// NewSlowQueryStatisticsBuilder creates a new slow query statistics builder.
+func NewSlowQueryStatisticsBuilder(engine storepb.Engine) *SlowQueryStatisticsBuilder {
+	return &SlowQueryStatisticsBuilder{
+		engine:    engine,
+		databases: make(map[string]map[string]*storepb.SlowQueryStatisticsItem),
+	}
+}
+
+// Add adds a statistics item of the database, whose SqlFingerprint is the SQL text collected from the engine.
+// The SQL text is kept as a sample and replaced by the fingerprint of the engine if the engine supports it.
+func (b *SlowQueryStatisticsBuilder) Add(database string, item *storepb.SlowQueryStatisticsItem) {
+	if len(item.Samples) == 0 {
+		sqlText := item.SqlFingerprint
+		if len(sqlText) > db.SlowQueryMaxLen {
+			sqlText, _ = common.TruncateString(sqlText, db.SlowQueryMaxLen)
+		}
+		item.Samples = []*storepb.SlowQueryDetails{
+			{
+				StartTime:    item.LatestLogTime,
+				QueryTime:    item.MaximumQueryTime,
+				RowsSent:     item.MaximumRowsSent,
+				RowsExamined: item.MaximumRowsExamined,
+				SqlText:      sqlText,
+			},
+		}
+	}
+	if fingerprint, err := base.GetFingerprint(b.engine, item.SqlFingerprint); err == nil && fingerprint != "" {
+		item.SqlFingerprint = fingerprint
+	}
+	if len(item.SqlFingerprint) > db.SlowQueryMaxLen {
+		item.SqlFingerprint, _ = common.TruncateString(item.SqlFingerprint, db.SlowQueryMaxLen)
+	}
+	items, ok := b.databases[database]
+	if !ok {
+		items = make(map[string]*storepb.SlowQueryStatisticsItem)
+		b.databases[database] = items
+	}
+	mergeSlowQueryStatisticsItem(items, item)
+}
+
+// MergeSlowQueryStatistics merges the slow query statistics whose items are already fingerprinted.
+func MergeSlowQueryStatistics(statistics ...*storepb.SlowQueryStatistics) *storepb.SlowQueryStatistics {
+	items := make(map[string]*storepb.SlowQueryStatisticsItem)
+	var fingerprints []string
+	for _, s := range statistics {
+		for _, item := range s.GetItems() {
+			if _, ok := items[item.SqlFingerprint]; !ok {
+				fingerprints = append(fingerprints, item.SqlFingerprint)
+			}
+			mergeSlowQueryStatisticsItem(items, proto.Clone(item).(*storepb.SlowQueryStatisticsItem))
+		}
+	}
+	result := &storepb.SlowQueryStatistics{}
+	for _, fingerprint := range fingerprints {
+		result.Items = append(result.Items, items[fingerprint])
+	}
+	return result
+}
+
+// mergeSlowQueryStatisticsItem merges the item into the item with the same fingerprint.
+func mergeSlowQueryStatisticsItem(items map[string]*storepb.SlowQueryStatisticsItem, item *storepb.SlowQueryStatisticsItem) {
+	existing, ok := items[item.SqlFingerprint]
+	if !ok {
+		items[item.SqlFingerprint] = item
+		return
+	}
+
+	existing.Count = saturatingAdd(existing.Count, item.Count)
+	if existing.LatestLogTime.AsTime().Before(item.LatestLogTime.AsTime()) {
+		existing.LatestLogTime = item.LatestLogTime
+	}
+	existing.TotalQueryTime = durationpb.New(existing.TotalQueryTime.AsDuration() + item.TotalQueryTime.AsDuration())
+	if existing.MaximumQueryTime.AsDuration() < item.MaximumQueryTime.AsDuration() {
+		existing.MaximumQueryTime = item.MaximumQueryTime
+	}
+	existing.TotalRowsSent = saturatingAdd(existing.TotalRowsSent, item.TotalRowsSent)
+	existing.MaximumRowsSent = max(existing.MaximumRowsSent, item.MaximumRowsSent)
+	existing.TotalRowsExamined = saturatingAdd(existing.TotalRowsExamined, item.TotalRowsExamined)
+	existing.MaximumRowsExamined = max(existing.MaximumRowsExamined, item.MaximumRowsExamined)
+	for _, sample := range item.Samples {
+		if len(existing.Samples) >= db.SlowQueryMaxSamplePerFingerprint {
+			break
+		}
+		existing.Samples = append(existing.Samples, sample)
+	}
+}
+
+// Build returns the slow query statistics by database name.
+func (b *SlowQueryStatisticsBuilder) Build() map[string]*storepb.SlowQueryStatistics {
+	result := make(map[string]*storepb.SlowQueryStatistics)
+	for database, items := range b.databases {
+		statistics := &storepb.SlowQueryStatistics{}
+		for _, item := range items {
+			statistics.Items = append(statistics.Items, item)
+		}
+		result[database] = statistics
+	}
+	return result
+}
+
+// ClampInt32 converts the int64 statistics value to int32, saturating at the int32 bounds.
+func ClampInt32(v int64) int32 {
+	if v > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	if v < math.MinInt32 {
+		return math.MinInt32
+	}
+	return int32(v)
+}
+
+func saturatingAdd(a, b int32) int32 {
+	return ClampInt32(int64(a) + int64(b))
+}
+
+// DiffSlowQueryStatistics returns the increments of the cumulative slow query statistics between two snapshots of a database,
+// for the engines whose statistics views keep cumulative counters since the statement is cached, such as
+// sys.dm_exec_query_stats and V$SQLSTATS.
+// A statement missing in the previous snapshot, or whose counters go backwards because it's evicted and cached again,
+// contributes all of its current statistics. The statements not executed between the snapshots are dropped.
+// The maximums cannot be diffed, so they are the maximums since the statement is cached.
+func DiffSlowQueryStatistics(previous, current *storepb.SlowQueryStatistics) *storepb.SlowQueryStatistics {
+	previousItems := make(map[string]*storepb.SlowQueryStatisticsItem)
+	for _, item := range previous.GetItems() {
+		previousItems[item.SqlFingerprint] = item
+	}
+
+	result := &storepb.SlowQueryStatistics{}
+	for _, item := range current.GetItems() {
+		prev, ok := previousItems[item.SqlFingerprint]
+		if !ok || item.Count < prev.Count || item.TotalQueryTime.AsDuration() < prev.TotalQueryTime.AsDuration() {
+			result.Items = append(result.Items, item)
+			continue
+		}
+		if item.Count == prev.Count {
+			continue
+		}
+		result.Items = append(result.Items, &storepb.SlowQueryStatisticsItem{
+			SqlFingerprint:      item.SqlFingerprint,
+			Count:               item.Count - prev.Count,
+			LatestLogTime:       item.LatestLogTime,
+			TotalQueryTime:      durationpb.New(item.TotalQueryTime.AsDuration() - prev.TotalQueryTime.AsDuration()),
+			MaximumQueryTime:    item.MaximumQueryTime,
+			TotalRowsSent:       max(item.TotalRowsSent-prev.TotalRowsSent, 0),
+			MaximumRowsSent:     item.MaximumRowsSent,
+			TotalRowsExamined:   max(item.TotalRowsExamined-prev.TotalRowsExamined, 0),
+			MaximumRowsExamined: item.MaximumRowsExamined,
+			Samples:             item.Samples,
+		})
+	}
+	return result
+}
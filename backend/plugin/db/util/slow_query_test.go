@@ -0,0 +1,192 @@
+package util
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestSlowQueryStatisticsBuilder(t *testing.T) {
+	a := require.New(t)
+	earlier := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	later := time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC)
+
+	builder := NewSlowQueryStatisticsBuilder(storepb.Engine_ENGINE_UNSPECIFIED)
+	builder.Add("db1", &storepb.SlowQueryStatisticsItem{
+		SqlFingerprint:      "SELECT * FROM t WHERE id = ?",
+		Count:               2,
+		LatestLogTime:       timestamppb.New(earlier),
+		TotalQueryTime:      durationpb.New(3 * time.Second),
+		MaximumQueryTime:    durationpb.New(2 * time.Second),
+		TotalRowsSent:       10,
+		MaximumRowsSent:     6,
+		TotalRowsExamined:   math.MaxInt32,
+		MaximumRowsExamined: 100,
+	})
+	builder.Add("db1", &storepb.SlowQueryStatisticsItem{
+		SqlFingerprint:      "SELECT * FROM t WHERE id = ?",
+		Count:               1,
+		LatestLogTime:       timestamppb.New(later),
+		TotalQueryTime:      durationpb.New(5 * time.Second),
+		MaximumQueryTime:    durationpb.New(5 * time.Second),
+		TotalRowsSent:       1,
+		MaximumRowsSent:     1,
+		TotalRowsExamined:   200,
+		MaximumRowsExamined: 200,
+	})
+	builder.Add("db2", &storepb.SlowQueryStatisticsItem{
+		SqlFingerprint:   "SELECT 1",
+		Count:            1,
+		LatestLogTime:    timestamppb.New(earlier),
+		TotalQueryTime:   durationpb.New(time.Second),
+		MaximumQueryTime: durationpb.New(time.Second),
+	})
+
+	result := builder.Build()
+	a.Len(result, 2)
+	a.Len(result["db1"].Items, 1)
+	item := result["db1"].Items[0]
+	a.Equal(int32(3), item.Count)
+	a.Equal(later, item.LatestLogTime.AsTime())
+	a.Equal(8*time.Second, item.TotalQueryTime.AsDuration())
+	a.Equal(5*time.Second, item.MaximumQueryTime.AsDuration())
+	a.Equal(int32(11), item.TotalRowsSent)
+	a.Equal(int32(6), item.MaximumRowsSent)
+	a.Equal(int32(math.MaxInt32), item.TotalRowsExamined)
+	a.Equal(int32(200), item.MaximumRowsExamined)
+	a.Len(item.Samples, 2)
+	a.Equal("SELECT * FROM t WHERE id = ?", item.Samples[0].SqlText)
+	a.Len(result["db2"].Items, 1)
+}
+
+func TestClampInt32(t *testing.T) {
+	tests := []struct {
+		value int64
+		want  int32
+	}{
+		{value: 0, want: 0},
+		{value: -5, want: -5},
+		{value: math.MaxInt32 + 1, want: math.MaxInt32},
+		{value: math.MinInt32 - 1, want: math.MinInt32},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, ClampInt32(test.value))
+	}
+}
+
+func TestDiffSlowQueryStatistics(t *testing.T) {
+	a := require.New(t)
+	earlier := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	later := time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC)
+
+	previous := &storepb.SlowQueryStatistics{
+		Items: []*storepb.SlowQueryStatisticsItem{
+			{
+				SqlFingerprint:    "SELECT * FROM t WHERE id = ?",
+				Count:             10,
+				LatestLogTime:     timestamppb.New(earlier),
+				TotalQueryTime:    durationpb.New(20 * time.Second),
+				MaximumQueryTime:  durationpb.New(5 * time.Second),
+				TotalRowsSent:     100,
+				TotalRowsExamined: 1000,
+			},
+			{
+				SqlFingerprint: "SELECT idle",
+				Count:          3,
+				LatestLogTime:  timestamppb.New(earlier),
+				TotalQueryTime: durationpb.New(6 * time.Second),
+			},
+			{
+				SqlFingerprint: "SELECT evicted",
+				Count:          50,
+				LatestLogTime:  timestamppb.New(earlier),
+				TotalQueryTime: durationpb.New(100 * time.Second),
+			},
+		},
+	}
+	current := &storepb.SlowQueryStatistics{
+		Items: []*storepb.SlowQueryStatisticsItem{
+			{
+				SqlFingerprint:    "SELECT * FROM t WHERE id = ?",
+				Count:             14,
+				LatestLogTime:     timestamppb.New(later),
+				TotalQueryTime:    durationpb.New(30 * time.Second),
+				MaximumQueryTime:  durationpb.New(6 * time.Second),
+				TotalRowsSent:     140,
+				TotalRowsExamined: 1800,
+			},
+			{
+				SqlFingerprint: "SELECT idle",
+				Count:          3,
+				LatestLogTime:  timestamppb.New(earlier),
+				TotalQueryTime: durationpb.New(6 * time.Second),
+			},
+			{
+				// Evicted and cached again, the counters restart.
+				SqlFingerprint: "SELECT evicted",
+				Count:          2,
+				LatestLogTime:  timestamppb.New(later),
+				TotalQueryTime: durationpb.New(3 * time.Second),
+			},
+			{
+				SqlFingerprint: "SELECT new",
+				Count:          1,
+				LatestLogTime:  timestamppb.New(later),
+				TotalQueryTime: durationpb.New(2 * time.Second),
+			},
+		},
+	}
+
+	result := DiffSlowQueryStatistics(previous, current)
+	items := make(map[string]*storepb.SlowQueryStatisticsItem)
+	for _, item := range result.Items {
+		items[item.SqlFingerprint] = item
+	}
+	a.Len(items, 3)
+	a.NotContains(items, "SELECT idle")
+
+	item := items["SELECT * FROM t WHERE id = ?"]
+	a.Equal(int32(4), item.Count)
+	a.Equal(10*time.Second, item.TotalQueryTime.AsDuration())
+	a.Equal(6*time.Second, item.MaximumQueryTime.AsDuration())
+	a.Equal(int32(40), item.TotalRowsSent)
+	a.Equal(int32(800), item.TotalRowsExamined)
+	a.Equal(later, item.LatestLogTime.AsTime())
+
+	a.Equal(int32(2), items["SELECT evicted"].Count)
+	a.Equal(3*time.Second, items["SELECT evicted"].TotalQueryTime.AsDuration())
+	a.Equal(int32(1), items["SELECT new"].Count)
+
+	// Without the previous snapshot, all of the statistics are the increments.
+	a.Len(DiffSlowQueryStatistics(nil, current).Items, 4)
+}
+
+func TestMergeSlowQueryStatistics(t *testing.T) {
+	a := require.New(t)
+	existing := &storepb.SlowQueryStatistics{
+		Items: []*storepb.SlowQueryStatisticsItem{
+			{SqlFingerprint: "select ?", Count: 2, TotalQueryTime: durationpb.New(2 * time.Second), MaximumQueryTime: durationpb.New(time.Second)},
+		},
+	}
+	increments := &storepb.SlowQueryStatistics{
+		Items: []*storepb.SlowQueryStatisticsItem{
+			{SqlFingerprint: "select ?", Count: 1, TotalQueryTime: durationpb.New(3 * time.Second), MaximumQueryTime: durationpb.New(3 * time.Second)},
+			{SqlFingerprint: "select * from t", Count: 1, TotalQueryTime: durationpb.New(time.Second)},
+		},
+	}
+
+	result := MergeSlowQueryStatistics(nil, existing, increments)
+	a.Len(result.Items, 2)
+	a.Equal("select ?", result.Items[0].SqlFingerprint)
+	a.Equal(int32(3), result.Items[0].Count)
+	a.Equal(5*time.Second, result.Items[0].TotalQueryTime.AsDuration())
+	a.Equal(3*time.Second, result.Items[0].MaximumQueryTime.AsDuration())
+	// The inputs are not modified.
+	a.Equal(int32(2), existing.Items[0].Count)
+}
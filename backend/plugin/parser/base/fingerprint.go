@@ -0,0 +1,138 @@
+package base
+
+import (
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// FingerprintTokenTypes is the token types of a lexer used to compute the token based fingerprint.
+type FingerprintTokenTypes struct {
+	// Literals is the set of the literal token types, which are replaced by the placeholder.
+	Literals map[int]bool
+	// CaseSensitive is the set of the token types keeping the original text, such as the quoted identifiers.
+	CaseSensitive map[int]bool
+	OpenParen     int
+	CloseParen    int
+	Comma         int
+	Semicolon     int
+}
+
+const (
+	fingerprintPlaceholder     = "?"
+	fingerprintListPlaceholder = "(?+)"
+)
+
+// GetTokenFingerprint gets the fingerprint from the tokens of a statement.
+// The comments and whitespaces are dropped, the literals are replaced by the placeholder, the keywords and
+// unquoted identifiers are lower cased, and the lists of placeholders such as IN lists and multi-row VALUES are collapsed.
+func GetTokenFingerprint(tokens []antlr.Token, tokenTypes *FingerprintTokenTypes) string {
+	var words []string
+	for _, token := range tokens {
+		if token.GetChannel() != antlr.TokenDefaultChannel || token.GetTokenType() == antlr.TokenEOF {
+			continue
+		}
+		switch {
+		case tokenTypes.Literals[token.GetTokenType()]:
+			words = append(words, fingerprintPlaceholder)
+		case tokenTypes.CaseSensitive[token.GetTokenType()]:
+			words = append(words, token.GetText())
+		case token.GetTokenType() == tokenTypes.OpenParen:
+			words = append(words, "(")
+		case token.GetTokenType() == tokenTypes.CloseParen:
+			words = append(words, ")")
+		case token.GetTokenType() == tokenTypes.Comma:
+			words = append(words, ",")
+		case token.GetTokenType() == tokenTypes.Semicolon:
+			words = append(words, ";")
+		default:
+			words = append(words, strings.ToLower(token.GetText()))
+		}
+	}
+	return JoinFingerprintWords(words)
+}
+
+// JoinFingerprintWords joins the normalized words of a statement into the fingerprint.
+// The literals in the words should have been replaced by the placeholder "?".
+func JoinFingerprintWords(words []string) string {
+	for len(words) > 0 && words[len(words)-1] == ";" {
+		words = words[:len(words)-1]
+	}
+	words = mergeComparisonOperators(words)
+	words = collapsePlaceholderList(words)
+
+	var buf strings.Builder
+	for i, word := range words {
+		if i > 0 && words[i-1] != "(" && word != ")" && word != "," && word != "." && words[i-1] != "." {
+			_, _ = buf.WriteString(" ")
+		}
+		_, _ = buf.WriteString(word)
+	}
+	return buf.String()
+}
+
+// splitComparisonOperators is the comparison operators which some grammars such as PL/SQL and T-SQL lex as two tokens.
+var splitComparisonOperators = map[[2]string]string{
+	{"<", "="}: "<=",
+	{">", "="}: ">=",
+	{"<", ">"}: "<>",
+	{"!", "="}: "!=",
+	{"^", "="}: "^=",
+}
+
+// mergeComparisonOperators merges the comparison operators lexed as two tokens, such as "<" "=", into one word.
+func mergeComparisonOperators(words []string) []string {
+	var result []string
+	for _, word := range words {
+		if len(result) > 0 {
+			if operator, ok := splitComparisonOperators[[2]string{result[len(result)-1], word}]; ok {
+				result[len(result)-1] = operator
+				continue
+			}
+		}
+		result = append(result, word)
+	}
+	return result
+}
+
+// collapsePlaceholderList collapses the IN and VALUES lists of placeholders into one list placeholder,
+// and the comma separated list placeholders of multi-row VALUES into one.
+func collapsePlaceholderList(words []string) []string {
+	var result []string
+	for i := 0; i < len(words); i++ {
+		if words[i] == "(" && len(result) > 0 {
+			if end, ok := matchPlaceholderList(words, i); ok {
+				switch {
+				case result[len(result)-1] == "in" || result[len(result)-1] == "values":
+					result = append(result, fingerprintListPlaceholder)
+					i = end
+					continue
+				case len(result) >= 2 && result[len(result)-1] == "," && result[len(result)-2] == fingerprintListPlaceholder:
+					result = result[:len(result)-1]
+					i = end
+					continue
+				}
+			}
+		}
+		result = append(result, words[i])
+	}
+	return result
+}
+
+// matchPlaceholderList returns the index of the closing paren if the words from the start index is a list of placeholders.
+func matchPlaceholderList(words []string, start int) (int, bool) {
+	expectPlaceholder := true
+	for i := start + 1; i < len(words); i++ {
+		switch {
+		case expectPlaceholder && (words[i] == fingerprintPlaceholder || words[i] == fingerprintListPlaceholder):
+			expectPlaceholder = false
+		case !expectPlaceholder && words[i] == ",":
+			expectPlaceholder = true
+		case !expectPlaceholder && words[i] == ")":
+			return i, true
+		default:
+			return 0, false
+		}
+	}
+	return 0, false
+}
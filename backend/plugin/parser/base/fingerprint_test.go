@@ -0,0 +1,106 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestJoinFingerprintWords(t *testing.T) {
+	tests := []struct {
+		words []string
+		want  string
+	}{
+		{
+			words: []string{"select", "a", ",", "b", "from", "s", ".", "t", "where", "id", "=", "?", ";", ";"},
+			want:  "select a, b from s.t where id = ?",
+		},
+		{
+			words: []string{"select", "*", "from", "t", "where", "id", "in", "(", "?", ",", "?", ",", "?", ")"},
+			want:  "select * from t where id in (?+)",
+		},
+		{
+			// The IN lists of different lengths share the same fingerprint.
+			words: []string{"select", "*", "from", "t", "where", "id", "in", "(", "?", ")"},
+			want:  "select * from t where id in (?+)",
+		},
+		{
+			// Multi-row VALUES are collapsed into one list.
+			words: []string{"insert", "into", "t", "(", "a", ",", "b", ")", "values", "(", "?", ",", "?", ")", ",", "(", "?", ",", "?", ")"},
+			want:  "insert into t (a, b) values (?+)",
+		},
+		{
+			// The lists that are not only placeholders are kept.
+			words: []string{"select", "*", "from", "t", "where", "id", "in", "(", "?", ",", "a", ")"},
+			want:  "select * from t where id in (?, a)",
+		},
+		{
+			// The function arguments are not collapsed.
+			words: []string{"select", "f", "(", "?", ",", "?", ")"},
+			want:  "select f (?, ?)",
+		},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.want, JoinFingerprintWords(test.words))
+	}
+}
+
+func TestGetTokenFingerprint(t *testing.T) {
+	const (
+		tokenWord = iota + 1
+		tokenString
+		tokenNumber
+		tokenQuotedID
+		tokenOpenParen
+		tokenCloseParen
+		tokenComma
+		tokenSemicolon
+		tokenComment
+	)
+	tokenTypes := &FingerprintTokenTypes{
+		Literals:      map[int]bool{tokenString: true, tokenNumber: true},
+		CaseSensitive: map[int]bool{tokenQuotedID: true},
+		OpenParen:     tokenOpenParen,
+		CloseParen:    tokenCloseParen,
+		Comma:         tokenComma,
+		Semicolon:     tokenSemicolon,
+	}
+	newToken := func(tokenType int, text string) antlr.Token {
+		channel := antlr.TokenDefaultChannel
+		if tokenType == tokenComment {
+			channel = antlr.TokenHiddenChannel
+		}
+		token := antlr.NewCommonToken(&antlr.TokenSourceCharStreamPair{}, tokenType, channel, -1, -1)
+		token.SetText(text)
+		return token
+	}
+
+	// SELECT "Name" FROM T /* comment */ WHERE ID IN (1, 'a');
+	tokens := []antlr.Token{
+		newToken(tokenWord, "SELECT"),
+		newToken(tokenQuotedID, `"Name"`),
+		newToken(tokenWord, "FROM"),
+		newToken(tokenWord, "T"),
+		newToken(tokenComment, "/* comment */"),
+		newToken(tokenWord, "WHERE"),
+		newToken(tokenWord, "ID"),
+		newToken(tokenWord, "IN"),
+		newToken(tokenOpenParen, "("),
+		newToken(tokenNumber, "1"),
+		newToken(tokenComma, ","),
+		newToken(tokenString, "'a'"),
+		newToken(tokenCloseParen, ")"),
+		newToken(tokenSemicolon, ";"),
+		newToken(antlr.TokenEOF, "<EOF>"),
+	}
+	require.Equal(t, `select "Name" from t where id in (?+)`, GetTokenFingerprint(tokens, tokenTypes))
+}
+
+func TestGetFingerprintUnsupportedEngine(t *testing.T) {
+	_, err := GetFingerprint(storepb.Engine_ENGINE_UNSPECIFIED, "SELECT 1")
+	require.Error(t, err)
+}
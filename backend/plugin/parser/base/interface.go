@@ -23,6 +23,7 @@ var (
 	spans                   = make(map[storepb.Engine]GetQuerySpanFunc)
 	affectedRows            = make(map[storepb.Engine]GetAffectedRowsFunc)
 	transformDMLToSelect    = make(map[storepb.Engine]TransformDMLToSelectFunc)
+	fingerprinters          = make(map[storepb.Engine]GetFingerprintFunc)
 )
 
 type ValidateSQLForEditorFunc func(string) (bool, error)
@@ -42,6 +43,9 @@ type GetAffectedRowsFunc func(ctx context.Context, stmt any, getAffectedRowsByQu
 // TransformDMLToSelectFunc is the interface of transforming DML statements to SELECT statements.
 type TransformDMLToSelectFunc func(statement string, sourceDatabase string, targetDatabase string, tableSuffix string) ([]RollbackStatement, error)
 
+// GetFingerprintFunc is the interface of getting the fingerprint of a statement.
+type GetFingerprintFunc func(statement string) (string, error)
+
 func RegisterQueryValidator(engine storepb.Engine, f ValidateSQLForEditorFunc) {
 	mux.Lock()
 	defer mux.Unlock()
@@ -253,3 +257,22 @@ func TransformDMLToSelect(engine storepb.Engine, statement string, sourceDatabas
 	}
 	return f(statement, sourceDatabase, targetDatabase, tableSuffix)
 }
+
+func RegisterGetFingerprintFunc(engine storepb.Engine, f GetFingerprintFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := fingerprinters[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	fingerprinters[engine] = f
+}
+
+// GetFingerprint gets the fingerprint of the statement, in which the literals are replaced by placeholders,
+// so that the statements differing only in literals share the same fingerprint.
+func GetFingerprint(engine storepb.Engine, statement string) (string, error) {
+	f, ok := fingerprinters[engine]
+	if !ok {
+		return "", errors.Errorf("engine %s is not supported", engine)
+	}
+	return f(statement)
+}
@@ -0,0 +1,306 @@
+package duckdb
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+
+	parser "github.com/bytebase/postgresql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterQueryValidator(storepb.Engine_DUCKDB, validateQuery)
+	base.RegisterExtractResourceListFunc(storepb.Engine_DUCKDB, ExtractResourceList)
+}
+
+var (
+	wordRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
+	// readOnlyPragmas are the PRAGMA statements that only inspect the database.
+	readOnlyPragmas = map[string]bool{
+		"collations":           true,
+		"database_list":        true,
+		"database_size":        true,
+		"functions":            true,
+		"metadata_info":        true,
+		"platform":             true,
+		"show":                 true,
+		"show_databases":       true,
+		"show_tables":          true,
+		"show_tables_expanded": true,
+		"storage_info":         true,
+		"table_info":           true,
+		"version":              true,
+	}
+
+	// fileAccessFunctions are the table functions reading the files on the host running Bytebase.
+	fileAccessFunctions = map[string]bool{
+		"glob":                true,
+		"parquet_metadata":    true,
+		"parquet_scan":        true,
+		"parquet_schema":      true,
+		"read_blob":           true,
+		"read_csv":            true,
+		"read_csv_auto":       true,
+		"read_json":           true,
+		"read_json_auto":      true,
+		"read_json_objects":   true,
+		"read_ndjson":         true,
+		"read_ndjson_auto":    true,
+		"read_ndjson_objects": true,
+		"read_parquet":        true,
+		"read_text":           true,
+		"sniff_csv":           true,
+	}
+
+	// writeTokenTypes are the tokens which only appear in the statements changing the data or the schema.
+	writeTokenTypes = map[int]bool{
+		parser.PostgreSQLLexerALTER:    true,
+		parser.PostgreSQLLexerCOPY:     true,
+		parser.PostgreSQLLexerCREATE:   true,
+		parser.PostgreSQLLexerDELETE_P: true,
+		parser.PostgreSQLLexerDROP:     true,
+		parser.PostgreSQLLexerINSERT:   true,
+		parser.PostgreSQLLexerTRUNCATE: true,
+		parser.PostgreSQLLexerUPDATE:   true,
+	}
+)
+
+// validateQuery validates the SQL statement for SQL editor.
+// The statements supported by PostgreSQL are validated by the PostgreSQL validator. For the DuckDB specific syntax
+// that PostgreSQL fails to parse, we check the tokens instead.
+// Reading the files on the host is disallowed because the files are not governed by the database permissions.
+func validateQuery(statement string) (bool, error) {
+	singleSQLs, err := SplitSQL(statement)
+	if err != nil {
+		return false, err
+	}
+	for _, singleSQL := range base.FilterEmptySQL(singleSQLs) {
+		tokens := getDefaultChannelTokens(singleSQL.Text)
+		if len(tokens) == 0 {
+			continue
+		}
+		if accessFiles(tokens) {
+			return false, nil
+		}
+
+		switch strings.ToUpper(tokens[0].GetText()) {
+		case "DESCRIBE", "SHOW", "SUMMARIZE":
+		case "PRAGMA":
+			if len(tokens) < 2 || !readOnlyPragmas[strings.ToLower(tokens[1].GetText())] {
+				return false, nil
+			}
+		case "FROM":
+			// FROM-first queries, such as "FROM t SELECT a" and "FROM t".
+			if containsWriteToken(tokens) {
+				return false, nil
+			}
+		case "SELECT", "WITH", "VALUES", "TABLE", "EXPLAIN", "(":
+			ok, err := base.ValidateSQLForEditor(storepb.Engine_POSTGRES, singleSQL.Text)
+			if err != nil {
+				if _, isSyntaxError := err.(*base.SyntaxError); !isSyntaxError {
+					return false, err
+				}
+				// The DuckDB specific syntax, such as "SELECT * EXCLUDE (a) FROM t".
+				ok = !containsWriteToken(tokens)
+			}
+			if !ok {
+				return false, nil
+			}
+		default:
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func containsWriteToken(tokens []antlr.Token) bool {
+	for _, token := range tokens {
+		if writeTokenTypes[token.GetTokenType()] {
+			return true
+		}
+	}
+	return false
+}
+
+// accessFiles returns true if the statement reads files by the table functions such as read_csv(),
+// or by the replacement scans such as "SELECT * FROM 'data.csv'".
+func accessFiles(tokens []antlr.Token) bool {
+	for i, token := range tokens {
+		switch token.GetTokenType() {
+		case parser.PostgreSQLLexerStringConstant, parser.PostgreSQLLexerEscapeStringConstant:
+			if i > 0 {
+				switch strings.ToUpper(tokens[i-1].GetText()) {
+				case "FROM", "JOIN":
+					return true
+				}
+			}
+		default:
+			if i+1 < len(tokens) && tokens[i+1].GetTokenType() == parser.PostgreSQLLexerOPEN_PAREN &&
+				fileAccessFunctions[strings.ToLower(token.GetText())] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ExtractResourceList extracts the resource list from the SQL statement.
+// The statements supported by PostgreSQL are extracted by the PostgreSQL extractor, and we collect the
+// relations following FROM, JOIN, DESCRIBE and SUMMARIZE for the DuckDB specific statements.
+func ExtractResourceList(currentDatabase string, currentSchema string, sql string) ([]base.SchemaResource, error) {
+	singleSQLs, err := SplitSQL(sql)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceMap := make(map[string]base.SchemaResource)
+	for _, singleSQL := range base.FilterEmptySQL(singleSQLs) {
+		resources, err := pgparser.ExtractResourceList(currentDatabase, currentSchema, singleSQL.Text)
+		if err != nil {
+			resources = extractResourceListFromTokens(currentDatabase, currentSchema, getDefaultChannelTokens(singleSQL.Text))
+		}
+		for _, resource := range resources {
+			resourceMap[resource.String()] = resource
+		}
+	}
+
+	var list []base.SchemaResource
+	for _, resource := range resourceMap {
+		list = append(list, resource)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].String() < list[j].String()
+	})
+	return list, nil
+}
+
+func extractResourceListFromTokens(currentDatabase string, currentSchema string, tokens []antlr.Token) []base.SchemaResource {
+	var result []base.SchemaResource
+	// queryGroups records whether the parentheses enclose a query, the FROM in the function calls
+	// such as EXTRACT(YEAR FROM ts) doesn't refer to the relations.
+	queryGroups := []bool{true}
+	for i := 0; i < len(tokens); i++ {
+		switch tokens[i].GetTokenType() {
+		case parser.PostgreSQLLexerOPEN_PAREN:
+			isQuery := false
+			if i+1 < len(tokens) {
+				switch strings.ToUpper(tokens[i+1].GetText()) {
+				case "SELECT", "WITH", "FROM", "VALUES", "TABLE":
+					isQuery = true
+				}
+			}
+			queryGroups = append(queryGroups, isQuery)
+			continue
+		case parser.PostgreSQLLexerCLOSE_PAREN:
+			if len(queryGroups) > 1 {
+				queryGroups = queryGroups[:len(queryGroups)-1]
+			}
+			continue
+		}
+		if !queryGroups[len(queryGroups)-1] {
+			continue
+		}
+
+		keyword := strings.ToUpper(tokens[i].GetText())
+		switch keyword {
+		case "FROM", "JOIN", "DESCRIBE", "SUMMARIZE":
+		default:
+			continue
+		}
+		pos := i + 1
+		for {
+			resource, next, ok := parseRelationName(currentDatabase, currentSchema, tokens, pos)
+			if !ok {
+				break
+			}
+			result = append(result, resource)
+			// Only FROM takes a list of relations.
+			if keyword != "FROM" {
+				break
+			}
+			next = skipAlias(tokens, next)
+			if next >= len(tokens) || tokens[next].GetTokenType() != parser.PostgreSQLLexerCOMMA {
+				break
+			}
+			pos = next + 1
+		}
+	}
+	return result
+}
+
+// parseRelationName parses the relation name such as "t", "s.t" or "db.s.t" starting from pos.
+// It returns the position following the relation name.
+func parseRelationName(currentDatabase string, currentSchema string, tokens []antlr.Token, pos int) (base.SchemaResource, int, bool) {
+	var parts []string
+	for pos < len(tokens) {
+		part, ok := getIdentifier(tokens[pos])
+		if !ok {
+			break
+		}
+		parts = append(parts, part)
+		pos++
+		if pos+1 < len(tokens) && tokens[pos].GetTokenType() == parser.PostgreSQLLexerDOT {
+			pos++
+			continue
+		}
+		break
+	}
+	// The table functions such as range(10).
+	if pos < len(tokens) && tokens[pos].GetTokenType() == parser.PostgreSQLLexerOPEN_PAREN {
+		return base.SchemaResource{}, pos, false
+	}
+
+	resource := base.SchemaResource{
+		Database: currentDatabase,
+		Schema:   currentSchema,
+	}
+	switch len(parts) {
+	case 1:
+		resource.Table = parts[0]
+	case 2:
+		resource.Schema = parts[0]
+		resource.Table = parts[1]
+	case 3:
+		resource.Database = parts[0]
+		resource.Schema = parts[1]
+		resource.Table = parts[2]
+	default:
+		return base.SchemaResource{}, pos, false
+	}
+	return resource, pos, true
+}
+
+func getIdentifier(token antlr.Token) (string, bool) {
+	text := token.GetText()
+	switch token.GetTokenType() {
+	case parser.PostgreSQLLexerQuotedIdentifier:
+		return strings.ReplaceAll(text[1:len(text)-1], `""`, `"`), true
+	case parser.PostgreSQLLexerSELECT, parser.PostgreSQLLexerLATERAL_P, parser.PostgreSQLLexerVALUES, parser.PostgreSQLLexerWITH:
+		return "", false
+	}
+	if !wordRegexp.MatchString(text) {
+		return "", false
+	}
+	// The unquoted identifiers are case-insensitive.
+	return strings.ToLower(text), true
+}
+
+func skipAlias(tokens []antlr.Token, pos int) int {
+	if pos >= len(tokens) {
+		return pos
+	}
+	switch tokens[pos].GetTokenType() {
+	case parser.PostgreSQLLexerAS:
+		return pos + 2
+	case parser.PostgreSQLLexerIdentifier, parser.PostgreSQLLexerQuotedIdentifier:
+		return pos + 1
+	}
+	return pos
+}
@@ -0,0 +1,84 @@
+package duckdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestValidateQuery(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      bool
+	}{
+		{statement: "SELECT * FROM t;", want: true},
+		{statement: "SELECT * EXCLUDE (a) FROM t;", want: true},
+		{statement: "FROM t SELECT a WHERE b > 1;", want: true},
+		{statement: "FROM t;", want: true},
+		{statement: "DESCRIBE t;", want: true},
+		{statement: "SUMMARIZE SELECT * FROM t;", want: true},
+		{statement: "SHOW TABLES;", want: true},
+		{statement: "PRAGMA table_info('t');", want: true},
+		{statement: "EXPLAIN SELECT * FROM t;", want: true},
+		{statement: "SELECT 1; SELECT 2;", want: true},
+		{statement: "EXPLAIN ANALYZE INSERT INTO t VALUES (1);", want: false},
+		{statement: "INSERT INTO t VALUES (1);", want: false},
+		{statement: "SELECT 1; DROP TABLE t;", want: false},
+		{statement: "PRAGMA enable_profiling;", want: false},
+		{statement: "ATTACH 'other.duckdb' AS other;", want: false},
+		{statement: "SET memory_limit = '1GB';", want: false},
+		{statement: "COPY t TO 'out.csv';", want: false},
+		{statement: "SELECT * FROM read_csv_auto('/etc/passwd');", want: false},
+		{statement: "SELECT * FROM '/etc/passwd';", want: false},
+		{statement: "SELECT * FROM t JOIN 'data.parquet' USING (id);", want: false},
+	}
+
+	for _, test := range tests {
+		got, err := validateQuery(test.statement)
+		require.NoError(t, err, test.statement)
+		require.Equal(t, test.want, got, test.statement)
+	}
+}
+
+func TestExtractResourceList(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      []base.SchemaResource
+	}{
+		{
+			statement: "SELECT * FROM t1 JOIN s.t2 ON t1.id = t2.id;",
+			want: []base.SchemaResource{
+				{Database: "db", Schema: "main", Table: "t1"},
+				{Database: "db", Schema: "s", Table: "t2"},
+			},
+		},
+		{
+			statement: `FROM t1 AS a, "S"."T 2" b SELECT a.id, EXTRACT(YEAR FROM b.created_at);`,
+			want: []base.SchemaResource{
+				{Database: "db", Schema: "S", Table: "T 2"},
+				{Database: "db", Schema: "main", Table: "t1"},
+			},
+		},
+		{
+			statement: "SELECT * EXCLUDE (a) FROM (FROM other.s.t3) JOIN range(10) r ON true;",
+			want: []base.SchemaResource{
+				{Database: "other", Schema: "s", Table: "t3"},
+			},
+		},
+		{
+			statement: "DESCRIBE T4; SUMMARIZE s.t5;",
+			want: []base.SchemaResource{
+				{Database: "db", Schema: "main", Table: "t4"},
+				{Database: "db", Schema: "s", Table: "t5"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		got, err := ExtractResourceList("db", "main", test.statement)
+		require.NoError(t, err, test.statement)
+		require.Equal(t, test.want, got, test.statement)
+	}
+}
@@ -0,0 +1,41 @@
+// Package duckdb is the parser for DuckDB.
+// DuckDB derives its SQL dialect from PostgreSQL, so we reuse the PostgreSQL lexer and parser,
+// and handle the DuckDB specific statements such as DESCRIBE, SUMMARIZE, PRAGMA and FROM-first queries on top of them.
+package duckdb
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+
+	parser "github.com/bytebase/postgresql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterSplitterFunc(storepb.Engine_DUCKDB, SplitSQL)
+}
+
+// SplitSQL splits the given SQL statement into multiple SQL statements.
+// DuckDB has no procedural blocks, so the PostgreSQL splitter works for the DuckDB statements.
+func SplitSQL(statement string) ([]base.SingleSQL, error) {
+	return pgparser.SplitSQL(statement)
+}
+
+// getDefaultChannelTokens returns the tokens in the default channel of the statement, the EOF token is excluded.
+func getDefaultChannelTokens(statement string) []antlr.Token {
+	lexer := parser.NewPostgreSQLLexer(antlr.NewInputStream(statement))
+	lexer.RemoveErrorListeners()
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	stream.Fill()
+
+	var tokens []antlr.Token
+	for _, token := range stream.GetAllTokens() {
+		if token.GetChannel() != antlr.TokenDefaultChannel || token.GetTokenType() == antlr.TokenEOF {
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
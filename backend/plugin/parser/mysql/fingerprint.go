@@ -6,8 +6,17 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
+func init() {
+	base.RegisterGetFingerprintFunc(storepb.Engine_MYSQL, GetFingerprint)
+	base.RegisterGetFingerprintFunc(storepb.Engine_MARIADB, GetFingerprint)
+	base.RegisterGetFingerprintFunc(storepb.Engine_OCEANBASE, GetFingerprint)
+}
+
 // GetFingerprint gets mysql query fingerprint.
 // From https://github.com/percona/percona-toolkit/blob/af686fe186d1fca4c4392c8fa75c31a00c8fb273/bin/pt-query-digest#L2930
 func GetFingerprint(query string) (string, error) {
@@ -25,6 +25,7 @@ func init() {
 	base.RegisterCompleteFunc(store.Engine_POSTGRES, Completion)
 	base.RegisterCompleteFunc(store.Engine_REDSHIFT, Completion)
 	base.RegisterCompleteFunc(store.Engine_RISINGWAVE, Completion)
+	base.RegisterCompleteFunc(store.Engine_COCKROACHDB, Completion)
 	base.RegisterCompleteFunc(store.Engine_ORACLE, Completion)
 	base.RegisterCompleteFunc(store.Engine_DM, Completion)
 	base.RegisterCompleteFunc(store.Engine_OCEANBASE_ORACLE, Completion)
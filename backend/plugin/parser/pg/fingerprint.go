@@ -0,0 +1,47 @@
+package pg
+
+import (
+	"strings"
+
+	pgquery "github.com/pganalyze/pg_query_go/v4"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterGetFingerprintFunc(storepb.Engine_POSTGRES, GetFingerprint)
+	base.RegisterGetFingerprintFunc(storepb.Engine_REDSHIFT, GetFingerprint)
+	base.RegisterGetFingerprintFunc(storepb.Engine_RISINGWAVE, GetFingerprint)
+	base.RegisterGetFingerprintFunc(storepb.Engine_COCKROACHDB, GetFingerprint)
+}
+
+// GetFingerprint gets the fingerprint of the PostgreSQL statement.
+// The constants are replaced in the same way as pg_stat_statements,
+// so that a statement and its normalized text in pg_stat_statements share the same fingerprint.
+func GetFingerprint(statement string) (string, error) {
+	normalized, err := pgquery.Normalize(statement)
+	if err != nil {
+		return "", err
+	}
+	result, err := pgquery.Scan(normalized)
+	if err != nil {
+		return "", err
+	}
+	var words []string
+	for _, token := range result.Tokens {
+		text := normalized[token.Start:token.End]
+		switch {
+		case token.Token == pgquery.Token_SQL_COMMENT || token.Token == pgquery.Token_C_COMMENT:
+			continue
+		case token.Token == pgquery.Token_PARAM:
+			words = append(words, "?")
+		case strings.HasPrefix(text, `"`):
+			// Keep the case of the quoted identifiers.
+			words = append(words, text)
+		default:
+			words = append(words, strings.ToLower(text))
+		}
+	}
+	return base.JoinFingerprintWords(words), nil
+}
@@ -0,0 +1,47 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFingerprint(t *testing.T) {
+	tests := []struct {
+		stmt string
+		want string
+	}{
+		{
+			stmt: "SELECT * FROM t WHERE id = 1 AND name = 'abc'",
+			want: `select * from t where id = ? and name = ?`,
+		},
+		{
+			stmt: "-- comment\nselect a, b from t where id in (1, 2, 3);",
+			want: `select a, b from t where id in (?+)`,
+		},
+		{
+			stmt: "INSERT INTO t (a, b) VALUES (1, 'a'), (2, 'b')",
+			want: `insert into t (a, b) values (?+)`,
+		},
+		{
+			// The bind parameters are placeholders, and the quoted identifiers keep the case.
+			stmt: `SELECT * FROM t WHERE id = $1 AND "UserName" = 'x'`,
+			want: `select * from t where id = ? and "UserName" = ?`,
+		},
+		{
+			// The dollar-quoted and escape strings are literals, while the type casts are kept.
+			stmt: `SELECT $$dollar quoted$$, E'esc', '2020-01-01'::date FROM "Schema"."Table"`,
+			want: `select ?, ?, ? :: date from "Schema"."Table"`,
+		},
+		{
+			stmt: "SELECT * FROM t WHERE a = -1.5e3 AND b IS NULL",
+			want: `select * from t where a = ? and b is null`,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := GetFingerprint(test.stmt)
+		require.NoError(t, err)
+		require.Equal(t, test.want, got, test.stmt)
+	}
+}
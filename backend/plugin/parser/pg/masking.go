@@ -17,6 +17,7 @@ func init() {
 	base.RegisterGetMaskedFieldsFunc(storepb.Engine_POSTGRES, GetMaskedFields)
 	base.RegisterGetMaskedFieldsFunc(storepb.Engine_REDSHIFT, GetMaskedFields)
 	base.RegisterGetMaskedFieldsFunc(storepb.Engine_RISINGWAVE, GetMaskedFields)
+	base.RegisterGetMaskedFieldsFunc(storepb.Engine_COCKROACHDB, GetMaskedFields)
 }
 
 func GetMaskedFields(statement, _ string, schemaInfo *base.SensitiveSchemaInfo) ([]base.SensitiveField, error) {
@@ -22,9 +22,11 @@ func init() {
 	base.RegisterQueryValidator(storepb.Engine_POSTGRES, validateQuery)
 	base.RegisterQueryValidator(storepb.Engine_REDSHIFT, validateQuery)
 	base.RegisterQueryValidator(storepb.Engine_RISINGWAVE, validateQuery)
+	base.RegisterQueryValidator(storepb.Engine_COCKROACHDB, validateQuery)
 	base.RegisterExtractResourceListFunc(storepb.Engine_POSTGRES, ExtractResourceList)
 	base.RegisterExtractResourceListFunc(storepb.Engine_REDSHIFT, ExtractResourceList)
 	base.RegisterExtractResourceListFunc(storepb.Engine_RISINGWAVE, ExtractResourceList)
+	base.RegisterExtractResourceListFunc(storepb.Engine_COCKROACHDB, ExtractResourceList)
 }
 
 // validateQuery validates the SQL statement for SQL editor.
@@ -16,6 +16,7 @@ func init() {
 	base.RegisterSplitterFunc(storepb.Engine_POSTGRES, SplitSQL)
 	base.RegisterSplitterFunc(storepb.Engine_REDSHIFT, SplitSQL)
 	base.RegisterSplitterFunc(storepb.Engine_RISINGWAVE, SplitSQL)
+	base.RegisterSplitterFunc(storepb.Engine_COCKROACHDB, SplitSQL)
 }
 
 // SplitSQL splits the given SQL statement into multiple SQL statements.
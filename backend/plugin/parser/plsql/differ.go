@@ -24,6 +24,7 @@ type diffNode struct {
 	// The different between the strict mode and non-strict mode is that the non-strict mode:
 	// 1. does not compare the index or constraint name, use the definition instead.
 	// 2. does not compare the storage option.
+	// 3. compares the normalized definition, see normalizeDefinition.
 	strictMode bool
 
 	schemaName     string
@@ -260,7 +261,7 @@ func (diff *diffNode) getConstraintID(ctx plsql.IRelational_propertyContext) str
 			return strings.TrimSpace(constraintName)
 		}
 	} else if ctx.Out_of_line_constraint() != nil || ctx.Out_of_line_ref_constraint() != nil {
-		return strings.TrimSpace(EraseString(EraseContext{
+		return normalizeDefinition(EraseString(EraseContext{
 			eraseConstraintName: true,
 			eraseSchemaName:     true,
 			eraseIndexName:      true,
@@ -473,7 +474,7 @@ func (diff *diffNode) diffColumn(oldTable, newTable *tableInfo) error {
 		}
 
 		// Compare the column definition.
-		if !isColumnEqual(oldColumn, item.Column_definition()) {
+		if !isColumnEqual(oldColumn, item.Column_definition(), diff.strictMode) {
 			modifyColumns = append(modifyColumns, item.Column_definition())
 		}
 		delete(oldColumnMap, newColumnName)
@@ -628,13 +629,56 @@ func (diff *diffNode) appendAddColumn(tableName string, addColumns []plsql.IColu
 	return nil
 }
 
-func isColumnEqual(oldColumn, newColumn plsql.IColumn_definitionContext) bool {
+func isColumnEqual(oldColumn, newColumn plsql.IColumn_definitionContext, strictMode bool) bool {
 	// TODO: compare column definition instead of text.
 	oldString := oldColumn.GetParser().GetTokenStream().GetTextFromRuleContext(oldColumn)
 	newString := newColumn.GetParser().GetTokenStream().GetTextFromRuleContext(newColumn)
+	if !strictMode {
+		return normalizeDefinition(oldString) == normalizeDefinition(newString)
+	}
 	return oldString == newString
 }
 
+// normalizeDefinition normalizes the definition so that the dumped schema, such as `"ID" NUMBER(10,0) NOT NULL ENABLE`,
+// equals to the one written by the user, such as `id NUMBER(10) NOT NULL`.
+// The unquoted identifiers and keywords are upper cased, the quotes of the identifiers are removed,
+// and the trailing semicolon, the default ENABLE and USABLE states, the default BYTE length semantics and the zero NUMBER scale are removed.
+func normalizeDefinition(text string) string {
+	lexer := plsql.NewPlSqlLexer(antlr.NewInputStream(text))
+	lexer.RemoveErrorListeners()
+	var tokens []string
+	for _, token := range lexer.GetAllTokens() {
+		if token.GetChannel() != antlr.TokenDefaultChannel {
+			continue
+		}
+		switch token.GetTokenType() {
+		case plsql.PlSqlLexerCHAR_STRING, plsql.PlSqlLexerNATIONAL_CHAR_STRING_LIT:
+			tokens = append(tokens, token.GetText())
+		case plsql.PlSqlLexerDELIMITED_ID:
+			tokens = append(tokens, strings.Trim(token.GetText(), `"`))
+		case plsql.PlSqlLexerENABLE, plsql.PlSqlLexerUSABLE:
+		default:
+			tokens = append(tokens, strings.ToUpper(token.GetText()))
+		}
+	}
+
+	var result []string
+	for i := 0; i < len(tokens); i++ {
+		switch {
+		case tokens[i] == "BYTE" && i+1 < len(tokens) && tokens[i+1] == ")":
+			continue
+		case tokens[i] == "," && i+2 < len(tokens) && tokens[i+1] == "0" && tokens[i+2] == ")" && i >= 3 && tokens[i-3] == "NUMBER" && tokens[i-2] == "(":
+			i++
+			continue
+		}
+		result = append(result, tokens[i])
+	}
+	for len(result) > 0 && result[len(result)-1] == ";" {
+		result = result[:len(result)-1]
+	}
+	return strings.Join(result, " ")
+}
+
 func buildSchemaInfo(statement string, strictMode bool) (*schemaInfo, error) {
 	node, _, err := ParsePLSQL(statement)
 	if err != nil {
@@ -726,7 +770,7 @@ func getIndexID(ctx plsql.ICreate_indexContext, strictMode bool) string {
 		_, indexName := NormalizeIndexName(ctx.Index_name())
 		return strings.TrimSpace(indexName)
 	}
-	return strings.TrimSpace(EraseString(EraseContext{
+	return normalizeDefinition(EraseString(EraseContext{
 		eraseIndexName:      true,
 		eraseSchemaName:     true,
 		eraseConstraintName: true,
@@ -0,0 +1,52 @@
+package plsql
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/plsql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterGetFingerprintFunc(storepb.Engine_ORACLE, GetFingerprint)
+	base.RegisterGetFingerprintFunc(storepb.Engine_DM, GetFingerprint)
+	base.RegisterGetFingerprintFunc(storepb.Engine_OCEANBASE_ORACLE, GetFingerprint)
+}
+
+var fingerprintTokenTypes = &base.FingerprintTokenTypes{
+	Literals: map[int]bool{
+		parser.PlSqlLexerCHAR_STRING:              true,
+		parser.PlSqlLexerNATIONAL_CHAR_STRING_LIT: true,
+		parser.PlSqlLexerUNSIGNED_INTEGER:         true,
+		parser.PlSqlLexerAPPROXIMATE_NUM_LIT:      true,
+		parser.PlSqlLexerBIT_STRING_LIT:           true,
+		parser.PlSqlLexerHEX_STRING_LIT:           true,
+		parser.PlSqlLexerTRUE:                     true,
+		parser.PlSqlLexerFALSE:                    true,
+		parser.PlSqlLexerNULL_:                    true,
+		// The bind variables such as :1 and :name are also placeholders.
+		parser.PlSqlLexerBINDVAR: true,
+	},
+	CaseSensitive: map[int]bool{
+		parser.PlSqlLexerDELIMITED_ID: true,
+	},
+	OpenParen:  parser.PlSqlLexerLEFT_PAREN,
+	CloseParen: parser.PlSqlLexerRIGHT_PAREN,
+	Comma:      parser.PlSqlLexerCOMMA,
+	Semicolon:  parser.PlSqlLexerSEMICOLON,
+}
+
+// GetFingerprint gets the fingerprint of the PL/SQL statement.
+func GetFingerprint(statement string) (string, error) {
+	lexer := parser.NewPlSqlLexer(antlr.NewInputStream(statement))
+	lexerErrorListener := &base.ParseErrorListener{}
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(lexerErrorListener)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	stream.Fill()
+	if lexerErrorListener.Err != nil {
+		return "", lexerErrorListener.Err
+	}
+	return base.GetTokenFingerprint(stream.GetAllTokens(), fingerprintTokenTypes), nil
+}
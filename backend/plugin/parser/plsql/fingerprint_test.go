@@ -0,0 +1,48 @@
+package plsql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFingerprint(t *testing.T) {
+	tests := []struct {
+		stmt string
+		want string
+	}{
+		{
+			stmt: "SELECT * FROM t WHERE id = 1 AND name = 'abc'",
+			want: `select * from t where id = ? and name = ?`,
+		},
+		{
+			stmt: "-- comment\nselect a, b from t where id in (1, 2, 3);",
+			want: `select a, b from t where id in (?+)`,
+		},
+		{
+			stmt: "INSERT INTO t (a, b) VALUES (1, 'a'), (2, 'b')",
+			want: `insert into t (a, b) values (?+)`,
+		},
+		{
+			// The bind variables are placeholders, and the delimited identifiers keep the case.
+			stmt: `SELECT "Name" FROM HR.EMPLOYEES WHERE ID = :1 AND NAME = :name`,
+			want: `select "Name" from hr.employees where id = ? and name = ?`,
+		},
+		{
+			// The date, quoted and national character literals are placeholders.
+			stmt: "SELECT * FROM t WHERE d = DATE '2020-01-01' AND s = q'[it's]' AND n = N'abc'",
+			want: "select * from t where d = date ? and s = ? and n = ?",
+		},
+		{
+			// The comparison operators lexed as two tokens are merged.
+			stmt: "SELECT * FROM t WHERE ROWNUM <= 10 AND a <> 1 AND b ^= 2",
+			want: "select * from t where rownum <= ? and a <> ? and b ^= ?",
+		},
+	}
+
+	for _, test := range tests {
+		got, err := GetFingerprint(test.stmt)
+		require.NoError(t, err)
+		require.Equal(t, test.want, got, test.stmt)
+	}
+}
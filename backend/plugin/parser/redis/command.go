@@ -0,0 +1,205 @@
+package redis
+
+import (
+	"strings"
+)
+
+// CommandType is the type of a Redis command.
+type CommandType int
+
+const (
+	// CommandTypeReadOnly is the type of the commands that don't modify the data.
+	CommandTypeReadOnly CommandType = iota
+	// CommandTypeWrite is the type of the commands that modify the data, and the unknown commands.
+	CommandTypeWrite
+	// CommandTypeDangerous is the type of the commands that may destroy the data, block the server
+	// or change the server state, such as FLUSHALL, KEYS and CONFIG SET.
+	CommandTypeDangerous
+)
+
+// DangerousRisk is the risk of a dangerous Redis command.
+type DangerousRisk int
+
+const (
+	// DangerousRiskNone is the risk of the commands that are not dangerous.
+	DangerousRiskNone DangerousRisk = iota
+	// DangerousRiskDestroyData is the risk of the commands that destroy the data, such as FLUSHALL.
+	DangerousRiskDestroyData
+	// DangerousRiskBlockServer is the risk of the commands that may block the server, such as KEYS and SAVE.
+	DangerousRiskBlockServer
+	// DangerousRiskChangeServerState is the risk of the commands that change the server state, such as CONFIG SET.
+	DangerousRiskChangeServerState
+)
+
+// String returns the name of the command type.
+func (t CommandType) String() string {
+	switch t {
+	case CommandTypeReadOnly:
+		return "read-only"
+	case CommandTypeWrite:
+		return "write"
+	case CommandTypeDangerous:
+		return "dangerous"
+	}
+	return "unknown"
+}
+
+var (
+	readOnlyCommands = map[string]bool{
+		// Generic.
+		"DBSIZE": true, "DUMP": true, "EXISTS": true, "EXPIRETIME": true, "LASTSAVE": true, "PEXPIRETIME": true,
+		"PING": true, "PTTL": true, "RANDOMKEY": true, "SCAN": true, "TIME": true, "TOUCH": true, "TTL": true,
+		"TYPE": true, "ECHO": true, "INFO": true, "ROLE": true, "LOLWUT": true, "SORT_RO": true,
+		// String.
+		"GET": true, "GETRANGE": true, "LCS": true, "MGET": true, "STRLEN": true, "SUBSTR": true,
+		// Bitmap.
+		"BITCOUNT": true, "BITFIELD_RO": true, "BITPOS": true, "GETBIT": true,
+		// Hash.
+		"HEXISTS": true, "HGET": true, "HGETALL": true, "HKEYS": true, "HLEN": true, "HMGET": true,
+		"HRANDFIELD": true, "HSCAN": true, "HSTRLEN": true, "HVALS": true,
+		// List.
+		"LINDEX": true, "LLEN": true, "LPOS": true, "LRANGE": true,
+		// Set.
+		"SCARD": true, "SDIFF": true, "SINTER": true, "SINTERCARD": true, "SISMEMBER": true, "SMEMBERS": true,
+		"SMISMEMBER": true, "SRANDMEMBER": true, "SSCAN": true, "SUNION": true,
+		// Sorted set.
+		"ZCARD": true, "ZCOUNT": true, "ZDIFF": true, "ZINTER": true, "ZINTERCARD": true, "ZLEXCOUNT": true,
+		"ZMSCORE": true, "ZRANDMEMBER": true, "ZRANGE": true, "ZRANGEBYLEX": true, "ZRANGEBYSCORE": true,
+		"ZRANK": true, "ZREVRANGE": true, "ZREVRANGEBYLEX": true, "ZREVRANGEBYSCORE": true, "ZREVRANK": true,
+		"ZSCAN": true, "ZSCORE": true, "ZUNION": true,
+		// Stream.
+		"XLEN": true, "XPENDING": true, "XRANGE": true, "XREVRANGE": true,
+		// HyperLogLog and geospatial.
+		"PFCOUNT": true, "GEODIST": true, "GEOHASH": true, "GEOPOS": true, "GEORADIUS_RO": true,
+		"GEORADIUSBYMEMBER_RO": true, "GEOSEARCH": true,
+		// JSON module.
+		"JSON.GET": true, "JSON.MGET": true, "JSON.TYPE": true, "JSON.STRLEN": true, "JSON.ARRLEN": true,
+		"JSON.OBJKEYS": true, "JSON.OBJLEN": true,
+	}
+
+	// readOnlySubcommands are the read-only subcommands of the container commands.
+	readOnlySubcommands = map[string]map[string]bool{
+		"CONFIG":   {"GET": true},
+		"CLIENT":   {"GETNAME": true, "ID": true, "INFO": true, "LIST": true},
+		"CLUSTER":  {"COUNTKEYSINSLOT": true, "INFO": true, "KEYSLOT": true, "MYID": true, "NODES": true, "SHARDS": true, "SLOTS": true},
+		"COMMAND":  {"COUNT": true, "DOCS": true, "GETKEYS": true, "INFO": true, "LIST": true},
+		"MEMORY":   {"STATS": true, "USAGE": true},
+		"OBJECT":   {"ENCODING": true, "FREQ": true, "IDLETIME": true, "REFCOUNT": true},
+		"XINFO":    {"CONSUMERS": true, "GROUPS": true, "STREAM": true},
+		"SLOWLOG":  {"GET": true, "LEN": true},
+		"PUBSUB":   {"CHANNELS": true, "NUMPAT": true, "NUMSUB": true, "SHARDCHANNELS": true, "SHARDNUMSUB": true},
+		"SCRIPT":   {"EXISTS": true},
+		"FUNCTION": {"DUMP": true, "LIST": true, "STATS": true},
+		"LATENCY":  {"DOCTOR": true, "GRAPH": true, "HISTOGRAM": true, "HISTORY": true, "LATEST": true},
+		"ACL":      {"CAT": true, "GETUSER": true, "LIST": true, "USERS": true, "WHOAMI": true},
+	}
+
+	dangerousCommands = map[string]DangerousRisk{
+		"FLUSHALL": DangerousRiskDestroyData, "FLUSHDB": DangerousRiskDestroyData, "SWAPDB": DangerousRiskDestroyData,
+		"KEYS": DangerousRiskBlockServer, "MONITOR": DangerousRiskBlockServer, "DEBUG": DangerousRiskBlockServer,
+		"SAVE": DangerousRiskBlockServer, "SYNC": DangerousRiskBlockServer, "PSYNC": DangerousRiskBlockServer,
+		"SHUTDOWN": DangerousRiskChangeServerState, "SLAVEOF": DangerousRiskChangeServerState, "REPLICAOF": DangerousRiskChangeServerState,
+		"FAILOVER": DangerousRiskChangeServerState, "MIGRATE": DangerousRiskChangeServerState, "MODULE": DangerousRiskChangeServerState,
+		"BGSAVE": DangerousRiskChangeServerState, "BGREWRITEAOF": DangerousRiskChangeServerState, "RESTORE-ASKING": DangerousRiskChangeServerState,
+	}
+
+	// dangerousSubcommands are the dangerous subcommands of the container commands, they change the server state.
+	dangerousSubcommands = map[string]map[string]bool{
+		"CONFIG":   {"SET": true, "RESETSTAT": true, "REWRITE": true},
+		"CLIENT":   {"KILL": true, "PAUSE": true, "NO-EVICT": true},
+		"CLUSTER":  {"ADDSLOTS": true, "DELSLOTS": true, "FAILOVER": true, "FORGET": true, "FLUSHSLOTS": true, "MEET": true, "RESET": true, "SETSLOT": true, "REPLICATE": true},
+		"SCRIPT":   {"FLUSH": true, "KILL": true},
+		"FUNCTION": {"FLUSH": true, "KILL": true, "DELETE": true, "RESTORE": true},
+		"ACL":      {"SETUSER": true, "DELUSER": true, "LOAD": true, "SAVE": true},
+		"SLOWLOG":  {"RESET": true},
+		"LATENCY":  {"RESET": true},
+		"MEMORY":   {"PURGE": true},
+	}
+
+	// keyspaceCommands are the commands whose time complexity is O(N) of the keyspace, a collection or several collections.
+	keyspaceCommands = map[string]bool{
+		"KEYS": true, "FLUSHALL": true, "FLUSHDB": true,
+		"HGETALL": true, "HKEYS": true, "HVALS": true, "SMEMBERS": true,
+		"SUNION": true, "SUNIONSTORE": true, "SINTER": true, "SINTERSTORE": true, "SDIFF": true, "SDIFFSTORE": true,
+		"ZUNION": true, "ZUNIONSTORE": true, "ZINTER": true, "ZINTERSTORE": true, "ZDIFF": true, "ZDIFFSTORE": true,
+		"SORT": true, "SORT_RO": true,
+	}
+
+	// rangeCommands are the commands reading a range of a collection, the range is the first two arguments after the key.
+	rangeCommands = map[string]bool{
+		"LRANGE": true, "ZRANGE": true, "ZREVRANGE": true, "XRANGE": true, "XREVRANGE": true,
+	}
+)
+
+// ClassifyCommand classifies the command into read-only, write and dangerous.
+func ClassifyCommand(command *Command) CommandType {
+	subcommand := ""
+	if len(command.Args) > 0 {
+		subcommand = strings.ToUpper(command.Args[0])
+	}
+	if GetDangerousRisk(command) != DangerousRiskNone {
+		return CommandTypeDangerous
+	}
+	if readOnlyCommands[command.Name] || readOnlySubcommands[command.Name][subcommand] {
+		return CommandTypeReadOnly
+	}
+	if command.Name == "SORT" && !hasArg(command.Args, "STORE") {
+		return CommandTypeReadOnly
+	}
+	return CommandTypeWrite
+}
+
+// GetDangerousRisk returns the risk of the command, or DangerousRiskNone if the command is not dangerous.
+func GetDangerousRisk(command *Command) DangerousRisk {
+	if risk, ok := dangerousCommands[command.Name]; ok {
+		return risk
+	}
+	if len(command.Args) > 0 && dangerousSubcommands[command.Name][strings.ToUpper(command.Args[0])] {
+		return DangerousRiskChangeServerState
+	}
+	return DangerousRiskNone
+}
+
+// IsLinearCommand returns true if the time complexity of the command is O(N) of the whole keyspace or a whole collection.
+// Such commands may block the server for a long time on big keys.
+func IsLinearCommand(command *Command) bool {
+	if keyspaceCommands[command.Name] {
+		return true
+	}
+	if rangeCommands[command.Name] && len(command.Args) >= 3 {
+		start, stop := command.Args[1], command.Args[2]
+		switch command.Name {
+		case "XRANGE":
+			return start == "-" && stop == "+" && !hasArg(command.Args, "COUNT")
+		case "XREVRANGE":
+			return start == "+" && stop == "-" && !hasArg(command.Args, "COUNT")
+		case "ZRANGE":
+			if hasArg(command.Args, "LIMIT") {
+				return false
+			}
+			if hasArg(command.Args, "BYSCORE") || hasArg(command.Args, "BYLEX") {
+				return isUnboundedScoreRange(start, stop) || isUnboundedScoreRange(stop, start)
+			}
+		}
+		return start == "0" && stop == "-1"
+	}
+	switch command.Name {
+	case "ZRANGEBYSCORE", "ZREVRANGEBYSCORE", "ZRANGEBYLEX", "ZREVRANGEBYLEX":
+		return len(command.Args) >= 3 && !hasArg(command.Args, "LIMIT") &&
+			(isUnboundedScoreRange(command.Args[1], command.Args[2]) || isUnboundedScoreRange(command.Args[2], command.Args[1]))
+	}
+	return false
+}
+
+func isUnboundedScoreRange(lower, upper string) bool {
+	return (strings.EqualFold(lower, "-inf") && strings.EqualFold(upper, "+inf")) || (lower == "-" && upper == "+")
+}
+
+func hasArg(args []string, arg string) bool {
+	for _, a := range args {
+		if strings.EqualFold(a, arg) {
+			return true
+		}
+	}
+	return false
+}
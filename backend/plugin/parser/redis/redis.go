@@ -0,0 +1,174 @@
+// Package redis provides the parser and the classifier of Redis commands.
+package redis
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterQueryValidator(storepb.Engine_REDIS, validateQuery)
+}
+
+// Command is a Redis command.
+type Command struct {
+	// Text is the original text of the command.
+	Text string
+	// Name is the upper-case command name, such as GET and HGETALL.
+	Name string
+	// Args are the arguments of the command, excluding the command name.
+	Args []string
+	// Line is the one-based line number of the command.
+	Line int
+}
+
+// Input returns the command name and the arguments for the Redis client.
+func (c *Command) Input() []any {
+	input := []any{c.Name}
+	for _, arg := range c.Args {
+		input = append(input, arg)
+	}
+	return input
+}
+
+// ParseRedisCommands parses the statement into Redis commands, one command per line.
+// The arguments are separated by whitespaces and can be quoted in the same way as redis-cli.
+// The empty lines and the lines starting with "#" are ignored.
+func ParseRedisCommands(statement string) ([]*Command, error) {
+	var commands []*Command
+	for i, line := range strings.Split(statement, "\n") {
+		text := strings.TrimSpace(line)
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		tokens, column, err := splitArgs(text)
+		if err != nil {
+			return nil, &base.SyntaxError{
+				Line:    i + 1,
+				Column:  column,
+				Message: fmt.Sprintf("Syntax error at line %d: %s", i+1, err.Error()),
+			}
+		}
+		commands = append(commands, &Command{
+			Text: text,
+			Name: strings.ToUpper(tokens[0]),
+			Args: tokens[1:],
+			Line: i + 1,
+		})
+	}
+	return commands, nil
+}
+
+// splitArgs splits the line into arguments like sdssplitargs in redis-cli.
+// It returns the column of the error if the line is invalid.
+func splitArgs(line string) ([]string, int, error) {
+	var args []string
+	i := 0
+	for {
+		for i < len(line) && isSpace(line[i]) {
+			i++
+		}
+		if i >= len(line) {
+			return args, 0, nil
+		}
+
+		var current strings.Builder
+		start := i
+		switch line[i] {
+		case '"':
+			i++
+			for {
+				if i >= len(line) {
+					return nil, start + 1, errors.New("unbalanced quotes")
+				}
+				c := line[i]
+				if c == '"' {
+					i++
+					break
+				}
+				if c == '\\' && i+1 < len(line) {
+					if line[i+1] == 'x' && i+3 < len(line) {
+						if v, err := strconv.ParseUint(line[i+2:i+4], 16, 8); err == nil {
+							current.WriteByte(byte(v))
+							i += 4
+							continue
+						}
+					}
+					switch line[i+1] {
+					case 'n':
+						current.WriteByte('\n')
+					case 'r':
+						current.WriteByte('\r')
+					case 't':
+						current.WriteByte('\t')
+					case 'b':
+						current.WriteByte('\b')
+					case 'a':
+						current.WriteByte('\a')
+					default:
+						current.WriteByte(line[i+1])
+					}
+					i += 2
+					continue
+				}
+				current.WriteByte(c)
+				i++
+			}
+		case '\'':
+			i++
+			for {
+				if i >= len(line) {
+					return nil, start + 1, errors.New("unbalanced quotes")
+				}
+				c := line[i]
+				if c == '\'' {
+					i++
+					break
+				}
+				if c == '\\' && i+1 < len(line) && line[i+1] == '\'' {
+					current.WriteByte('\'')
+					i += 2
+					continue
+				}
+				current.WriteByte(c)
+				i++
+			}
+		default:
+			for i < len(line) && !isSpace(line[i]) {
+				current.WriteByte(line[i])
+				i++
+			}
+			args = append(args, current.String())
+			continue
+		}
+		// The closing quote must be followed by a space or nothing.
+		if i < len(line) && !isSpace(line[i]) {
+			return nil, i + 1, errors.New("closing quote must be followed by a space")
+		}
+		args = append(args, current.String())
+	}
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+// validateQuery returns true if all the commands are read-only and not dangerous.
+func validateQuery(statement string) (bool, error) {
+	commands, err := ParseRedisCommands(statement)
+	if err != nil {
+		return false, err
+	}
+	for _, command := range commands {
+		if ClassifyCommand(command) != CommandTypeReadOnly {
+			return false, nil
+		}
+	}
+	return true, nil
+}
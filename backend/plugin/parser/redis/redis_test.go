@@ -0,0 +1,76 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestParseRedisCommands(t *testing.T) {
+	a := require.New(t)
+	commands, err := ParseRedisCommands(`set key "hello world"
+
+# comment
+hset 'user:1' name "a\"b\x41" age 13
+`)
+	a.NoError(err)
+	a.Equal([]*Command{
+		{Text: `set key "hello world"`, Name: "SET", Args: []string{"key", "hello world"}, Line: 1},
+		{Text: `hset 'user:1' name "a\"b\x41" age 13`, Name: "HSET", Args: []string{"user:1", "name", `a"bA`, "age", "13"}, Line: 4},
+	}, commands)
+
+	_, err = ParseRedisCommands("get key\nset key \"value")
+	a.Equal(&base.SyntaxError{Line: 2, Column: 9, Message: "Syntax error at line 2: unbalanced quotes"}, err)
+	_, err = ParseRedisCommands(`set "key"value 1`)
+	a.Error(err)
+}
+
+func TestClassifyCommand(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      CommandType
+		linear    bool
+	}{
+		{statement: "GET key", want: CommandTypeReadOnly},
+		{statement: "hgetall key", want: CommandTypeReadOnly, linear: true},
+		{statement: "LRANGE list 0 -1", want: CommandTypeReadOnly, linear: true},
+		{statement: "LRANGE list 0 10", want: CommandTypeReadOnly},
+		{statement: "ZRANGE z -inf +inf BYSCORE", want: CommandTypeReadOnly, linear: true},
+		{statement: "ZRANGE z -inf +inf BYSCORE LIMIT 0 10", want: CommandTypeReadOnly},
+		{statement: "XRANGE s - +", want: CommandTypeReadOnly, linear: true},
+		{statement: "CONFIG GET maxmemory", want: CommandTypeReadOnly},
+		{statement: "SORT list", want: CommandTypeReadOnly, linear: true},
+		{statement: "SORT list STORE dst", want: CommandTypeWrite, linear: true},
+		{statement: "SET key value", want: CommandTypeWrite},
+		{statement: "EVAL \"return 1\" 0", want: CommandTypeWrite},
+		{statement: "UNKNOWN key", want: CommandTypeWrite},
+		{statement: "KEYS *", want: CommandTypeDangerous, linear: true},
+		{statement: "FLUSHALL ASYNC", want: CommandTypeDangerous, linear: true},
+		{statement: "config set maxmemory 1gb", want: CommandTypeDangerous},
+		{statement: "CLIENT KILL ID 1", want: CommandTypeDangerous},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		commands, err := ParseRedisCommands(test.statement)
+		a.NoError(err)
+		a.Len(commands, 1)
+		a.Equal(test.want, ClassifyCommand(commands[0]), test.statement)
+		a.Equal(test.linear, IsLinearCommand(commands[0]), test.statement)
+	}
+}
+
+func TestValidateQuery(t *testing.T) {
+	a := require.New(t)
+	ok, err := validateQuery("GET a\nSCAN 0 MATCH user:* COUNT 100")
+	a.NoError(err)
+	a.True(ok)
+	ok, err = validateQuery("GET a\nDEL a")
+	a.NoError(err)
+	a.False(ok)
+	ok, err = validateQuery("KEYS *")
+	a.NoError(err)
+	a.False(ok)
+}
@@ -0,0 +1,195 @@
+package snowflake
+
+import (
+	"context"
+
+	parser "github.com/bytebase/snowsql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterGetAffectedRows(storepb.Engine_SNOWFLAKE, GetAffectedRows)
+}
+
+// GetAffectedRows returns the rows count affected by the sql.
+// Snowflake EXPLAIN doesn't estimate the rows, so the UPDATE and DELETE statements without WHERE clause
+// use the table row count, and the others are estimated by getAffectedRowsByQuery with the original statement.
+func GetAffectedRows(ctx context.Context, stmt any, getAffectedRowsByQuery base.GetAffectedRowsCountByQueryFunc, getTableDataSizeFunc base.GetTableDataSizeFunc) (int64, error) {
+	result, ok := stmt.(*ParseResult)
+	if !ok {
+		return 0, errors.New("failed to convert stmt to snowflake parse result")
+	}
+	file, ok := result.Tree.(*parser.Snowflake_fileContext)
+	if !ok {
+		return 0, nil
+	}
+
+	var affectedRows int64
+	for _, batch := range file.AllBatch() {
+		command := batch.Sql_command()
+		if command == nil {
+			continue
+		}
+		var text string
+		var objectNames []parser.IObject_nameContext
+		switch {
+		case command.Dml_command() != nil:
+			dml := command.Dml_command()
+			switch {
+			case dml.Insert_statement() != nil:
+				if values := dml.Insert_statement().Values_builder(); values != nil {
+					affectedRows += int64(len(values.AllExpr_list()))
+					continue
+				}
+				text = result.Tokens.GetTextFromRuleContext(dml)
+			case dml.Update_statement() != nil:
+				if dml.Update_statement().Search_condition() == nil && dml.Update_statement().Table_sources() == nil {
+					objectNames = append(objectNames, dml.Update_statement().Object_name())
+				} else {
+					text = result.Tokens.GetTextFromRuleContext(dml)
+				}
+			case dml.Delete_statement() != nil:
+				if dml.Delete_statement().Search_condition() == nil && len(dml.Delete_statement().AllTable_or_query()) == 0 {
+					objectNames = append(objectNames, dml.Delete_statement().Object_name())
+				} else {
+					text = result.Tokens.GetTextFromRuleContext(dml)
+				}
+			case dml.Merge_statement() != nil:
+				text = result.Tokens.GetTextFromRuleContext(dml)
+			}
+		case command.Ddl_command() != nil:
+			ddl := command.Ddl_command()
+			if ddl.Alter_command() != nil && ddl.Alter_command().Alter_table() != nil {
+				objectNames = append(objectNames, ddl.Alter_command().Alter_table().Object_name(0))
+			}
+			if ddl.Alter_command() != nil && ddl.Alter_command().Alter_table_alter_column() != nil {
+				objectNames = append(objectNames, ddl.Alter_command().Alter_table_alter_column().Object_name())
+			}
+			if ddl.Drop_command() != nil && ddl.Drop_command().Drop_table() != nil {
+				objectNames = append(objectNames, ddl.Drop_command().Drop_table().Object_name())
+			}
+		case command.Other_command() != nil:
+			if command.Other_command().Truncate_table() != nil {
+				objectNames = append(objectNames, command.Other_command().Truncate_table().Object_name())
+			}
+		}
+
+		if text != "" && getAffectedRowsByQuery != nil {
+			rows, err := getAffectedRowsByQuery(ctx, text)
+			if err != nil {
+				return 0, err
+			}
+			affectedRows += rows
+		}
+		if getTableDataSizeFunc == nil {
+			continue
+		}
+		for _, objectName := range objectNames {
+			if objectName == nil {
+				continue
+			}
+			_, schema, table := normalizeObjectNameParts(objectName, "", "PUBLIC")
+			affectedRows += getTableDataSizeFunc(schema, table)
+		}
+	}
+	return affectedRows, nil
+}
+
+// GetStatementType returns the type of the first statement.
+func GetStatementType(stmt *ParseResult) string {
+	file, ok := stmt.Tree.(*parser.Snowflake_fileContext)
+	if !ok {
+		return "UNKNOWN"
+	}
+	for _, batch := range file.AllBatch() {
+		command := batch.Sql_command()
+		if command == nil {
+			continue
+		}
+		if dml := command.Dml_command(); dml != nil {
+			switch {
+			case dml.Insert_statement() != nil, dml.Insert_multi_table_statement() != nil:
+				return "INSERT"
+			case dml.Update_statement() != nil:
+				return "UPDATE"
+			case dml.Delete_statement() != nil:
+				return "DELETE"
+			case dml.Merge_statement() != nil:
+				return "MERGE"
+			case dml.Query_statement() != nil:
+				return "SELECT"
+			}
+		}
+		if ddl := command.Ddl_command(); ddl != nil {
+			if create := ddl.Create_command(); create != nil {
+				switch {
+				case create.Create_database() != nil:
+					return "CREATE_DATABASE"
+				case create.Create_schema() != nil:
+					return "CREATE_SCHEMA"
+				case create.Create_table() != nil, create.Create_table_as_select() != nil:
+					return "CREATE_TABLE"
+				case create.Create_view() != nil, create.Create_materialized_view() != nil:
+					return "CREATE_VIEW"
+				case create.Create_sequence() != nil:
+					return "CREATE_SEQUENCE"
+				case create.Create_function() != nil:
+					return "CREATE_FUNCTION"
+				case create.Create_procedure() != nil:
+					return "CREATE_PROCEDURE"
+				case create.Create_object_clone() != nil:
+					return "CREATE_CLONE"
+				}
+			}
+			if alter := ddl.Alter_command(); alter != nil {
+				switch {
+				case alter.Alter_database() != nil:
+					return "ALTER_DATABASE"
+				case alter.Alter_schema() != nil:
+					return "ALTER_SCHEMA"
+				case alter.Alter_table() != nil, alter.Alter_table_alter_column() != nil:
+					return "ALTER_TABLE"
+				case alter.Alter_view() != nil, alter.Alter_materialized_view() != nil:
+					return "ALTER_VIEW"
+				case alter.Alter_sequence() != nil:
+					return "ALTER_SEQUENCE"
+				}
+			}
+			if drop := ddl.Drop_command(); drop != nil {
+				switch {
+				case drop.Drop_database() != nil:
+					return "DROP_DATABASE"
+				case drop.Drop_schema() != nil:
+					return "DROP_SCHEMA"
+				case drop.Drop_table() != nil:
+					return "DROP_TABLE"
+				case drop.Drop_view() != nil, drop.Drop_materialized_view() != nil:
+					return "DROP_VIEW"
+				case drop.Drop_sequence() != nil:
+					return "DROP_SEQUENCE"
+				case drop.Drop_function() != nil:
+					return "DROP_FUNCTION"
+				case drop.Drop_procedure() != nil:
+					return "DROP_PROCEDURE"
+				}
+			}
+			if ddl.Undrop_command() != nil {
+				return "UNDROP"
+			}
+		}
+		if other := command.Other_command(); other != nil {
+			switch {
+			case other.Truncate_table() != nil:
+				return "TRUNCATE"
+			case other.Copy_into_table() != nil:
+				return "COPY"
+			case other.Comment() != nil:
+				return "COMMENT"
+			}
+		}
+	}
+	return "UNKNOWN"
+}
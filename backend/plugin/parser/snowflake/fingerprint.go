@@ -0,0 +1,48 @@
+package snowflake
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/snowsql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterGetFingerprintFunc(storepb.Engine_SNOWFLAKE, GetFingerprint)
+}
+
+var fingerprintTokenTypes = &base.FingerprintTokenTypes{
+	Literals: map[int]bool{
+		parser.SnowflakeLexerSTRING: true,
+		// The dollar-quoted string constants such as $$abc$$.
+		parser.SnowflakeLexerDBL_DOLLAR: true,
+		parser.SnowflakeLexerDECIMAL:    true,
+		parser.SnowflakeLexerFLOAT:      true,
+		parser.SnowflakeLexerREAL:       true,
+		parser.SnowflakeLexerTRUE:       true,
+		parser.SnowflakeLexerFALSE:      true,
+		parser.SnowflakeLexerNULL_:      true,
+	},
+	CaseSensitive: map[int]bool{
+		parser.SnowflakeLexerDOUBLE_QUOTE_ID: true,
+	},
+	OpenParen:  parser.SnowflakeLexerLR_BRACKET,
+	CloseParen: parser.SnowflakeLexerRR_BRACKET,
+	Comma:      parser.SnowflakeLexerCOMMA,
+	Semicolon:  parser.SnowflakeLexerSEMI,
+}
+
+// GetFingerprint gets the fingerprint of the Snowflake SQL statement.
+func GetFingerprint(statement string) (string, error) {
+	lexer := parser.NewSnowflakeLexer(antlr.NewInputStream(statement))
+	lexerErrorListener := &base.ParseErrorListener{}
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(lexerErrorListener)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	stream.Fill()
+	if lexerErrorListener.Err != nil {
+		return "", lexerErrorListener.Err
+	}
+	return base.GetTokenFingerprint(stream.GetAllTokens(), fingerprintTokenTypes), nil
+}
@@ -0,0 +1,42 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFingerprint(t *testing.T) {
+	tests := []struct {
+		stmt string
+		want string
+	}{
+		{
+			stmt: "SELECT * FROM t WHERE id = 1 AND name = 'abc'",
+			want: `select * from t where id = ? and name = ?`,
+		},
+		{
+			stmt: "-- comment\nselect a, b from t where id in (1, 2, 3);",
+			want: `select a, b from t where id in (?+)`,
+		},
+		{
+			stmt: "INSERT INTO t (a, b) VALUES (1, 'a'), (2, 'b')",
+			want: `insert into t (a, b) values (?+)`,
+		},
+		{
+			// The double quoted identifiers keep the case, and the dollar-quoted strings are literals.
+			stmt: `SELECT "Name" FROM DB.PUBLIC.T WHERE ID = 1 AND NAME = $$abc$$`,
+			want: `select "Name" from db.public.t where id = ? and name = ?`,
+		},
+		{
+			stmt: "SELECT * FROM t WHERE a IN (1, 2) AND b = TRUE AND c = 1.5",
+			want: "select * from t where a in (?+) and b = ? and c = ?",
+		},
+	}
+
+	for _, test := range tests {
+		got, err := GetFingerprint(test.stmt)
+		require.NoError(t, err)
+		require.Equal(t, test.want, got, test.stmt)
+	}
+}
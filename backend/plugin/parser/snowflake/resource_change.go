@@ -0,0 +1,117 @@
+package snowflake
+
+import (
+	"sort"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/snowsql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterExtractChangedResourcesFunc(storepb.Engine_SNOWFLAKE, extractChangedResources)
+}
+
+func extractChangedResources(currentDatabase string, currentSchema string, statement string) ([]base.SchemaResource, error) {
+	tree, err := ParseSnowSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	if currentSchema == "" {
+		currentSchema = "PUBLIC"
+	}
+
+	l := &snowflakeChangedResourceExtractListener{
+		currentDatabase: currentDatabase,
+		currentSchema:   currentSchema,
+		resourceMap:     make(map[string]base.SchemaResource),
+	}
+
+	var result []base.SchemaResource
+	antlr.ParseTreeWalkerDefault.Walk(l, tree.Tree)
+	for _, resource := range l.resourceMap {
+		result = append(result, resource)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].String() < result[j].String()
+	})
+
+	return result, nil
+}
+
+type snowflakeChangedResourceExtractListener struct {
+	*parser.BaseSnowflakeParserListener
+
+	currentDatabase string
+	currentSchema   string
+	resourceMap     map[string]base.SchemaResource
+}
+
+func (l *snowflakeChangedResourceExtractListener) addObjectName(ctx parser.IObject_nameContext) {
+	if ctx == nil {
+		return
+	}
+	database, schema, table := normalizeObjectNameParts(ctx, l.currentDatabase, l.currentSchema)
+	if table == "" {
+		return
+	}
+	resource := base.SchemaResource{
+		Database: database,
+		Schema:   schema,
+		Table:    table,
+	}
+	l.resourceMap[resource.String()] = resource
+}
+
+// EnterCreate_table is called when production create_table is entered.
+func (l *snowflakeChangedResourceExtractListener) EnterCreate_table(ctx *parser.Create_tableContext) {
+	l.addObjectName(ctx.Object_name())
+}
+
+// EnterCreate_table_as_select is called when production create_table_as_select is entered.
+func (l *snowflakeChangedResourceExtractListener) EnterCreate_table_as_select(ctx *parser.Create_table_as_selectContext) {
+	l.addObjectName(ctx.Object_name())
+}
+
+// EnterAlter_table is called when production alter_table is entered.
+func (l *snowflakeChangedResourceExtractListener) EnterAlter_table(ctx *parser.Alter_tableContext) {
+	// The second object name is the new name for RENAME TO, or the other table for SWAP WITH.
+	for _, objectName := range ctx.AllObject_name() {
+		l.addObjectName(objectName)
+	}
+}
+
+// EnterAlter_table_alter_column is called when production alter_table_alter_column is entered.
+func (l *snowflakeChangedResourceExtractListener) EnterAlter_table_alter_column(ctx *parser.Alter_table_alter_columnContext) {
+	l.addObjectName(ctx.Object_name())
+}
+
+// EnterDrop_table is called when production drop_table is entered.
+func (l *snowflakeChangedResourceExtractListener) EnterDrop_table(ctx *parser.Drop_tableContext) {
+	l.addObjectName(ctx.Object_name())
+}
+
+// EnterUndrop_table is called when production undrop_table is entered.
+func (l *snowflakeChangedResourceExtractListener) EnterUndrop_table(ctx *parser.Undrop_tableContext) {
+	l.addObjectName(ctx.Object_name())
+}
+
+// EnterTruncate_table is called when production truncate_table is entered.
+func (l *snowflakeChangedResourceExtractListener) EnterTruncate_table(ctx *parser.Truncate_tableContext) {
+	l.addObjectName(ctx.Object_name())
+}
+
+// normalizeObjectNameParts returns the normalized database, schema and object name of the object name.
+func normalizeObjectNameParts(ctx parser.IObject_nameContext, fallbackDatabaseName, fallbackSchemaName string) (string, string, string) {
+	database, schema := fallbackDatabaseName, fallbackSchemaName
+	if d := NormalizeSnowSQLObjectNamePart(ctx.GetD()); d != "" {
+		database = d
+	}
+	if s := NormalizeSnowSQLObjectNamePart(ctx.GetS()); s != "" {
+		schema = s
+	}
+	return database, schema, NormalizeSnowSQLObjectNamePart(ctx.GetO())
+}
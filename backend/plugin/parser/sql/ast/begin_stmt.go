@@ -0,0 +1,6 @@
+package ast
+
+// BeginStmt is the struct for begin or start transaction statement.
+type BeginStmt struct {
+	node
+}
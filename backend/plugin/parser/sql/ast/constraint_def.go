@@ -30,6 +30,8 @@ const (
 	ConstraintTypeGenerated
 	// ConstraintTypeNull is the null constraint.
 	ConstraintTypeNull
+	// ConstraintTypeIdentity is the GENERATED { ALWAYS | BY DEFAULT } AS IDENTITY constraint.
+	ConstraintTypeIdentity
 )
 
 // ConstraintDef is struct for constraint definition.
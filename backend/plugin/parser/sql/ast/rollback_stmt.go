@@ -0,0 +1,6 @@
+package ast
+
+// RollbackStmt is the struct for rollback statement.
+type RollbackStmt struct {
+	node
+}
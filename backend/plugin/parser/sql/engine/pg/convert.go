@@ -826,8 +826,13 @@ func convert(node *pgquery.Node, statement base.SingleSQL) (res ast.Node, err er
 		}
 		// TODO(rebelice): support RENAME ENUM VALUE statements
 	case *pgquery.Node_TransactionStmt:
-		if in.TransactionStmt.Kind == pgquery.TransactionStmtKind_TRANS_STMT_COMMIT {
+		switch in.TransactionStmt.Kind {
+		case pgquery.TransactionStmtKind_TRANS_STMT_BEGIN, pgquery.TransactionStmtKind_TRANS_STMT_START:
+			return &ast.BeginStmt{}, nil
+		case pgquery.TransactionStmtKind_TRANS_STMT_COMMIT:
 			return &ast.CommitStmt{}, nil
+		case pgquery.TransactionStmtKind_TRANS_STMT_ROLLBACK:
+			return &ast.RollbackStmt{}, nil
 		}
 	case *pgquery.Node_VariableSetStmt:
 		return &ast.VariableSetStmt{}, nil
@@ -1609,6 +1614,8 @@ func convertConstraintType(in pgquery.ConstrType, usingIndex bool) ast.Constrain
 		return ast.ConstraintTypeGenerated
 	case pgquery.ConstrType_CONSTR_NULL:
 		return ast.ConstraintTypeNull
+	case pgquery.ConstrType_CONSTR_IDENTITY:
+		return ast.ConstraintTypeIdentity
 	}
 	return ast.ConstraintTypeUndefined
 }
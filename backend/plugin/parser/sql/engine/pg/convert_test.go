@@ -3218,6 +3218,35 @@ func TestCommit(t *testing.T) {
 	runTests(t, tests)
 }
 
+func TestTransaction(t *testing.T) {
+	tests := []testData{
+		{
+			stmt: "BEGIN;\nSTART TRANSACTION;\nROLLBACK;",
+			want: []ast.Node{
+				&ast.BeginStmt{},
+				&ast.BeginStmt{},
+				&ast.RollbackStmt{},
+			},
+			statementList: []base.SingleSQL{
+				{
+					Text:     `BEGIN;`,
+					LastLine: 1,
+				},
+				{
+					Text:     `START TRANSACTION;`,
+					LastLine: 2,
+				},
+				{
+					Text:     `ROLLBACK;`,
+					LastLine: 3,
+				},
+			},
+		},
+	}
+
+	runTests(t, tests)
+}
+
 func TestRenameSchema(t *testing.T) {
 	tests := []testData{
 		{
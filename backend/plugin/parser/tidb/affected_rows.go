@@ -0,0 +1,106 @@
+package tidb
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterGetAffectedRows(storepb.Engine_TIDB, GetAffectedRows)
+}
+
+// GetAffectedRows returns the rows count affected by the sql.
+func GetAffectedRows(ctx context.Context, stmt any, getAffectedRowsByQuery base.GetAffectedRowsCountByQueryFunc, getTableDataSizeFunc base.GetTableDataSizeFunc) (int64, error) {
+	node, ok := stmt.(ast.StmtNode)
+	if !ok {
+		return 0, errors.New("failed to convert stmt to tidb statement node")
+	}
+
+	getTableDataSize := func(table *ast.TableName) int64 {
+		if table == nil || getTableDataSizeFunc == nil {
+			return 0
+		}
+		return getTableDataSizeFunc(table.Schema.O, table.Name.O)
+	}
+
+	switch node := node.(type) {
+	case *ast.InsertStmt:
+		// The Lists also contains the single row of INSERT ... SET.
+		if node.Select == nil {
+			return int64(len(node.Lists)), nil
+		}
+	case *ast.UpdateStmt, *ast.DeleteStmt:
+	case *ast.AlterTableStmt:
+		return getTableDataSize(node.Table), nil
+	case *ast.DropTableStmt:
+		if node.IsView {
+			return 0, nil
+		}
+		var total int64
+		for _, table := range node.Tables {
+			total += getTableDataSize(table)
+		}
+		return total, nil
+	case *ast.TruncateTableStmt:
+		return getTableDataSize(node.Table), nil
+	default:
+		return 0, nil
+	}
+
+	if getAffectedRowsByQuery == nil {
+		return 0, nil
+	}
+	return getAffectedRowsByQuery(ctx, node.Text())
+}
+
+// GetStatementType returns the type of the statement.
+func GetStatementType(node ast.StmtNode) string {
+	switch node := node.(type) {
+	case *ast.CreateDatabaseStmt:
+		return "CREATE_DATABASE"
+	case *ast.CreateIndexStmt:
+		return "CREATE_INDEX"
+	case *ast.CreateTableStmt:
+		return "CREATE_TABLE"
+	case *ast.CreateViewStmt:
+		return "CREATE_VIEW"
+	case *ast.CreateSequenceStmt:
+		return "CREATE_SEQUENCE"
+	case *ast.DropIndexStmt:
+		return "DROP_INDEX"
+	case *ast.DropTableStmt:
+		if node.IsView {
+			return "DROP_VIEW"
+		}
+		return "DROP_TABLE"
+	case *ast.DropDatabaseStmt:
+		return "DROP_DATABASE"
+	case *ast.DropSequenceStmt:
+		return "DROP_SEQUENCE"
+	case *ast.AlterTableStmt:
+		return "ALTER_TABLE"
+	case *ast.AlterDatabaseStmt:
+		return "ALTER_DATABASE"
+	case *ast.AlterSequenceStmt:
+		return "ALTER_SEQUENCE"
+	case *ast.TruncateTableStmt:
+		return "TRUNCATE"
+	case *ast.RenameTableStmt:
+		return "RENAME"
+	case *ast.InsertStmt:
+		if node.IsReplace {
+			return "REPLACE"
+		}
+		return "INSERT"
+	case *ast.UpdateStmt:
+		return "UPDATE"
+	case *ast.DeleteStmt:
+		return "DELETE"
+	}
+	return "UNKNOWN"
+}
@@ -0,0 +1,18 @@
+package tidb
+
+import (
+	"github.com/pingcap/tidb/pkg/parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterGetFingerprintFunc(storepb.Engine_TIDB, GetFingerprint)
+}
+
+// GetFingerprint gets the fingerprint of the TiDB statement.
+// It's the same as the normalized statement of the TiDB statement summary, whose digest is used to group statements.
+func GetFingerprint(statement string) (string, error) {
+	return parser.Normalize(statement), nil
+}
@@ -0,0 +1,47 @@
+package tidb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFingerprint(t *testing.T) {
+	tests := []struct {
+		stmt string
+		want string
+	}{
+		{
+			stmt: "SELECT * FROM t WHERE id = 1 AND name = 'abc'",
+			want: "select * from `t` where `id` = ? and `name` = ?",
+		},
+		{
+			stmt: "-- comment\nselect a, b from t where id in (1, 2, 3);",
+			want: "select `a` , `b` from `t` where `id` in ( ... )",
+		},
+		{
+			stmt: "INSERT INTO t (a, b) VALUES (1, 'a'), (2, 'b')",
+			want: "insert into `t` ( `a` , `b` ) values ( ... )",
+		},
+		{
+			// The optimizer hints are dropped and the identifiers are lower cased as in TiDB statement summary.
+			stmt: "SELECT /*+ USE_INDEX(t, idx) */ `Name` FROM T WHERE id = 1",
+			want: "select `name` from `t` where `id` = ?",
+		},
+		{
+			// The hexadecimal and bit literals are placeholders.
+			stmt: "SELECT * FROM t WHERE a = 0x1F AND b = b'101' AND c = x'AB'",
+			want: "select * from `t` where `a` = ? and `b` = ? and `c` = ?",
+		},
+		{
+			stmt: "SELECT * FROM t LIMIT 10, 20",
+			want: "select * from `t` limit ...",
+		},
+	}
+
+	for _, test := range tests {
+		got, err := GetFingerprint(test.stmt)
+		require.NoError(t, err)
+		require.Equal(t, test.want, got, test.stmt)
+	}
+}
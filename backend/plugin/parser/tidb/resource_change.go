@@ -0,0 +1,73 @@
+package tidb
+
+import (
+	"sort"
+
+	"github.com/pingcap/tidb/pkg/parser/ast"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterExtractChangedResourcesFunc(storepb.Engine_TIDB, extractChangedResources)
+}
+
+func extractChangedResources(currentDatabase string, _ string, statement string) ([]base.SchemaResource, error) {
+	nodes, err := ParseTiDB(statement, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	resourceMap := make(map[string]base.SchemaResource)
+	addTable := func(table *ast.TableName) {
+		if table == nil {
+			return
+		}
+		resource := base.SchemaResource{
+			Database: currentDatabase,
+			Table:    table.Name.O,
+		}
+		if table.Schema.O != "" {
+			resource.Database = table.Schema.O
+		}
+		resourceMap[resource.String()] = resource
+	}
+
+	for _, node := range nodes {
+		switch node := node.(type) {
+		case *ast.CreateTableStmt:
+			addTable(node.Table)
+		case *ast.DropTableStmt:
+			if node.IsView {
+				continue
+			}
+			for _, table := range node.Tables {
+				addTable(table)
+			}
+		case *ast.AlterTableStmt:
+			addTable(node.Table)
+		case *ast.RenameTableStmt:
+			for _, tableToTable := range node.TableToTables {
+				addTable(tableToTable.OldTable)
+				addTable(tableToTable.NewTable)
+			}
+		case *ast.TruncateTableStmt:
+			addTable(node.Table)
+		case *ast.CreateIndexStmt:
+			addTable(node.Table)
+		case *ast.DropIndexStmt:
+			addTable(node.Table)
+		}
+	}
+
+	var result []base.SchemaResource
+	for _, resource := range resourceMap {
+		result = append(result, resource)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].String() < result[j].String()
+	})
+	return result, nil
+}
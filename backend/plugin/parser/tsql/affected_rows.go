@@ -0,0 +1,181 @@
+package tsql
+
+import (
+	"context"
+
+	parser "github.com/bytebase/tsql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterGetAffectedRows(storepb.Engine_MSSQL, GetAffectedRows)
+}
+
+// GetAffectedRows returns the rows count affected by the sql.
+// The getAffectedRowsByQuery is called with the original statement for the DML statements, and the
+// caller is responsible for getting the estimated rows from the query plan.
+func GetAffectedRows(ctx context.Context, stmt any, getAffectedRowsByQuery base.GetAffectedRowsCountByQueryFunc, getTableDataSizeFunc base.GetTableDataSizeFunc) (int64, error) {
+	result, ok := stmt.(*ParseResult)
+	if !ok {
+		return 0, errors.New("failed to convert stmt to tsql parse result")
+	}
+
+	var affectedRows int64
+	for _, clause := range getTopLevelSQLClauses(result) {
+		switch {
+		case clause.Dml_clause() != nil:
+			rows, err := getDMLAffectedRows(ctx, result, clause.Dml_clause(), getAffectedRowsByQuery)
+			if err != nil {
+				return 0, err
+			}
+			affectedRows += rows
+		case clause.Ddl_clause() != nil:
+			affectedRows += getDDLAffectedRows(clause.Ddl_clause(), getTableDataSizeFunc)
+		}
+	}
+	return affectedRows, nil
+}
+
+func getDMLAffectedRows(ctx context.Context, result *ParseResult, dml parser.IDml_clauseContext, getAffectedRowsByQuery base.GetAffectedRowsCountByQueryFunc) (int64, error) {
+	switch {
+	case dml.Insert_statement() != nil:
+		value := dml.Insert_statement().Insert_statement_value()
+		if value == nil {
+			return 0, nil
+		}
+		if value.Table_value_constructor() != nil {
+			return int64(len(value.Table_value_constructor().AllExpression_list_())), nil
+		}
+		if value.DEFAULT() != nil {
+			return 1, nil
+		}
+	case dml.Update_statement() != nil, dml.Delete_statement() != nil, dml.Merge_statement() != nil:
+	default:
+		return 0, nil
+	}
+	if getAffectedRowsByQuery == nil {
+		return 0, nil
+	}
+	return getAffectedRowsByQuery(ctx, result.Tokens.GetTextFromRuleContext(dml))
+}
+
+func getDDLAffectedRows(ddl parser.IDdl_clauseContext, getTableDataSizeFunc base.GetTableDataSizeFunc) int64 {
+	if getTableDataSizeFunc == nil {
+		return 0
+	}
+	var tableNames []parser.ITable_nameContext
+	switch {
+	case ddl.Alter_table() != nil:
+		tableNames = append(tableNames, ddl.Alter_table().Table_name(0))
+	case ddl.Drop_table() != nil:
+		tableNames = append(tableNames, ddl.Drop_table().AllTable_name()...)
+	case ddl.Truncate_table() != nil:
+		tableNames = append(tableNames, ddl.Truncate_table().Table_name())
+	}
+
+	var affectedRows int64
+	for _, tableName := range tableNames {
+		if tableName == nil {
+			continue
+		}
+		_, schema, table := normalizeTableNameWithCase(tableName, "", "dbo")
+		affectedRows += getTableDataSizeFunc(schema, table)
+	}
+	return affectedRows
+}
+
+func getTopLevelSQLClauses(result *ParseResult) []parser.ISql_clausesContext {
+	file, ok := result.Tree.(*parser.Tsql_fileContext)
+	if !ok {
+		return nil
+	}
+	var clauses []parser.ISql_clausesContext
+	for _, batch := range file.AllBatch() {
+		clauses = append(clauses, batch.AllSql_clauses()...)
+	}
+	return clauses
+}
+
+// GetStatementType returns the type of the first statement.
+func GetStatementType(stmt *ParseResult) string {
+	file, ok := stmt.Tree.(*parser.Tsql_fileContext)
+	if !ok {
+		return "UNKNOWN"
+	}
+	for _, batch := range file.AllBatch() {
+		if s := batch.Batch_level_statement(); s != nil {
+			switch {
+			case s.Create_or_alter_function() != nil:
+				return "CREATE_FUNCTION"
+			case s.Create_or_alter_procedure() != nil:
+				return "CREATE_PROCEDURE"
+			case s.Create_or_alter_trigger() != nil:
+				return "CREATE_TRIGGER"
+			case s.Create_view() != nil:
+				return "CREATE_VIEW"
+			}
+		}
+		for _, clause := range batch.AllSql_clauses() {
+			if dml := clause.Dml_clause(); dml != nil {
+				switch {
+				case dml.Insert_statement() != nil:
+					return "INSERT"
+				case dml.Update_statement() != nil:
+					return "UPDATE"
+				case dml.Delete_statement() != nil:
+					return "DELETE"
+				case dml.Merge_statement() != nil:
+					return "MERGE"
+				case dml.Select_statement_standalone() != nil:
+					return "SELECT"
+				}
+			}
+			if ddl := clause.Ddl_clause(); ddl != nil {
+				switch {
+				case ddl.Create_database() != nil:
+					return "CREATE_DATABASE"
+				case ddl.Create_schema() != nil:
+					return "CREATE_SCHEMA"
+				case ddl.Create_table() != nil:
+					return "CREATE_TABLE"
+				case ddl.Create_index() != nil, ddl.Create_columnstore_index() != nil, ddl.Create_nonclustered_columnstore_index() != nil:
+					return "CREATE_INDEX"
+				case ddl.Create_sequence() != nil:
+					return "CREATE_SEQUENCE"
+				case ddl.Alter_database() != nil:
+					return "ALTER_DATABASE"
+				case ddl.Alter_table() != nil:
+					return "ALTER_TABLE"
+				case ddl.Alter_index() != nil:
+					return "ALTER_INDEX"
+				case ddl.Alter_sequence() != nil:
+					return "ALTER_SEQUENCE"
+				case ddl.Drop_database() != nil:
+					return "DROP_DATABASE"
+				case ddl.Drop_schema() != nil:
+					return "DROP_SCHEMA"
+				case ddl.Drop_table() != nil:
+					return "DROP_TABLE"
+				case ddl.Drop_index() != nil:
+					return "DROP_INDEX"
+				case ddl.Drop_view() != nil:
+					return "DROP_VIEW"
+				case ddl.Drop_procedure() != nil:
+					return "DROP_PROCEDURE"
+				case ddl.Drop_function() != nil:
+					return "DROP_FUNCTION"
+				case ddl.Drop_trigger() != nil:
+					return "DROP_TRIGGER"
+				case ddl.Drop_sequence() != nil:
+					return "DROP_SEQUENCE"
+				case ddl.Truncate_table() != nil:
+					return "TRUNCATE"
+				}
+			}
+		}
+	}
+	return "UNKNOWN"
+}
@@ -0,0 +1,1169 @@
+package tsql
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/tsql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterSchemaDiffFunc(storepb.Engine_MSSQL, SchemaDiff)
+}
+
+const defaultSchema = "dbo"
+
+// systemNameRegexp matches the constraint names generated by SQL Server, such as PK__orders__3213E83F0C85DE4D.
+var systemNameRegexp = regexp.MustCompile(`(?i)^(PK|UQ|DF|CK|FK)__.+__[0-9A-F]{8,16}$`)
+
+// typeDefaults is the type attributes used by SQL Server if the column type omits them.
+var typeDefaults = map[string]string{
+	"decimal":        "(18,0)",
+	"numeric":        "(18,0)",
+	"char":           "(1)",
+	"nchar":          "(1)",
+	"varchar":        "(1)",
+	"nvarchar":       "(1)",
+	"binary":         "(1)",
+	"varbinary":      "(1)",
+	"datetime2":      "(7)",
+	"datetimeoffset": "(7)",
+	"time":           "(7)",
+}
+
+var typeAliases = map[string]string{
+	"integer":          "int",
+	"dec":              "decimal",
+	"double precision": "float",
+	"float(53)":        "float",
+}
+
+type diffNode struct {
+	dropModule     []string
+	dropForeignKey []string
+	dropIndex      []string
+	dropConstraint []string
+	dropColumn     []string
+	dropTable      []string
+	createTable    []string
+	addColumn      []string
+	alterColumn    []string
+	addConstraint  []string
+	addIndex       []string
+	addForeignKey  []string
+	createModule   []string
+}
+
+func (diff *diffNode) String() string {
+	var buf strings.Builder
+	for _, statements := range [][]string{
+		diff.dropModule,
+		diff.dropForeignKey,
+		diff.dropIndex,
+		diff.dropConstraint,
+		diff.dropColumn,
+		diff.dropTable,
+		diff.createTable,
+		diff.addColumn,
+		diff.alterColumn,
+		diff.addConstraint,
+		diff.addIndex,
+		diff.addForeignKey,
+	} {
+		for _, statement := range statements {
+			_, _ = buf.WriteString(statement)
+			_, _ = buf.WriteString("\n")
+		}
+	}
+	// The views, functions and procedures must be created in their own batches.
+	if len(diff.createModule) > 0 {
+		_, _ = buf.WriteString("GO\n")
+	}
+	for _, statement := range diff.createModule {
+		_, _ = buf.WriteString(statement)
+		_, _ = buf.WriteString("\nGO\n")
+	}
+	return buf.String()
+}
+
+// SchemaDiff computes the migration from the old schema to the new schema for SQL Server.
+// It diffs the tables, columns, constraints and indexes, and the views, functions and procedures.
+// The system generated constraint names and the storage options, such as the filegroups and the index options, are not compared.
+// The changed views, functions and procedures are dropped and created again, and the triggers are not supported.
+func SchemaDiff(_ base.DiffContext, oldStmt, newStmt string) (string, error) {
+	oldSchemaInfo, err := buildSchemaInfo(oldStmt)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to build schema info for old statement")
+	}
+	newSchemaInfo, err := buildSchemaInfo(newStmt)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to build schema info for new statement")
+	}
+
+	diff := &diffNode{}
+	existsInNew := make(map[string]bool)
+	for _, newTable := range newSchemaInfo.tables {
+		oldTable, ok := oldSchemaInfo.tableMap[newTable.id]
+		if !ok {
+			diff.createTable = append(diff.createTable, newTable.text)
+			for _, index := range newTable.indexes {
+				if !index.inline {
+					diff.addIndex = append(diff.addIndex, index.text)
+				}
+			}
+			continue
+		}
+		existsInNew[newTable.id] = true
+		if err := diff.diffTable(oldTable, newTable); err != nil {
+			return "", err
+		}
+	}
+	for _, oldTable := range oldSchemaInfo.tables {
+		if existsInNew[oldTable.id] {
+			continue
+		}
+		// Drop the foreign keys first so that the tables can be dropped in any order.
+		for _, constraint := range oldTable.constraints {
+			if constraint.kind == foreignKeyConstraint {
+				statement, err := dropConstraintStatement(oldTable, constraint)
+				if err != nil {
+					return "", err
+				}
+				diff.dropForeignKey = append(diff.dropForeignKey, statement)
+			}
+		}
+		diff.dropTable = append(diff.dropTable, fmt.Sprintf("DROP TABLE %s;", oldTable.quotedName()))
+	}
+	diff.diffModules(oldSchemaInfo, newSchemaInfo)
+	return diff.String(), nil
+}
+
+// diffModules drops the views, functions and procedures which are removed or changed, and creates the added or changed ones in the order of the new schema.
+func (diff *diffNode) diffModules(oldSchemaInfo, newSchemaInfo *schemaInfo) {
+	for _, oldModule := range oldSchemaInfo.modules {
+		newModule, ok := newSchemaInfo.moduleMap[oldModule.id]
+		if ok && newModule.equal(oldModule) {
+			continue
+		}
+		diff.dropModule = append(diff.dropModule, fmt.Sprintf("DROP %s %s;", oldModule.kind, oldModule.quotedName()))
+	}
+	for _, newModule := range newSchemaInfo.modules {
+		oldModule, ok := oldSchemaInfo.moduleMap[newModule.id]
+		if ok && newModule.equal(oldModule) {
+			continue
+		}
+		diff.createModule = append(diff.createModule, newModule.text)
+	}
+}
+
+func (diff *diffNode) diffTable(oldTable, newTable *tableInfo) error {
+	tableName := newTable.quotedName()
+	alteredColumns := make(map[string]bool)
+	newColumns := make(map[string]bool)
+	for _, newColumn := range newTable.columns {
+		newColumns[newColumn.id] = true
+		oldColumn, ok := oldTable.columnMap[newColumn.id]
+		if !ok {
+			diff.addColumn = append(diff.addColumn, fmt.Sprintf("ALTER TABLE %s ADD %s;", tableName, newColumn.definition()))
+			continue
+		}
+		if oldColumn.computed != "" || newColumn.computed != "" {
+			if oldColumn.computed != newColumn.computed || oldColumn.persisted != newColumn.persisted {
+				// The computed columns cannot be altered.
+				alteredColumns[newColumn.id] = true
+				if err := diff.dropDefault(oldTable, oldColumn); err != nil {
+					return err
+				}
+				diff.dropColumn = append(diff.dropColumn, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", tableName, quoteIdentifier(oldColumn.name)))
+				diff.addColumn = append(diff.addColumn, fmt.Sprintf("ALTER TABLE %s ADD %s;", tableName, newColumn.definition()))
+			}
+			continue
+		}
+		if oldColumn.identity != newColumn.identity {
+			return errors.Errorf("changing the identity of column %q in table %q is not supported", newColumn.name, newTable.name)
+		}
+		if oldColumn.typ != newColumn.typ || oldColumn.nullable != newColumn.nullable || oldColumn.collation != newColumn.collation {
+			alteredColumns[newColumn.id] = true
+			diff.alterColumn = append(diff.alterColumn, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s;", tableName, newColumn.alterDefinition()))
+		}
+		// The default constraint depends on the column, so it has to be recreated if the column is altered.
+		if alteredColumns[newColumn.id] || !isDefaultEqual(oldColumn, newColumn) {
+			if err := diff.dropDefault(oldTable, oldColumn); err != nil {
+				return err
+			}
+			if newColumn.defaultExpression != "" {
+				constraint := ""
+				if newColumn.defaultName != "" {
+					constraint = fmt.Sprintf("CONSTRAINT %s ", quoteIdentifier(newColumn.defaultName))
+				}
+				diff.addConstraint = append(diff.addConstraint, fmt.Sprintf("ALTER TABLE %s ADD %sDEFAULT %s FOR %s;", tableName, constraint, newColumn.defaultText, quoteIdentifier(newColumn.name)))
+			}
+		}
+	}
+	for _, oldColumn := range oldTable.columns {
+		if newColumns[oldColumn.id] {
+			continue
+		}
+		if err := diff.dropDefault(oldTable, oldColumn); err != nil {
+			return err
+		}
+		diff.dropColumn = append(diff.dropColumn, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", tableName, quoteIdentifier(oldColumn.name)))
+	}
+
+	// The unnamed or system named constraints are matched by the definition.
+	matched := make(map[*constraintInfo]bool)
+	for _, newConstraint := range newTable.constraints {
+		var oldConstraint *constraintInfo
+		if newConstraint.isNamed() {
+			if c := oldTable.getConstraint(newConstraint.name); c != nil && c.definition == newConstraint.definition {
+				oldConstraint = c
+			}
+		} else {
+			for _, c := range oldTable.constraints {
+				if !matched[c] && c.definition == newConstraint.definition {
+					oldConstraint = c
+					break
+				}
+			}
+		}
+		if oldConstraint != nil && !matched[oldConstraint] && !referencesAny(oldConstraint.columns, alteredColumns) {
+			matched[oldConstraint] = true
+			continue
+		}
+		statement := fmt.Sprintf("ALTER TABLE %s ADD %s;", tableName, newConstraint.definitionText())
+		if newConstraint.kind == foreignKeyConstraint {
+			diff.addForeignKey = append(diff.addForeignKey, statement)
+		} else {
+			diff.addConstraint = append(diff.addConstraint, statement)
+		}
+	}
+	for _, oldConstraint := range oldTable.constraints {
+		if matched[oldConstraint] {
+			continue
+		}
+		statement, err := dropConstraintStatement(oldTable, oldConstraint)
+		if err != nil {
+			return err
+		}
+		if oldConstraint.kind == foreignKeyConstraint {
+			diff.dropForeignKey = append(diff.dropForeignKey, statement)
+		} else {
+			diff.dropConstraint = append(diff.dropConstraint, statement)
+		}
+	}
+
+	newIndexes := make(map[string]bool)
+	for _, newIndex := range newTable.indexes {
+		oldIndex, ok := oldTable.indexMap[newIndex.id]
+		if ok && oldIndex.definition == newIndex.definition && !referencesAny(oldIndex.columns, alteredColumns) {
+			newIndexes[newIndex.id] = true
+			continue
+		}
+		diff.addIndex = append(diff.addIndex, newIndex.text)
+	}
+	for _, oldIndex := range oldTable.indexes {
+		if !newIndexes[oldIndex.id] {
+			diff.dropIndex = append(diff.dropIndex, fmt.Sprintf("DROP INDEX %s ON %s;", quoteIdentifier(oldIndex.name), tableName))
+		}
+	}
+	return nil
+}
+
+func (diff *diffNode) dropDefault(table *tableInfo, column *columnInfo) error {
+	if column.defaultExpression == "" {
+		return nil
+	}
+	if column.defaultName == "" {
+		return errors.Errorf("cannot drop the unnamed default constraint of column %q in table %q", column.name, table.name)
+	}
+	diff.dropConstraint = append(diff.dropConstraint, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;", table.quotedName(), quoteIdentifier(column.defaultName)))
+	return nil
+}
+
+func dropConstraintStatement(table *tableInfo, constraint *constraintInfo) (string, error) {
+	if constraint.name == "" {
+		return "", errors.Errorf("cannot drop the unnamed constraint %q in table %q", constraint.definition, table.name)
+	}
+	return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;", table.quotedName(), quoteIdentifier(constraint.name)), nil
+}
+
+func isDefaultEqual(oldColumn, newColumn *columnInfo) bool {
+	if oldColumn.defaultExpression != newColumn.defaultExpression {
+		return false
+	}
+	if newColumn.defaultExpression == "" || newColumn.defaultName == "" || systemNameRegexp.MatchString(newColumn.defaultName) {
+		return true
+	}
+	return strings.EqualFold(oldColumn.defaultName, newColumn.defaultName)
+}
+
+func referencesAny(columns []string, columnSet map[string]bool) bool {
+	for _, column := range columns {
+		if columnSet[column] {
+			return true
+		}
+	}
+	return false
+}
+
+type constraintKind string
+
+const (
+	primaryKeyConstraint constraintKind = "PRIMARY KEY"
+	uniqueConstraint     constraintKind = "UNIQUE"
+	foreignKeyConstraint constraintKind = "FOREIGN KEY"
+	checkConstraint      constraintKind = "CHECK"
+)
+
+type moduleKind string
+
+const (
+	viewModule      moduleKind = "VIEW"
+	functionModule  moduleKind = "FUNCTION"
+	procedureModule moduleKind = "PROCEDURE"
+)
+
+type schemaInfo struct {
+	tables    []*tableInfo
+	tableMap  map[string]*tableInfo
+	modules   []*moduleInfo
+	moduleMap map[string]*moduleInfo
+}
+
+// moduleInfo is a view, function or procedure.
+type moduleInfo struct {
+	id     string
+	kind   moduleKind
+	schema string
+	name   string
+	text   string
+	// tokens is the normalized definition after the name.
+	tokens []string
+}
+
+type tableInfo struct {
+	id          string
+	schema      string
+	name        string
+	text        string
+	columns     []*columnInfo
+	columnMap   map[string]*columnInfo
+	constraints []*constraintInfo
+	indexes     []*indexInfo
+	indexMap    map[string]*indexInfo
+}
+
+type columnInfo struct {
+	id   string
+	name string
+	// typ is the normalized type, and typeText is the type in the statement.
+	typ               string
+	typeText          string
+	computed          string
+	computedText      string
+	persisted         bool
+	identity          string
+	collation         string
+	notNull           *bool
+	nullable          bool
+	defaultName       string
+	defaultExpression string
+	defaultText       string
+}
+
+type constraintInfo struct {
+	name      string
+	kind      constraintKind
+	clustered *bool
+	// body is the normalized definition without the constraint kind and the clustered option.
+	body       string
+	definition string
+	// text is the constraint in the statement without the constraint name.
+	text    string
+	columns []string
+	// tokens is the normalized check condition.
+	tokens []string
+}
+
+type indexInfo struct {
+	id         string
+	name       string
+	inline     bool
+	clustered  bool
+	tableID    string
+	definition string
+	text       string
+	columns    []string
+}
+
+func (m *moduleInfo) quotedName() string {
+	return fmt.Sprintf("%s.%s", quoteIdentifier(m.schema), quoteIdentifier(m.name))
+}
+
+func (m *moduleInfo) equal(other *moduleInfo) bool {
+	return m.kind == other.kind && slices.Equal(m.tokens, other.tokens)
+}
+
+func (t *tableInfo) quotedName() string {
+	return fmt.Sprintf("%s.%s", quoteIdentifier(t.schema), quoteIdentifier(t.name))
+}
+
+func (t *tableInfo) getConstraint(name string) *constraintInfo {
+	for _, constraint := range t.constraints {
+		if strings.EqualFold(constraint.name, name) {
+			return constraint
+		}
+	}
+	return nil
+}
+
+// resolve resolves the attributes depending on the other parts of the table.
+func (t *tableInfo) resolve() {
+	var primaryKeyColumns []string
+	hasClustered := false
+	for _, constraint := range t.constraints {
+		if constraint.kind == primaryKeyConstraint {
+			primaryKeyColumns = append(primaryKeyColumns, constraint.columns...)
+		}
+		if constraint.kind == uniqueConstraint && constraint.clustered != nil && *constraint.clustered {
+			hasClustered = true
+		}
+	}
+	for _, index := range t.indexes {
+		if index.clustered {
+			hasClustered = true
+		}
+	}
+
+	// The columns in the primary key are not nullable, and the others are nullable by default.
+	for _, column := range t.columns {
+		switch {
+		case column.notNull != nil:
+			column.nullable = !*column.notNull
+		case column.computed != "":
+			column.nullable = true
+		default:
+			column.nullable = true
+			for _, primaryKeyColumn := range primaryKeyColumns {
+				if primaryKeyColumn == column.id {
+					column.nullable = false
+				}
+			}
+		}
+	}
+
+	for _, constraint := range t.constraints {
+		switch constraint.kind {
+		case primaryKeyConstraint, uniqueConstraint:
+			// The primary key is clustered by default unless there is another clustered index.
+			clustered := constraint.kind == primaryKeyConstraint && !hasClustered
+			if constraint.clustered != nil {
+				clustered = *constraint.clustered
+			}
+			clusteredText := "nonclustered"
+			if clustered {
+				clusteredText = "clustered"
+			}
+			constraint.definition = fmt.Sprintf("%s %s %s", strings.ToLower(string(constraint.kind)), clusteredText, constraint.body)
+		case checkConstraint:
+			for _, token := range constraint.tokens {
+				if _, ok := t.columnMap[token]; ok {
+					constraint.columns = append(constraint.columns, token)
+				}
+			}
+			constraint.definition = fmt.Sprintf("check %s", constraint.body)
+		default:
+			constraint.definition = fmt.Sprintf("%s %s", strings.ToLower(string(constraint.kind)), constraint.body)
+		}
+	}
+}
+
+func (c *columnInfo) definition() string {
+	if c.computed != "" {
+		s := fmt.Sprintf("%s AS %s", quoteIdentifier(c.name), c.computedText)
+		if c.persisted {
+			s += " PERSISTED"
+		}
+		return s
+	}
+	parts := []string{quoteIdentifier(c.name), c.typeText}
+	if c.collation != "" {
+		parts = append(parts, "COLLATE", c.collation)
+	}
+	if c.identity != "" {
+		parts = append(parts, strings.ToUpper(c.identity))
+	}
+	parts = append(parts, c.nullableText())
+	if c.defaultExpression != "" {
+		if c.defaultName != "" {
+			parts = append(parts, "CONSTRAINT", quoteIdentifier(c.defaultName))
+		}
+		parts = append(parts, "DEFAULT", c.defaultText)
+	}
+	return strings.Join(parts, " ")
+}
+
+func (c *columnInfo) alterDefinition() string {
+	parts := []string{quoteIdentifier(c.name), c.typeText}
+	if c.collation != "" {
+		parts = append(parts, "COLLATE", c.collation)
+	}
+	parts = append(parts, c.nullableText())
+	return strings.Join(parts, " ")
+}
+
+func (c *columnInfo) nullableText() string {
+	if c.nullable {
+		return "NULL"
+	}
+	return "NOT NULL"
+}
+
+func (c *constraintInfo) isNamed() bool {
+	return c.name != "" && !systemNameRegexp.MatchString(c.name)
+}
+
+func (c *constraintInfo) definitionText() string {
+	if c.isNamed() {
+		return fmt.Sprintf("CONSTRAINT %s %s", quoteIdentifier(c.name), c.text)
+	}
+	return c.text
+}
+
+func buildSchemaInfo(statement string) (*schemaInfo, error) {
+	schemaInfo := &schemaInfo{
+		tableMap:  make(map[string]*tableInfo),
+		moduleMap: make(map[string]*moduleInfo),
+	}
+	if strings.TrimSpace(statement) == "" {
+		return schemaInfo, nil
+	}
+	result, err := ParseTSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &buildSchemaInfoListener{
+		schemaInfo: schemaInfo,
+	}
+	antlr.ParseTreeWalkerDefault.Walk(listener, result.Tree)
+	if listener.err != nil {
+		return nil, listener.err
+	}
+	for _, index := range listener.indexes {
+		table, ok := schemaInfo.tableMap[index.tableID]
+		if !ok {
+			return nil, errors.Errorf("table %q of index %q not found", index.tableID, index.name)
+		}
+		if err := table.addIndex(index); err != nil {
+			return nil, err
+		}
+	}
+	for _, table := range schemaInfo.tables {
+		table.resolve()
+	}
+	return schemaInfo, nil
+}
+
+type buildSchemaInfoListener struct {
+	*parser.BaseTSqlParserListener
+
+	schemaInfo *schemaInfo
+	// indexes are the indexes created by the CREATE INDEX statements, which are added to the tables after walking.
+	indexes []*indexInfo
+	err     error
+}
+
+// EnterCreate_table is called when production create_table is entered.
+func (l *buildSchemaInfoListener) EnterCreate_table(ctx *parser.Create_tableContext) {
+	if l.err != nil {
+		return
+	}
+	schema, name := getTableName(ctx.Table_name())
+	table := &tableInfo{
+		id:        getTableID(schema, name),
+		schema:    schema,
+		name:      name,
+		text:      getStatementText(ctx),
+		columnMap: make(map[string]*columnInfo),
+		indexMap:  make(map[string]*indexInfo),
+	}
+	if _, ok := l.schemaInfo.tableMap[table.id]; ok {
+		l.err = errors.Errorf("table %q is defined more than once", table.id)
+		return
+	}
+
+	for _, item := range ctx.Column_def_table_constraints().AllColumn_def_table_constraint() {
+		var err error
+		switch {
+		case item.Column_definition() != nil:
+			err = table.addColumn(item.Column_definition())
+		case item.Materialized_column_definition() != nil:
+			err = table.addMaterializedColumn(item.Materialized_column_definition())
+		case item.Table_constraint() != nil:
+			err = table.addTableConstraint(item.Table_constraint())
+		}
+		if err != nil {
+			l.err = err
+			return
+		}
+	}
+	for _, index := range ctx.AllTable_indices() {
+		if err := table.addIndex(newTableIndex(table, index)); err != nil {
+			l.err = err
+			return
+		}
+	}
+
+	l.schemaInfo.tables = append(l.schemaInfo.tables, table)
+	l.schemaInfo.tableMap[table.id] = table
+}
+
+// EnterCreate_index is called when production create_index is entered.
+func (l *buildSchemaInfoListener) EnterCreate_index(ctx *parser.Create_indexContext) {
+	if l.err != nil {
+		return
+	}
+	schema, tableName := getTableName(ctx.Table_name())
+	name := unquoteIdentifier(ctx.Id_(0).GetText())
+	columns, keys := getColumnListWithOrder(ctx.Column_name_list_with_order())
+	var definition strings.Builder
+	_, _ = definition.WriteString(getIndexPrefix(ctx.UNIQUE() != nil, ctx.Clustered()))
+	_, _ = definition.WriteString(fmt.Sprintf("(%s)", strings.Join(keys, ", ")))
+	if ctx.INCLUDE() != nil {
+		_, _ = definition.WriteString(fmt.Sprintf(" include (%s)", strings.Join(getColumnList(ctx.Column_name_list()), ", ")))
+	}
+	if ctx.GetWhere() != nil {
+		_, _ = definition.WriteString(fmt.Sprintf(" where %s", normalizeExpression(getText(ctx.GetWhere()))))
+	}
+	l.indexes = append(l.indexes, &indexInfo{
+		id:         strings.ToLower(name),
+		name:       name,
+		tableID:    getTableID(schema, tableName),
+		clustered:  isClustered(ctx.Clustered()),
+		definition: definition.String(),
+		text:       getStatementText(ctx),
+		columns:    columns,
+	})
+}
+
+// EnterCreate_view is called when production create_view is entered.
+func (l *buildSchemaInfoListener) EnterCreate_view(ctx *parser.Create_viewContext) {
+	l.addModule(viewModule, ctx, ctx.CREATE() != nil, ctx.Simple_name().GetSchema(), ctx.Simple_name().GetName(), ctx.Simple_name())
+}
+
+// EnterCreate_or_alter_function is called when production create_or_alter_function is entered.
+func (l *buildSchemaInfoListener) EnterCreate_or_alter_function(ctx *parser.Create_or_alter_functionContext) {
+	name := ctx.Func_proc_name_schema()
+	l.addModule(functionModule, ctx, ctx.CREATE() != nil, name.GetSchema(), name.GetProcedure(), name)
+}
+
+// EnterCreate_or_alter_procedure is called when production create_or_alter_procedure is entered.
+func (l *buildSchemaInfoListener) EnterCreate_or_alter_procedure(ctx *parser.Create_or_alter_procedureContext) {
+	name := ctx.Func_proc_name_schema()
+	l.addModule(procedureModule, ctx, ctx.CREATE() != nil, name.GetSchema(), name.GetProcedure(), name)
+}
+
+// EnterCreate_or_alter_trigger is called when production create_or_alter_trigger is entered.
+func (l *buildSchemaInfoListener) EnterCreate_or_alter_trigger(*parser.Create_or_alter_triggerContext) {
+	if l.err != nil {
+		return
+	}
+	l.err = errors.New("trigger is not supported in the schema")
+}
+
+func (l *buildSchemaInfoListener) addModule(kind moduleKind, ctx antlr.ParserRuleContext, create bool, schemaCtx, nameCtx parser.IId_Context, fullNameCtx antlr.ParserRuleContext) {
+	if l.err != nil {
+		return
+	}
+	if !create {
+		l.err = errors.Errorf("use CREATE %s instead of ALTER %s in the schema", kind, kind)
+		return
+	}
+	schema := defaultSchema
+	if schemaCtx != nil {
+		schema = unquoteIdentifier(schemaCtx.GetText())
+	}
+	name := unquoteIdentifier(nameCtx.GetText())
+	module := &moduleInfo{
+		id:     getTableID(schema, name),
+		kind:   kind,
+		schema: schema,
+		name:   name,
+		text:   trimModuleText(getText(ctx)) + ";",
+	}
+	if _, ok := l.schemaInfo.moduleMap[module.id]; ok {
+		l.err = errors.Errorf("%s %q is defined more than once", strings.ToLower(string(kind)), module.id)
+		return
+	}
+	if stop := fullNameCtx.GetStop().GetStop(); stop < ctx.GetStop().GetStop() {
+		body := ctx.GetStart().GetInputStream().GetTextFromInterval(antlr.NewInterval(stop+1, ctx.GetStop().GetStop()))
+		module.tokens = normalizeTokens(trimModuleText(body))
+	}
+	l.schemaInfo.modules = append(l.schemaInfo.modules, module)
+	l.schemaInfo.moduleMap[module.id] = module
+}
+
+// trailingGoRegexp matches the GO on the last line, which is parsed as a part of the module if the module doesn't end with a semicolon.
+var trailingGoRegexp = regexp.MustCompile(`(?i)\n[ \t]*GO[ \t]*$`)
+
+// trimModuleText removes the trailing semicolons and the GO batch separator of the module.
+func trimModuleText(text string) string {
+	text = strings.TrimRight(text, "; \t\r\n")
+	if loc := trailingGoRegexp.FindStringIndex(text); loc != nil {
+		text = strings.TrimRight(text[:loc[0]], "; \t\r\n")
+	}
+	return strings.TrimLeft(text, " \t\r\n")
+}
+
+func (t *tableInfo) addIndex(index *indexInfo) error {
+	if _, ok := t.indexMap[index.id]; ok {
+		return errors.Errorf("index %q is defined more than once in table %q", index.name, t.name)
+	}
+	t.indexes = append(t.indexes, index)
+	t.indexMap[index.id] = index
+	return nil
+}
+
+func (t *tableInfo) addColumnInfo(column *columnInfo) error {
+	if _, ok := t.columnMap[column.id]; ok {
+		return errors.Errorf("column %q is defined more than once in table %q", column.name, t.name)
+	}
+	t.columns = append(t.columns, column)
+	t.columnMap[column.id] = column
+	return nil
+}
+
+func (t *tableInfo) addColumn(ctx parser.IColumn_definitionContext) error {
+	name := unquoteIdentifier(ctx.Id_().GetText())
+	column := &columnInfo{
+		id:   strings.ToLower(name),
+		name: name,
+	}
+	if dataType := ctx.Data_type(); dataType != nil {
+		column.typeText = getText(dataType)
+		if dataType.IDENTITY() != nil {
+			// The identity could be parsed as a part of the data type, such as "INT IDENTITY(1, 1)".
+			column.typeText = getText(dataType.GetExt_type())
+			column.identity = getIdentity(dataType.GetSeed(), dataType.GetInc())
+		}
+		column.typ = normalizeDataType(column.typeText)
+	} else {
+		column.computedText = getText(ctx.Expression())
+		column.computed = normalizeExpression(column.computedText)
+		column.persisted = ctx.PERSISTED() != nil
+	}
+
+	for _, element := range ctx.AllColumn_definition_element() {
+		switch {
+		case element.COLLATE() != nil:
+			column.collation = strings.ToLower(element.GetCollation_name().GetText())
+		case element.IDENTITY() != nil:
+			column.identity = getIdentity(element.GetSeed(), element.GetIncrement())
+		case element.DEFAULT() != nil:
+			if element.GetConstraint() != nil {
+				column.defaultName = unquoteIdentifier(element.GetConstraint().GetText())
+			}
+			column.defaultText = getText(element.GetConstant_expr())
+			column.defaultExpression = normalizeExpression(column.defaultText)
+		case element.Column_constraint() != nil:
+			if err := t.addColumnConstraint(column, element.Column_constraint()); err != nil {
+				return err
+			}
+		}
+	}
+	if index := ctx.Column_index(); index != nil {
+		name := unquoteIdentifier(index.GetIndex_name().GetText())
+		prefix := getIndexPrefix(false, index.Clustered())
+		if err := t.addIndex(&indexInfo{
+			id:         strings.ToLower(name),
+			name:       name,
+			inline:     true,
+			definition: fmt.Sprintf("%s(%s)", prefix, column.id),
+			clustered:  isClustered(index.Clustered()),
+			text:       fmt.Sprintf("CREATE %s %s ON %s (%s);", strings.ToUpper(strings.TrimSpace(prefix)), quoteIdentifier(name), t.quotedName(), quoteIdentifier(column.name)),
+			columns:    []string{column.id},
+		}); err != nil {
+			return err
+		}
+	}
+	return t.addColumnInfo(column)
+}
+
+func (t *tableInfo) addMaterializedColumn(ctx parser.IMaterialized_column_definitionContext) error {
+	name := unquoteIdentifier(ctx.Id_().GetText())
+	column := &columnInfo{
+		id:           strings.ToLower(name),
+		name:         name,
+		computedText: getText(ctx.Expression()),
+		persisted:    ctx.MATERIALIZED() != nil && ctx.NOT() == nil,
+	}
+	column.computed = normalizeExpression(column.computedText)
+	return t.addColumnInfo(column)
+}
+
+func (t *tableInfo) addColumnConstraint(column *columnInfo, ctx parser.IColumn_constraintContext) error {
+	if ctx.Null_notnull() != nil {
+		notNull := ctx.Null_notnull().NOT() != nil
+		column.notNull = &notNull
+		return nil
+	}
+	constraint := &constraintInfo{}
+	if ctx.GetConstraint() != nil {
+		constraint.name = unquoteIdentifier(ctx.GetConstraint().GetText())
+	}
+	quotedColumn := quoteIdentifier(column.name)
+	switch {
+	case ctx.PRIMARY() != nil || ctx.UNIQUE() != nil:
+		constraint.kind = uniqueConstraint
+		if ctx.PRIMARY() != nil {
+			constraint.kind = primaryKeyConstraint
+		}
+		constraint.clustered = getClustered(ctx.Clustered())
+		constraint.body = fmt.Sprintf("(%s)", column.id)
+		constraint.columns = []string{column.id}
+		text := []string{string(constraint.kind)}
+		if ctx.Clustered() != nil {
+			text = append(text, strings.ToUpper(ctx.Clustered().GetText()))
+		}
+		text = append(text, fmt.Sprintf("(%s)", quotedColumn))
+		if options := getText(ctx.Primary_key_options()); options != "" {
+			text = append(text, options)
+		}
+		constraint.text = strings.Join(text, " ")
+	case ctx.Foreign_key_options() != nil:
+		constraint.kind = foreignKeyConstraint
+		constraint.body = fmt.Sprintf("(%s) %s", column.id, getForeignKeyBody(ctx.Foreign_key_options()))
+		constraint.columns = []string{column.id}
+		constraint.text = fmt.Sprintf("FOREIGN KEY (%s) %s", quotedColumn, getText(ctx.Foreign_key_options()))
+	case ctx.Check_constraint() != nil:
+		setCheckConstraint(constraint, ctx.Check_constraint())
+	default:
+		return errors.Errorf("unsupported constraint %q on column %q", getText(ctx), column.name)
+	}
+	t.constraints = append(t.constraints, constraint)
+	return nil
+}
+
+func (t *tableInfo) addTableConstraint(ctx parser.ITable_constraintContext) error {
+	constraint := &constraintInfo{}
+	start := ctx.GetStart().GetTokenIndex()
+	if ctx.GetConstraint() != nil {
+		constraint.name = unquoteIdentifier(ctx.GetConstraint().GetText())
+		start = ctx.GetConstraint().GetStop().GetTokenIndex() + 1
+	}
+	constraint.text = strings.TrimSpace(ctx.GetParser().GetTokenStream().GetTextFromInterval(antlr.NewInterval(start, ctx.GetStop().GetTokenIndex())))
+	switch {
+	case ctx.PRIMARY() != nil || ctx.UNIQUE() != nil:
+		constraint.kind = uniqueConstraint
+		if ctx.PRIMARY() != nil {
+			constraint.kind = primaryKeyConstraint
+		}
+		constraint.clustered = getClustered(ctx.Clustered())
+		columns, keys := getColumnListWithOrder(ctx.Column_name_list_with_order())
+		constraint.body = fmt.Sprintf("(%s)", strings.Join(keys, ", "))
+		constraint.columns = columns
+	case ctx.FOREIGN() != nil:
+		constraint.kind = foreignKeyConstraint
+		constraint.columns = getColumnList(ctx.GetFk())
+		constraint.body = fmt.Sprintf("(%s) %s", strings.Join(constraint.columns, ", "), getForeignKeyBody(ctx.Foreign_key_options()))
+	case ctx.Check_constraint() != nil:
+		setCheckConstraint(constraint, ctx.Check_constraint())
+	case ctx.DEFAULT() != nil:
+		column, ok := t.columnMap[strings.ToLower(unquoteIdentifier(ctx.GetColumn().GetText()))]
+		if !ok {
+			return errors.Errorf("column %q of default constraint not found in table %q", ctx.GetColumn().GetText(), t.name)
+		}
+		column.defaultName = constraint.name
+		column.defaultText = getText(ctx.GetConstant_expr())
+		column.defaultExpression = normalizeExpression(column.defaultText)
+		return nil
+	default:
+		return errors.Errorf("unsupported constraint %q in table %q", getText(ctx), t.name)
+	}
+	t.constraints = append(t.constraints, constraint)
+	return nil
+}
+
+func setCheckConstraint(constraint *constraintInfo, ctx parser.ICheck_constraintContext) {
+	constraint.kind = checkConstraint
+	condition := getText(ctx.Search_condition())
+	constraint.tokens = normalizeTokens(condition)
+	constraint.body = strings.Join(constraint.tokens, " ")
+	if ctx.REPLICATION() != nil {
+		constraint.body += " not for replication"
+	}
+	constraint.text = getText(ctx)
+}
+
+func newTableIndex(table *tableInfo, ctx parser.ITable_indicesContext) *indexInfo {
+	name := unquoteIdentifier(ctx.Id_(0).GetText())
+	index := &indexInfo{
+		id:     strings.ToLower(name),
+		name:   name,
+		inline: true,
+	}
+	var text []string
+	switch {
+	case ctx.COLUMNSTORE() != nil && ctx.CLUSTERED() != nil:
+		index.clustered = true
+		index.definition = "index clustered columnstore"
+		text = []string{"CREATE CLUSTERED COLUMNSTORE INDEX", quoteIdentifier(name), "ON", table.quotedName()}
+	case ctx.COLUMNSTORE() != nil:
+		index.columns = getColumnList(ctx.Column_name_list())
+		index.definition = fmt.Sprintf("index nonclustered columnstore (%s)", strings.Join(index.columns, ", "))
+		text = []string{"CREATE NONCLUSTERED COLUMNSTORE INDEX", quoteIdentifier(name), "ON", table.quotedName(), fmt.Sprintf("(%s)", getText(ctx.Column_name_list()))}
+	default:
+		columns, keys := getColumnListWithOrder(ctx.Column_name_list_with_order())
+		index.columns = columns
+		prefix := getIndexPrefix(ctx.UNIQUE() != nil, ctx.Clustered())
+		index.definition = fmt.Sprintf("%s(%s)", prefix, strings.Join(keys, ", "))
+		index.clustered = isClustered(ctx.Clustered())
+		text = []string{"CREATE", strings.ToUpper(strings.TrimSpace(prefix)), quoteIdentifier(name), "ON", table.quotedName(), fmt.Sprintf("(%s)", getText(ctx.Column_name_list_with_order()))}
+	}
+	index.text = strings.Join(text, " ") + ";"
+	return index
+}
+
+// getIndexPrefix returns the normalized index prefix, such as "unique nonclustered index ".
+// The index is nonclustered by default.
+func getIndexPrefix(unique bool, clustered parser.IClusteredContext) string {
+	var buf strings.Builder
+	if unique {
+		_, _ = buf.WriteString("unique ")
+	}
+	if isClustered(clustered) {
+		_, _ = buf.WriteString("clustered ")
+	} else {
+		_, _ = buf.WriteString("nonclustered ")
+	}
+	_, _ = buf.WriteString("index ")
+	return buf.String()
+}
+
+func getClustered(ctx parser.IClusteredContext) *bool {
+	if ctx == nil {
+		return nil
+	}
+	clustered := ctx.CLUSTERED() != nil
+	return &clustered
+}
+
+func isClustered(ctx parser.IClusteredContext) bool {
+	return ctx != nil && ctx.CLUSTERED() != nil
+}
+
+func getForeignKeyBody(ctx parser.IForeign_key_optionsContext) string {
+	schema, table := getTableName(ctx.Table_name())
+	body := fmt.Sprintf("references %s (%s)", getTableID(schema, table), strings.Join(getColumnList(ctx.GetPk()), ", "))
+	for _, onDelete := range ctx.AllOn_delete() {
+		if action := strings.Join(normalizeTokens(getText(onDelete)), " "); action != "on delete no action" {
+			body += " " + action
+		}
+	}
+	for _, onUpdate := range ctx.AllOn_update() {
+		if action := strings.Join(normalizeTokens(getText(onUpdate)), " "); action != "on update no action" {
+			body += " " + action
+		}
+	}
+	if ctx.REPLICATION() != nil {
+		body += " not for replication"
+	}
+	return body
+}
+
+func getIdentity(seed, increment antlr.Token) string {
+	if seed == nil || increment == nil {
+		return "identity(1,1)"
+	}
+	return fmt.Sprintf("identity(%s,%s)", seed.GetText(), increment.GetText())
+}
+
+// getColumnListWithOrder returns the column ids and the keys with the sort order.
+func getColumnListWithOrder(ctx parser.IColumn_name_list_with_orderContext) ([]string, []string) {
+	var columns, keys []string
+	for _, child := range ctx.GetChildren() {
+		switch child := child.(type) {
+		case parser.IId_Context:
+			id := strings.ToLower(unquoteIdentifier(child.GetText()))
+			columns = append(columns, id)
+			keys = append(keys, id)
+		case antlr.TerminalNode:
+			if child.GetSymbol().GetTokenType() == parser.TSqlParserDESC {
+				keys[len(keys)-1] += " desc"
+			}
+		}
+	}
+	return columns, keys
+}
+
+func getColumnList(ctx parser.IColumn_name_listContext) []string {
+	var columns []string
+	for _, id := range ctx.AllId_() {
+		columns = append(columns, strings.ToLower(unquoteIdentifier(id.GetText())))
+	}
+	return columns
+}
+
+func getTableName(ctx parser.ITable_nameContext) (string, string) {
+	schema := defaultSchema
+	if ctx.GetSchema() != nil {
+		schema = unquoteIdentifier(ctx.GetSchema().GetText())
+	}
+	table := ""
+	if ctx.GetTable() != nil {
+		table = unquoteIdentifier(ctx.GetTable().GetText())
+	}
+	return schema, table
+}
+
+func getTableID(schema, table string) string {
+	return strings.ToLower(fmt.Sprintf("%s.%s", schema, table))
+}
+
+func getText(ctx antlr.ParserRuleContext) string {
+	if ctx == nil || ctx.GetStart() == nil || ctx.GetStop() == nil || ctx.GetStop().GetTokenIndex() < ctx.GetStart().GetTokenIndex() {
+		return ""
+	}
+	return ctx.GetStart().GetInputStream().GetTextFromInterval(antlr.NewInterval(ctx.GetStart().GetStart(), ctx.GetStop().GetStop()))
+}
+
+func getStatementText(ctx antlr.ParserRuleContext) string {
+	return strings.TrimRight(getText(ctx), "; \t\r\n") + ";"
+}
+
+func unquoteIdentifier(text string) string {
+	if len(text) >= 2 {
+		if text[0] == '[' && text[len(text)-1] == ']' {
+			return strings.ReplaceAll(text[1:len(text)-1], "]]", "]")
+		}
+		if text[0] == '"' && text[len(text)-1] == '"' {
+			return strings.ReplaceAll(text[1:len(text)-1], `""`, `"`)
+		}
+	}
+	return text
+}
+
+func quoteIdentifier(s string) string {
+	return fmt.Sprintf("[%s]", strings.ReplaceAll(s, "]", "]]"))
+}
+
+// normalizeDataType normalizes the data type, such as "NVARCHAR (50)" to "nvarchar(50)" and "DECIMAL" to "decimal(18,0)".
+func normalizeDataType(text string) string {
+	var buf strings.Builder
+	tokens := normalizeTokens(text)
+	for i, token := range tokens {
+		if i > 0 && isWord(token) && isWord(tokens[i-1]) {
+			_, _ = buf.WriteString(" ")
+		}
+		_, _ = buf.WriteString(token)
+	}
+	typ := buf.String()
+	if alias, ok := typeAliases[typ]; ok {
+		typ = alias
+	}
+	name, attributes, hasAttributes := strings.Cut(typ, "(")
+	if alias, ok := typeAliases[name]; ok {
+		name = alias
+	}
+	if !hasAttributes {
+		return name + typeDefaults[name]
+	}
+	if (name == "decimal" || name == "numeric") && !strings.Contains(attributes, ",") {
+		return fmt.Sprintf("%s(%s,0)", name, strings.TrimSuffix(attributes, ")"))
+	}
+	return fmt.Sprintf("%s(%s", name, attributes)
+}
+
+// normalizeExpression normalizes the expression so that the expressions generated by SQL Server, such as "((0))" and "([price]>(0))",
+// equal to the ones in the statement, such as "0" and "price > 0".
+func normalizeExpression(text string) string {
+	return strings.Join(normalizeTokens(text), " ")
+}
+
+// parenthesizedKeywords are the keywords which could be followed by the redundant parentheses.
+var parenthesizedKeywords = map[string]bool{
+	"and":     true,
+	"or":      true,
+	"not":     true,
+	"is":      true,
+	"like":    true,
+	"between": true,
+	"case":    true,
+	"when":    true,
+	"then":    true,
+	"else":    true,
+}
+
+// normalizeTokens returns the lower case tokens without the identifier quotes and the redundant parentheses.
+func normalizeTokens(text string) []string {
+	lexer := parser.NewTSqlLexer(antlr.NewInputStream(text))
+	lexer.RemoveErrorListeners()
+	var tokens []string
+	for _, token := range lexer.GetAllTokens() {
+		if token.GetChannel() != antlr.TokenDefaultChannel {
+			continue
+		}
+		switch token.GetTokenType() {
+		case parser.TSqlLexerSTRING:
+			tokens = append(tokens, token.GetText())
+		case parser.TSqlLexerSQUARE_BRACKET_ID, parser.TSqlLexerDOUBLE_QUOTE_ID:
+			tokens = append(tokens, strings.ToLower(unquoteIdentifier(token.GetText())))
+		default:
+			tokens = append(tokens, strings.ToLower(token.GetText()))
+		}
+	}
+
+	// Remove the parentheses around a single token, such as "(0)", unless it's the argument of a function call.
+	for changed := true; changed; {
+		changed = false
+		for i := 0; i+2 < len(tokens); i++ {
+			if tokens[i] != "(" || tokens[i+2] != ")" || tokens[i+1] == "(" || tokens[i+1] == ")" {
+				continue
+			}
+			if i > 0 && isWord(tokens[i-1]) && !parenthesizedKeywords[tokens[i-1]] {
+				continue
+			}
+			tokens = append(tokens[:i], append([]string{tokens[i+1]}, tokens[i+3:]...)...)
+			changed = true
+		}
+	}
+	// Remove the parentheses around the whole expression.
+	for len(tokens) >= 2 && tokens[0] == "(" && tokens[len(tokens)-1] == ")" && isEnclosed(tokens) {
+		tokens = tokens[1 : len(tokens)-1]
+	}
+	return tokens
+}
+
+// isEnclosed returns true if the first parenthesis matches the last one.
+func isEnclosed(tokens []string) bool {
+	depth := 0
+	for i, token := range tokens {
+		switch token {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		}
+		if depth == 0 && i != len(tokens)-1 {
+			return false
+		}
+	}
+	return depth == 0
+}
+
+func isWord(token string) bool {
+	for _, r := range token {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '@' || r == '#'
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package tsql
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+type differTestData struct {
+	OldSchema string `yaml:"oldSchema"`
+	NewSchema string `yaml:"newSchema"`
+	Diff      string `yaml:"diff"`
+}
+
+func TestTSQLDiffer(t *testing.T) {
+	const (
+		record = false
+	)
+	var tests []differTestData
+	filepath := filepath.Join("test-data", "test_differ_data.yaml")
+	yamlFile, err := os.Open(filepath)
+	require.NoError(t, err)
+	defer yamlFile.Close()
+
+	byteValue, err := io.ReadAll(yamlFile)
+	require.NoError(t, err)
+	err = yaml.Unmarshal(byteValue, &tests)
+	require.NoError(t, err)
+
+	for i, test := range tests {
+		diff, err := SchemaDiff(base.DiffContext{}, test.OldSchema, test.NewSchema)
+		require.NoError(t, err, test.NewSchema)
+		if record {
+			tests[i].Diff = diff
+		} else {
+			require.Equal(t, test.Diff, diff, test.NewSchema)
+		}
+	}
+
+	if record {
+		err := yamlFile.Close()
+		require.NoError(t, err)
+		byteValue, err = yaml.Marshal(tests)
+		require.NoError(t, err)
+		err = os.WriteFile(filepath, byteValue, 0644)
+		require.NoError(t, err)
+	}
+}
+
+func TestTSQLDifferUnsupported(t *testing.T) {
+	for _, schema := range []string{
+		"CREATE TRIGGER trg ON orders AFTER INSERT AS SELECT 1;\nGO",
+		"ALTER VIEW v AS SELECT 1;\nGO",
+		"CREATE VIEW v AS SELECT 1;\nGO\nCREATE VIEW dbo.v AS SELECT 2;\nGO",
+	} {
+		_, err := SchemaDiff(base.DiffContext{}, "", schema)
+		require.Error(t, err, schema)
+	}
+}
@@ -0,0 +1,46 @@
+package tsql
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/tsql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterGetFingerprintFunc(storepb.Engine_MSSQL, GetFingerprint)
+}
+
+var fingerprintTokenTypes = &base.FingerprintTokenTypes{
+	Literals: map[int]bool{
+		parser.TSqlLexerSTRING:  true,
+		parser.TSqlLexerDECIMAL: true,
+		parser.TSqlLexerFLOAT:   true,
+		parser.TSqlLexerREAL:    true,
+		parser.TSqlLexerBINARY:  true,
+		parser.TSqlLexerNULL_:   true,
+	},
+	CaseSensitive: map[int]bool{
+		parser.TSqlLexerDOUBLE_QUOTE_ID:   true,
+		parser.TSqlLexerSQUARE_BRACKET_ID: true,
+	},
+	OpenParen:  parser.TSqlLexerLR_BRACKET,
+	CloseParen: parser.TSqlLexerRR_BRACKET,
+	Comma:      parser.TSqlLexerCOMMA,
+	Semicolon:  parser.TSqlLexerSEMI,
+}
+
+// GetFingerprint gets the fingerprint of the T-SQL statement.
+func GetFingerprint(statement string) (string, error) {
+	lexer := parser.NewTSqlLexer(antlr.NewInputStream(statement))
+	lexerErrorListener := &base.ParseErrorListener{}
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(lexerErrorListener)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	stream.Fill()
+	if lexerErrorListener.Err != nil {
+		return "", lexerErrorListener.Err
+	}
+	return base.GetTokenFingerprint(stream.GetAllTokens(), fingerprintTokenTypes), nil
+}
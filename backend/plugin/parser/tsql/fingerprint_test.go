@@ -0,0 +1,48 @@
+package tsql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFingerprint(t *testing.T) {
+	tests := []struct {
+		stmt string
+		want string
+	}{
+		{
+			stmt: "SELECT * FROM t WHERE id = 1 AND name = 'abc'",
+			want: `select * from t where id = ? and name = ?`,
+		},
+		{
+			stmt: "-- comment\nselect a, b from t where id in (1, 2, 3);",
+			want: `select a, b from t where id in (?+)`,
+		},
+		{
+			stmt: "INSERT INTO t (a, b) VALUES (1, 'a'), (2, 'b')",
+			want: `insert into t (a, b) values (?+)`,
+		},
+		{
+			// The bracket quoted identifiers keep the case, and TOP counts and unicode strings are literals.
+			stmt: "SELECT TOP 10 [Name] FROM [dbo].[Users] WHERE id = 1 AND name = N'abc'",
+			want: "select top ? [Name] from [dbo].[Users] where id = ? and name = ?",
+		},
+		{
+			// The binary literals are placeholders, while the variables are kept.
+			stmt: "SELECT * FROM t WHERE b = 0x1F AND c = @p1",
+			want: "select * from t where b = ? and c = @p1",
+		},
+		{
+			// The comparison operators lexed as two tokens are merged.
+			stmt: "SELECT * FROM t WHERE a <= 1 AND b <> 2 AND c != 3 AND d >= 4",
+			want: "select * from t where a <= ? and b <> ? and c != ? and d >= ?",
+		},
+	}
+
+	for _, test := range tests {
+		got, err := GetFingerprint(test.stmt)
+		require.NoError(t, err)
+		require.Equal(t, test.want, got, test.stmt)
+	}
+}
@@ -0,0 +1,148 @@
+package tsql
+
+import (
+	"sort"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/tsql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterExtractChangedResourcesFunc(storepb.Engine_MSSQL, extractChangedResources)
+}
+
+func extractChangedResources(currentDatabase string, currentSchema string, statement string) ([]base.SchemaResource, error) {
+	tree, err := ParseTSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	if currentSchema == "" {
+		currentSchema = "dbo"
+	}
+
+	l := &tsqlChangedResourceExtractListener{
+		currentDatabase: currentDatabase,
+		currentSchema:   currentSchema,
+		resourceMap:     make(map[string]base.SchemaResource),
+	}
+
+	var result []base.SchemaResource
+	antlr.ParseTreeWalkerDefault.Walk(l, tree.Tree)
+	for _, resource := range l.resourceMap {
+		result = append(result, resource)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].String() < result[j].String()
+	})
+
+	return result, nil
+}
+
+type tsqlChangedResourceExtractListener struct {
+	*parser.BaseTSqlParserListener
+
+	currentDatabase string
+	currentSchema   string
+	resourceMap     map[string]base.SchemaResource
+}
+
+func (l *tsqlChangedResourceExtractListener) addTableName(ctx parser.ITable_nameContext) {
+	if ctx == nil {
+		return
+	}
+	database, schema, table := normalizeTableNameWithCase(ctx, l.currentDatabase, l.currentSchema)
+	l.add(database, schema, table)
+}
+
+func (l *tsqlChangedResourceExtractListener) addFullTableName(ctx parser.IFull_table_nameContext) {
+	if ctx == nil {
+		return
+	}
+	database, schema, table := normalizeFullTableNameWithCase(ctx, l.currentDatabase, l.currentSchema)
+	l.add(database, schema, table)
+}
+
+func (l *tsqlChangedResourceExtractListener) add(database, schema, table string) {
+	if table == "" {
+		return
+	}
+	resource := base.SchemaResource{
+		Database: database,
+		Schema:   schema,
+		Table:    table,
+	}
+	l.resourceMap[resource.String()] = resource
+}
+
+// EnterCreate_table is called when production create_table is entered.
+func (l *tsqlChangedResourceExtractListener) EnterCreate_table(ctx *parser.Create_tableContext) {
+	l.addTableName(ctx.Table_name())
+}
+
+// EnterAlter_table is called when production alter_table is entered.
+func (l *tsqlChangedResourceExtractListener) EnterAlter_table(ctx *parser.Alter_tableContext) {
+	// The second table name is the referenced table of the foreign key, which is not changed.
+	l.addTableName(ctx.Table_name(0))
+}
+
+// EnterDrop_table is called when production drop_table is entered.
+func (l *tsqlChangedResourceExtractListener) EnterDrop_table(ctx *parser.Drop_tableContext) {
+	for _, table := range ctx.AllTable_name() {
+		l.addTableName(table)
+	}
+}
+
+// EnterTruncate_table is called when production truncate_table is entered.
+func (l *tsqlChangedResourceExtractListener) EnterTruncate_table(ctx *parser.Truncate_tableContext) {
+	l.addTableName(ctx.Table_name())
+}
+
+// EnterCreate_index is called when production create_index is entered.
+func (l *tsqlChangedResourceExtractListener) EnterCreate_index(ctx *parser.Create_indexContext) {
+	l.addTableName(ctx.Table_name())
+}
+
+// EnterDrop_relational_or_xml_or_spatial_index is called when production drop_relational_or_xml_or_spatial_index is entered.
+func (l *tsqlChangedResourceExtractListener) EnterDrop_relational_or_xml_or_spatial_index(ctx *parser.Drop_relational_or_xml_or_spatial_indexContext) {
+	l.addFullTableName(ctx.Full_table_name())
+}
+
+// normalizeTableNameWithCase returns the database, schema and table name of the table name.
+// Unlike NormalizeTSQLTableName, the case of the identifiers is kept so that they can match the synced metadata.
+func normalizeTableNameWithCase(ctx parser.ITable_nameContext, fallbackDatabaseName, fallbackSchemaName string) (string, string, string) {
+	database, schema := fallbackDatabaseName, fallbackSchemaName
+	if d := unquoteTSQLIdentifier(ctx.GetDatabase()); d != "" {
+		database = d
+	}
+	if s := unquoteTSQLIdentifier(ctx.GetSchema()); s != "" {
+		schema = s
+	}
+	return database, schema, unquoteTSQLIdentifier(ctx.GetTable())
+}
+
+// normalizeFullTableNameWithCase is the same as normalizeTableNameWithCase but for the full table name.
+func normalizeFullTableNameWithCase(ctx parser.IFull_table_nameContext, fallbackDatabaseName, fallbackSchemaName string) (string, string, string) {
+	database, schema := fallbackDatabaseName, fallbackSchemaName
+	if d := unquoteTSQLIdentifier(ctx.GetDatabase()); d != "" {
+		database = d
+	}
+	if s := unquoteTSQLIdentifier(ctx.GetSchema()); s != "" {
+		schema = s
+	}
+	return database, schema, unquoteTSQLIdentifier(ctx.GetTable())
+}
+
+func unquoteTSQLIdentifier(part parser.IId_Context) string {
+	if part == nil {
+		return ""
+	}
+	text := part.GetText()
+	if len(text) >= 2 && ((text[0] == '[' && text[len(text)-1] == ']') || (text[0] == '"' && text[len(text)-1] == '"')) {
+		text = text[1 : len(text)-1]
+	}
+	return text
+}
@@ -0,0 +1,140 @@
+package approval
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	apiv1 "github.com/bytebase/bytebase/backend/api/v1"
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// resolveApprovalTemplateApprovers returns a copy of the approval template with the dynamic approvers
+// of the approval nodes resolved. getInput is only called if the template has any approver expression.
+func resolveApprovalTemplateApprovers(template *storepb.ApprovalTemplate, getInput func() (map[string]any, error)) (*storepb.ApprovalTemplate, error) {
+	template, ok := proto.Clone(template).(*storepb.ApprovalTemplate)
+	if !ok {
+		return nil, errors.New("failed to clone approval template")
+	}
+
+	var input map[string]any
+	for _, step := range template.GetFlow().GetSteps() {
+		for _, node := range step.Nodes {
+			expression, ok := node.Payload.(*storepb.ApprovalNode_ApproverExpression)
+			if !ok {
+				continue
+			}
+			if input == nil {
+				v, err := getInput()
+				if err != nil {
+					return nil, errors.Wrap(err, "failed to get approver expression input")
+				}
+				input = v
+			}
+			approvers, err := common.EvalApproverExpression(expression.ApproverExpression, input)
+			if err != nil {
+				return nil, err
+			}
+			if len(approvers) == 0 {
+				return nil, errors.Errorf("no approver is resolved by expression %q", expression.ApproverExpression)
+			}
+			if int(node.RequiredApprovals) > len(approvers) {
+				return nil, errors.Errorf("required approvals %v exceeds the %v approvers resolved by expression %q", node.RequiredApprovals, len(approvers), expression.ApproverExpression)
+			}
+			node.ResolvedApprovers = approvers
+		}
+	}
+	return template, nil
+}
+
+// getApproverInput returns the input of the approver expressions for the issue.
+func getApproverInput(ctx context.Context, s *store.Store, licenseService enterprise.LicenseService, dbFactory *dbfactory.DBFactory, issue *store.IssueMessage) (map[string]any, error) {
+	policy, err := s.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{ProjectID: &issue.Project.ResourceID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project policy")
+	}
+	projectOwners := []string{}
+	for _, binding := range policy.Bindings {
+		if binding.Role != api.ProjectOwner {
+			continue
+		}
+		for _, member := range binding.Members {
+			projectOwners = append(projectOwners, member.Email)
+		}
+	}
+
+	databases, err := getIssueDatabases(ctx, s, licenseService, dbFactory, issue)
+	if err != nil {
+		return nil, err
+	}
+	databaseLabels := []map[string]string{}
+	for _, database := range databases {
+		labels := map[string]string{}
+		for key, value := range database.Metadata.GetLabels() {
+			labels[key] = value
+		}
+		databaseLabels = append(databaseLabels, labels)
+	}
+
+	return map[string]any{
+		"issue_creator":   issue.Creator.Email,
+		"project_owners":  projectOwners,
+		"database_labels": databaseLabels,
+	}, nil
+}
+
+// getIssueDatabases returns the existing databases the issue targets.
+func getIssueDatabases(ctx context.Context, s *store.Store, licenseService enterprise.LicenseService, dbFactory *dbfactory.DBFactory, issue *store.IssueMessage) ([]*store.DatabaseMessage, error) {
+	switch issue.Type {
+	case api.IssueGrantRequest:
+		factors, err := common.GetQueryExportFactors(issue.Payload.GetGrantRequest().GetCondition().GetExpression())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get query export factors")
+		}
+		return getGrantRequestDatabases(ctx, s, issue, factors)
+	case api.IssueDatabaseGeneral:
+		if issue.PlanUID == nil {
+			return nil, errors.Errorf("expected plan UID in issue %v", issue.UID)
+		}
+		plan, err := s.GetPlan(ctx, &store.FindPlanMessage{UID: issue.PlanUID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get plan %v", *issue.PlanUID)
+		}
+		if plan == nil {
+			return nil, errors.Errorf("plan %v not found", *issue.PlanUID)
+		}
+		pipelineCreate, err := apiv1.GetPipelineCreate(ctx, s, licenseService, dbFactory, plan.Config.Steps, issue.Project)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get pipeline create")
+		}
+
+		var databases []*store.DatabaseMessage
+		seen := map[int]bool{}
+		for _, stage := range pipelineCreate.Stages {
+			for _, task := range stage.TaskList {
+				// The database to create doesn't have labels yet.
+				if task.DatabaseID == nil || seen[*task.DatabaseID] {
+					continue
+				}
+				seen[*task.DatabaseID] = true
+				database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to get database %v", *task.DatabaseID)
+				}
+				if database == nil {
+					continue
+				}
+				databases = append(databases, database)
+			}
+		}
+		return databases, nil
+	default:
+		return nil, errors.Errorf("unknown issue type %v", issue.Type)
+	}
+}
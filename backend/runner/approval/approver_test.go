@@ -0,0 +1,84 @@
+package approval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestResolveApprovalTemplateApprovers(t *testing.T) {
+	input := map[string]any{
+		"issue_creator":  "dev@example.com",
+		"project_owners": []string{"owner1@example.com", "owner2@example.com"},
+		"database_labels": []map[string]string{
+			{"owner": "dba@example.com"},
+			{"tenant": "bytebase"},
+			{"owner": "dba@example.com"},
+		},
+	}
+	tests := []struct {
+		expression        string
+		requiredApprovals int32
+		want              []string
+		wantErr           bool
+	}{
+		{
+			expression: "project_owners",
+			want:       []string{"owner1@example.com", "owner2@example.com"},
+		},
+		{
+			expression: `database_labels.filter(l, "owner" in l).map(l, l["owner"])`,
+			want:       []string{"dba@example.com"},
+		},
+		{
+			expression: `issue_creator == "dev@example.com" ? "lead@example.com" : project_owners[0]`,
+			want:       []string{"lead@example.com"},
+		},
+		{
+			expression:        "project_owners",
+			requiredApprovals: 3,
+			wantErr:           true,
+		},
+		{
+			expression: `database_labels.filter(l, "manager" in l).map(l, l["manager"])`,
+			wantErr:    true,
+		},
+		{
+			expression: "size(project_owners)",
+			wantErr:    true,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		template := &storepb.ApprovalTemplate{
+			Flow: &storepb.ApprovalFlow{
+				Steps: []*storepb.ApprovalStep{
+					{
+						Type: storepb.ApprovalStep_ANY,
+						Nodes: []*storepb.ApprovalNode{
+							{
+								Type:              storepb.ApprovalNode_ANY_IN_GROUP,
+								Payload:           &storepb.ApprovalNode_ApproverExpression{ApproverExpression: test.expression},
+								RequiredApprovals: test.requiredApprovals,
+							},
+						},
+					},
+				},
+			},
+		}
+		got, err := resolveApprovalTemplateApprovers(template, func() (map[string]any, error) {
+			return input, nil
+		})
+		if test.wantErr {
+			a.Error(err, test.expression)
+			continue
+		}
+		a.NoError(err, test.expression)
+		a.Equal(test.want, got.Flow.Steps[0].Nodes[0].ResolvedApprovers, test.expression)
+		// The original template is not changed.
+		a.Empty(template.Flow.Steps[0].Nodes[0].ResolvedApprovers)
+	}
+}
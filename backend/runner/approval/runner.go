@@ -158,10 +158,19 @@ func (r *Runner) findApprovalTemplateForIssue(ctx context.Context, issue *store.
 
 		approvalTemplate, err := getApprovalTemplate(approvalSetting, riskLevel, riskSource)
 		if err != nil {
-			err = errors.Wrapf(err, "failed to get approval template, riskLevel: %v", riskLevel)
+			return nil, true, errors.Wrapf(err, "failed to get approval template, riskLevel: %v", riskLevel)
+		}
+		if approvalTemplate == nil {
+			return nil, true, nil
 		}
 
-		return approvalTemplate, true, err
+		approvalTemplate, err = resolveApprovalTemplateApprovers(approvalTemplate, func() (map[string]any, error) {
+			return getApproverInput(ctx, r.store, r.licenseService, r.dbFactory, issue)
+		})
+		if err != nil {
+			return nil, true, errors.Wrap(err, "failed to resolve approvers")
+		}
+		return approvalTemplate, true, nil
 	}()
 	if err != nil {
 		if updateErr := updateIssueApprovalPayload(ctx, r.store, issue, &storepb.IssuePayloadApproval{
@@ -408,7 +417,7 @@ func getDatabaseGeneralIssueRisk(ctx context.Context, s *store.Store, licenseSer
 		if seenTaskType[api.TaskDatabaseCreate] {
 			return store.RiskSourceDatabaseCreate
 		}
-		if seenTaskType[api.TaskDatabaseSchemaUpdate] || seenTaskType[api.TaskDatabaseSchemaUpdateSDL] || seenTaskType[api.TaskDatabaseSchemaUpdateGhostSync] {
+		if seenTaskType[api.TaskDatabaseSchemaUpdate] || seenTaskType[api.TaskDatabaseSchemaUpdateSDL] || seenTaskType[api.TaskDatabaseSchemaUpdateGhostSync] || seenTaskType[api.TaskDatabaseSchemaUpdateOnlineSync] {
 			return store.RiskSourceDatabaseSchemaUpdate
 		}
 		if seenTaskType[api.TaskDatabaseDataUpdate] {
@@ -603,40 +612,9 @@ func getGrantRequestIssueRisk(ctx context.Context, s *store.Store, issue *store.
 		return 0, store.RiskSourceUnknown, false, errors.Wrap(err, "failed to get query export factors")
 	}
 	expirationDays := payload.GrantRequest.Expiration.AsDuration().Hours() / 24
-	databases := []*store.DatabaseMessage{}
-	if len(factors.DatabaseNames) == 0 {
-		list, err := s.ListDatabases(ctx, &store.FindDatabaseMessage{
-			ProjectID: &issue.Project.ResourceID,
-		})
-		if err != nil {
-			return 0, store.RiskSourceUnknown, false, errors.Wrap(err, "failed to list databases")
-		}
-		databases = list
-	} else {
-		for _, dbName := range factors.DatabaseNames {
-			instanceID, databaseName, err := common.GetInstanceDatabaseID(dbName)
-			if err != nil {
-				return 0, store.RiskSourceUnknown, false, errors.Wrap(err, "failed to get instance database id")
-			}
-
-			instance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
-			if err != nil {
-				return 0, store.RiskSourceUnknown, false, errors.Wrap(err, "failed to get instance")
-			}
-			database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
-				ProjectID:           &issue.Project.ResourceID,
-				InstanceID:          &instanceID,
-				DatabaseName:        &databaseName,
-				IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
-			})
-			if err != nil {
-				return 0, store.RiskSourceUnknown, false, errors.Wrap(err, "failed to get database")
-			}
-			if database == nil {
-				return 0, store.RiskSourceUnknown, false, errors.Errorf("database %q not found", databaseName)
-			}
-			databases = append(databases, database)
-		}
+	databases, err := getGrantRequestDatabases(ctx, s, issue, factors)
+	if err != nil {
+		return 0, store.RiskSourceUnknown, false, err
 	}
 
 	var maxRisk int32
@@ -719,6 +697,46 @@ func getGrantRequestIssueRisk(ctx context.Context, s *store.Store, issue *store.
 	return maxRisk, riskSource, true, nil
 }
 
+// getGrantRequestDatabases returns the databases of the grant request, which are all databases in the project if the request doesn't specify any.
+func getGrantRequestDatabases(ctx context.Context, s *store.Store, issue *store.IssueMessage, factors *common.QueryExportFactors) ([]*store.DatabaseMessage, error) {
+	if len(factors.DatabaseNames) == 0 {
+		databases, err := s.ListDatabases(ctx, &store.FindDatabaseMessage{
+			ProjectID: &issue.Project.ResourceID,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list databases")
+		}
+		return databases, nil
+	}
+
+	var databases []*store.DatabaseMessage
+	for _, dbName := range factors.DatabaseNames {
+		instanceID, databaseName, err := common.GetInstanceDatabaseID(dbName)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get instance database id")
+		}
+
+		instance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get instance")
+		}
+		database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+			ProjectID:           &issue.Project.ResourceID,
+			InstanceID:          &instanceID,
+			DatabaseName:        &databaseName,
+			IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get database")
+		}
+		if database == nil {
+			return nil, errors.Errorf("database %q not found", databaseName)
+		}
+		databases = append(databases, database)
+	}
+	return databases, nil
+}
+
 func updateIssueApprovalPayload(ctx context.Context, s *store.Store, issue *store.IssueMessage, approval *storepb.IssuePayloadApproval) error {
 	if _, err := s.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
 		PayloadUpsert: &storepb.IssuePayload{
@@ -0,0 +1,307 @@
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/runner/mail"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// SLARunner is the runner for the SLA of the pending approvals.
+// It reminds the pending approvers, escalates the pending steps and rejects the stale approvals.
+type SLARunner struct {
+	store           *store.Store
+	activityManager *activity.Manager
+	licenseService  enterprise.LicenseService
+}
+
+// NewSLARunner creates a new SLA runner.
+func NewSLARunner(store *store.Store, activityManager *activity.Manager, licenseService enterprise.LicenseService) *SLARunner {
+	return &SLARunner{
+		store:           store,
+		activityManager: activityManager,
+		licenseService:  licenseService,
+	}
+}
+
+const approvalSLARunnerInterval = 1 * time.Minute
+
+// Run runs the runner.
+func (r *SLARunner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(approvalSLARunnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Approval SLA runner started and will run every %v", approvalSLARunnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+			r.runOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *SLARunner) runOnce(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, ok := r.(error)
+			if !ok {
+				err = errors.Errorf("%v", r)
+			}
+			slog.Error("Approval SLA runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+		}
+	}()
+
+	if r.licenseService.IsFeatureEnabled(api.FeatureCustomApproval) != nil {
+		return
+	}
+	issues, err := r.store.ListIssueV2(ctx, &store.FindIssueMessage{
+		StatusList:         []api.IssueStatus{api.IssueOpen},
+		PendingApprovalSLA: true,
+	})
+	if err != nil {
+		slog.Error("approval SLA runner failed to list issues", log.BBError(err))
+		return
+	}
+	now := time.Now()
+	for _, issue := range issues {
+		if err := r.checkIssueSLA(ctx, issue, now); err != nil {
+			slog.Error("approval SLA runner failed to check issue", slog.Int("issue", issue.UID), log.BBError(err))
+		}
+	}
+}
+
+func (r *SLARunner) checkIssueSLA(ctx context.Context, issue *store.IssueMessage, now time.Time) error {
+	approval := issue.Payload.Approval
+	if approval == nil || !approval.ApprovalFindingDone || approval.ApprovalFindingError != "" || len(approval.ApprovalTemplates) != 1 {
+		return nil
+	}
+	template := approval.ApprovalTemplates[0]
+	if template.Sla == nil {
+		return nil
+	}
+	if utils.FindRejectedStep(template, approval.Approvers) != nil {
+		return nil
+	}
+	step := utils.FindNextPendingStep(template, approval.Approvers)
+	if step == nil {
+		return nil
+	}
+
+	state, action := getSLAAction(template.Sla, approval.SlaState, len(approval.Approvers), now)
+	if action == slaActionNone && proto.Equal(state, approval.SlaState) {
+		return nil
+	}
+	// Keep the loaded approval intact as the expected value of the compare-and-swap update.
+	expectedApproval := approval
+	approval, ok := proto.Clone(expectedApproval).(*storepb.IssuePayloadApproval)
+	if !ok {
+		return errors.Errorf("failed to clone issue approval payload")
+	}
+	approval.SlaState = state
+
+	var activityCreates []*store.ActivityMessage
+	var notifySteps []*storepb.ApprovalStep
+	switch action {
+	case slaActionRemind:
+		notifySteps = append(notifySteps, step)
+		if state.Escalated {
+			notifySteps = append(notifySteps, getEscalationStep(template.Sla))
+		}
+	case slaActionEscalate:
+		create, err := getApprovalCommentActivityCreate(issue, storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_STATUS_UNSPECIFIED,
+			fmt.Sprintf("Escalated the pending approval to %s because it has been pending for more than %v.", template.Sla.EscalationRole, template.Sla.EscalationTimeout.AsDuration()))
+		if err != nil {
+			return err
+		}
+		activityCreates = append(activityCreates, create)
+		notifySteps = append(notifySteps, getEscalationStep(template.Sla))
+	case slaActionReject:
+		approval.Approvers = append(approval.Approvers, &storepb.IssuePayloadApproval_Approver{
+			Status:      storepb.IssuePayloadApproval_Approver_REJECTED,
+			PrincipalId: api.SystemBotID,
+		})
+		create, err := getApprovalCommentActivityCreate(issue, storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_REJECTED,
+			fmt.Sprintf("Rejected automatically because the approval has been pending for more than %v.", template.Sla.RejectionTimeout.AsDuration()))
+		if err != nil {
+			return err
+		}
+		activityCreates = append(activityCreates, create)
+	}
+
+	// The approval may be changed by the approvers after it's loaded, e.g. ApproveIssue.
+	// Skip the issue on conflict and check it again in the next run.
+	if _, err := r.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
+		PayloadUpsert: &storepb.IssuePayload{
+			Approval: approval,
+		},
+		ExpectedApproval: expectedApproval,
+	}, api.SystemBotID); err != nil {
+		if common.ErrorCode(err) == common.Conflict {
+			slog.Debug("skip the approval SLA check because the issue approval has been modified", slog.Int("issue", issue.UID))
+			return nil
+		}
+		return errors.Wrap(err, "failed to update issue payload")
+	}
+
+	// It's ok to fail to create activities and send mails.
+	for _, create := range activityCreates {
+		if _, err := r.activityManager.CreateActivity(ctx, create, &activity.Metadata{}); err != nil {
+			slog.Error("failed to create approval SLA activity", slog.Int("issue", issue.UID), log.BBError(err))
+		}
+	}
+	for _, notifyStep := range notifySteps {
+		if err := r.notifyApprovalStep(ctx, issue, notifyStep); err != nil {
+			slog.Error("failed to notify approval step", slog.Int("issue", issue.UID), log.BBError(err))
+		}
+	}
+	return nil
+}
+
+type slaAction int
+
+const (
+	slaActionNone slaAction = iota
+	slaActionRemind
+	slaActionEscalate
+	slaActionReject
+)
+
+// getSLAAction returns the new SLA state of the pending step and the action to take.
+// The SLA timer restarts whenever the number of approvers changes, i.e. someone approves the step or the issue is re-requested after rejection.
+func getSLAAction(sla *storepb.ApprovalSLA, state *storepb.IssuePayloadApproval_SLAState, approverCount int, now time.Time) (*storepb.IssuePayloadApproval_SLAState, slaAction) {
+	if state == nil || int(state.ApproverCount) != approverCount {
+		return &storepb.IssuePayloadApproval_SLAState{
+			ApproverCount: int32(approverCount),
+			PendingSince:  timestamppb.New(now),
+		}, slaActionNone
+	}
+	state, ok := proto.Clone(state).(*storepb.IssuePayloadApproval_SLAState)
+	if !ok {
+		return nil, slaActionNone
+	}
+
+	pending := now.Sub(state.PendingSince.AsTime())
+	if timeout := sla.RejectionTimeout.AsDuration(); timeout > 0 && pending >= timeout {
+		return state, slaActionReject
+	}
+	if timeout := sla.EscalationTimeout.AsDuration(); timeout > 0 && pending >= timeout && !state.Escalated && sla.EscalationRole != "" {
+		state.Escalated = true
+		state.LastRemindTime = timestamppb.New(now)
+		return state, slaActionEscalate
+	}
+	if interval := sla.ReminderInterval.AsDuration(); interval > 0 {
+		lastRemindTime := state.PendingSince.AsTime()
+		if state.LastRemindTime != nil && state.LastRemindTime.AsTime().After(lastRemindTime) {
+			lastRemindTime = state.LastRemindTime.AsTime()
+		}
+		if now.Sub(lastRemindTime) >= interval {
+			state.LastRemindTime = timestamppb.New(now)
+			return state, slaActionRemind
+		}
+	}
+	return state, slaActionNone
+}
+
+// getEscalationStep returns the step approved by the escalation role.
+func getEscalationStep(sla *storepb.ApprovalSLA) *storepb.ApprovalStep {
+	return &storepb.ApprovalStep{
+		Type: storepb.ApprovalStep_ANY,
+		Nodes: []*storepb.ApprovalNode{
+			{
+				Type:    storepb.ApprovalNode_ANY_IN_GROUP,
+				Payload: &storepb.ApprovalNode_Role{Role: sla.EscalationRole},
+			},
+		},
+	}
+}
+
+func getApprovalCommentActivityCreate(issue *store.IssueMessage, status storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_Status, comment string) (*store.ActivityMessage, error) {
+	payload := &storepb.ActivityIssueCommentCreatePayload{
+		IssueName: issue.Title,
+	}
+	if status != storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_STATUS_UNSPECIFIED {
+		payload.Event = &storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_{
+			ApprovalEvent: &storepb.ActivityIssueCommentCreatePayload_ApprovalEvent{
+				Status: status,
+			},
+		}
+	}
+	activityPayload, err := protojson.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &store.ActivityMessage{
+		CreatorUID:   api.SystemBotID,
+		ContainerUID: issue.UID,
+		Type:         api.ActivityIssueCommentCreate,
+		Level:        api.ActivityInfo,
+		Comment:      comment,
+		Payload:      string(activityPayload),
+	}, nil
+}
+
+// notifyApprovalStep notifies the approvers of the step via webhooks and mails.
+func (r *SLARunner) notifyApprovalStep(ctx context.Context, issue *store.IssueMessage, step *storepb.ApprovalStep) error {
+	protoPayload, err := protojson.Marshal(&storepb.ActivityIssueApprovalNotifyPayload{
+		ApprovalStep: step,
+	})
+	if err != nil {
+		return err
+	}
+	activityPayload, err := json.Marshal(api.ActivityIssueApprovalNotifyPayload{
+		ProtoPayload: string(protoPayload),
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := r.activityManager.CreateActivity(ctx, &store.ActivityMessage{
+		CreatorUID:   api.SystemBotID,
+		ContainerUID: issue.UID,
+		Type:         api.ActivityIssueApprovalNotify,
+		Level:        api.ActivityInfo,
+		Comment:      "",
+		Payload:      string(activityPayload),
+	}, &activity.Metadata{Issue: issue}); err != nil {
+		return err
+	}
+
+	if len(step.Nodes) != 1 {
+		return errors.Errorf("expecting one node but got %v", len(step.Nodes))
+	}
+	users, err := activity.GetApprovalNodeUsers(ctx, r.store, step.Nodes[0], issue.Project.ResourceID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get approval node users")
+	}
+	var to []string
+	for _, user := range users {
+		to = append(to, user.Email)
+	}
+	setting, err := r.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get workspace general setting")
+	}
+	link := fmt.Sprintf("%s/issue/%s-%d", setting.ExternalUrl, slug.Make(issue.Title), issue.UID)
+	subject := fmt.Sprintf("Issue approval needed - %s", issue.Title)
+	body := fmt.Sprintf("The issue %q created by %s is waiting for your approval.\n\n%s", issue.Title, issue.Creator.Email, link)
+	return mail.SendMail(ctx, r.store, to, subject, body)
+}
@@ -0,0 +1,110 @@
+package approval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetSLAAction(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sla := &storepb.ApprovalSLA{
+		ReminderInterval:  durationpb.New(4 * time.Hour),
+		EscalationTimeout: durationpb.New(24 * time.Hour),
+		EscalationRole:    "roles/OWNER",
+		RejectionTimeout:  durationpb.New(72 * time.Hour),
+	}
+	newState := func(approverCount int32, lastRemind time.Duration, escalated bool) *storepb.IssuePayloadApproval_SLAState {
+		state := &storepb.IssuePayloadApproval_SLAState{
+			ApproverCount: approverCount,
+			PendingSince:  timestamppb.New(start),
+			Escalated:     escalated,
+		}
+		if lastRemind > 0 {
+			state.LastRemindTime = timestamppb.New(start.Add(lastRemind))
+		}
+		return state
+	}
+
+	tests := []struct {
+		description   string
+		state         *storepb.IssuePayloadApproval_SLAState
+		approverCount int
+		elapsed       time.Duration
+		wantState     *storepb.IssuePayloadApproval_SLAState
+		wantAction    slaAction
+	}{
+		{
+			description: "the timer starts on the first check",
+			elapsed:     0,
+			wantState:   newState(0, 0, false),
+			wantAction:  slaActionNone,
+		},
+		{
+			description: "no action before the reminder interval",
+			state:       newState(0, 0, false),
+			elapsed:     time.Hour,
+			wantState:   newState(0, 0, false),
+			wantAction:  slaActionNone,
+		},
+		{
+			description: "remind after the reminder interval",
+			state:       newState(0, 0, false),
+			elapsed:     5 * time.Hour,
+			wantState:   newState(0, 5*time.Hour, false),
+			wantAction:  slaActionRemind,
+		},
+		{
+			description: "no reminder within the interval after the last reminder",
+			state:       newState(0, 5*time.Hour, false),
+			elapsed:     8 * time.Hour,
+			wantState:   newState(0, 5*time.Hour, false),
+			wantAction:  slaActionNone,
+		},
+		{
+			description: "escalate after the escalation timeout",
+			state:       newState(0, 20*time.Hour, false),
+			elapsed:     25 * time.Hour,
+			wantState:   newState(0, 25*time.Hour, true),
+			wantAction:  slaActionEscalate,
+		},
+		{
+			description: "keep reminding after the escalation",
+			state:       newState(0, 25*time.Hour, true),
+			elapsed:     30 * time.Hour,
+			wantState:   newState(0, 30*time.Hour, true),
+			wantAction:  slaActionRemind,
+		},
+		{
+			description: "reject after the rejection timeout",
+			state:       newState(0, 70*time.Hour, true),
+			elapsed:     73 * time.Hour,
+			wantState:   newState(0, 70*time.Hour, true),
+			wantAction:  slaActionReject,
+		},
+		{
+			description: "the timer restarts when the step is approved",
+			state:       newState(0, 70*time.Hour, true),
+			elapsed:     73 * time.Hour,
+			// The new pending since is the time of the check.
+			approverCount: 1,
+			wantState: &storepb.IssuePayloadApproval_SLAState{
+				ApproverCount: 1,
+				PendingSince:  timestamppb.New(start.Add(73 * time.Hour)),
+			},
+			wantAction: slaActionNone,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		state, action := getSLAAction(sla, test.state, test.approverCount, start.Add(test.elapsed))
+		a.Equal(test.wantAction, action, test.description)
+		a.Equal(test.wantState.String(), state.String(), test.description)
+	}
+}
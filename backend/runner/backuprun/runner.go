@@ -297,7 +297,7 @@ func (r *Runner) downloadBinlogFilesForInstance(ctx context.Context, instance *s
 	}
 	defer driver.Close(ctx)
 
-	mysqlDriver, ok := driver.(*mysql.Driver)
+	mysqlDriver, ok := dbfactory.Unwrap(driver).(*mysql.Driver)
 	if !ok {
 		slog.Error("Failed to cast driver to mysql.Driver", slog.String("instance", instance.ResourceID))
 		return
@@ -343,8 +343,8 @@ func (r *Runner) startAutoBackups(ctx context.Context) {
 		if instance.Deleted {
 			continue
 		}
-		// backup for ClickHouse, Snowflake, MongoDB, Spanner, Redis, Oracle is not supported.
-		if instance.Engine == storepb.Engine_CLICKHOUSE || instance.Engine == storepb.Engine_SNOWFLAKE || instance.Engine == storepb.Engine_MONGODB || instance.Engine == storepb.Engine_SPANNER || instance.Engine == storepb.Engine_REDIS || instance.Engine == storepb.Engine_ORACLE || instance.Engine == storepb.Engine_OCEANBASE_ORACLE {
+		// backup for ClickHouse, Snowflake, Spanner, Redis, Oracle, CockroachDB is not supported.
+		if instance.Engine == storepb.Engine_CLICKHOUSE || instance.Engine == storepb.Engine_SNOWFLAKE || instance.Engine == storepb.Engine_SPANNER || instance.Engine == storepb.Engine_REDIS || instance.Engine == storepb.Engine_ORACLE || instance.Engine == storepb.Engine_OCEANBASE_ORACLE || instance.Engine == storepb.Engine_COCKROACHDB {
 			continue
 		}
 		environment, err := r.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &database.EffectiveEnvironmentID})
@@ -0,0 +1,45 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/mail"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// SendMail sends the mail to the recipients with the workspace mail delivery setting.
+// It does nothing if the mail delivery is not configured.
+func SendMail(ctx context.Context, s *store.Store, to []string, subject string, body string) error {
+	if len(to) == 0 {
+		return nil
+	}
+	name := api.SettingWorkspaceMailDelivery
+	mailSetting, err := s.GetSettingV2(ctx, &store.FindSettingMessage{Name: &name})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get mail setting")
+	}
+	if mailSetting == nil {
+		return nil
+	}
+	var storeValue storepb.SMTPMailDeliverySetting
+	if err := protojson.Unmarshal([]byte(mailSetting.Value), &storeValue); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal mail setting value")
+	}
+
+	email := mail.NewEmailMsg()
+	email.SetFrom(fmt.Sprintf("Bytebase <%s>", storeValue.From)).
+		AddTo(to...).
+		SetSubject(subject).
+		SetBody(body)
+	client := mail.NewSMTPClient(storeValue.Server, int(storeValue.Port))
+	client.SetAuthType(convertToMailSMTPAuthType(storeValue.Authentication)).
+		SetAuthCredentials(storeValue.Username, storeValue.Password).
+		SetEncryptionType(convertToMailSMTPEncryptionType(storeValue.Encryption))
+	return client.SendMail(email)
+}
@@ -417,6 +417,12 @@ func engineTypeString(engine storepb.Engine) string {
 		return "MySQL"
 	case storepb.Engine_POSTGRES:
 		return "Postgres"
+	case storepb.Engine_MSSQL:
+		return "SQL Server"
+	case storepb.Engine_ORACLE:
+		return "Oracle"
+	case storepb.Engine_SNOWFLAKE:
+		return "Snowflake"
 	}
 	return ""
 }
@@ -699,6 +705,12 @@ func engineOrder(engine storepb.Engine) int {
 		return 1
 	case storepb.Engine_POSTGRES:
 		return 2
+	case storepb.Engine_MSSQL:
+		return 3
+	case storepb.Engine_ORACLE:
+		return 4
+	case storepb.Engine_SNOWFLAKE:
+		return 5
 	default:
 		return 100
 	}
@@ -42,7 +42,7 @@ func isStatementTypeCheckSupported(dbType storepb.Engine) bool {
 
 func isStatementAdviseSupported(dbType storepb.Engine) bool {
 	switch dbType {
-	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_POSTGRES, storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE, storepb.Engine_OCEANBASE, storepb.Engine_SNOWFLAKE, storepb.Engine_MSSQL:
+	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_POSTGRES, storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE, storepb.Engine_OCEANBASE, storepb.Engine_SNOWFLAKE, storepb.Engine_MSSQL, storepb.Engine_REDIS, storepb.Engine_COCKROACHDB:
 		return true
 	default:
 		return false
@@ -51,9 +51,17 @@ func isStatementAdviseSupported(dbType storepb.Engine) bool {
 
 func isStatementReportSupported(dbType storepb.Engine) bool {
 	switch dbType {
-	case storepb.Engine_POSTGRES, storepb.Engine_MYSQL, storepb.Engine_OCEANBASE, storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE:
+	case storepb.Engine_POSTGRES, storepb.Engine_MYSQL, storepb.Engine_OCEANBASE, storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE, storepb.Engine_TIDB, storepb.Engine_MSSQL, storepb.Engine_SNOWFLAKE, storepb.Engine_CLICKHOUSE:
 		return true
 	default:
 		return false
 	}
 }
+
+func isStatementLockImpactSupported(dbType storepb.Engine) bool {
+	return dbType == storepb.Engine_POSTGRES
+}
+
+func isStatementDMLDryRunSupported(dbType storepb.Engine) bool {
+	return dbType == storepb.Engine_MYSQL || dbType == storepb.Engine_POSTGRES
+}
@@ -2,31 +2,38 @@ package plancheck
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 
 	"github.com/github/gh-ost/go/logic"
 	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/ghost"
+	"github.com/bytebase/bytebase/backend/component/pgonline"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
 // NewGhostSyncExecutor creates a gh-ost sync check executor.
-func NewGhostSyncExecutor(store *store.Store, secret string) Executor {
+func NewGhostSyncExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, secret string) Executor {
 	return &GhostSyncExecutor{
-		store:  store,
-		secret: secret,
+		store:     store,
+		dbFactory: dbFactory,
+		secret:    secret,
 	}
 }
 
 // GhostSyncExecutor is the gh-ost sync check executor.
+// For PostgreSQL, it checks the online schema migration instead.
 type GhostSyncExecutor struct {
-	store  *store.Store
-	secret string
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+	secret    string
 }
 
 // Run runs the gh-ost sync check executor.
@@ -97,6 +104,10 @@ func (e *GhostSyncExecutor) Run(ctx context.Context, config *storepb.PlanCheckRu
 	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
 	renderedStatement := utils.RenderStatement(statement, materials)
 
+	if instance.Engine == storepb.Engine_POSTGRES {
+		return e.runOnlineMigrationDryRun(ctx, instance, database, statement, renderedStatement, config.GhostFlags)
+	}
+
 	tableName, err := ghost.GetTableNameFromStatement(renderedStatement)
 	if err != nil {
 		return nil, common.Wrapf(err, common.Internal, "failed to parse table name from statement, statement: %v", statement)
@@ -131,3 +142,47 @@ func (e *GhostSyncExecutor) Run(ctx context.Context, config *storepb.PlanCheckRu
 		},
 	}, nil
 }
+
+// runOnlineMigrationDryRun checks the prerequisites of the PostgreSQL online schema migration,
+// and applies the statement on the shadow table in a transaction which is rolled back.
+func (e *GhostSyncExecutor) runOnlineMigrationDryRun(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, statement, renderedStatement string, flags map[string]string) ([]*storepb.PlanCheckRunResult_Result, error) {
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+
+	migration, err := pgonline.NewMigration(driver.GetDB(), rand.Intn(10000000), renderedStatement, flags)
+	if err != nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_ERROR,
+				Title:   "Online schema migration is not applicable",
+				Content: fmt.Sprintf("%v, statement: %s", err, statement),
+				Code:    common.Internal.Int32(),
+				Report:  nil,
+			},
+		}, nil
+	}
+	if err := migration.Check(ctx); err != nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_ERROR,
+				Title:   "Online schema migration dry run failed",
+				Content: err.Error(),
+				Code:    common.Internal.Int32(),
+				Report:  nil,
+			},
+		}, nil
+	}
+
+	return []*storepb.PlanCheckRunResult_Result{
+		{
+			Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+			Title:   "OK",
+			Content: "Online schema migration dry run succeeded",
+			Code:    common.Ok.Int32(),
+			Report:  nil,
+		},
+	}, nil
+}
@@ -48,7 +48,7 @@ func (e *PITRMySQLExecutor) Run(ctx context.Context, config *storepb.PlanCheckRu
 		return nil, err
 	}
 	defer driver.Close(ctx)
-	mysqlDriver, ok := driver.(*mysql.Driver)
+	mysqlDriver, ok := dbfactory.Unwrap(driver).(*mysql.Driver)
 	if !ok {
 		return nil, errors.Errorf("Failed to cast driver to mysql.Driver")
 	}
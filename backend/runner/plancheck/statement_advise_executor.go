@@ -162,12 +162,13 @@ func (e *StatementAdviseExecutor) runForDatabaseTarget(ctx context.Context, conf
 	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
 	renderedStatement := utils.RenderStatement(statement, materials)
 	adviceList, err := advisor.SQLReviewCheck(renderedStatement, policy.RuleList, advisor.SQLReviewCheckContext{
-		Charset:   dbSchema.GetMetadata().CharacterSet,
-		Collation: dbSchema.GetMetadata().Collation,
-		DbType:    instance.Engine,
-		Catalog:   catalog,
-		Driver:    connection,
-		Context:   ctx,
+		Charset:        dbSchema.GetMetadata().CharacterSet,
+		Collation:      dbSchema.GetMetadata().Collation,
+		DbType:         instance.Engine,
+		Catalog:        catalog,
+		Driver:         connection,
+		Context:        ctx,
+		DatabaseLabels: database.Metadata.GetLabels(),
 	})
 	if err != nil {
 		return nil, err
@@ -374,12 +375,13 @@ func (e *StatementAdviseExecutor) runForDatabaseGroupTarget(ctx context.Context,
 				// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
 				renderedStatement := utils.RenderStatement(statement, materials)
 				adviceList, err := advisor.SQLReviewCheck(renderedStatement, policy.RuleList, advisor.SQLReviewCheckContext{
-					Charset:   dbSchema.GetMetadata().CharacterSet,
-					Collation: dbSchema.GetMetadata().Collation,
-					DbType:    instance.Engine,
-					Catalog:   catalog,
-					Driver:    connection,
-					Context:   ctx,
+					Charset:        dbSchema.GetMetadata().CharacterSet,
+					Collation:      dbSchema.GetMetadata().Collation,
+					DbType:         instance.Engine,
+					Catalog:        catalog,
+					Driver:         connection,
+					Context:        ctx,
+					DatabaseLabels: database.Metadata.GetLabels(),
 				})
 				if err != nil {
 					return nil, err
@@ -0,0 +1,411 @@
+package plancheck
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/antlr4-go/antlr/v4"
+	mysql "github.com/bytebase/mysql-parser"
+	pgquery "github.com/pganalyze/pg_query_go/v4"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// defaultDMLDryRunStatementTimeout is the statement timeout if the DML dry run policy doesn't set it.
+const defaultDMLDryRunStatementTimeout = 10 * time.Second
+
+// NewStatementDMLDryRunExecutor creates a plan check statement DML dry run executor.
+func NewStatementDMLDryRunExecutor(store *store.Store, dbFactory *dbfactory.DBFactory) Executor {
+	return &StatementDMLDryRunExecutor{
+		store:     store,
+		dbFactory: dbFactory,
+	}
+}
+
+// StatementDMLDryRunExecutor is the plan check statement DML dry run executor.
+// It executes the DML statements against the database in a transaction that is always rolled back,
+// and reports the exact affected rows and the error of each statement.
+// Only the statement types are checked, so the side effects of the functions called by the statements
+// are not rolled back, e.g. nextval() and AUTO_INCREMENT consume the values and pg_terminate_backend() terminates the sessions.
+type StatementDMLDryRunExecutor struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+}
+
+// dmlDryRunStatement is a single DML statement to dry run.
+type dmlDryRunStatement struct {
+	text string
+	// line is the 1-based line of the statement.
+	line int
+}
+
+// Run will run the plan check statement DML dry run executor once.
+func (e *StatementDMLDryRunExecutor) Run(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
+	instanceUID := int(config.InstanceUid)
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &instanceUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance UID %v", instanceUID)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance not found UID %v", instanceUID)
+	}
+	if !isStatementDMLDryRunSupported(instance.Engine) {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   fmt.Sprintf("DML dry run is not supported for %s", instance.Engine),
+				Content: "",
+			},
+		}, nil
+	}
+
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", config.DatabaseName)
+	}
+	dbSchema, err := e.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, err
+	}
+	if dbSchema == nil {
+		return nil, errors.Errorf("database schema not found: %d", database.UID)
+	}
+
+	environment, err := e.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &database.EffectiveEnvironmentID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get environment %q", database.EffectiveEnvironmentID)
+	}
+	if environment == nil {
+		return nil, errors.Errorf("environment %q not found", database.EffectiveEnvironmentID)
+	}
+	// The policy may be disabled after the plan check run is created.
+	policy, err := e.store.GetDMLDryRunPolicy(ctx, environment.UID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get DML dry run policy for environment %q", environment.ResourceID)
+	}
+	if policy == nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   fmt.Sprintf("DML dry run is not enabled for environment %q", environment.Title),
+				Content: "",
+			},
+		}, nil
+	}
+
+	sheetUID := int(config.SheetUid)
+	sheet, err := e.store.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID}, api.SystemBotID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+	}
+	if sheet == nil {
+		return nil, errors.Errorf("sheet %d not found", sheetUID)
+	}
+	if sheet.Size > common.MaxSheetCheckSize {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.SizeExceeded.Int32(),
+				Title:   "Large SQL DML dry run is disabled",
+				Content: "",
+			},
+		}, nil
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet statement %d", sheetUID)
+	}
+	renderedStatement := utils.RenderStatement(statement, utils.GetSecretMapFromDatabaseMessage(database))
+
+	var statements []*dmlDryRunStatement
+	var results []*storepb.PlanCheckRunResult_Result
+	switch instance.Engine {
+	case storepb.Engine_POSTGRES:
+		statements, results = getPostgresDMLDryRunStatements(renderedStatement)
+	case storepb.Engine_MYSQL:
+		statements, results = getMySQLDMLDryRunStatements(renderedStatement, database.DatabaseName, dbSchema.GetDatabaseMetadata())
+	}
+	// The statements cannot be dry run safely.
+	if len(results) > 0 {
+		return results, nil
+	}
+	if len(statements) == 0 {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Title:   "OK",
+				Content: "",
+				Code:    common.Ok.Int32(),
+			},
+		}, nil
+	}
+
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+
+	return runDMLDryRun(ctx, driver.GetDB(), instance.Engine, statements, getDMLDryRunStatementTimeout(policy))
+}
+
+func getDMLDryRunStatementTimeout(policy *storepb.DMLDryRunPolicy) time.Duration {
+	if timeout := policy.GetStatementTimeout().AsDuration(); timeout > 0 {
+		return timeout
+	}
+	return defaultDMLDryRunStatementTimeout
+}
+
+// runDMLDryRun executes the statements in a transaction and always rolls it back.
+// It stops at the first failed statement because the following statements may depend on it,
+// and PostgreSQL refuses to run any statement in an aborted transaction.
+func runDMLDryRun(ctx context.Context, sqlDB *sql.DB, engine storepb.Engine, statements []*dmlDryRunStatement, timeout time.Duration) ([]*storepb.PlanCheckRunResult_Result, error) {
+	// Use a dedicated connection so that the session settings only apply to the dry run.
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get connection")
+	}
+	defer conn.Close()
+	if engine == storepb.Engine_MYSQL {
+		// The session variables are not rolled back with the transaction, so the connection is never returned to the pool.
+		defer util.DiscardConn(conn)
+	}
+	// MySQL keeps running the canceled statement and holding its row locks after the driver closes the connection,
+	// so the timed out statement is killed from another connection.
+	var killQuery func()
+	if engine == storepb.Engine_MYSQL {
+		sessionID, err := util.GetSessionID(ctx, engine, conn)
+		if err != nil {
+			return nil, err
+		}
+		killQuery = func() {
+			killCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+			defer cancel()
+			if err := util.CancelSessionQuery(killCtx, engine, sqlDB, sessionID); err != nil {
+				slog.Warn("failed to kill the timed out DML dry run statement", slog.String("sessionID", sessionID), log.BBError(err))
+			}
+		}
+	}
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin transaction")
+	}
+	// The changes of the dry run must never be committed.
+	defer tx.Rollback()
+
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL statement_timeout = %d", timeout.Milliseconds())); err != nil {
+			return nil, errors.Wrapf(err, "failed to set statement timeout")
+		}
+	case storepb.Engine_MYSQL:
+		// MySQL has no statement timeout for DML, so we bound the lock waits and kill the statement on timeout.
+		lockWaitTimeout := max(int64(timeout.Seconds()), 1)
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET SESSION innodb_lock_wait_timeout = %d", lockWaitTimeout)); err != nil {
+			return nil, errors.Wrapf(err, "failed to set lock wait timeout")
+		}
+	}
+
+	var results []*storepb.PlanCheckRunResult_Result
+	failed := false
+	for _, statement := range statements {
+		affectedRows, err := execDMLDryRunStatement(ctx, tx, statement.text, timeout, killQuery)
+		if err != nil {
+			failed = true
+			results = append(results, &storepb.PlanCheckRunResult_Result{
+				Status:  storepb.PlanCheckRunResult_Result_ERROR,
+				Code:    common.DMLDryRunStatementFailed.Int32(),
+				Title:   fmt.Sprintf("Failed to execute the statement at line %d", statement.line),
+				Content: err.Error(),
+				Report: &storepb.PlanCheckRunResult_Result_DmlDryRunReport{
+					DmlDryRunReport: &storepb.PlanCheckRunResult_Result_DMLDryRunReport{
+						Line: int32(statement.line),
+					},
+				},
+			})
+			break
+		}
+		results = append(results, &storepb.PlanCheckRunResult_Result{
+			Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+			Code:    common.Ok.Int32(),
+			Title:   fmt.Sprintf("The statement at line %d affects %d rows", statement.line, affectedRows),
+			Content: "",
+			Report: &storepb.PlanCheckRunResult_Result_DmlDryRunReport{
+				DmlDryRunReport: &storepb.PlanCheckRunResult_Result_DMLDryRunReport{
+					Line:         int32(statement.line),
+					AffectedRows: affectedRows,
+				},
+			},
+		})
+	}
+
+	if err := tx.Rollback(); err != nil {
+		// The connection is closed if the statement is canceled, and the database rolls back the transaction of a closed connection.
+		if !failed {
+			return nil, errors.Wrapf(err, "failed to roll back the dry run")
+		}
+		slog.Warn("failed to roll back the DML dry run", log.BBError(err))
+	}
+	return results, nil
+}
+
+// execDMLDryRunStatement executes the statement with the timeout, and calls killQuery if it's not nil and the statement times out.
+func execDMLDryRunStatement(ctx context.Context, tx *sql.Tx, statement string, timeout time.Duration, killQuery func()) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	sqlResult, err := tx.ExecContext(ctx, statement)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			if killQuery != nil {
+				killQuery()
+			}
+			return 0, errors.Errorf("the statement exceeds the timeout %v", timeout)
+		}
+		return 0, err
+	}
+	return sqlResult.RowsAffected()
+}
+
+func getPostgresDMLDryRunStatements(statement string) ([]*dmlDryRunStatement, []*storepb.PlanCheckRunResult_Result) {
+	res, err := pgquery.Parse(statement)
+	if err != nil {
+		return nil, []*storepb.PlanCheckRunResult_Result{newDMLDryRunSyntaxErrorResult(err)}
+	}
+
+	var statements []*dmlDryRunStatement
+	for _, stmt := range res.Stmts {
+		line := getStatementLine(statement, stmt)
+		switch stmt.Stmt.Node.(type) {
+		case *pgquery.Node_InsertStmt, *pgquery.Node_UpdateStmt, *pgquery.Node_DeleteStmt:
+		default:
+			return nil, []*storepb.PlanCheckRunResult_Result{newDMLDryRunUnsupportedResult(line, "The statement is not an INSERT, UPDATE or DELETE statement.")}
+		}
+		start := int(stmt.StmtLocation)
+		end := len(statement)
+		if stmt.StmtLen > 0 {
+			end = start + int(stmt.StmtLen)
+		}
+		statements = append(statements, &dmlDryRunStatement{
+			text: strings.TrimSpace(statement[start:end]),
+			line: line,
+		})
+	}
+	return statements, nil
+}
+
+func getMySQLDMLDryRunStatements(statement string, databaseName string, metadata *model.DatabaseMetadata) ([]*dmlDryRunStatement, []*storepb.PlanCheckRunResult_Result) {
+	singleSQLs, err := base.SplitMultiSQL(storepb.Engine_MYSQL, statement)
+	if err != nil {
+		return nil, []*storepb.PlanCheckRunResult_Result{newDMLDryRunSyntaxErrorResult(err)}
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+
+	var statements []*dmlDryRunStatement
+	for _, singleSQL := range singleSQLs {
+		line := singleSQL.FirstStatementLine + 1
+		stmts, err := mysqlparser.ParseMySQL(singleSQL.Text)
+		if err != nil {
+			return nil, []*storepb.PlanCheckRunResult_Result{newDMLDryRunSyntaxErrorResult(err)}
+		}
+		if len(stmts) != 1 {
+			return nil, []*storepb.PlanCheckRunResult_Result{newDMLDryRunUnsupportedResult(line, "Failed to parse the statement.")}
+		}
+		if !isDML(mysqlparser.GetStatementType(stmts[0])) {
+			// DDL statements commit the transaction implicitly in MySQL.
+			return nil, []*storepb.PlanCheckRunResult_Result{newDMLDryRunUnsupportedResult(line, "The statement is not an INSERT, UPDATE, DELETE or REPLACE statement.")}
+		}
+		if reason := getMySQLNonTransactionalTable(stmts[0], databaseName, metadata); reason != "" {
+			return nil, []*storepb.PlanCheckRunResult_Result{newDMLDryRunUnsupportedResult(line, reason)}
+		}
+		statements = append(statements, &dmlDryRunStatement{
+			text: singleSQL.Text,
+			line: line,
+		})
+	}
+	return statements, nil
+}
+
+// getMySQLNonTransactionalTable returns the reason if the statement may change a table that cannot be rolled back.
+func getMySQLNonTransactionalTable(stmt *mysqlparser.ParseResult, databaseName string, metadata *model.DatabaseMetadata) string {
+	l := &mysqlTableRefListener{}
+	antlr.ParseTreeWalkerDefault.Walk(l, stmt.Tree)
+	for _, table := range l.tables {
+		if table.Database != "" && table.Database != databaseName {
+			return fmt.Sprintf("The table %s.%s is not in database %q, so it cannot be checked whether the table is transactional.", table.Database, table.Table, databaseName)
+		}
+		if metadata == nil || metadata.GetSchema("") == nil {
+			continue
+		}
+		// The unknown table is either a CTE or a table that doesn't exist, and the statement fails in the latter case.
+		tableMetadata := metadata.GetSchema("").GetTable(table.Table)
+		if tableMetadata == nil {
+			continue
+		}
+		if engine := tableMetadata.GetProto().GetEngine(); engine != "" && !strings.EqualFold(engine, "InnoDB") {
+			return fmt.Sprintf("The table %s uses the %s engine, which cannot be rolled back.", table.Table, engine)
+		}
+	}
+	return ""
+}
+
+type mysqlTableRefListener struct {
+	*mysql.BaseMySQLParserListener
+
+	tables []base.SchemaResource
+}
+
+// EnterTableRef is called when production tableRef is entered.
+func (l *mysqlTableRefListener) EnterTableRef(ctx *mysql.TableRefContext) {
+	database, table := mysqlparser.NormalizeMySQLTableRef(ctx)
+	l.tables = append(l.tables, base.SchemaResource{Database: database, Table: table})
+}
+
+// EnterTableRefWithWildcard is called when production tableRefWithWildcard is entered.
+func (l *mysqlTableRefListener) EnterTableRefWithWildcard(ctx *mysql.TableRefWithWildcardContext) {
+	database, table := mysqlparser.NormalizeMySQLTableRefWithWildcard(ctx)
+	l.tables = append(l.tables, base.SchemaResource{Database: database, Table: table})
+}
+
+func newDMLDryRunSyntaxErrorResult(err error) *storepb.PlanCheckRunResult_Result {
+	return &storepb.PlanCheckRunResult_Result{
+		Status:  storepb.PlanCheckRunResult_Result_ERROR,
+		Code:    common.DMLDryRunUnsupportedStatement.Int32(),
+		Title:   "Syntax error",
+		Content: err.Error(),
+	}
+}
+
+func newDMLDryRunUnsupportedResult(line int, content string) *storepb.PlanCheckRunResult_Result {
+	return &storepb.PlanCheckRunResult_Result{
+		Status:  storepb.PlanCheckRunResult_Result_WARNING,
+		Code:    common.DMLDryRunUnsupportedStatement.Int32(),
+		Title:   fmt.Sprintf("DML dry run is skipped because of the statement at line %d", line),
+		Content: content,
+		Report: &storepb.PlanCheckRunResult_Result_DmlDryRunReport{
+			DmlDryRunReport: &storepb.PlanCheckRunResult_Result_DMLDryRunReport{
+				Line: int32(line),
+			},
+		},
+	}
+}
@@ -0,0 +1,278 @@
+package plancheck
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetPostgresDMLDryRunStatements(t *testing.T) {
+	tests := []struct {
+		statement      string
+		wantStatements []*dmlDryRunStatement
+		wantLine       int32
+	}{
+		{
+			statement: "INSERT INTO t VALUES (1);\n\nUPDATE t SET a = 2 WHERE a = 1;\nDELETE FROM t",
+			wantStatements: []*dmlDryRunStatement{
+				{text: "INSERT INTO t VALUES (1)", line: 1},
+				{text: "UPDATE t SET a = 2 WHERE a = 1", line: 3},
+				{text: "DELETE FROM t", line: 4},
+			},
+		},
+		{
+			statement: "UPDATE t SET a = 2;\nALTER TABLE t ADD COLUMN b int;",
+			wantLine:  2,
+		},
+		{
+			statement: "UPDATE t SET a = 2;\nCOMMIT;",
+			wantLine:  2,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		statements, results := getPostgresDMLDryRunStatements(test.statement)
+		if test.wantLine == 0 {
+			a.Empty(results, test.statement)
+			a.Equal(test.wantStatements, statements, test.statement)
+			continue
+		}
+		a.Empty(statements, test.statement)
+		a.Len(results, 1, test.statement)
+		a.Equal(common.DMLDryRunUnsupportedStatement.Int32(), results[0].Code, test.statement)
+		a.Equal(test.wantLine, results[0].GetDmlDryRunReport().Line, test.statement)
+	}
+}
+
+func TestGetMySQLDMLDryRunStatements(t *testing.T) {
+	metadata := model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Tables: []*storepb.TableMetadata{
+					{Name: "orders", Engine: "InnoDB"},
+					{Name: "logs", Engine: "MyISAM"},
+				},
+			},
+		},
+	})
+	tests := []struct {
+		statement string
+		wantCount int
+		wantLine  int32
+	}{
+		{
+			statement: "INSERT INTO orders VALUES (1);\nDELETE FROM orders WHERE id IN (SELECT id FROM orders);",
+			wantCount: 2,
+		},
+		{
+			statement: "UPDATE orders SET a = 1;\nINSERT INTO logs SELECT * FROM orders;",
+			wantLine:  2,
+		},
+		{
+			statement: "UPDATE orders SET a = 1;\nDELETE FROM other_db.orders;",
+			wantLine:  2,
+		},
+		{
+			statement: "UPDATE orders SET a = 1;\nCREATE TABLE t (id int);",
+			wantLine:  2,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		statements, results := getMySQLDMLDryRunStatements(test.statement, "db", metadata)
+		if test.wantLine == 0 {
+			a.Empty(results, test.statement)
+			a.Len(statements, test.wantCount, test.statement)
+			continue
+		}
+		a.Empty(statements, test.statement)
+		a.Len(results, 1, test.statement)
+		a.Equal(common.DMLDryRunUnsupportedStatement.Int32(), results[0].Code, test.statement)
+		a.Equal(test.wantLine, results[0].GetDmlDryRunReport().Line, test.statement)
+	}
+}
+
+// fakeDryRunDatabase is a database/sql driver that records the dry run.
+// The statements containing SLEEP block until they are canceled, and the queries return the session ID 42.
+type fakeDryRunDatabase struct {
+	mu        sync.Mutex
+	executed  []string
+	commits   int
+	rollbacks int
+	closed    int
+}
+
+func (d *fakeDryRunDatabase) Open(string) (driver.Conn, error) {
+	return &fakeDryRunConn{database: d}, nil
+}
+
+type fakeDryRunConn struct {
+	database *fakeDryRunDatabase
+}
+
+func (*fakeDryRunConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *fakeDryRunConn) Close() error {
+	c.database.mu.Lock()
+	defer c.database.mu.Unlock()
+	c.database.closed++
+	return nil
+}
+
+func (c *fakeDryRunConn) Begin() (driver.Tx, error) {
+	return &fakeDryRunTx{database: c.database}, nil
+}
+
+func (c *fakeDryRunConn) ExecContext(ctx context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.database.mu.Lock()
+	c.database.executed = append(c.database.executed, query)
+	c.database.mu.Unlock()
+	if strings.Contains(query, "SLEEP") {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return driver.RowsAffected(3), nil
+}
+
+func (c *fakeDryRunConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	c.database.mu.Lock()
+	c.database.executed = append(c.database.executed, query)
+	c.database.mu.Unlock()
+	return &fakeDryRunRows{}, nil
+}
+
+type fakeDryRunRows struct {
+	done bool
+}
+
+func (*fakeDryRunRows) Columns() []string {
+	return []string{"id"}
+}
+
+func (*fakeDryRunRows) Close() error {
+	return nil
+}
+
+func (r *fakeDryRunRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = int64(42)
+	return nil
+}
+
+type fakeDryRunTx struct {
+	database *fakeDryRunDatabase
+}
+
+func (tx *fakeDryRunTx) Commit() error {
+	tx.database.mu.Lock()
+	defer tx.database.mu.Unlock()
+	tx.database.commits++
+	return nil
+}
+
+func (tx *fakeDryRunTx) Rollback() error {
+	tx.database.mu.Lock()
+	defer tx.database.mu.Unlock()
+	tx.database.rollbacks++
+	return nil
+}
+
+func TestRunDMLDryRun(t *testing.T) {
+	database := &fakeDryRunDatabase{}
+	sql.Register("dml-dry-run-fake", database)
+
+	tests := []struct {
+		engine     storepb.Engine
+		statements []*dmlDryRunStatement
+		wantStatus []storepb.PlanCheckRunResult_Result_Status
+		// wantExecuted is the executed statements including the session settings.
+		wantExecuted []string
+		// wantDiscarded is true if the connection is not returned to the pool.
+		wantDiscarded bool
+	}{
+		{
+			engine: storepb.Engine_POSTGRES,
+			statements: []*dmlDryRunStatement{
+				{text: "INSERT INTO t VALUES (1)", line: 1},
+				{text: "UPDATE t SET a = 2", line: 2},
+			},
+			wantStatus: []storepb.PlanCheckRunResult_Result_Status{
+				storepb.PlanCheckRunResult_Result_SUCCESS,
+				storepb.PlanCheckRunResult_Result_SUCCESS,
+			},
+			wantExecuted: []string{
+				"SET LOCAL statement_timeout = 100",
+				"INSERT INTO t VALUES (1)",
+				"UPDATE t SET a = 2",
+			},
+		},
+		{
+			engine: storepb.Engine_MYSQL,
+			statements: []*dmlDryRunStatement{
+				{text: "UPDATE t SET a = 2", line: 1},
+				{text: "UPDATE t SET a = SLEEP(10)", line: 2},
+				{text: "DELETE FROM t", line: 3},
+			},
+			wantStatus: []storepb.PlanCheckRunResult_Result_Status{
+				storepb.PlanCheckRunResult_Result_SUCCESS,
+				storepb.PlanCheckRunResult_Result_ERROR,
+			},
+			wantExecuted: []string{
+				"SELECT CONNECTION_ID()",
+				"SET SESSION innodb_lock_wait_timeout = 1",
+				"UPDATE t SET a = 2",
+				"UPDATE t SET a = SLEEP(10)",
+				"KILL QUERY 42",
+			},
+			wantDiscarded: true,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		db, err := sql.Open("dml-dry-run-fake", "")
+		a.NoError(err)
+		database.executed, database.commits, database.rollbacks, database.closed = nil, 0, 0, 0
+
+		results, err := runDMLDryRun(context.Background(), db, test.engine, test.statements, 100*time.Millisecond)
+		a.NoError(err, test.engine)
+		var status []storepb.PlanCheckRunResult_Result_Status
+		for _, result := range results {
+			status = append(status, result.Status)
+		}
+		a.Equal(test.wantStatus, status, test.engine)
+		if last := results[len(results)-1]; last.Status == storepb.PlanCheckRunResult_Result_ERROR {
+			a.Contains(last.Content, "exceeds the timeout", test.engine)
+		} else {
+			a.Equal(int64(3), last.GetDmlDryRunReport().AffectedRows, test.engine)
+		}
+		a.Equal(test.wantExecuted, database.executed, test.engine)
+		a.Equal(0, database.commits, test.engine)
+		a.Equal(1, database.rollbacks, test.engine)
+		if test.wantDiscarded {
+			a.Equal(1, database.closed, test.engine)
+		} else {
+			a.Equal(0, database.closed, test.engine)
+		}
+		a.NoError(db.Close())
+	}
+}
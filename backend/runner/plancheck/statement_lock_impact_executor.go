@@ -0,0 +1,835 @@
+package plancheck
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	pgquery "github.com/pganalyze/pg_query_go/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// defaultLockImpactWarningThreshold is the warning threshold if the lock impact policy is not set.
+	defaultLockImpactWarningThreshold = 10 * time.Second
+	// defaultScanThroughput is the rough bytes per second of scanning a table.
+	defaultScanThroughput = 200 * 1024 * 1024
+	// defaultRewriteThroughput is the rough bytes per second of rewriting a table and rebuilding its indexes.
+	defaultRewriteThroughput = 50 * 1024 * 1024
+	// defaultPostgresSchema is the schema of the table without the schema name.
+	defaultPostgresSchema = "public"
+)
+
+var (
+	// nonVolatileFunctions are the common immutable or stable functions used in the column default.
+	// The functions not in the list are considered volatile because PostgreSQL rewrites the table for the volatile default.
+	nonVolatileFunctions = map[string]bool{
+		"now":                   true,
+		"transaction_timestamp": true,
+		"statement_timestamp":   true,
+		"current_setting":       true,
+		"lower":                 true,
+		"upper":                 true,
+		"length":                true,
+		"concat":                true,
+		"to_char":               true,
+		"to_date":               true,
+		"to_timestamp":          true,
+		"make_date":             true,
+		"make_interval":         true,
+		"date_trunc":            true,
+		"json_build_object":     true,
+		"json_build_array":      true,
+		"jsonb_build_object":    true,
+		"jsonb_build_array":     true,
+		"array_fill":            true,
+	}
+	serialTypes = map[string]bool{
+		"smallserial": true,
+		"serial":      true,
+		"bigserial":   true,
+		"serial2":     true,
+		"serial4":     true,
+		"serial8":     true,
+	}
+	varcharTypeReg = regexp.MustCompile(`^character varying(?:\((\d+)\))?$`)
+)
+
+// NewStatementLockImpactExecutor creates a plan check statement lock impact executor.
+func NewStatementLockImpactExecutor(store *store.Store) Executor {
+	return &StatementLockImpactExecutor{
+		store: store,
+	}
+}
+
+// StatementLockImpactExecutor is the plan check statement lock impact executor.
+// It reports the table lock, the rewrite and the full scan of each PostgreSQL statement,
+// and estimates the duration from the table size in the synced metadata.
+type StatementLockImpactExecutor struct {
+	store *store.Store
+}
+
+// Run will run the plan check statement lock impact executor once.
+func (e *StatementLockImpactExecutor) Run(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
+	instanceUID := int(config.InstanceUid)
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &instanceUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance UID %v", instanceUID)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance not found UID %v", instanceUID)
+	}
+	if !isStatementLockImpactSupported(instance.Engine) {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   fmt.Sprintf("Statement lock impact is not supported for %s", instance.Engine),
+				Content: "",
+			},
+		}, nil
+	}
+
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", config.DatabaseName)
+	}
+	dbSchema, err := e.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, err
+	}
+	if dbSchema == nil {
+		return nil, errors.Errorf("database schema not found: %d", database.UID)
+	}
+
+	environment, err := e.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &database.EffectiveEnvironmentID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get environment %q", database.EffectiveEnvironmentID)
+	}
+	if environment == nil {
+		return nil, errors.Errorf("environment %q not found", database.EffectiveEnvironmentID)
+	}
+	policy, err := e.store.GetLockImpactPolicy(ctx, environment.UID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get lock impact policy for environment %q", environment.ResourceID)
+	}
+
+	sheetUID := int(config.SheetUid)
+	sheet, err := e.store.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID}, api.SystemBotID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+	}
+	if sheet == nil {
+		return nil, errors.Errorf("sheet %d not found", sheetUID)
+	}
+	if sheet.Size > common.MaxSheetCheckSize {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.SizeExceeded.Int32(),
+				Title:   "Large SQL lock impact check is disabled",
+				Content: "",
+			},
+		}, nil
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet statement %d", sheetUID)
+	}
+	renderedStatement := utils.RenderStatement(statement, utils.GetSecretMapFromDatabaseMessage(database))
+
+	results := postgresqlLockImpactCheck(renderedStatement, dbSchema.GetDatabaseMetadata(), policy)
+	if len(results) == 0 {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Title:   "OK",
+				Content: "",
+				Code:    common.Ok.Int32(),
+			},
+		}, nil
+	}
+	return results, nil
+}
+
+// lockMode is the PostgreSQL table-level lock mode.
+// The value is the same as the lock mode number of PostgreSQL, and a larger value is stronger.
+type lockMode int32
+
+const (
+	lockModeNone lockMode = iota
+	lockModeAccessShare
+	lockModeRowShare
+	lockModeRowExclusive
+	lockModeShareUpdateExclusive
+	lockModeShare
+	lockModeShareRowExclusive
+	lockModeExclusive
+	lockModeAccessExclusive
+)
+
+func (m lockMode) String() string {
+	switch m {
+	case lockModeAccessShare:
+		return "ACCESS SHARE"
+	case lockModeRowShare:
+		return "ROW SHARE"
+	case lockModeRowExclusive:
+		return "ROW EXCLUSIVE"
+	case lockModeShareUpdateExclusive:
+		return "SHARE UPDATE EXCLUSIVE"
+	case lockModeShare:
+		return "SHARE"
+	case lockModeShareRowExclusive:
+		return "SHARE ROW EXCLUSIVE"
+	case lockModeExclusive:
+		return "EXCLUSIVE"
+	case lockModeAccessExclusive:
+		return "ACCESS EXCLUSIVE"
+	}
+	return "NONE"
+}
+
+// blocksWrites returns true if the lock conflicts with the ROW EXCLUSIVE lock of INSERT, UPDATE and DELETE.
+func (m lockMode) blocksWrites() bool {
+	return m >= lockModeShare
+}
+
+// tableLockImpact is the impact of a statement on a table.
+type tableLockImpact struct {
+	schema  string
+	table   string
+	lock    lockMode
+	rewrite bool
+	scan    bool
+	// reasons are the operations causing the lock, the rewrite or the scan.
+	reasons []string
+}
+
+func (i *tableLockImpact) key() string {
+	return fmt.Sprintf("%q.%q", i.schema, i.table)
+}
+
+// merge merges the impact of another operation of the same statement on the same table.
+func (i *tableLockImpact) merge(other *tableLockImpact) {
+	i.lock = max(i.lock, other.lock)
+	i.rewrite = i.rewrite || other.rewrite
+	i.scan = i.scan || other.scan
+	i.reasons = append(i.reasons, other.reasons...)
+}
+
+// heldLock is the lock held by the transaction on a table.
+type heldLock struct {
+	lock lockMode
+	line int
+}
+
+// postgresqlLockImpactCheck reports the lock impact of each statement.
+// The statements except the non-transactional ones run in a single transaction, so the locks are held until the transaction commits.
+func postgresqlLockImpactCheck(statement string, metadata *model.DatabaseMetadata, policy *storepb.LockImpactPolicy) []*storepb.PlanCheckRunResult_Result {
+	res, err := pgquery.Parse(statement)
+	if err != nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_ERROR,
+				Code:    common.LockImpactSyntaxError.Int32(),
+				Title:   "Syntax error",
+				Content: err.Error(),
+			},
+		}
+	}
+
+	var results []*storepb.PlanCheckRunResult_Result
+	heldLocks := make(map[string]heldLock)
+	createdTables := make(map[string]bool)
+	for _, stmt := range res.Stmts {
+		line := getStatementLine(statement, stmt)
+		if create, ok := stmt.Stmt.Node.(*pgquery.Node_CreateStmt); ok {
+			createdTables[newTableLockImpact(create.CreateStmt.Relation, lockModeNone, "").key()] = true
+			continue
+		}
+		transactional := !isNonTransactionalStatement(stmt.Stmt)
+		for _, impact := range getStatementLockImpacts(stmt.Stmt, metadata) {
+			// The table created in the same script is empty and invisible to others.
+			if createdTables[impact.key()] {
+				continue
+			}
+			effectiveLock := impact.lock
+			held, hasHeld := heldLocks[impact.key()]
+			if transactional {
+				if hasHeld && held.lock > effectiveLock {
+					effectiveLock = held.lock
+				} else if impact.lock > lockModeRowExclusive {
+					heldLocks[impact.key()] = heldLock{lock: impact.lock, line: line}
+				}
+			}
+			if effectiveLock <= lockModeRowExclusive && !impact.rewrite && !impact.scan {
+				continue
+			}
+			heldBy := 0
+			if transactional && effectiveLock > impact.lock {
+				heldBy = held.line
+			}
+			results = append(results, convertLockImpactToResult(impact, effectiveLock, heldBy, line, transactional, metadata, policy))
+		}
+	}
+	return results
+}
+
+func convertLockImpactToResult(impact *tableLockImpact, effectiveLock lockMode, heldBy int, line int, transactional bool, metadata *model.DatabaseMetadata, policy *storepb.LockImpactPolicy) *storepb.PlanCheckRunResult_Result {
+	var content []string
+	content = append(content, fmt.Sprintf("Line %d: %s.", line, strings.Join(impact.reasons, ", ")))
+	if heldBy > 0 {
+		content = append(content, fmt.Sprintf("The %s lock acquired at line %d is held until the transaction commits.", effectiveLock, heldBy))
+	}
+	switch {
+	case impact.rewrite:
+		content = append(content, "The table and its indexes are rewritten.")
+	case impact.scan:
+		content = append(content, "The whole table is scanned.")
+	}
+
+	var duration time.Duration
+	if impact.rewrite || impact.scan {
+		size, ok := getTableSize(metadata, impact.schema, impact.table, impact.rewrite)
+		if ok {
+			duration = estimateLockImpactDuration(size, impact.rewrite, policy)
+			content = append(content, fmt.Sprintf("The estimated duration is %s for %s.", duration, formatBytes(size)))
+		} else {
+			content = append(content, "The duration cannot be estimated because the table size is unknown.")
+		}
+	}
+	until := "the statement completes"
+	if transactional {
+		until = "the transaction commits"
+	}
+	switch {
+	case effectiveLock == lockModeAccessExclusive:
+		content = append(content, fmt.Sprintf("Reads and writes on the table are blocked until %s.", until))
+	case effectiveLock.blocksWrites():
+		content = append(content, fmt.Sprintf("Writes on the table are blocked until %s.", until))
+	}
+
+	result := &storepb.PlanCheckRunResult_Result{
+		Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+		Code:    common.Ok.Int32(),
+		Title:   fmt.Sprintf("%s lock on %s", effectiveLock, impact.key()),
+		Content: strings.Join(content, " "),
+	}
+	if !effectiveLock.blocksWrites() || duration == 0 {
+		return result
+	}
+	warningThreshold, errorThreshold := getLockImpactThresholds(policy)
+	switch {
+	case errorThreshold > 0 && duration >= errorThreshold:
+		result.Status = storepb.PlanCheckRunResult_Result_ERROR
+	case warningThreshold > 0 && duration >= warningThreshold:
+		result.Status = storepb.PlanCheckRunResult_Result_WARNING
+	default:
+		return result
+	}
+	switch {
+	case impact.rewrite:
+		result.Code = common.LockImpactTableRewrite.Int32()
+	case impact.scan:
+		result.Code = common.LockImpactTableScan.Int32()
+	default:
+		result.Code = common.LockImpactBlockingLock.Int32()
+	}
+	return result
+}
+
+// getLockImpactThresholds returns the warning and error thresholds, and the default is used if the policy is not set.
+func getLockImpactThresholds(policy *storepb.LockImpactPolicy) (time.Duration, time.Duration) {
+	if policy == nil {
+		return defaultLockImpactWarningThreshold, 0
+	}
+	return policy.GetWarningThreshold().AsDuration(), policy.GetErrorThreshold().AsDuration()
+}
+
+func estimateLockImpactDuration(size int64, rewrite bool, policy *storepb.LockImpactPolicy) time.Duration {
+	throughput := int64(defaultScanThroughput)
+	if policy.GetScanThroughput() > 0 {
+		throughput = policy.GetScanThroughput()
+	}
+	if rewrite {
+		throughput = defaultRewriteThroughput
+		if policy.GetRewriteThroughput() > 0 {
+			throughput = policy.GetRewriteThroughput()
+		}
+	}
+	return time.Duration(float64(size) / float64(throughput) * float64(time.Second)).Round(time.Second)
+}
+
+// getTableSize returns the data size of the table, and the index size is included for the rewrite.
+func getTableSize(metadata *model.DatabaseMetadata, schemaName string, tableName string, rewrite bool) (int64, bool) {
+	if metadata == nil {
+		return 0, false
+	}
+	schema := metadata.GetSchema(schemaName)
+	if schema == nil {
+		return 0, false
+	}
+	table := schema.GetTable(tableName)
+	if table == nil {
+		return 0, false
+	}
+	size := table.GetProto().GetDataSize()
+	if rewrite {
+		size += table.GetProto().GetIndexSize()
+	}
+	return size, true
+}
+
+func getColumnType(metadata *model.DatabaseMetadata, schemaName string, tableName string, columnName string) string {
+	if metadata == nil {
+		return ""
+	}
+	schema := metadata.GetSchema(schemaName)
+	if schema == nil {
+		return ""
+	}
+	table := schema.GetTable(tableName)
+	if table == nil {
+		return ""
+	}
+	return table.GetColumn(columnName).GetType()
+}
+
+// getIndexTable returns the table of the index in the schema.
+func getIndexTable(metadata *model.DatabaseMetadata, schemaName string, indexName string) string {
+	if metadata == nil {
+		return ""
+	}
+	schema := metadata.GetSchema(schemaName)
+	if schema == nil {
+		return ""
+	}
+	for _, tableName := range schema.ListTableNames() {
+		for _, index := range schema.GetTable(tableName).GetProto().GetIndexes() {
+			if index.Name == indexName {
+				return tableName
+			}
+		}
+	}
+	return ""
+}
+
+func isNonTransactionalStatement(node *pgquery.Node) bool {
+	switch n := node.Node.(type) {
+	case *pgquery.Node_IndexStmt:
+		return n.IndexStmt.Concurrent
+	case *pgquery.Node_DropStmt:
+		return n.DropStmt.Concurrent
+	case *pgquery.Node_VacuumStmt:
+		return n.VacuumStmt.IsVacuumcmd
+	case *pgquery.Node_DropdbStmt:
+		return true
+	}
+	return false
+}
+
+// getStatementLockImpacts returns the impacts of the statement on the tables.
+func getStatementLockImpacts(node *pgquery.Node, metadata *model.DatabaseMetadata) []*tableLockImpact {
+	var impacts []*tableLockImpact
+	add := func(impact *tableLockImpact) {
+		for _, existing := range impacts {
+			if existing.key() == impact.key() {
+				existing.merge(impact)
+				return
+			}
+		}
+		impacts = append(impacts, impact)
+	}
+
+	switch n := node.Node.(type) {
+	case *pgquery.Node_AlterTableStmt:
+		if n.AlterTableStmt.Objtype != pgquery.ObjectType_OBJECT_TABLE {
+			return nil
+		}
+		for _, cmd := range n.AlterTableStmt.Cmds {
+			alterTableCmd, ok := cmd.Node.(*pgquery.Node_AlterTableCmd)
+			if !ok {
+				continue
+			}
+			for _, impact := range getAlterTableCmdLockImpacts(n.AlterTableStmt.Relation, alterTableCmd.AlterTableCmd, metadata) {
+				add(impact)
+			}
+		}
+	case *pgquery.Node_IndexStmt:
+		impact := newTableLockImpact(n.IndexStmt.Relation, lockModeShare, "creating an index")
+		impact.scan = true
+		if n.IndexStmt.Concurrent {
+			impact.lock = lockModeShareUpdateExclusive
+			impact.reasons = []string{"creating an index concurrently"}
+		}
+		add(impact)
+	case *pgquery.Node_DropStmt:
+		for _, object := range n.DropStmt.Objects {
+			list, ok := object.Node.(*pgquery.Node_List)
+			if !ok {
+				continue
+			}
+			relation := convertNameListToRangeVar(list.List.Items)
+			switch n.DropStmt.RemoveType {
+			case pgquery.ObjectType_OBJECT_TABLE:
+				add(newTableLockImpact(relation, lockModeAccessExclusive, "dropping the table"))
+			case pgquery.ObjectType_OBJECT_INDEX:
+				impact := newTableLockImpact(relation, lockModeAccessExclusive, fmt.Sprintf("dropping index %q", relation.Relname))
+				impact.table = getIndexTable(metadata, impact.schema, relation.Relname)
+				if impact.table == "" {
+					continue
+				}
+				if n.DropStmt.Concurrent {
+					impact.lock = lockModeShareUpdateExclusive
+					impact.reasons = []string{fmt.Sprintf("dropping index %q concurrently", relation.Relname)}
+				}
+				add(impact)
+			}
+		}
+	case *pgquery.Node_TruncateStmt:
+		for _, relation := range n.TruncateStmt.Relations {
+			if rangeVar, ok := relation.Node.(*pgquery.Node_RangeVar); ok {
+				add(newTableLockImpact(rangeVar.RangeVar, lockModeAccessExclusive, "truncating the table"))
+			}
+		}
+	case *pgquery.Node_RenameStmt:
+		if n.RenameStmt.Relation == nil {
+			return nil
+		}
+		switch n.RenameStmt.RenameType {
+		case pgquery.ObjectType_OBJECT_TABLE, pgquery.ObjectType_OBJECT_COLUMN, pgquery.ObjectType_OBJECT_TABCONSTRAINT:
+			add(newTableLockImpact(n.RenameStmt.Relation, lockModeAccessExclusive, "renaming"))
+		}
+	case *pgquery.Node_VacuumStmt:
+		full := false
+		for _, option := range n.VacuumStmt.Options {
+			if defElem, ok := option.Node.(*pgquery.Node_DefElem); ok && strings.EqualFold(defElem.DefElem.Defname, "full") {
+				full = true
+			}
+		}
+		for _, rel := range n.VacuumStmt.Rels {
+			vacuumRelation, ok := rel.Node.(*pgquery.Node_VacuumRelation)
+			if !ok || vacuumRelation.VacuumRelation.Relation == nil {
+				continue
+			}
+			switch {
+			case full:
+				impact := newTableLockImpact(vacuumRelation.VacuumRelation.Relation, lockModeAccessExclusive, "running VACUUM FULL")
+				impact.rewrite = true
+				add(impact)
+			case n.VacuumStmt.IsVacuumcmd:
+				impact := newTableLockImpact(vacuumRelation.VacuumRelation.Relation, lockModeShareUpdateExclusive, "running VACUUM")
+				impact.scan = true
+				add(impact)
+			default:
+				add(newTableLockImpact(vacuumRelation.VacuumRelation.Relation, lockModeShareUpdateExclusive, "running ANALYZE"))
+			}
+		}
+	case *pgquery.Node_ClusterStmt:
+		if n.ClusterStmt.Relation == nil {
+			return nil
+		}
+		impact := newTableLockImpact(n.ClusterStmt.Relation, lockModeAccessExclusive, "running CLUSTER")
+		impact.rewrite = true
+		add(impact)
+	case *pgquery.Node_ReindexStmt:
+		if n.ReindexStmt.Relation == nil {
+			return nil
+		}
+		impact := newTableLockImpact(n.ReindexStmt.Relation, lockModeShare, "rebuilding the indexes")
+		switch n.ReindexStmt.Kind {
+		case pgquery.ReindexObjectType_REINDEX_OBJECT_TABLE:
+		case pgquery.ReindexObjectType_REINDEX_OBJECT_INDEX:
+			impact.reasons = []string{fmt.Sprintf("rebuilding index %q", n.ReindexStmt.Relation.Relname)}
+			impact.table = getIndexTable(metadata, impact.schema, n.ReindexStmt.Relation.Relname)
+			if impact.table == "" {
+				return nil
+			}
+		default:
+			return nil
+		}
+		impact.scan = true
+		for _, param := range n.ReindexStmt.Params {
+			if defElem, ok := param.Node.(*pgquery.Node_DefElem); ok && strings.EqualFold(defElem.DefElem.Defname, "concurrently") {
+				impact.lock = lockModeShareUpdateExclusive
+			}
+		}
+		add(impact)
+	case *pgquery.Node_RefreshMatViewStmt:
+		impact := newTableLockImpact(n.RefreshMatViewStmt.Relation, lockModeAccessExclusive, "refreshing the materialized view")
+		impact.rewrite = true
+		if n.RefreshMatViewStmt.Concurrent {
+			impact.lock = lockModeExclusive
+		}
+		add(impact)
+	case *pgquery.Node_CreateTrigStmt:
+		add(newTableLockImpact(n.CreateTrigStmt.Relation, lockModeShareRowExclusive, "creating a trigger"))
+	case *pgquery.Node_LockStmt:
+		for _, relation := range n.LockStmt.Relations {
+			if rangeVar, ok := relation.Node.(*pgquery.Node_RangeVar); ok {
+				add(newTableLockImpact(rangeVar.RangeVar, lockMode(n.LockStmt.Mode), "locking the table explicitly"))
+			}
+		}
+	case *pgquery.Node_UpdateStmt:
+		impact := newTableLockImpact(n.UpdateStmt.Relation, lockModeRowExclusive, "updating rows")
+		if n.UpdateStmt.WhereClause == nil {
+			impact.scan = true
+			impact.reasons = []string{"updating all rows"}
+		}
+		add(impact)
+	case *pgquery.Node_DeleteStmt:
+		impact := newTableLockImpact(n.DeleteStmt.Relation, lockModeRowExclusive, "deleting rows")
+		if n.DeleteStmt.WhereClause == nil {
+			impact.scan = true
+			impact.reasons = []string{"deleting all rows"}
+		}
+		add(impact)
+	}
+	return impacts
+}
+
+func getAlterTableCmdLockImpacts(relation *pgquery.RangeVar, cmd *pgquery.AlterTableCmd, metadata *model.DatabaseMetadata) []*tableLockImpact {
+	switch cmd.Subtype {
+	case pgquery.AlterTableType_AT_AddColumn:
+		columnDef := cmd.Def.GetColumnDef()
+		if columnDef == nil {
+			return nil
+		}
+		return getAddColumnLockImpacts(relation, columnDef)
+	case pgquery.AlterTableType_AT_AlterColumnType:
+		impact := newTableLockImpact(relation, lockModeAccessExclusive, fmt.Sprintf("changing the type of column %q", cmd.Name))
+		columnDef := cmd.Def.GetColumnDef()
+		if columnDef == nil || columnDef.RawDefault != nil || !isBinaryCoercible(getColumnType(metadata, impact.schema, impact.table, cmd.Name), columnDef.TypeName) {
+			impact.rewrite = true
+		}
+		return []*tableLockImpact{impact}
+	case pgquery.AlterTableType_AT_SetNotNull:
+		impact := newTableLockImpact(relation, lockModeAccessExclusive, fmt.Sprintf("setting NOT NULL on column %q", cmd.Name))
+		impact.scan = true
+		return []*tableLockImpact{impact}
+	case pgquery.AlterTableType_AT_AddConstraint:
+		constraint := cmd.Def.GetConstraint()
+		if constraint == nil {
+			return nil
+		}
+		return getAddConstraintLockImpacts(relation, constraint)
+	case pgquery.AlterTableType_AT_ValidateConstraint:
+		impact := newTableLockImpact(relation, lockModeShareUpdateExclusive, fmt.Sprintf("validating constraint %q", cmd.Name))
+		impact.scan = true
+		return []*tableLockImpact{impact}
+	case pgquery.AlterTableType_AT_SetTableSpace, pgquery.AlterTableType_AT_SetAccessMethod, pgquery.AlterTableType_AT_SetLogged, pgquery.AlterTableType_AT_SetUnLogged:
+		impact := newTableLockImpact(relation, lockModeAccessExclusive, "changing the table storage")
+		impact.rewrite = true
+		return []*tableLockImpact{impact}
+	case pgquery.AlterTableType_AT_SetStatistics, pgquery.AlterTableType_AT_ClusterOn, pgquery.AlterTableType_AT_DropCluster, pgquery.AlterTableType_AT_SetRelOptions, pgquery.AlterTableType_AT_ResetRelOptions:
+		return []*tableLockImpact{newTableLockImpact(relation, lockModeShareUpdateExclusive, "changing the table options")}
+	case pgquery.AlterTableType_AT_EnableTrig, pgquery.AlterTableType_AT_EnableAlwaysTrig, pgquery.AlterTableType_AT_EnableReplicaTrig, pgquery.AlterTableType_AT_DisableTrig,
+		pgquery.AlterTableType_AT_EnableTrigAll, pgquery.AlterTableType_AT_DisableTrigAll, pgquery.AlterTableType_AT_EnableTrigUser, pgquery.AlterTableType_AT_DisableTrigUser:
+		return []*tableLockImpact{newTableLockImpact(relation, lockModeShareRowExclusive, "enabling or disabling triggers")}
+	case pgquery.AlterTableType_AT_AttachPartition:
+		impacts := []*tableLockImpact{newTableLockImpact(relation, lockModeShareUpdateExclusive, "attaching a partition")}
+		if partition := cmd.Def.GetPartitionCmd().GetName(); partition != nil {
+			impact := newTableLockImpact(partition, lockModeAccessExclusive, "validating the partition bound")
+			impact.scan = true
+			impacts = append(impacts, impact)
+		}
+		return impacts
+	case pgquery.AlterTableType_AT_DetachPartition:
+		if cmd.Def.GetPartitionCmd().GetConcurrent() {
+			return []*tableLockImpact{newTableLockImpact(relation, lockModeShareUpdateExclusive, "detaching a partition concurrently")}
+		}
+		return []*tableLockImpact{newTableLockImpact(relation, lockModeAccessExclusive, "detaching a partition")}
+	}
+	return []*tableLockImpact{newTableLockImpact(relation, lockModeAccessExclusive, "altering the table")}
+}
+
+func getAddColumnLockImpacts(relation *pgquery.RangeVar, columnDef *pgquery.ColumnDef) []*tableLockImpact {
+	impact := newTableLockImpact(relation, lockModeAccessExclusive, fmt.Sprintf("adding column %q", columnDef.Colname))
+	impacts := []*tableLockImpact{impact}
+	if serialTypes[getTypeName(columnDef.TypeName)] {
+		impact.rewrite = true
+		impact.reasons = []string{fmt.Sprintf("adding serial column %q", columnDef.Colname)}
+	}
+	for _, node := range columnDef.Constraints {
+		constraint := node.GetConstraint()
+		if constraint == nil {
+			continue
+		}
+		switch constraint.Contype {
+		case pgquery.ConstrType_CONSTR_DEFAULT:
+			if constraint.RawExpr != nil && containsVolatileFunction(constraint.RawExpr.ProtoReflect()) {
+				impact.rewrite = true
+				impact.reasons = []string{fmt.Sprintf("adding column %q with a volatile default", columnDef.Colname)}
+			}
+		case pgquery.ConstrType_CONSTR_IDENTITY:
+			impact.rewrite = true
+			impact.reasons = []string{fmt.Sprintf("adding identity column %q", columnDef.Colname)}
+		case pgquery.ConstrType_CONSTR_GENERATED:
+			impact.rewrite = true
+			impact.reasons = []string{fmt.Sprintf("adding generated column %q", columnDef.Colname)}
+		case pgquery.ConstrType_CONSTR_CHECK, pgquery.ConstrType_CONSTR_PRIMARY, pgquery.ConstrType_CONSTR_UNIQUE:
+			impact.scan = true
+		case pgquery.ConstrType_CONSTR_FOREIGN:
+			if constraint.Pktable != nil {
+				impacts = append(impacts, newTableLockImpact(constraint.Pktable, lockModeShareRowExclusive, "adding a referencing foreign key"))
+			}
+		}
+	}
+	return impacts
+}
+
+func getAddConstraintLockImpacts(relation *pgquery.RangeVar, constraint *pgquery.Constraint) []*tableLockImpact {
+	name := constraint.Conname
+	switch constraint.Contype {
+	case pgquery.ConstrType_CONSTR_CHECK:
+		impact := newTableLockImpact(relation, lockModeAccessExclusive, fmt.Sprintf("adding check constraint %q", name))
+		if !constraint.SkipValidation {
+			impact.scan = true
+			impact.reasons = []string{fmt.Sprintf("adding check constraint %q without NOT VALID", name)}
+		}
+		return []*tableLockImpact{impact}
+	case pgquery.ConstrType_CONSTR_FOREIGN:
+		impact := newTableLockImpact(relation, lockModeShareRowExclusive, fmt.Sprintf("adding foreign key %q", name))
+		if !constraint.SkipValidation {
+			impact.scan = true
+			impact.reasons = []string{fmt.Sprintf("adding foreign key %q without NOT VALID", name)}
+		}
+		impacts := []*tableLockImpact{impact}
+		if constraint.Pktable != nil {
+			impacts = append(impacts, newTableLockImpact(constraint.Pktable, lockModeShareRowExclusive, fmt.Sprintf("adding referencing foreign key %q", name)))
+		}
+		return impacts
+	case pgquery.ConstrType_CONSTR_PRIMARY, pgquery.ConstrType_CONSTR_UNIQUE, pgquery.ConstrType_CONSTR_EXCLUSION:
+		if constraint.Indexname != "" {
+			return []*tableLockImpact{newTableLockImpact(relation, lockModeAccessExclusive, fmt.Sprintf("adding constraint %q using index %q", name, constraint.Indexname))}
+		}
+		impact := newTableLockImpact(relation, lockModeAccessExclusive, fmt.Sprintf("adding constraint %q with a new index", name))
+		impact.scan = true
+		return []*tableLockImpact{impact}
+	}
+	return []*tableLockImpact{newTableLockImpact(relation, lockModeAccessExclusive, fmt.Sprintf("adding constraint %q", name))}
+}
+
+// isBinaryCoercible returns true if changing the column type from the old type to the new type doesn't rewrite the table.
+// Only the common cases of increasing or removing the length limit of the varchar are considered.
+func isBinaryCoercible(oldType string, newType *pgquery.TypeName) bool {
+	matches := varcharTypeReg.FindStringSubmatch(oldType)
+	if matches == nil {
+		return false
+	}
+	switch getTypeName(newType) {
+	case "text":
+		return true
+	case "varchar":
+		if len(newType.Typmods) == 0 {
+			return true
+		}
+		if matches[1] == "" {
+			return false
+		}
+		oldLength, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return false
+		}
+		return int(newType.Typmods[0].GetAConst().GetIval().GetIval()) >= oldLength
+	}
+	return false
+}
+
+// containsVolatileFunction returns true if the expression calls a function that is not known to be immutable or stable.
+func containsVolatileFunction(message protoreflect.Message) bool {
+	if call, ok := message.Interface().(*pgquery.FuncCall); ok {
+		if names := call.Funcname; len(names) > 0 && !nonVolatileFunctions[strings.ToLower(names[len(names)-1].GetString_().GetSval())] {
+			return true
+		}
+	}
+	found := false
+	message.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Message() == nil || fd.IsMap():
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len() && !found; i++ {
+				found = containsVolatileFunction(list.Get(i).Message())
+			}
+		default:
+			found = containsVolatileFunction(v.Message())
+		}
+		return !found
+	})
+	return found
+}
+
+func getTypeName(typeName *pgquery.TypeName) string {
+	if typeName == nil || len(typeName.Names) == 0 {
+		return ""
+	}
+	return strings.ToLower(typeName.Names[len(typeName.Names)-1].GetString_().GetSval())
+}
+
+func newTableLockImpact(relation *pgquery.RangeVar, lock lockMode, reason string) *tableLockImpact {
+	schema := relation.GetSchemaname()
+	if schema == "" {
+		schema = defaultPostgresSchema
+	}
+	return &tableLockImpact{
+		schema:  schema,
+		table:   relation.GetRelname(),
+		lock:    lock,
+		reasons: []string{reason},
+	}
+}
+
+func convertNameListToRangeVar(items []*pgquery.Node) *pgquery.RangeVar {
+	var names []string
+	for _, item := range items {
+		names = append(names, item.GetString_().GetSval())
+	}
+	relation := &pgquery.RangeVar{}
+	if len(names) > 0 {
+		relation.Relname = names[len(names)-1]
+	}
+	if len(names) > 1 {
+		relation.Schemaname = names[len(names)-2]
+	}
+	return relation
+}
+
+// getStatementLine returns the 1-based line of the first token of the statement.
+func getStatementLine(statement string, stmt *pgquery.RawStmt) int {
+	location := int(stmt.StmtLocation)
+	if location > len(statement) {
+		return 0
+	}
+	text := statement[location:]
+	leading := text[:len(text)-len(strings.TrimLeft(text, " \t\r\n"))]
+	return 1 + strings.Count(statement[:location], "\n") + strings.Count(leading, "\n")
+}
+
+func formatBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
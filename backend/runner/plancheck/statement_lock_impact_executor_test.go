@@ -0,0 +1,232 @@
+package plancheck
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestPostgreSQLLockImpactCheck(t *testing.T) {
+	const gib = 1024 * 1024 * 1024
+	metadata := model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name:      "orders",
+						DataSize:  10 * gib,
+						IndexSize: 2 * gib,
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "note", Type: "character varying(64)"},
+							{Name: "amount", Type: "integer"},
+						},
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "idx_orders_amount"},
+						},
+					},
+					{
+						Name:     "users",
+						DataSize: 1024,
+					},
+				},
+			},
+		},
+	})
+
+	type result struct {
+		status  storepb.PlanCheckRunResult_Result_Status
+		code    common.Code
+		title   string
+		content string
+	}
+	tests := []struct {
+		statement string
+		policy    *storepb.LockImpactPolicy
+		want      []result
+	}{
+		{
+			statement: "ALTER TABLE orders ALTER COLUMN amount TYPE bigint;",
+			want: []result{
+				{
+					status:  storepb.PlanCheckRunResult_Result_WARNING,
+					code:    common.LockImpactTableRewrite,
+					title:   `ACCESS EXCLUSIVE lock on "public"."orders"`,
+					content: `Line 1: changing the type of column "amount". The table and its indexes are rewritten. The estimated duration is 4m6s for 12.0 GiB. Reads and writes on the table are blocked until the transaction commits.`,
+				},
+			},
+		},
+		{
+			// Increasing the varchar length doesn't rewrite the table.
+			statement: "ALTER TABLE orders ALTER COLUMN note TYPE varchar(128);",
+			want: []result{
+				{
+					status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+					code:    common.Ok,
+					title:   `ACCESS EXCLUSIVE lock on "public"."orders"`,
+					content: `Line 1: changing the type of column "note". Reads and writes on the table are blocked until the transaction commits.`,
+				},
+			},
+		},
+		{
+			statement: "ALTER TABLE orders ADD COLUMN id uuid DEFAULT gen_random_uuid(), ADD COLUMN created_at timestamptz DEFAULT now();",
+			policy:    &storepb.LockImpactPolicy{ErrorThreshold: durationpb.New(time.Minute), RewriteThroughput: gib},
+			want: []result{
+				{
+					status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+					code:    common.Ok,
+					title:   `ACCESS EXCLUSIVE lock on "public"."orders"`,
+					content: `Line 1: adding column "id" with a volatile default, adding column "created_at". The table and its indexes are rewritten. The estimated duration is 12s for 12.0 GiB. Reads and writes on the table are blocked until the transaction commits.`,
+				},
+			},
+		},
+		{
+			statement: "ALTER TABLE orders ADD COLUMN created_at timestamptz DEFAULT now();",
+			want: []result{
+				{
+					status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+					code:    common.Ok,
+					title:   `ACCESS EXCLUSIVE lock on "public"."orders"`,
+					content: `Line 1: adding column "created_at". Reads and writes on the table are blocked until the transaction commits.`,
+				},
+			},
+		},
+		{
+			// The ACCESS EXCLUSIVE lock of adding the constraint is held while validating it in the same transaction.
+			statement: "ALTER TABLE orders ADD CONSTRAINT chk_amount CHECK (amount > 0) NOT VALID;\n\nALTER TABLE orders VALIDATE CONSTRAINT chk_amount;",
+			policy:    &storepb.LockImpactPolicy{WarningThreshold: durationpb.New(10 * time.Second), ErrorThreshold: durationpb.New(30 * time.Second)},
+			want: []result{
+				{
+					status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+					code:    common.Ok,
+					title:   `ACCESS EXCLUSIVE lock on "public"."orders"`,
+					content: `Line 1: adding check constraint "chk_amount". Reads and writes on the table are blocked until the transaction commits.`,
+				},
+				{
+					status:  storepb.PlanCheckRunResult_Result_ERROR,
+					code:    common.LockImpactTableScan,
+					title:   `ACCESS EXCLUSIVE lock on "public"."orders"`,
+					content: `Line 3: validating constraint "chk_amount". The ACCESS EXCLUSIVE lock acquired at line 1 is held until the transaction commits. The whole table is scanned. The estimated duration is 51s for 10.0 GiB. Reads and writes on the table are blocked until the transaction commits.`,
+				},
+			},
+		},
+		{
+			statement: "ALTER TABLE orders VALIDATE CONSTRAINT chk_amount;",
+			want: []result{
+				{
+					status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+					code:    common.Ok,
+					title:   `SHARE UPDATE EXCLUSIVE lock on "public"."orders"`,
+					content: `Line 1: validating constraint "chk_amount". The whole table is scanned. The estimated duration is 51s for 10.0 GiB.`,
+				},
+			},
+		},
+		{
+			statement: "ALTER TABLE orders ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id);",
+			want: []result{
+				{
+					status:  storepb.PlanCheckRunResult_Result_WARNING,
+					code:    common.LockImpactTableScan,
+					title:   `SHARE ROW EXCLUSIVE lock on "public"."orders"`,
+					content: `Line 1: adding foreign key "fk_user" without NOT VALID. The whole table is scanned. The estimated duration is 51s for 10.0 GiB. Writes on the table are blocked until the transaction commits.`,
+				},
+				{
+					status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+					code:    common.Ok,
+					title:   `SHARE ROW EXCLUSIVE lock on "public"."users"`,
+					content: `Line 1: adding referencing foreign key "fk_user". Writes on the table are blocked until the transaction commits.`,
+				},
+			},
+		},
+		{
+			statement: "CREATE INDEX idx_orders_note ON orders (note);\nCREATE INDEX CONCURRENTLY idx_orders_id ON orders (id);\nCREATE INDEX idx_users_name ON users (name);",
+			want: []result{
+				{
+					status:  storepb.PlanCheckRunResult_Result_WARNING,
+					code:    common.LockImpactTableScan,
+					title:   `SHARE lock on "public"."orders"`,
+					content: `Line 1: creating an index. The whole table is scanned. The estimated duration is 51s for 10.0 GiB. Writes on the table are blocked until the transaction commits.`,
+				},
+				{
+					status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+					code:    common.Ok,
+					title:   `SHARE UPDATE EXCLUSIVE lock on "public"."orders"`,
+					content: `Line 2: creating an index concurrently. The whole table is scanned. The estimated duration is 51s for 10.0 GiB.`,
+				},
+				{
+					status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+					code:    common.Ok,
+					title:   `SHARE lock on "public"."users"`,
+					content: `Line 3: creating an index. The whole table is scanned. The estimated duration is 0s for 1.0 KiB. Writes on the table are blocked until the transaction commits.`,
+				},
+			},
+		},
+		{
+			statement: "DROP INDEX CONCURRENTLY idx_orders_amount;\nVACUUM FULL orders;",
+			want: []result{
+				{
+					status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+					code:    common.Ok,
+					title:   `SHARE UPDATE EXCLUSIVE lock on "public"."orders"`,
+					content: `Line 1: dropping index "idx_orders_amount" concurrently.`,
+				},
+				{
+					status:  storepb.PlanCheckRunResult_Result_WARNING,
+					code:    common.LockImpactTableRewrite,
+					title:   `ACCESS EXCLUSIVE lock on "public"."orders"`,
+					content: `Line 2: running VACUUM FULL. The table and its indexes are rewritten. The estimated duration is 4m6s for 12.0 GiB. Reads and writes on the table are blocked until the statement completes.`,
+				},
+			},
+		},
+		{
+			// The table created in the same script and the filtered DML are not reported.
+			statement: "CREATE TABLE t (id int);\nALTER TABLE t ALTER COLUMN id TYPE bigint;\nINSERT INTO orders (amount) VALUES (1);\nUPDATE orders SET amount = 2 WHERE amount = 1;\nDELETE FROM users;",
+			want: []result{
+				{
+					status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+					code:    common.Ok,
+					title:   `ROW EXCLUSIVE lock on "public"."users"`,
+					content: `Line 5: deleting all rows. The whole table is scanned. The estimated duration is 0s for 1.0 KiB.`,
+				},
+			},
+		},
+		{
+			statement: "ALTER TABLE unknown_table ALTER COLUMN c SET NOT NULL;",
+			policy:    &storepb.LockImpactPolicy{ErrorThreshold: durationpb.New(time.Second)},
+			want: []result{
+				{
+					status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+					code:    common.Ok,
+					title:   `ACCESS EXCLUSIVE lock on "public"."unknown_table"`,
+					content: `Line 1: setting NOT NULL on column "c". The whole table is scanned. The duration cannot be estimated because the table size is unknown. Reads and writes on the table are blocked until the transaction commits.`,
+				},
+			},
+		},
+		{
+			statement: "ALTER TABLE orders ADD COLUMN;",
+			want: []result{
+				{
+					status:  storepb.PlanCheckRunResult_Result_ERROR,
+					code:    common.LockImpactSyntaxError,
+					title:   "Syntax error",
+					content: `syntax error at or near ";"`,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		results := postgresqlLockImpactCheck(test.statement, metadata, test.policy)
+		var got []result
+		for _, r := range results {
+			got = append(got, result{status: r.Status, code: common.Code(r.Code), title: r.Title, content: r.Content})
+		}
+		require.Equal(t, test.want, got, test.statement)
+	}
+}
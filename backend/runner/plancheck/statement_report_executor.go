@@ -4,9 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 
 	"log/slog"
 
+	snowsql "github.com/bytebase/snowsql-parser"
 	pgquery "github.com/pganalyze/pg_query_go/v4"
 	"github.com/pkg/errors"
 
@@ -18,8 +20,11 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+	snowsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/snowflake"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
 	pgrawparser "github.com/bytebase/bytebase/backend/plugin/parser/sql/engine/pg"
+	tidbparser "github.com/bytebase/bytebase/backend/plugin/parser/tidb"
+	tsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/tsql"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/store/model"
 	"github.com/bytebase/bytebase/backend/utils"
@@ -116,25 +121,26 @@ func (e *StatementReportExecutor) runForDatabaseTarget(ctx context.Context, conf
 	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
 	renderedStatement := utils.RenderStatement(statement, materials)
 
-	switch instance.Engine {
-	case storepb.Engine_POSTGRES:
-		driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
-		if err != nil {
-			return nil, err
-		}
-		defer driver.Close(ctx)
-		sqlDB := driver.GetDB()
-
-		return reportForPostgres(ctx, sqlDB, database.DatabaseName, renderedStatement, dbSchema)
-	case storepb.Engine_MYSQL, storepb.Engine_OCEANBASE:
-		driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
-		if err != nil {
-			return nil, err
-		}
-		defer driver.Close(ctx)
-		sqlDB := driver.GetDB()
+	results, err := e.runReport(ctx, instance, database, renderedStatement, dbSchema)
+	if err != nil {
+		return nil, err
+	}
+	if results == nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   "Not available",
+				Content: fmt.Sprintf("Report is not supported for %s", instance.Engine),
+			},
+		}, nil
+	}
+	return results, nil
+}
 
-		return reportForMySQL(ctx, sqlDB, instance.Engine, database.DatabaseName, renderedStatement, dbSchema)
+// runReport runs the report of the statement on the database, and returns nil if the engine is not supported.
+func (e *StatementReportExecutor) runReport(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, statement string, dbSchema *model.DBSchema) ([]*storepb.PlanCheckRunResult_Result, error) {
+	switch instance.Engine {
 	case storepb.Engine_ORACLE, storepb.Engine_DM, storepb.Engine_OCEANBASE_ORACLE:
 		schema := ""
 		if instance.Options == nil || !instance.Options.SchemaTenantMode {
@@ -143,16 +149,34 @@ func (e *StatementReportExecutor) runForDatabaseTarget(ctx context.Context, conf
 		} else {
 			schema = database.DatabaseName
 		}
-		return reportForOracle(database.DatabaseName, schema, renderedStatement, dbSchema)
+		return reportForOracle(database.DatabaseName, schema, statement, dbSchema)
+	case storepb.Engine_SNOWFLAKE:
+		return reportForSnowflake(ctx, database.DatabaseName, statement, dbSchema)
+	case storepb.Engine_POSTGRES, storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE, storepb.Engine_TIDB, storepb.Engine_MSSQL, storepb.Engine_CLICKHOUSE:
 	default:
-		return []*storepb.PlanCheckRunResult_Result{
-			{
-				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
-				Code:    common.Ok.Int32(),
-				Title:   "Not available",
-				Content: fmt.Sprintf("Report is not supported for %s", instance.Engine),
-			},
-		}, nil
+		return nil, nil
+	}
+
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+	sqlDB := driver.GetDB()
+
+	switch instance.Engine {
+	case storepb.Engine_POSTGRES:
+		return reportForPostgres(ctx, sqlDB, database.DatabaseName, statement, dbSchema)
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
+		return reportForMySQL(ctx, sqlDB, instance.Engine, database.DatabaseName, statement, dbSchema)
+	case storepb.Engine_TIDB:
+		return reportForTiDB(ctx, sqlDB, database.DatabaseName, statement, dbSchema)
+	case storepb.Engine_MSSQL:
+		return reportForMSSQL(ctx, sqlDB, database.DatabaseName, statement, dbSchema)
+	case storepb.Engine_CLICKHOUSE:
+		return reportForClickHouse(ctx, sqlDB, database.DatabaseName, statement, dbSchema)
+	default:
+		return nil, nil
 	}
 }
 
@@ -272,39 +296,7 @@ func (e *StatementReportExecutor) runForDatabaseGroupTarget(ctx context.Context,
 			materials := utils.GetSecretMapFromDatabaseMessage(database)
 			// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
 			renderedStatement := utils.RenderStatement(statement, materials)
-			stmtResults, err := func() ([]*storepb.PlanCheckRunResult_Result, error) {
-				switch instance.Engine {
-				case storepb.Engine_POSTGRES:
-					driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
-					if err != nil {
-						return nil, err
-					}
-					defer driver.Close(ctx)
-					sqlDB := driver.GetDB()
-
-					return reportForPostgres(ctx, sqlDB, database.DatabaseName, renderedStatement, dbSchema)
-				case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
-					driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
-					if err != nil {
-						return nil, err
-					}
-					defer driver.Close(ctx)
-					sqlDB := driver.GetDB()
-
-					return reportForMySQL(ctx, sqlDB, instance.Engine, database.DatabaseName, renderedStatement, dbSchema)
-				case storepb.Engine_ORACLE, storepb.Engine_DM, storepb.Engine_OCEANBASE_ORACLE:
-					schema := ""
-					if instance.Options == nil || !instance.Options.SchemaTenantMode {
-						adminSource := utils.DataSourceFromInstanceWithType(instance, api.Admin)
-						schema = adminSource.Username
-					} else {
-						schema = database.DatabaseName
-					}
-					return reportForOracle(database.DatabaseName, schema, renderedStatement, dbSchema)
-				default:
-					return nil, nil
-				}
-			}()
+			stmtResults, err := e.runReport(ctx, instance, database, renderedStatement, dbSchema)
 			if err != nil {
 				results = append(results, &storepb.PlanCheckRunResult_Result{
 					Status:  storepb.PlanCheckRunResult_Result_ERROR,
@@ -435,7 +427,7 @@ func reportForMySQL(ctx context.Context, sqlDB *sql.DB, engine storepb.Engine, d
 			}
 		}
 
-		affectedRows, err := base.GetAffectedRows(ctx, engine, stmts[0], buildGetRowsCountByQueryForMySQL(sqlDB, engine), buildGetTableDataSizeFuncForMySQL(dbMetadata))
+		affectedRows, err := base.GetAffectedRows(ctx, engine, stmts[0], buildGetRowsCountByQueryForMySQL(sqlDB, engine), buildGetTableDataSizeFunc(dbMetadata))
 		if err != nil {
 			slog.Error("failed to get affected rows for mysql", slog.String("database", databaseName), log.BBError(err))
 		} else {
@@ -463,6 +455,210 @@ func reportForMySQL(ctx context.Context, sqlDB *sql.DB, engine storepb.Engine, d
 	}, nil
 }
 
+func reportForTiDB(ctx context.Context, sqlDB *sql.DB, databaseName string, statement string, dbMetadata *model.DBSchema) ([]*storepb.PlanCheckRunResult_Result, error) {
+	nodes, err := tidbparser.ParseTiDB(statement, "", "")
+	if err != nil {
+		// nolint:nilerr
+		return newReportSyntaxErrorResults(err), nil
+	}
+
+	var statements []*reportStatement
+	for _, node := range nodes {
+		sqlType := tidbparser.GetStatementType(node)
+		statements = append(statements, &reportStatement{
+			sqlType:   sqlType,
+			resources: extractChangedResources(storepb.Engine_TIDB, databaseName, "" /* currentSchema */, sqlType, node.Text()),
+			node:      node,
+		})
+	}
+
+	getRowsCountByQuery := buildGetRowsCountByQueryForMySQL(sqlDB, storepb.Engine_TIDB)
+	return newSQLSummaryReportResults(ctx, storepb.Engine_TIDB, databaseName, statements, dbMetadata, func(ctx context.Context, stmt *reportStatement) (int64, error) {
+		return base.GetAffectedRows(ctx, storepb.Engine_TIDB, stmt.node, getRowsCountByQuery, buildGetTableDataSizeFunc(dbMetadata))
+	}), nil
+}
+
+func reportForMSSQL(ctx context.Context, sqlDB *sql.DB, databaseName string, statement string, dbMetadata *model.DBSchema) ([]*storepb.PlanCheckRunResult_Result, error) {
+	singleSQLs, err := base.SplitMultiSQL(storepb.Engine_MSSQL, statement)
+	if err != nil {
+		// nolint:nilerr
+		return newReportSyntaxErrorResults(err), nil
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+
+	var statements []*reportStatement
+	for _, stmt := range singleSQLs {
+		tree, err := tsqlparser.ParseTSQL(stmt.Text)
+		if err != nil {
+			slog.Error("failed to parse statement", slog.String("statement", stmt.Text), log.BBError(err))
+			continue
+		}
+		sqlType := tsqlparser.GetStatementType(tree)
+		statements = append(statements, &reportStatement{
+			sqlType:   sqlType,
+			resources: extractChangedResources(storepb.Engine_MSSQL, databaseName, "dbo", sqlType, stmt.Text),
+			node:      tree,
+		})
+	}
+
+	getRowsCountByQuery := func(ctx context.Context, statement string) (int64, error) {
+		return getAffectedRowsCountForMSSQL(ctx, sqlDB, statement)
+	}
+	return newSQLSummaryReportResults(ctx, storepb.Engine_MSSQL, databaseName, statements, dbMetadata, func(ctx context.Context, stmt *reportStatement) (int64, error) {
+		return base.GetAffectedRows(ctx, storepb.Engine_MSSQL, stmt.node, getRowsCountByQuery, buildGetTableDataSizeFunc(dbMetadata))
+	}), nil
+}
+
+func reportForSnowflake(ctx context.Context, databaseName string, statement string, dbMetadata *model.DBSchema) ([]*storepb.PlanCheckRunResult_Result, error) {
+	tree, err := snowsqlparser.ParseSnowSQL(statement)
+	if err != nil {
+		// nolint:nilerr
+		return newReportSyntaxErrorResults(err), nil
+	}
+	file, ok := tree.Tree.(*snowsql.Snowflake_fileContext)
+	if !ok {
+		return nil, errors.Errorf("expect snowflake file but got %T", tree.Tree)
+	}
+
+	var statements []*reportStatement
+	for _, batch := range file.AllBatch() {
+		text := tree.Tokens.GetTextFromRuleContext(batch)
+		stmt, err := snowsqlparser.ParseSnowSQL(text)
+		if err != nil {
+			slog.Error("failed to parse statement", slog.String("statement", text), log.BBError(err))
+			continue
+		}
+		sqlType := snowsqlparser.GetStatementType(stmt)
+		statements = append(statements, &reportStatement{
+			sqlType:   sqlType,
+			resources: extractChangedResources(storepb.Engine_SNOWFLAKE, databaseName, "PUBLIC", sqlType, text),
+			node:      stmt,
+		})
+	}
+
+	return newSQLSummaryReportResults(ctx, storepb.Engine_SNOWFLAKE, databaseName, statements, dbMetadata, func(ctx context.Context, stmt *reportStatement) (int64, error) {
+		// Snowflake EXPLAIN doesn't estimate the rows, so we only use the table statistics.
+		return base.GetAffectedRows(ctx, storepb.Engine_SNOWFLAKE, stmt.node, nil, buildGetTableDataSizeFunc(dbMetadata))
+	}), nil
+}
+
+func reportForClickHouse(ctx context.Context, sqlDB *sql.DB, databaseName string, statement string, dbMetadata *model.DBSchema) ([]*storepb.PlanCheckRunResult_Result, error) {
+	singleSQLs, err := base.SplitMultiSQL(storepb.Engine_CLICKHOUSE, statement)
+	if err != nil {
+		// nolint:nilerr
+		return newReportSyntaxErrorResults(err), nil
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+
+	var statements []*reportStatement
+	for _, stmt := range singleSQLs {
+		clickhouseStmt := getClickHouseStatement(stmt.Text)
+		statements = append(statements, &reportStatement{
+			sqlType:   clickhouseStmt.sqlType,
+			resources: clickhouseStmt.getResources(databaseName),
+			node:      clickhouseStmt,
+		})
+	}
+
+	return newSQLSummaryReportResults(ctx, storepb.Engine_CLICKHOUSE, databaseName, statements, dbMetadata, func(ctx context.Context, stmt *reportStatement) (int64, error) {
+		return stmt.node.(*clickhouseStatement).getAffectedRows(ctx, sqlDB, dbMetadata)
+	}), nil
+}
+
+// reportStatement is a statement in the SQL summary report.
+type reportStatement struct {
+	sqlType   string
+	resources []base.SchemaResource
+	// node is the parse result of the statement for estimating the affected rows.
+	node any
+}
+
+// extractChangedResources returns the resources changed by the statement other than DML.
+// The failure is logged rather than returned, so that the report still covers the other statements.
+func extractChangedResources(engine storepb.Engine, databaseName, schemaName, sqlType, statement string) []base.SchemaResource {
+	if isDML(sqlType) {
+		return nil
+	}
+	resources, err := base.ExtractChangedResources(engine, databaseName, schemaName, statement)
+	if err != nil {
+		slog.Error("failed to extract changed resources", slog.String("statement", statement), log.BBError(err))
+		return nil
+	}
+	return resources
+}
+
+// newSQLSummaryReportResults summarizes the statement types, the affected rows and the changed resources of the statements.
+// getAffectedRows estimates the affected rows of a statement for the engine, and the statements failing the estimation are not counted.
+func newSQLSummaryReportResults(ctx context.Context, engine storepb.Engine, databaseName string, statements []*reportStatement, dbMetadata *model.DBSchema, getAffectedRows func(context.Context, *reportStatement) (int64, error)) []*storepb.PlanCheckRunResult_Result {
+	sqlTypeSet := map[string]struct{}{}
+	var totalAffectedRows int64
+	var changedResources []base.SchemaResource
+	for _, stmt := range statements {
+		sqlTypeSet[stmt.sqlType] = struct{}{}
+		changedResources = append(changedResources, stmt.resources...)
+
+		affectedRows, err := getAffectedRows(ctx, stmt)
+		if err != nil {
+			slog.Error("failed to get affected rows", slog.String("engine", engine.String()), slog.String("database", databaseName), log.BBError(err))
+		} else {
+			totalAffectedRows += affectedRows
+		}
+	}
+
+	changedResources = sortAndDeduplicateResources(changedResources)
+
+	var sqlTypes []string
+	for sqlType := range sqlTypeSet {
+		sqlTypes = append(sqlTypes, sqlType)
+	}
+	return []*storepb.PlanCheckRunResult_Result{
+		{
+			Status: storepb.PlanCheckRunResult_Result_SUCCESS,
+			Code:   common.Ok.Int32(),
+			Title:  "OK",
+			Report: &storepb.PlanCheckRunResult_Result_SqlSummaryReport_{
+				SqlSummaryReport: &storepb.PlanCheckRunResult_Result_SqlSummaryReport{
+					StatementTypes:   sqlTypes,
+					AffectedRows:     int32(totalAffectedRows),
+					ChangedResources: convertToChangedResources(dbMetadata, changedResources),
+				},
+			},
+		},
+	}
+}
+
+func newReportSyntaxErrorResults(err error) []*storepb.PlanCheckRunResult_Result {
+	return []*storepb.PlanCheckRunResult_Result{
+		{
+			Status:  storepb.PlanCheckRunResult_Result_ERROR,
+			Title:   "Syntax error",
+			Content: err.Error(),
+			Code:    0,
+			Report: &storepb.PlanCheckRunResult_Result_SqlSummaryReport_{
+				SqlSummaryReport: &storepb.PlanCheckRunResult_Result_SqlSummaryReport{
+					Code: advisor.StatementSyntaxError.Int32(),
+				},
+			},
+		},
+	}
+}
+
+// sortAndDeduplicateResources orders the resources by (db, schema, table) as convertToChangedResources requires,
+// and removes the tables changed by more than one statement.
+func sortAndDeduplicateResources(resources []base.SchemaResource) []base.SchemaResource {
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].String() < resources[j].String()
+	})
+	var result []base.SchemaResource
+	for _, resource := range resources {
+		if len(result) > 0 && result[len(result)-1].String() == resource.String() {
+			continue
+		}
+		result = append(result, resource)
+	}
+	return result
+}
+
 func isDML(tp string) bool {
 	switch tp {
 	case "REPLACE", "INSERT", "UPDATE", "DELETE":
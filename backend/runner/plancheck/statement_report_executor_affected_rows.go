@@ -13,6 +13,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/store/model"
 
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
@@ -35,7 +36,7 @@ func getTableDataSize(metadata *storepb.DatabaseSchemaMetadata, schemaName, tabl
 	return 0
 }
 
-func buildGetTableDataSizeFuncForMySQL(metadata *model.DBSchema) func(schemaName, tableName string) int64 {
+func buildGetTableDataSizeFunc(metadata *model.DBSchema) func(schemaName, tableName string) int64 {
 	return func(schemaName, tableName string) int64 {
 		if metadata == nil {
 			return 0
@@ -63,6 +64,8 @@ func buildGetRowsCountByQueryForMySQL(sqlDB *sql.DB, engine storepb.Engine) func
 			return getAffectedRowsCount(ctx, sqlDB, fmt.Sprintf("EXPLAIN FORMAT=JSON %s", statement), getAffectedRowsCountForOceanBase)
 		case storepb.Engine_MYSQL, storepb.Engine_MARIADB:
 			return getAffectedRowsCount(ctx, sqlDB, fmt.Sprintf("EXPLAIN %s", statement), getAffectedRowsCountForMysql)
+		case storepb.Engine_TIDB:
+			return getAffectedRowsCount(ctx, sqlDB, fmt.Sprintf("EXPLAIN %s", statement), getAffectedRowsCountForTiDB)
 		default:
 			return 0, errors.Errorf("engine %v is not supported", engine)
 		}
@@ -390,3 +393,111 @@ func getAffectedRowsCountForMysql(res []any) (int64, error) {
 
 	return 0, errors.Errorf("failed to extract rows from query plan")
 }
+
+func getAffectedRowsCountForTiDB(res []any) (int64, error) {
+	// the res struct is []any{columnName, columnTable, rowDataList}
+	if len(res) != 3 {
+		return 0, errors.Errorf("expected 3 but got %d", len(res))
+	}
+	rowList, ok := res[2].([]any)
+	if !ok {
+		return 0, errors.Errorf("expected []any but got %t", res[2])
+	}
+
+	// TiDB EXPLAIN statement result has 5 columns.
+	// the column 1 is the estimated rows 'estRows'.
+	// the first numeric value of column 1 is the affected rows count, the root DML operator has the N/A estRows.
+	//
+	// mysql> explain delete from t where a > 1;
+	// +---------------------------+---------+-----------+---------------+--------------------------------+
+	// | id                        | estRows | task      | access object | operator info                  |
+	// +---------------------------+---------+-----------+---------------+--------------------------------+
+	// | Delete_4                  | N/A     | root      |               | N/A                            |
+	// | └─TableReader_8           | 3333.33 | root      |               | data:Selection_7               |
+	// |   └─Selection_7           | 3333.33 | cop[tikv] |               | gt(test.t.a, 1)                |
+	// |     └─TableFullScan_6     | 10000.00| cop[tikv] | table:t       | keep order:false, stats:pseudo |
+	// +---------------------------+---------+-----------+---------------+--------------------------------+
+	for _, rowAny := range rowList {
+		row, ok := rowAny.([]any)
+		if !ok {
+			return 0, errors.Errorf("expected []any but got %t", row)
+		}
+		if len(row) != 5 {
+			return 0, errors.Errorf("expected 5 but got %d", len(row))
+		}
+		col, ok := row[1].(string)
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseFloat(col, 64)
+		if err != nil {
+			continue
+		}
+		return int64(v), nil
+	}
+
+	return 0, errors.Errorf("failed to extract rows from query plan")
+}
+
+// getAffectedRowsCountForMSSQL returns the estimated rows of the statement plan.
+// SHOWPLAN_ALL is a session option, so we use a dedicated connection and turn it off before returning the connection to the pool.
+// If SHOWPLAN_ALL fails to be turned off, the connection is discarded, otherwise the later statements on the connection
+// would silently do nothing.
+func getAffectedRowsCountForMSSQL(ctx context.Context, sqlDB *sql.DB, statement string) (int64, error) {
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SET SHOWPLAN_ALL ON"); err != nil {
+		return 0, err
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.WithoutCancel(ctx), "SET SHOWPLAN_ALL OFF"); err != nil {
+			util.DiscardConn(conn)
+		}
+	}()
+
+	rows, err := conn.QueryContext(ctx, statement)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	estimateRowsIndex := -1
+	for i, column := range columns {
+		if strings.EqualFold(column, "EstimateRows") {
+			estimateRowsIndex = i
+			break
+		}
+	}
+	if estimateRowsIndex < 0 {
+		return 0, errors.Errorf("failed to find the EstimateRows column in the query plan")
+	}
+
+	// The first row is the statement itself, and its EstimateRows is the estimated affected rows.
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return 0, err
+		}
+		return 0, errors.Errorf("not found any data")
+	}
+	values := make([]any, len(columns))
+	var estimateRows sql.NullFloat64
+	for i := range values {
+		if i == estimateRowsIndex {
+			values[i] = &estimateRows
+			continue
+		}
+		values[i] = new(any)
+	}
+	if err := rows.Scan(values...); err != nil {
+		return 0, err
+	}
+	return int64(estimateRows.Float64), nil
+}
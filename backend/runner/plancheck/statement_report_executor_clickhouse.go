@@ -0,0 +1,197 @@
+package plancheck
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+// We don't have a ClickHouse parser, so the statement report for ClickHouse is based on the leading keywords of the statements.
+const clickhouseTableNamePattern = "((?:`[^`]+`|\"[^\"]+\"|[A-Za-z_][A-Za-z0-9_]*)(?:\\.(?:`[^`]+`|\"[^\"]+\"|[A-Za-z_][A-Za-z0-9_]*))?)"
+
+var (
+	clickhouseCreateTableRegexp  = regexp.MustCompile(`(?is)^CREATE\s+(?:OR\s+REPLACE\s+)?(?:TEMPORARY\s+)?TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?` + clickhouseTableNamePattern)
+	clickhouseAlterTableRegexp   = regexp.MustCompile(`(?is)^ALTER\s+TABLE\s+` + clickhouseTableNamePattern)
+	clickhouseDropTableRegexp    = regexp.MustCompile(`(?is)^DROP\s+(?:TEMPORARY\s+)?TABLE\s+(?:IF\s+EXISTS\s+)?` + clickhouseTableNamePattern)
+	clickhouseTruncateRegexp     = regexp.MustCompile(`(?is)^TRUNCATE\s+(?:TEMPORARY\s+)?(?:TABLE\s+)?(?:IF\s+EXISTS\s+)?` + clickhouseTableNamePattern)
+	clickhouseRenameTableRegexp  = regexp.MustCompile(`(?is)^RENAME\s+TABLE\s+(.+)$`)
+	clickhouseRenamePairRegexp   = regexp.MustCompile(`(?is)^\s*` + clickhouseTableNamePattern + `\s+TO\s+` + clickhouseTableNamePattern)
+	clickhouseInsertRegexp       = regexp.MustCompile(`(?is)^INSERT\s+INTO\s+(?:TABLE\s+)?` + clickhouseTableNamePattern)
+	clickhouseDeleteRegexp       = regexp.MustCompile(`(?is)^DELETE\s+FROM\s+` + clickhouseTableNamePattern)
+	clickhouseMutationRegexp     = regexp.MustCompile(`(?is)\b(DELETE|UPDATE)\b.*?\bWHERE\b(.+)$`)
+	clickhouseWhereRegexp        = regexp.MustCompile(`(?is)\bWHERE\b(.+)$`)
+	clickhouseInsertSelectRegexp = regexp.MustCompile(`(?is)^INSERT\s+INTO\s+(?:TABLE\s+)?` + clickhouseTableNamePattern + `\s*(?:\([^)]*\))?\s*((?:SELECT|WITH)\b.+)$`)
+	clickhouseIdentifierRegexp   = regexp.MustCompile("`[^`]+`|\"[^\"]+\"|[A-Za-z_][A-Za-z0-9_]*")
+	clickhouseKeywordsRegexp     = regexp.MustCompile(`(?is)^(CREATE|ALTER|DROP)\s+(?:OR\s+REPLACE\s+)?(?:TEMPORARY\s+)?(MATERIALIZED\s+VIEW|DATABASE|DICTIONARY|FUNCTION|VIEW|TABLE)\b`)
+)
+
+// clickhouseStatement is the summary of a ClickHouse statement.
+type clickhouseStatement struct {
+	sqlType string
+	// tables are the tables referenced by the statement, in the "database.table" or "table" format.
+	tables []string
+	// condition is the WHERE condition of the DELETE and UPDATE statements.
+	condition string
+	// query is the SELECT query of the INSERT ... SELECT statements.
+	query string
+}
+
+func getClickHouseStatement(statement string) *clickhouseStatement {
+	statement = strings.TrimRight(strings.TrimSpace(statement), ";")
+	switch {
+	case clickhouseCreateTableRegexp.MatchString(statement):
+		return &clickhouseStatement{
+			sqlType: "CREATE_TABLE",
+			tables:  []string{clickhouseCreateTableRegexp.FindStringSubmatch(statement)[1]},
+		}
+	case clickhouseAlterTableRegexp.MatchString(statement):
+		s := &clickhouseStatement{
+			sqlType: "ALTER_TABLE",
+			tables:  []string{clickhouseAlterTableRegexp.FindStringSubmatch(statement)[1]},
+		}
+		// ALTER TABLE ... DELETE WHERE and ALTER TABLE ... UPDATE ... WHERE are the mutations.
+		if matches := clickhouseMutationRegexp.FindStringSubmatch(statement); matches != nil {
+			s.sqlType = strings.ToUpper(matches[1])
+			s.condition = strings.TrimSpace(matches[2])
+		}
+		return s
+	case clickhouseDropTableRegexp.MatchString(statement):
+		return &clickhouseStatement{
+			sqlType: "DROP_TABLE",
+			tables:  []string{clickhouseDropTableRegexp.FindStringSubmatch(statement)[1]},
+		}
+	case clickhouseTruncateRegexp.MatchString(statement):
+		return &clickhouseStatement{
+			sqlType: "TRUNCATE",
+			tables:  []string{clickhouseTruncateRegexp.FindStringSubmatch(statement)[1]},
+		}
+	case clickhouseRenameTableRegexp.MatchString(statement):
+		s := &clickhouseStatement{sqlType: "RENAME"}
+		for _, pair := range strings.Split(clickhouseRenameTableRegexp.FindStringSubmatch(statement)[1], ",") {
+			if matches := clickhouseRenamePairRegexp.FindStringSubmatch(pair); matches != nil {
+				s.tables = append(s.tables, matches[1], matches[2])
+			}
+		}
+		return s
+	case clickhouseInsertRegexp.MatchString(statement):
+		s := &clickhouseStatement{
+			sqlType: "INSERT",
+			tables:  []string{clickhouseInsertRegexp.FindStringSubmatch(statement)[1]},
+		}
+		if matches := clickhouseInsertSelectRegexp.FindStringSubmatch(statement); matches != nil {
+			s.query = matches[2]
+		}
+		return s
+	case clickhouseDeleteRegexp.MatchString(statement):
+		s := &clickhouseStatement{
+			sqlType: "DELETE",
+			tables:  []string{clickhouseDeleteRegexp.FindStringSubmatch(statement)[1]},
+		}
+		if matches := clickhouseWhereRegexp.FindStringSubmatch(statement); matches != nil {
+			s.condition = strings.TrimSpace(matches[1])
+		}
+		return s
+	case clickhouseKeywordsRegexp.MatchString(statement):
+		matches := clickhouseKeywordsRegexp.FindStringSubmatch(statement)
+		object := strings.Join(strings.Fields(strings.ToUpper(matches[2])), "_")
+		if object == "MATERIALIZED_VIEW" {
+			object = "VIEW"
+		}
+		return &clickhouseStatement{sqlType: fmt.Sprintf("%s_%s", strings.ToUpper(matches[1]), object)}
+	default:
+		return &clickhouseStatement{sqlType: "UNKNOWN"}
+	}
+}
+
+// getResources returns the changed tables of the DDL statements.
+func (s *clickhouseStatement) getResources(databaseName string) []base.SchemaResource {
+	if isDML(s.sqlType) {
+		return nil
+	}
+	var resources []base.SchemaResource
+	for _, table := range s.tables {
+		database, tableName := splitClickHouseTableName(table)
+		if database == "" {
+			database = databaseName
+		}
+		resources = append(resources, base.SchemaResource{
+			Database: database,
+			Table:    tableName,
+		})
+	}
+	return resources
+}
+
+// getAffectedRows returns the estimated affected rows of the statement.
+// The mutations and INSERT ... SELECT are estimated by EXPLAIN ESTIMATE, and the other changes to the tables use the table rows.
+func (s *clickhouseStatement) getAffectedRows(ctx context.Context, sqlDB *sql.DB, dbMetadata *model.DBSchema) (int64, error) {
+	switch s.sqlType {
+	case "INSERT":
+		if s.query == "" {
+			return 0, nil
+		}
+		return getClickHouseEstimatedRows(ctx, sqlDB, s.query)
+	case "DELETE", "UPDATE":
+		if s.condition == "" {
+			return 0, nil
+		}
+		return getClickHouseEstimatedRows(ctx, sqlDB, fmt.Sprintf("SELECT 1 FROM %s WHERE %s", s.tables[0], s.condition))
+	case "ALTER_TABLE", "DROP_TABLE", "TRUNCATE":
+		getTableDataSize := buildGetTableDataSizeFunc(dbMetadata)
+		var total int64
+		for _, table := range s.tables {
+			_, tableName := splitClickHouseTableName(table)
+			total += getTableDataSize("", tableName)
+		}
+		return total, nil
+	default:
+		return 0, nil
+	}
+}
+
+// getClickHouseEstimatedRows returns the rows to read by the query, the result of EXPLAIN ESTIMATE has the
+// database, table, parts, rows and marks columns for each table.
+func getClickHouseEstimatedRows(ctx context.Context, sqlDB *sql.DB, query string) (int64, error) {
+	rows, err := sqlDB.QueryContext(ctx, fmt.Sprintf("EXPLAIN ESTIMATE %s", query))
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var total int64
+	for rows.Next() {
+		var database, table string
+		var parts, rowCount, marks uint64
+		if err := rows.Scan(&database, &table, &parts, &rowCount, &marks); err != nil {
+			return 0, err
+		}
+		total += int64(rowCount)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func splitClickHouseTableName(table string) (string, string) {
+	var parts []string
+	for _, part := range clickhouseIdentifierRegexp.FindAllString(table, -1) {
+		if len(part) >= 2 && (part[0] == '`' || part[0] == '"') {
+			part = part[1 : len(part)-1]
+		}
+		parts = append(parts, part)
+	}
+	switch len(parts) {
+	case 1:
+		return "", parts[0]
+	case 2:
+		return parts[0], parts[1]
+	default:
+		return "", table
+	}
+}
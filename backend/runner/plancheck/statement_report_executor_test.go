@@ -0,0 +1,216 @@
+package plancheck
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	// Register the ClickHouse splitter.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/standard"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+type statementReportTest struct {
+	statement      string
+	statementTypes []string
+	affectedRows   int32
+	// changedTables is in the "schema.table:tableRows" format.
+	changedTables []string
+}
+
+func TestReportForTiDB(t *testing.T) {
+	tests := []statementReportTest{
+		{
+			statement:      "CREATE TABLE t3(a int); ALTER TABLE t1 ADD COLUMN b int; INSERT INTO t2 VALUES (1), (2), (3);",
+			statementTypes: []string{"ALTER_TABLE", "CREATE_TABLE", "INSERT"},
+			affectedRows:   103,
+			changedTables:  []string{".t1:100", ".t3:0"},
+		},
+		{
+			statement:      "DROP TABLE t1, t2; RENAME TABLE t3 TO t4; TRUNCATE TABLE t1;",
+			statementTypes: []string{"DROP_TABLE", "RENAME", "TRUNCATE"},
+			affectedRows:   1200,
+			changedTables:  []string{".t1:100", ".t2:1000", ".t3:0", ".t4:0"},
+		},
+	}
+
+	dbSchema := getMetadataForStatementReportTest("")
+	for _, test := range tests {
+		results, err := reportForTiDB(context.Background(), nil, "db", test.statement, dbSchema)
+		require.NoError(t, err)
+		requireStatementReport(t, test, results)
+	}
+}
+
+func TestReportForMSSQL(t *testing.T) {
+	tests := []statementReportTest{
+		{
+			statement:      "CREATE TABLE t3(a int);\nALTER TABLE [dbo].[t1] ADD b int;\nINSERT INTO t2 VALUES (1), (2);",
+			statementTypes: []string{"ALTER_TABLE", "CREATE_TABLE", "INSERT"},
+			affectedRows:   102,
+			changedTables:  []string{"dbo.t1:100", "dbo.t3:0"},
+		},
+		{
+			statement:      "DROP TABLE t1, dbo.t2;\nTRUNCATE TABLE t1;\nCREATE INDEX idx_a ON sales.t4(a);",
+			statementTypes: []string{"CREATE_INDEX", "DROP_TABLE", "TRUNCATE"},
+			affectedRows:   1200,
+			changedTables:  []string{"dbo.t1:100", "dbo.t2:1000", "sales.t4:0"},
+		},
+	}
+
+	dbSchema := getMetadataForStatementReportTest("dbo")
+	for _, test := range tests {
+		results, err := reportForMSSQL(context.Background(), nil, "db", test.statement, dbSchema)
+		require.NoError(t, err)
+		requireStatementReport(t, test, results)
+	}
+}
+
+func TestReportForSnowflake(t *testing.T) {
+	tests := []statementReportTest{
+		{
+			statement:      "CREATE TABLE t3(a int); ALTER TABLE t1 ADD COLUMN b int; INSERT INTO t2 VALUES (1), (2);",
+			statementTypes: []string{"ALTER_TABLE", "CREATE_TABLE", "INSERT"},
+			affectedRows:   102,
+			changedTables:  []string{"PUBLIC.T1:100", "PUBLIC.T3:0"},
+		},
+		{
+			statement:      "DELETE FROM t2; UPDATE t1 SET a = 1; DROP TABLE public.t1; TRUNCATE TABLE \"t4\";",
+			statementTypes: []string{"DELETE", "DROP_TABLE", "TRUNCATE", "UPDATE"},
+			affectedRows:   1200,
+			changedTables:  []string{"PUBLIC.T1:100", "PUBLIC.t4:0"},
+		},
+	}
+
+	dbSchema := getMetadataForStatementReportTest("PUBLIC")
+	for _, test := range tests {
+		results, err := reportForSnowflake(context.Background(), "DB", test.statement, dbSchema)
+		require.NoError(t, err)
+		requireStatementReport(t, test, results)
+	}
+}
+
+func TestReportForClickHouse(t *testing.T) {
+	tests := []statementReportTest{
+		{
+			statement:      "CREATE TABLE IF NOT EXISTS db.t3 (a Int32) ENGINE = MergeTree ORDER BY a;\nALTER TABLE `t1` ADD COLUMN b Int32;\nINSERT INTO t2 VALUES (1);",
+			statementTypes: []string{"ALTER_TABLE", "CREATE_TABLE", "INSERT"},
+			affectedRows:   100,
+			changedTables:  []string{".t1:100", ".t3:0"},
+		},
+		{
+			statement:      "DROP TABLE IF EXISTS t1;\nTRUNCATE TABLE t2;\nRENAME TABLE t3 TO t4;\nCREATE MATERIALIZED VIEW mv AS SELECT 1;",
+			statementTypes: []string{"CREATE_VIEW", "DROP_TABLE", "RENAME", "TRUNCATE"},
+			affectedRows:   1100,
+			changedTables:  []string{".t1:100", ".t2:1000", ".t3:0", ".t4:0"},
+		},
+	}
+
+	dbSchema := getMetadataForStatementReportTest("")
+	for _, test := range tests {
+		results, err := reportForClickHouse(context.Background(), nil, "db", test.statement, dbSchema)
+		require.NoError(t, err)
+		requireStatementReport(t, test, results)
+	}
+}
+
+func TestGetClickHouseStatement(t *testing.T) {
+	tests := []struct {
+		statement string
+		sqlType   string
+		condition string
+		query     string
+	}{
+		{
+			statement: "ALTER TABLE t1 DELETE WHERE a > 1",
+			sqlType:   "DELETE",
+			condition: "a > 1",
+		},
+		{
+			statement: "ALTER TABLE t1 ON CLUSTER c UPDATE b = 1 WHERE a = 2;",
+			sqlType:   "UPDATE",
+			condition: "a = 2",
+		},
+		{
+			statement: "DELETE FROM t1 WHERE a = 1",
+			sqlType:   "DELETE",
+			condition: "a = 1",
+		},
+		{
+			statement: "INSERT INTO t1 (a, b) SELECT a, b FROM t2",
+			sqlType:   "INSERT",
+			query:     "SELECT a, b FROM t2",
+		},
+		{
+			statement: "INSERT INTO t1 VALUES ('select')",
+			sqlType:   "INSERT",
+		},
+		{
+			statement: "OPTIMIZE TABLE t1 FINAL",
+			sqlType:   "UNKNOWN",
+		},
+	}
+
+	for _, test := range tests {
+		stmt := getClickHouseStatement(test.statement)
+		require.Equal(t, test.sqlType, stmt.sqlType, test.statement)
+		require.Equal(t, test.condition, stmt.condition, test.statement)
+		require.Equal(t, test.query, stmt.query, test.statement)
+	}
+}
+
+func requireStatementReport(t *testing.T, test statementReportTest, results []*storepb.PlanCheckRunResult_Result) {
+	require.Len(t, results, 1)
+	require.Equal(t, storepb.PlanCheckRunResult_Result_SUCCESS, results[0].Status, test.statement)
+	report := results[0].GetSqlSummaryReport()
+	require.NotNil(t, report)
+
+	statementTypes := report.StatementTypes
+	sort.Strings(statementTypes)
+	require.Equal(t, test.statementTypes, statementTypes, test.statement)
+	require.Equal(t, test.affectedRows, report.AffectedRows, test.statement)
+
+	var changedTables []string
+	for _, database := range report.ChangedResources.GetDatabases() {
+		for _, schema := range database.Schemas {
+			for _, table := range schema.Tables {
+				changedTables = append(changedTables, schema.Name+"."+table.Name+":"+fmt.Sprint(table.TableRows))
+			}
+		}
+	}
+	require.Equal(t, test.changedTables, changedTables, test.statement)
+}
+
+func getMetadataForStatementReportTest(schemaName string) *model.DBSchema {
+	tableName := func(name string) string {
+		// Snowflake stores the unquoted identifiers in upper case.
+		if schemaName == "PUBLIC" {
+			return strings.ToUpper(name)
+		}
+		return name
+	}
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: schemaName,
+				Tables: []*storepb.TableMetadata{
+					{
+						Name:     tableName("t1"),
+						RowCount: 100,
+					},
+					{
+						Name:     tableName("t2"),
+						RowCount: 1000,
+					},
+				},
+			},
+		},
+	}
+	return model.NewDBSchema(metadata, nil /* schema */, nil /* config */)
+}
@@ -121,7 +121,7 @@ func (e *StatementTypeExecutor) runForDatabaseTarget(ctx context.Context, config
 
 	var results []*storepb.PlanCheckRunResult_Result
 	switch instance.Engine {
-	case storepb.Engine_POSTGRES, storepb.Engine_RISINGWAVE:
+	case storepb.Engine_POSTGRES, storepb.Engine_RISINGWAVE, storepb.Engine_COCKROACHDB:
 		checkResults, err := postgresqlStatementTypeCheck(renderedStatement, changeType)
 		if err != nil {
 			return nil, err
@@ -296,7 +296,7 @@ func (e *StatementTypeExecutor) runForDatabaseGroupTarget(ctx context.Context, c
 			stmtResults, err := func() ([]*storepb.PlanCheckRunResult_Result, error) {
 				var results []*storepb.PlanCheckRunResult_Result
 				switch instance.Engine {
-				case storepb.Engine_POSTGRES, storepb.Engine_RISINGWAVE:
+				case storepb.Engine_POSTGRES, storepb.Engine_RISINGWAVE, storepb.Engine_COCKROACHDB:
 					checkResults, err := postgresqlStatementTypeCheck(renderedStatement, changeType)
 					if err != nil {
 						return nil, err
@@ -0,0 +1,217 @@
+package schemasync
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/sensitivedata"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// columnKey is the key of a column in a database.
+type columnKey struct {
+	schema string
+	table  string
+	column string
+}
+
+// DiscoverSensitiveData scans all columns of the database for sensitive data and upserts the pending proposals.
+func (s *Syncer) DiscoverSensitiveData(ctx context.Context, database *store.DatabaseMessage) error {
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get instance %q", database.InstanceID)
+	}
+	if instance == nil {
+		return errors.Errorf("instance %q not found", database.InstanceID)
+	}
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get database schema for database %q", database.DatabaseName)
+	}
+	if dbSchema == nil {
+		return errors.Errorf("database schema for database %q not found", database.DatabaseName)
+	}
+
+	var driver db.Driver
+	if database.Metadata.GetSensitiveDataDiscovery().GetSampleSize() > 0 {
+		driver, err = s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, "" /* dataSourceID */)
+		if err != nil {
+			return err
+		}
+		defer driver.Close(ctx)
+	}
+	return s.discoverSensitiveData(ctx, driver, instance, database, dbSchema.GetMetadata(), nil /* columns */)
+}
+
+// discoverSensitiveData detects the sensitive data in the columns and upserts the pending proposals.
+// All columns are scanned if columns is nil. The values are sampled only if the driver is not nil and the database opts in.
+func (s *Syncer) discoverSensitiveData(ctx context.Context, driver db.Driver, instance *store.InstanceMessage, database *store.DatabaseMessage, metadata *storepb.DatabaseSchemaMetadata, columns map[columnKey]bool) error {
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project %q", database.ProjectID)
+	}
+	var classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig
+	if project != nil && project.DataClassificationConfigID != "" {
+		setting, err := s.store.GetDataClassificationSetting(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get data classification setting")
+		}
+		for _, config := range setting.GetConfigs() {
+			if config.Id == project.DataClassificationConfigID {
+				classificationConfig = config
+				break
+			}
+		}
+	}
+
+	sampleSize := int(database.Metadata.GetSensitiveDataDiscovery().GetSampleSize())
+	if sampleSize > sensitivedata.MaxSampleSize {
+		sampleSize = sensitivedata.MaxSampleSize
+	}
+
+	var proposals []*store.SensitiveDataProposalMessage
+	for _, schema := range metadata.GetSchemas() {
+		for _, table := range schema.Tables {
+			for _, column := range table.Columns {
+				if columns != nil && !columns[columnKey{schema: schema.Name, table: table.Name, column: column.Name}] {
+					continue
+				}
+				// Skip the columns which are classified by the comment.
+				if column.Classification != "" {
+					continue
+				}
+				detectColumn := &sensitivedata.Column{
+					Name: column.Name,
+					Type: column.Type,
+				}
+				if driver != nil && sampleSize > 0 {
+					values, err := sampleColumnValues(ctx, driver, instance.Engine, schema.Name, table.Name, column.Name, sampleSize)
+					if err != nil {
+						slog.Debug("Failed to sample column values",
+							slog.String("instance", instance.ResourceID),
+							slog.String("database", database.DatabaseName),
+							slog.String("table", table.Name),
+							slog.String("column", column.Name),
+							log.BBError(err))
+					}
+					detectColumn.Values = values
+				}
+				payload := sensitivedata.Detect(detectColumn)
+				if payload == nil {
+					continue
+				}
+				payload.ClassificationId = sensitivedata.MatchClassification(payload.Category, classificationConfig)
+				proposals = append(proposals, &store.SensitiveDataProposalMessage{
+					DatabaseUID: database.UID,
+					SchemaName:  schema.Name,
+					TableName:   table.Name,
+					ColumnName:  column.Name,
+					Payload:     payload,
+				})
+			}
+		}
+	}
+
+	return s.store.UpsertSensitiveDataProposals(ctx, proposals, api.SystemBotID)
+}
+
+// sampleColumnValues samples at most limit non-null values of the column.
+func sampleColumnValues(ctx context.Context, driver db.Driver, engine storepb.Engine, schemaName, tableName, columnName string, limit int) ([]string, error) {
+	statement, err := getSampleStatement(engine, schemaName, tableName, columnName, limit)
+	if err != nil {
+		return nil, err
+	}
+	sqlDB := driver.GetDB()
+	if sqlDB == nil {
+		return nil, errors.Errorf("engine %s doesn't support sampling column values", engine)
+	}
+	rows, err := sqlDB.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var values []string
+	for rows.Next() {
+		var value sql.NullString
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		if value.Valid {
+			values = append(values, value.String)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func getSampleStatement(engine storepb.Engine, schemaName, tableName, columnName string, limit int) (string, error) {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE, storepb.Engine_STARROCKS, storepb.Engine_DORIS:
+		column := fmt.Sprintf("`%s`", strings.ReplaceAll(columnName, "`", "``"))
+		table := fmt.Sprintf("`%s`", strings.ReplaceAll(tableName, "`", "``"))
+		return fmt.Sprintf("SELECT %s FROM %s WHERE %s IS NOT NULL LIMIT %d", column, table, column, limit), nil
+	case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_RISINGWAVE, storepb.Engine_COCKROACHDB, storepb.Engine_SNOWFLAKE:
+		column := quoteDoubleQuoteIdentifier(columnName)
+		table := quoteDoubleQuoteIdentifier(tableName)
+		if schemaName != "" {
+			table = fmt.Sprintf("%s.%s", quoteDoubleQuoteIdentifier(schemaName), table)
+		}
+		return fmt.Sprintf("SELECT %s FROM %s WHERE %s IS NOT NULL LIMIT %d", column, table, column, limit), nil
+	case storepb.Engine_MSSQL:
+		column := fmt.Sprintf("[%s]", strings.ReplaceAll(columnName, "]", "]]"))
+		table := fmt.Sprintf("[%s]", strings.ReplaceAll(tableName, "]", "]]"))
+		if schemaName != "" {
+			table = fmt.Sprintf("[%s].%s", strings.ReplaceAll(schemaName, "]", "]]"), table)
+		}
+		return fmt.Sprintf("SELECT TOP %d %s FROM %s WHERE %s IS NOT NULL", limit, column, table, column), nil
+	case storepb.Engine_ORACLE, storepb.Engine_DM, storepb.Engine_OCEANBASE_ORACLE:
+		column := quoteDoubleQuoteIdentifier(columnName)
+		table := quoteDoubleQuoteIdentifier(tableName)
+		if schemaName != "" {
+			table = fmt.Sprintf("%s.%s", quoteDoubleQuoteIdentifier(schemaName), table)
+		}
+		return fmt.Sprintf("SELECT %s FROM %s WHERE %s IS NOT NULL AND ROWNUM <= %d", column, table, column, limit), nil
+	default:
+		return "", errors.Errorf("engine %s doesn't support sampling column values", engine)
+	}
+}
+
+func quoteDoubleQuoteIdentifier(identifier string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+}
+
+// getNewColumns returns the columns in the new metadata but not in the old one.
+func getNewColumns(oldMetadata, newMetadata *storepb.DatabaseSchemaMetadata) map[columnKey]bool {
+	oldColumns := make(map[columnKey]bool)
+	for _, schema := range oldMetadata.GetSchemas() {
+		for _, table := range schema.Tables {
+			for _, column := range table.Columns {
+				oldColumns[columnKey{schema: schema.Name, table: table.Name, column: column.Name}] = true
+			}
+		}
+	}
+	newColumns := make(map[columnKey]bool)
+	for _, schema := range newMetadata.GetSchemas() {
+		for _, table := range schema.Tables {
+			for _, column := range table.Columns {
+				key := columnKey{schema: schema.Name, table: table.Name, column: column.Name}
+				if !oldColumns[key] {
+					newColumns[key] = true
+				}
+			}
+		}
+	}
+	return newColumns
+}
@@ -407,6 +407,7 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 	if dbSchema != nil {
 		oldDatabaseMetadata = dbSchema.GetMetadata()
 		rawDump = dbSchema.GetSchema()
+		setClassificationFromConfig(databaseMetadata, dbSchema.GetConfig())
 	}
 
 	if force || !cmp.Equal(oldDatabaseMetadata, databaseMetadata, protocmp.Transform()) {
@@ -427,6 +428,18 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 		); err != nil {
 			return errors.Wrapf(err, "failed to upsert database schema for database %q", database.DatabaseName)
 		}
+
+		// Scan the new columns for sensitive data.
+		if s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil {
+			if newColumns := getNewColumns(oldDatabaseMetadata, databaseMetadata); len(newColumns) > 0 {
+				if err := s.discoverSensitiveData(ctx, driver, instance, database, databaseMetadata, newColumns); err != nil {
+					slog.Error("Failed to discover sensitive data",
+						slog.String("instance", instance.ResourceID),
+						slog.String("database", database.DatabaseName),
+						log.BBError(err))
+				}
+			}
+		}
 	}
 
 	// Check schema drift
@@ -451,6 +464,11 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 			return nil
 		}
 		latestSchema := string(rawDump)
+		// The MongoDB change histories recorded before MongoDB schemas were dumped have no baseline schema,
+		// so the drift is unknown until the next change history records one.
+		if len(list) > 0 && list[0].Schema == "" && instance.Engine == storepb.Engine_MONGODB {
+			return nil
+		}
 		if len(list) > 0 {
 			if list[0].Schema != latestSchema {
 				anomalyPayload := api.AnomalyDatabaseSchemaDriftPayload{
@@ -763,6 +781,33 @@ func setClassificationAndUserCommentFromComment(dbSchema *storepb.DatabaseSchema
 	}
 }
 
+// setClassificationFromConfig sets the classification accepted from sensitive data discovery for the columns without a classification in the comment.
+func setClassificationFromConfig(dbSchema *storepb.DatabaseSchemaMetadata, config *storepb.DatabaseConfig) {
+	classifications := make(map[columnKey]string)
+	for _, schemaConfig := range config.GetSchemaConfigs() {
+		for _, tableConfig := range schemaConfig.GetTableConfigs() {
+			for _, columnConfig := range tableConfig.GetColumnConfigs() {
+				if columnConfig.GetClassificationId() != "" {
+					classifications[columnKey{schema: schemaConfig.Name, table: tableConfig.Name, column: columnConfig.Name}] = columnConfig.ClassificationId
+				}
+			}
+		}
+	}
+	if len(classifications) == 0 {
+		return
+	}
+	for _, schema := range dbSchema.Schemas {
+		for _, table := range schema.Tables {
+			for _, col := range table.Columns {
+				if col.Classification != "" {
+					continue
+				}
+				col.Classification = classifications[columnKey{schema: schema.Name, table: table.Name, column: col.Name}]
+			}
+		}
+	}
+}
+
 func getOrDefaultSyncInterval(instance *store.InstanceMessage) time.Duration {
 	if !instance.Activation {
 		return defaultSyncInterval
@@ -785,7 +830,6 @@ func getOrDefaultLastSyncTime(t *timestamppb.Timestamp) time.Time {
 
 func disableSchemaDriftAnomalyCheck(dbTp storepb.Engine) bool {
 	m := map[storepb.Engine]struct{}{
-		storepb.Engine_MONGODB:          {},
 		storepb.Engine_REDIS:            {},
 		storepb.Engine_ORACLE:           {},
 		storepb.Engine_OCEANBASE_ORACLE: {},
@@ -798,7 +842,6 @@ func disableSchemaDriftAnomalyCheck(dbTp storepb.Engine) bool {
 
 func disableBackupAnomalyCheck(dbTp storepb.Engine) bool {
 	m := map[storepb.Engine]struct{}{
-		storepb.Engine_MONGODB:          {},
 		storepb.Engine_SPANNER:          {},
 		storepb.Engine_REDIS:            {},
 		storepb.Engine_ORACLE:           {},
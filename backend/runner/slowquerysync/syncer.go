@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sync"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/state"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
 	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -36,6 +38,7 @@ func NewSyncer(store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *sta
 		dbFactory: dbFactory,
 		stateCfg:  stateCfg,
 		profile:   profile,
+		snapshots: make(map[int]map[string]*storepb.SlowQueryStatistics),
 	}
 }
 
@@ -45,6 +48,12 @@ type Syncer struct {
 	dbFactory *dbfactory.DBFactory
 	stateCfg  *state.State
 	profile   config.Profile
+
+	// snapshots is the map from instance UID to the latest cumulative slow query statistics by database name,
+	// for the engines implementing db.CumulativeSlowQueryDriver.
+	// The snapshots are kept in memory, so the first sync after the server starts only takes the baseline.
+	snapshotsMu sync.Mutex
+	snapshots   map[int]map[string]*storepb.SlowQueryStatistics
 }
 
 // Run will run the slow query syncer.
@@ -119,8 +128,8 @@ func (s *Syncer) syncInstanceSlowQuery(ctx context.Context, instance *store.Inst
 	}
 
 	switch instance.Engine {
-	case storepb.Engine_MYSQL:
-		return s.syncMySQLSlowQuery(ctx, instance)
+	case storepb.Engine_MYSQL, storepb.Engine_MSSQL, storepb.Engine_ORACLE, storepb.Engine_SNOWFLAKE:
+		return s.syncSlowQueryByLogDate(ctx, instance)
 	case storepb.Engine_POSTGRES:
 		return s.syncPostgreSQLSlowQuery(ctx, instance, project)
 	default:
@@ -288,7 +297,9 @@ func getLatestLogTime(logMap map[string]*storepb.SlowQueryStatistics) time.Time
 	return time.Time{}
 }
 
-func (s *Syncer) syncMySQLSlowQuery(ctx context.Context, instance *store.InstanceMessage) error {
+// syncSlowQueryByLogDate syncs the slow query logs day by day since the latest synced log date,
+// for the engines whose driver collects the slow query statistics of a given log date.
+func (s *Syncer) syncSlowQueryByLogDate(ctx context.Context, instance *store.InstanceMessage) error {
 	today := time.Now().UTC().Truncate(24 * time.Hour)
 
 	earliestDate := today.AddDate(0, 0, -retentionCycle)
@@ -336,5 +347,57 @@ func (s *Syncer) syncMySQLSlowQuery(ctx context.Context, instance *store.Instanc
 		}
 	}
 
+	if cumulativeDriver, ok := dbfactory.Unwrap(driver).(db.CumulativeSlowQueryDriver); ok {
+		return s.syncSlowQuerySnapshot(ctx, instance, cumulativeDriver, today)
+	}
+	return nil
+}
+
+// syncSlowQuerySnapshot adds the increments of the cumulative slow query statistics since the last snapshot to the slow
+// query logs of today. The increments are filtered by the driver threshold after diffing.
+func (s *Syncer) syncSlowQuerySnapshot(ctx context.Context, instance *store.InstanceMessage, driver db.CumulativeSlowQueryDriver, today time.Time) error {
+	snapshot, err := driver.SyncSlowQuerySnapshot(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.snapshotsMu.Lock()
+	previous, ok := s.snapshots[instance.UID]
+	s.snapshots[instance.UID] = snapshot
+	s.snapshotsMu.Unlock()
+	if !ok {
+		// Take the baseline, the cumulative statistics before it cannot be attributed to a log date.
+		return nil
+	}
+
+	for dbName, statistics := range snapshot {
+		increments := util.DiffSlowQueryStatistics(previous[dbName], statistics)
+		increments.Items = slices.DeleteFunc(increments.Items, func(item *storepb.SlowQueryStatisticsItem) bool {
+			return !driver.IsSlowQuery(item)
+		})
+		if len(increments.Items) == 0 {
+			continue
+		}
+		existing, err := s.store.GetSlowLog(ctx, &store.GetSlowLogMessage{
+			InstanceID:   &instance.ResourceID,
+			DatabaseName: dbName,
+			InstanceUID:  instance.UID,
+			LogDate:      today,
+		})
+		if err != nil {
+			return err
+		}
+		if err := s.store.UpsertSlowLog(ctx, &store.UpsertSlowLogMessage{
+			EnvironmentID: &instance.EnvironmentID,
+			InstanceID:    &instance.ResourceID,
+			DatabaseName:  dbName,
+			InstanceUID:   instance.UID,
+			LogDate:       today,
+			SlowLog:       util.MergeSlowQueryStatistics(existing, increments),
+			UpdaterID:     api.SystemBotID,
+		}); err != nil {
+			return err
+		}
+	}
 	return nil
 }
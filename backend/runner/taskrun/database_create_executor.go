@@ -413,7 +413,7 @@ func getConnectionStatement(dbType storepb.Engine, databaseName string) (string,
 		return fmt.Sprintf("USE `%s`;\n", databaseName), nil
 	case storepb.Engine_MSSQL:
 		return fmt.Sprintf(`USE "%s";\n`, databaseName), nil
-	case storepb.Engine_POSTGRES, storepb.Engine_RISINGWAVE:
+	case storepb.Engine_POSTGRES, storepb.Engine_RISINGWAVE, storepb.Engine_COCKROACHDB:
 		return fmt.Sprintf("\\connect \"%s\";\n", databaseName), nil
 	case storepb.Engine_CLICKHOUSE:
 		return fmt.Sprintf("USE `%s`;\n", databaseName), nil
@@ -429,6 +429,9 @@ func getConnectionStatement(dbType storepb.Engine, databaseName string) (string,
 		return fmt.Sprintf("\\connect \"%s\";\n", databaseName), nil
 	case storepb.Engine_SPANNER:
 		return "", nil
+	case storepb.Engine_DUCKDB:
+		// The DuckDB driver connects to the database file directly.
+		return "", nil
 	}
 
 	return "", errors.Errorf("unsupported database type %s", dbType)
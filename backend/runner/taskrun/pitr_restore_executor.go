@@ -240,8 +240,8 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 	}
 	slog.Debug("Found backup list", "backups", store.SLogBackupArray(backupList))
 
-	mysqlSourceDriver, sourceOk := sourceDriver.(*mysql.Driver)
-	mysqlTargetDriver, targetOk := targetDriver.(*mysql.Driver)
+	mysqlSourceDriver, sourceOk := dbfactory.Unwrap(sourceDriver).(*mysql.Driver)
+	mysqlTargetDriver, targetOk := dbfactory.Unwrap(targetDriver).(*mysql.Driver)
 	if (!sourceOk) || (!targetOk) {
 		slog.Error("Failed to cast driver to mysql.Driver")
 		return nil, errors.Errorf("[internal] cast driver to mysql.Driver failed")
@@ -394,7 +394,7 @@ func (*PITRRestoreExecutor) doRestoreInPlacePostgres(ctx context.Context, stores
 	}
 	defer driver.Close(ctx)
 
-	pgDriver, ok := driver.(*pg.Driver)
+	pgDriver, ok := dbfactory.Unwrap(driver).(*pg.Driver)
 	if !ok {
 		slog.Error("Failed to cast driver to pg.Driver")
 		return nil, errors.Errorf("[internal] cast driver to pg.Driver failed")
@@ -134,6 +134,10 @@ func (s *SchedulerV2) scheduleAutoRolloutTask(ctx context.Context, taskUID int)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get task")
 	}
+	// The cutover of the online schema migration blocks the table, so it must be run explicitly even with auto rollout.
+	if task.Type == api.TaskDatabaseSchemaUpdateOnlineCutover {
+		return nil
+	}
 
 	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
 	if err != nil {
@@ -0,0 +1,102 @@
+package taskrun
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/pgonline"
+	"github.com/bytebase/bytebase/backend/component/state"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+// NewOnlineMigrationCleaner creates an online schema migration cleaner.
+func NewOnlineMigrationCleaner(store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State) *OnlineMigrationCleaner {
+	return &OnlineMigrationCleaner{
+		store:     store,
+		dbFactory: dbFactory,
+		stateCfg:  stateCfg,
+	}
+}
+
+// OnlineMigrationCleaner drops the shadow table and the sync triggers of the PostgreSQL online schema migration
+// whose cutover task won't run, i.e. the cutover task is skipped or the issue is closed.
+// Otherwise the sync triggers stay on the original table and slow down every write on it.
+type OnlineMigrationCleaner struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+	stateCfg  *state.State
+}
+
+// Run will run the online schema migration cleaner.
+func (c *OnlineMigrationCleaner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	slog.Debug("Online schema migration cleaner started")
+	for {
+		select {
+		case taskID := <-c.stateCfg.OnlineMigrationCleanupChan:
+			if err := c.cleanup(ctx, taskID); err != nil {
+				slog.Error("failed to clean up online schema migration", slog.Int("taskID", taskID), log.BBError(err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *OnlineMigrationCleaner) cleanup(ctx context.Context, cutoverTaskID int) error {
+	task, err := c.store.GetTaskV2ByID(ctx, cutoverTaskID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get task")
+	}
+	if task.Type != api.TaskDatabaseSchemaUpdateOnlineCutover || task.LatestTaskRunStatus == api.TaskRunDone {
+		return nil
+	}
+	// The cutover task may still run if it's not skipped and the issue is reopened.
+	skipped, err := utils.GetTaskSkipped(task)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get task skipped")
+	}
+	if !skipped {
+		issue, err := c.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &task.PipelineID})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get issue")
+		}
+		if issue != nil && issue.Status == api.IssueOpen {
+			return nil
+		}
+	}
+	if len(task.BlockedBy) != 1 {
+		return errors.Errorf("failed to find task dag for ToTask %v", task.ID)
+	}
+
+	instance, err := c.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return err
+	}
+	if instance == nil || instance.Deleted {
+		return nil
+	}
+	database, err := c.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return err
+	}
+	if database == nil {
+		return nil
+	}
+	driver, err := c.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return err
+	}
+	defer driver.Close(ctx)
+
+	// The migration is named after the sync task, which owns the shadow table.
+	return pgonline.CleanupTask(ctx, driver.GetDB(), task.BlockedBy[0])
+}
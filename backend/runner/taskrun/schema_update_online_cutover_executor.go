@@ -0,0 +1,131 @@
+package taskrun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/pgonline"
+	"github.com/bytebase/bytebase/backend/component/state"
+	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/runner/schemasync"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	"github.com/bytebase/bytebase/backend/utils"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// NewSchemaUpdateOnlineCutoverExecutor creates a schema update (PostgreSQL online schema migration) cutover task executor.
+func NewSchemaUpdateOnlineCutoverExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, activityManager *activity.Manager, license enterprise.LicenseService, stateCfg *state.State, schemaSyncer *schemasync.Syncer, profile config.Profile) Executor {
+	return &SchemaUpdateOnlineCutoverExecutor{
+		store:           store,
+		dbFactory:       dbFactory,
+		activityManager: activityManager,
+		license:         license,
+		stateCfg:        stateCfg,
+		schemaSyncer:    schemaSyncer,
+		profile:         profile,
+	}
+}
+
+// SchemaUpdateOnlineCutoverExecutor is the schema update (PostgreSQL online schema migration) cutover task executor.
+type SchemaUpdateOnlineCutoverExecutor struct {
+	store           *store.Store
+	dbFactory       *dbfactory.DBFactory
+	activityManager *activity.Manager
+	license         enterprise.LicenseService
+	stateCfg        *state.State
+	schemaSyncer    *schemasync.Syncer
+	profile         config.Profile
+}
+
+// RunOnce will run SchemaUpdateOnlineCutover task once.
+// The shadow table is kept on failure so that the cutover can be retried.
+func (e *SchemaUpdateOnlineCutoverExecutor) RunOnce(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, taskRunUID int) (bool, *api.TaskRunResultPayload, error) {
+	e.stateCfg.TaskRunExecutionStatuses.Store(taskRunUID,
+		state.TaskRunExecutionStatus{
+			ExecutionStatus: v1pb.TaskRun_PRE_EXECUTING,
+			UpdateTime:      time.Now(),
+		})
+
+	if len(task.BlockedBy) != 1 {
+		return true, nil, errors.Errorf("failed to find task dag for ToTask %v", task.ID)
+	}
+	syncTaskID := task.BlockedBy[0]
+	defer e.stateCfg.TaskProgress.Delete(syncTaskID)
+
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return true, nil, err
+	}
+
+	syncTask, err := e.store.GetTaskV2ByID(ctx, syncTaskID)
+	if err != nil {
+		return true, nil, errors.Wrap(err, "failed to get schema update online sync task for cutover task")
+	}
+	payload := &api.TaskDatabaseSchemaUpdateOnlineSyncPayload{}
+	if err := json.Unmarshal([]byte(syncTask.Payload), payload); err != nil {
+		return true, nil, errors.Wrap(err, "invalid database schema update online sync payload")
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, payload.SheetID)
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get sheet statement by id: %d", payload.SheetID)
+	}
+	statement = strings.TrimSpace(statement)
+	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
+	renderedStatement := utils.RenderStatement(statement, materials)
+
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return true, nil, err
+	}
+	defer driver.Close(ctx)
+
+	// The migration is named after the sync task, which owns the shadow table.
+	migration, err := pgonline.NewMigration(driver.GetDB(), syncTaskID, renderedStatement, payload.Flags)
+	if err != nil {
+		return true, nil, common.Wrapf(err, common.Internal, "failed to create online schema migration, statement: %v", statement)
+	}
+
+	// not using the rendered statement here because we want to avoid leaking the rendered statement
+	mi, err := getMigrationInfo(ctx, e.store, e.profile, task, db.Migrate, statement, model.Version{Version: payload.SchemaVersion})
+	if err != nil {
+		return true, nil, err
+	}
+	execFunc := func(execCtx context.Context, _ string) error {
+		return migration.Cutover(execCtx)
+	}
+	migrationID, schema, err := utils.ExecuteMigrationWithFunc(ctx, driverCtx, e.store, e.stateCfg, taskRunUID, driver, mi, statement, &payload.SheetID, execFunc)
+	if err != nil {
+		return true, nil, err
+	}
+	terminated, result, err := postMigration(ctx, e.store, e.activityManager, e.license, task, mi, migrationID, schema, &payload.SheetID)
+	if result != nil {
+		result.Detail = fmt.Sprintf("%s The original table is kept as %q.", result.Detail, migration.OldTable())
+	}
+	if err := e.schemaSyncer.SyncDatabaseSchema(ctx, database, true /* force */); err != nil {
+		slog.Error("failed to sync database schema",
+			slog.String("instanceName", instance.ResourceID),
+			slog.String("databaseName", database.DatabaseName),
+			log.BBError(err),
+		)
+	}
+	return terminated, result, err
+}
@@ -0,0 +1,124 @@
+package taskrun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/pgonline"
+	"github.com/bytebase/bytebase/backend/component/state"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// NewSchemaUpdateOnlineSyncExecutor creates a schema update (PostgreSQL online schema migration) sync task executor.
+func NewSchemaUpdateOnlineSyncExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State) Executor {
+	return &SchemaUpdateOnlineSyncExecutor{
+		store:     store,
+		dbFactory: dbFactory,
+		stateCfg:  stateCfg,
+	}
+}
+
+// SchemaUpdateOnlineSyncExecutor is the schema update (PostgreSQL online schema migration) sync task executor.
+// It creates the shadow table with the triggers syncing the ongoing changes, and backfills the existing rows.
+// The triggers keep the shadow table in sync until the cutover task runs.
+// If the cutover task won't run, OnlineMigrationCleaner drops them.
+type SchemaUpdateOnlineSyncExecutor struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+	stateCfg  *state.State
+}
+
+// RunOnce will run SchemaUpdateOnlineSync task once.
+func (exec *SchemaUpdateOnlineSyncExecutor) RunOnce(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, taskRunUID int) (terminated bool, result *api.TaskRunResultPayload, err error) {
+	exec.stateCfg.TaskRunExecutionStatuses.Store(taskRunUID,
+		state.TaskRunExecutionStatus{
+			ExecutionStatus: v1pb.TaskRun_EXECUTING,
+			UpdateTime:      time.Now(),
+		})
+
+	payload := &api.TaskDatabaseSchemaUpdateOnlineSyncPayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return true, nil, errors.Wrap(err, "invalid database schema update online sync payload")
+	}
+	statement, err := exec.store.GetSheetStatementByID(ctx, payload.SheetID)
+	if err != nil {
+		return true, nil, err
+	}
+	instance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	database, err := exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return true, nil, err
+	}
+	if database == nil {
+		return true, nil, errors.Errorf("database not found")
+	}
+
+	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
+	renderedStatement := utils.RenderStatement(strings.TrimSpace(statement), materials)
+
+	driver, err := exec.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return true, nil, err
+	}
+	defer driver.Close(ctx)
+
+	migration, err := pgonline.NewMigration(driver.GetDB(), task.ID, renderedStatement, payload.Flags)
+	if err != nil {
+		return true, nil, common.Wrapf(err, common.Internal, "failed to create online schema migration, statement: %v", statement)
+	}
+	if err := migration.Prepare(driverCtx); err != nil {
+		exec.cleanup(ctx, migration)
+		return true, nil, errors.Wrap(err, "failed to prepare online schema migration")
+	}
+
+	createdTs := time.Now().Unix()
+	var copiedRows int64
+	if err := migration.Backfill(driverCtx, func(progress pgonline.Progress) {
+		copiedRows = progress.CopiedRows
+		var progressPayload string
+		if progress.Throttled != "" {
+			bytes, err := json.Marshal(map[string]string{"comment": fmt.Sprintf("throttled because %s", progress.Throttled)})
+			if err == nil {
+				progressPayload = string(bytes)
+			}
+		}
+		exec.stateCfg.TaskProgress.Store(task.ID, api.Progress{
+			TotalUnit:     progress.TotalRows,
+			CompletedUnit: progress.CopiedRows,
+			CreatedTs:     createdTs,
+			UpdatedTs:     time.Now().Unix(),
+			Payload:       progressPayload,
+		})
+	}); err != nil {
+		exec.cleanup(ctx, migration)
+		return true, nil, errors.Wrap(err, "failed to backfill the shadow table")
+	}
+
+	return true, &api.TaskRunResultPayload{
+		Detail: fmt.Sprintf("Synced %d rows to the shadow table, the ongoing changes are synced until cutover", copiedRows),
+	}, nil
+}
+
+// cleanup drops the shadow table and the sync triggers, so that the failed migration doesn't slow down the writes on the original table.
+func (*SchemaUpdateOnlineSyncExecutor) cleanup(ctx context.Context, migration *pgonline.Migration) {
+	if err := migration.Cleanup(context.WithoutCancel(ctx)); err != nil {
+		slog.Error("failed to clean up online schema migration", log.BBError(err))
+	}
+}
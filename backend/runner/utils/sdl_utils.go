@@ -33,24 +33,41 @@ func ComputeDatabaseSchemaDiff(ctx context.Context, instance *store.InstanceMess
 	if err != nil {
 		return "", errors.Wrap(err, "dump old schema")
 	}
+	return computeSchemaDiff(instance.Engine, store.IgnoreDatabaseAndTableCaseSensitive(instance), schema.String(), newSchema)
+}
 
+// computeSchemaDiff computes the migration from the dumped schema to the SDL schema.
+func computeSchemaDiff(instanceEngine storepb.Engine, ignoreCaseSensitive bool, oldSchema, newSchema string) (string, error) {
 	var engine storepb.Engine
-	switch instance.Engine {
+	strictMode := true
+	switch instanceEngine {
 	case storepb.Engine_POSTGRES, storepb.Engine_RISINGWAVE:
 		engine = storepb.Engine_POSTGRES
 	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
 		engine = storepb.Engine_MYSQL
+	case storepb.Engine_ORACLE, storepb.Engine_DM, storepb.Engine_OCEANBASE_ORACLE:
+		engine = storepb.Engine_ORACLE
+		// The non-strict mode compares the constraints and indexes by definition instead of the system generated names,
+		// and ignores the storage options in the dump.
+		strictMode = false
+	case storepb.Engine_MSSQL:
+		// The MSSQL differ always ignores the system generated constraint names and the storage options.
+		engine = storepb.Engine_MSSQL
 	default:
-		return "", errors.Errorf("unsupported database engine %q", instance.Engine)
+		return "", errors.Errorf("unsupported database engine %q", instanceEngine)
 	}
 
-	sdlFormat, err := transform.SchemaTransform(engine, schema.String())
-	if err != nil {
-		return "", errors.Wrapf(err, "failed to transform SDL format")
+	sdlFormat := oldSchema
+	if engine == storepb.Engine_POSTGRES || engine == storepb.Engine_MYSQL {
+		var err error
+		sdlFormat, err = transform.SchemaTransform(engine, sdlFormat)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to transform SDL format")
+		}
 	}
 	diff, err := base.SchemaDiff(engine, base.DiffContext{
-		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
-		StrictMode:          true,
+		IgnoreCaseSensitive: ignoreCaseSensitive,
+		StrictMode:          strictMode,
 	}, sdlFormat, newSchema)
 	if err != nil {
 		return "", errors.Wrapf(err, "compute schema diff")
@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestComputeSchemaDiff(t *testing.T) {
+	tests := []struct {
+		engine    storepb.Engine
+		oldSchema string
+		newSchema string
+		want      string
+	}{
+		{
+			// The Oracle dump converges with the SDL.
+			engine: storepb.Engine_ORACLE,
+			oldSchema: `CREATE TABLE "BB"."ORDERS" (
+  "ID" NUMBER(10,0) NOT NULL ENABLE,
+  "NAME" VARCHAR2(100 BYTE),
+  CONSTRAINT "SYS_C008123" PRIMARY KEY ("ID") ENABLE
+)
+LOGGING
+NOCOMPRESS
+PCTFREE 10
+;
+
+CREATE INDEX "BB"."IDX_ORDERS_NAME" ON "BB"."ORDERS" ("NAME")
+LOGGING
+VISIBLE
+USABLE
+;
+`,
+			newSchema: `CREATE TABLE orders (
+  id NUMBER(10) NOT NULL,
+  name VARCHAR2(100),
+  PRIMARY KEY (id)
+);
+CREATE INDEX idx_orders_name ON orders (name);
+`,
+			want: "",
+		},
+		{
+			engine: storepb.Engine_ORACLE,
+			oldSchema: `CREATE TABLE "BB"."ORDERS" (
+  "ID" NUMBER(10,0) NOT NULL ENABLE
+)
+;
+`,
+			newSchema: `CREATE TABLE orders (
+  id NUMBER(10) NOT NULL,
+  note VARCHAR2(20)
+);
+`,
+			want: `ALTER TABLE "ORDERS" ADD (
+	note VARCHAR2(20)
+);
+`,
+		},
+		{
+			// The MSSQL dump converges with the SDL, including the views and procedures.
+			engine: storepb.Engine_MSSQL,
+			oldSchema: `CREATE TABLE [dbo].[orders] (
+    [id] int NOT NULL,
+    CONSTRAINT [PK__orders__3213E83F0C85DE4D] PRIMARY KEY CLUSTERED ([id])
+);
+
+GO
+
+CREATE VIEW dbo.all_orders AS SELECT id FROM dbo.orders;
+GO
+
+`,
+			newSchema: `CREATE TABLE orders (
+    id int NOT NULL PRIMARY KEY
+);
+GO
+CREATE VIEW [dbo].[all_orders] AS SELECT id FROM dbo.orders;
+GO
+`,
+			want: "",
+		},
+		{
+			engine: storepb.Engine_MSSQL,
+			oldSchema: `CREATE TABLE [dbo].[orders] (
+    [id] int NOT NULL
+);
+
+GO
+
+CREATE VIEW dbo.all_orders AS SELECT id FROM dbo.orders;
+GO
+
+`,
+			newSchema: `CREATE TABLE orders (
+    id int NOT NULL
+);
+`,
+			want: "DROP VIEW [dbo].[all_orders];\n",
+		},
+	}
+
+	for _, test := range tests {
+		diff, err := computeSchemaDiff(test.engine, false /* ignoreCaseSensitive */, test.oldSchema, test.newSchema)
+		require.NoError(t, err, test.newSchema)
+		require.Equal(t, test.want, diff, test.newSchema)
+	}
+}
@@ -4,9 +4,14 @@ import (
 	"context"
 	"log/slog"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	"github.com/bytebase/bytebase/backend/plugin/schema"
 	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
 func backfillBranches(ctx context.Context, stores *store.Store) {
@@ -51,3 +56,40 @@ func backfillBranches(ctx context.Context, stores *store.Store) {
 		slog.Info("backfill branch done", slog.Int("total", len(ids)), slog.Int("done", count))
 	}
 }
+
+// backfillTiDBSlowQueryFingerprints re-fingerprints the stored TiDB slow query logs with the TiDB normalizer,
+// so that the logs collected before and after the fingerprint format change are merged by fingerprint.
+func backfillTiDBSlowQueryFingerprints(ctx context.Context, stores *store.Store) {
+	slowLogs, err := stores.ListSlowLogsByEngine(ctx, storepb.Engine_TIDB)
+	if err != nil {
+		slog.Error("failed to list TiDB slow query logs", log.BBError(err))
+		return
+	}
+	count := 0
+	for _, slowLog := range slowLogs {
+		changed := false
+		statistics := &storepb.SlowQueryStatistics{}
+		for _, item := range slowLog.SlowLog.GetItems() {
+			item = proto.Clone(item).(*storepb.SlowQueryStatisticsItem)
+			if len(item.Samples) > 0 {
+				fingerprint, err := base.GetFingerprint(storepb.Engine_TIDB, item.Samples[0].SqlText)
+				if err == nil && fingerprint != "" && fingerprint != item.SqlFingerprint {
+					item.SqlFingerprint = fingerprint
+					changed = true
+				}
+			}
+			statistics.Items = append(statistics.Items, item)
+		}
+		if !changed {
+			continue
+		}
+		if err := stores.UpdateSlowLog(ctx, slowLog.UID, util.MergeSlowQueryStatistics(statistics)); err != nil {
+			slog.Error("failed to update slow query log", slog.Int("slowQueryID", slowLog.UID), log.BBError(err))
+			continue
+		}
+		count++
+	}
+	if count > 0 {
+		slog.Info("backfill TiDB slow query fingerprints done", slog.Int("total", len(slowLogs)), slog.Int("done", count))
+	}
+}
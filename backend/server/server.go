@@ -68,6 +68,7 @@ const (
 type Server struct {
 	// Asynchronous runners.
 	taskSchedulerV2    *taskrun.SchedulerV2
+	onlineCleaner      *taskrun.OnlineMigrationCleaner
 	planCheckScheduler *plancheck.Scheduler
 	metricReporter     *metricreport.Reporter
 	schemaSyncer       *schemasync.Syncer
@@ -76,6 +77,7 @@ type Server struct {
 	backupRunner       *backuprun.Runner
 	rollbackRunner     *rollbackrun.Runner
 	approvalRunner     *approval.Runner
+	approvalSLARunner  *approval.SLARunner
 	relayRunner        *relay.Runner
 	runnerWG           sync.WaitGroup
 
@@ -225,6 +227,7 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		slog.Warn("failed to backfill issue ts vector", log.BBError(err))
 	}
 	go backfillBranches(ctx, storeInstance)
+	go backfillTiDBSlowQueryFingerprints(ctx, storeInstance)
 
 	s.licenseService, err = enterprisesvc.NewLicenseService(profile.Mode, storeInstance)
 	if err != nil {
@@ -274,6 +277,7 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		s.mailSender = mail.NewSender(s.store, s.stateCfg)
 		s.relayRunner = relay.NewRunner(storeInstance, s.activityManager, s.stateCfg)
 		s.approvalRunner = approval.NewRunner(storeInstance, s.dbFactory, s.stateCfg, s.activityManager, s.relayRunner, s.licenseService)
+		s.approvalSLARunner = approval.NewSLARunner(storeInstance, s.activityManager, s.licenseService)
 
 		s.taskSchedulerV2 = taskrun.NewSchedulerV2(storeInstance, s.stateCfg, s.activityManager)
 		s.taskSchedulerV2.Register(api.TaskGeneral, taskrun.NewDefaultExecutor())
@@ -285,6 +289,9 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		s.taskSchedulerV2.Register(api.TaskDatabaseBackup, taskrun.NewDatabaseBackupExecutor(storeInstance, s.dbFactory, s.s3Client, s.stateCfg, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateGhostSync, taskrun.NewSchemaUpdateGhostSyncExecutor(storeInstance, s.stateCfg, s.secret))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateGhostCutover, taskrun.NewSchemaUpdateGhostCutoverExecutor(storeInstance, s.dbFactory, s.activityManager, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
+		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateOnlineSync, taskrun.NewSchemaUpdateOnlineSyncExecutor(storeInstance, s.dbFactory, s.stateCfg))
+		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateOnlineCutover, taskrun.NewSchemaUpdateOnlineCutoverExecutor(storeInstance, s.dbFactory, s.activityManager, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
+		s.onlineCleaner = taskrun.NewOnlineMigrationCleaner(storeInstance, s.dbFactory, s.stateCfg)
 		s.taskSchedulerV2.Register(api.TaskDatabaseRestorePITRRestore, taskrun.NewPITRRestoreExecutor(storeInstance, s.dbFactory, s.s3Client, s.schemaSyncer, s.stateCfg, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseRestorePITRCutover, taskrun.NewPITRCutoverExecutor(storeInstance, s.dbFactory, s.schemaSyncer, s.stateCfg, s.backupRunner, s.activityManager, profile))
 
@@ -295,12 +302,16 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementType, statementTypeExecutor)
 		statementAdviseExecutor := plancheck.NewStatementAdviseExecutor(storeInstance, s.dbFactory, s.licenseService)
 		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementAdvise, statementAdviseExecutor)
-		ghostSyncExecutor := plancheck.NewGhostSyncExecutor(storeInstance, s.secret)
+		ghostSyncExecutor := plancheck.NewGhostSyncExecutor(storeInstance, s.dbFactory, s.secret)
 		s.planCheckScheduler.Register(store.PlanCheckDatabaseGhostSync, ghostSyncExecutor)
 		pitrMySQLExecutor := plancheck.NewPITRMySQLExecutor(storeInstance, s.dbFactory)
 		s.planCheckScheduler.Register(store.PlanCheckDatabasePITRMySQL, pitrMySQLExecutor)
 		statementReportExecutor := plancheck.NewStatementReportExecutor(storeInstance, s.dbFactory)
 		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementSummaryReport, statementReportExecutor)
+		statementLockImpactExecutor := plancheck.NewStatementLockImpactExecutor(storeInstance)
+		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementLockImpact, statementLockImpactExecutor)
+		statementDMLDryRunExecutor := plancheck.NewStatementDMLDryRunExecutor(storeInstance, s.dbFactory)
+		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementDMLDryRun, statementDMLDryRunExecutor)
 
 		// Metric reporter
 		s.initMetricReporter()
@@ -386,6 +397,8 @@ func (s *Server) Run(ctx context.Context, port int) error {
 		s.runnerWG.Add(1)
 		go s.taskSchedulerV2.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
+		go s.onlineCleaner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
 		go s.schemaSyncer.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
 		go s.slowQuerySyncer.Run(ctx, &s.runnerWG)
@@ -398,6 +411,8 @@ func (s *Server) Run(ctx context.Context, port int) error {
 		s.runnerWG.Add(1)
 		go s.approvalRunner.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
+		go s.approvalSLARunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
 		go s.relayRunner.Run(ctx, &s.runnerWG)
 
 		s.runnerWG.Add(1)
@@ -406,6 +421,9 @@ func (s *Server) Run(ctx context.Context, port int) error {
 		s.runnerWG.Add(1)
 		go s.planCheckScheduler.Run(ctx, &s.runnerWG)
 	}
+	// The driver pool is used by SQL editor in the readonly mode as well.
+	s.runnerWG.Add(1)
+	go s.dbFactory.Run(ctx, &s.runnerWG)
 
 	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", port+1))
 	if err != nil {
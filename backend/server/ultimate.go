@@ -5,7 +5,9 @@ package server
 import (
 	// Drivers.
 	_ "github.com/bytebase/bytebase/backend/plugin/db/clickhouse"
+	_ "github.com/bytebase/bytebase/backend/plugin/db/cockroachdb"
 	_ "github.com/bytebase/bytebase/backend/plugin/db/dm"
+	_ "github.com/bytebase/bytebase/backend/plugin/db/duckdb"
 	_ "github.com/bytebase/bytebase/backend/plugin/db/mssql"
 	_ "github.com/bytebase/bytebase/backend/plugin/db/oracle"
 	_ "github.com/bytebase/bytebase/backend/plugin/db/redis"
@@ -16,13 +18,17 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/db/starrocks"
 
 	// Parsers.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/duckdb"
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/plsql"
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/redis"
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/snowflake"
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/standard"
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/tsql"
 
 	// Advisors.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/cockroachdb"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/mssql"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/oracle"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/redis"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/snowflake"
 )
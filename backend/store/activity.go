@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -253,6 +254,8 @@ type FindActivityMessage struct {
 	// Different use cases want different orders.
 	// e.g. Issue activity list wants ASC, while view recent activity list wants DESC.
 	Order *api.SortOrder
+	// DatabaseUID filters the activities by the databaseId in the payload, such as the SQL editor query activities.
+	DatabaseUID *int
 }
 
 // UpdateActivityMessage updates the activity.
@@ -456,6 +459,9 @@ func listActivityImplV2(ctx context.Context, tx *Tx, find *FindActivityMessage)
 	if v := find.CreatorUID; v != nil {
 		where, args = append(where, fmt.Sprintf("creator_id = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := find.DatabaseUID; v != nil {
+		where, args = append(where, fmt.Sprintf("payload->>'databaseId' = $%d", len(args)+1)), append(args, strconv.Itoa(*v))
+	}
 	if v := find.LevelList; len(v) > 0 {
 		var queryValues []string
 		for _, level := range v {
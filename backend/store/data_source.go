@@ -35,6 +35,9 @@ type DataSourceMessage struct {
 	SSHUser                 string
 	SSHObfuscatedPassword   string
 	SSHObfuscatedPrivateKey string
+	// Authentication related.
+	AuthenticationType storepb.DataSourceOptions_AuthenticationType
+	Region             string
 	// (deprecated) Output only.
 	UID int
 }
@@ -61,6 +64,8 @@ func (m *DataSourceMessage) Copy() *DataSourceMessage {
 		SSHUser:                 m.SSHUser,
 		SSHObfuscatedPassword:   m.SSHObfuscatedPassword,
 		SSHObfuscatedPrivateKey: m.SSHObfuscatedPrivateKey,
+		AuthenticationType:      m.AuthenticationType,
+		Region:                  m.Region,
 		UID:                     m.UID,
 	}
 }
@@ -91,6 +96,9 @@ type UpdateDataSourceMessage struct {
 	SSHUser                 *string
 	SSHObfuscatedPassword   *string
 	SSHObfuscatedPrivateKey *string
+	// Authentication related.
+	AuthenticationType *storepb.DataSourceOptions_AuthenticationType
+	Region             *string
 }
 
 func (*Store) listDataSourceV2(ctx context.Context, tx *Tx, instanceID string) ([]*DataSourceMessage, error) {
@@ -151,6 +159,8 @@ func (*Store) listDataSourceV2(ctx context.Context, tx *Tx, instanceID string) (
 		dataSourceMessage.SSHUser = dataSourceOptions.SshUser
 		dataSourceMessage.SSHObfuscatedPassword = dataSourceOptions.SshObfuscatedPassword
 		dataSourceMessage.SSHObfuscatedPrivateKey = dataSourceOptions.SshObfuscatedPrivateKey
+		dataSourceMessage.AuthenticationType = dataSourceOptions.AuthenticationType
+		dataSourceMessage.Region = dataSourceOptions.Region
 
 		dataSourceMessages = append(dataSourceMessages, &dataSourceMessage)
 	}
@@ -273,6 +283,13 @@ func (s *Store) UpdateDataSourceV2(ctx context.Context, patch *UpdateDataSourceM
 	if v := patch.SSHObfuscatedPrivateKey; v != nil {
 		optionSet, args = append(optionSet, fmt.Sprintf("jsonb_build_object('sshObfuscatedPrivateKey', to_jsonb($%d::TEXT))", len(args)+1)), append(args, *v)
 	}
+	if v := patch.AuthenticationType; v != nil {
+		// The enum is stored by name in the protojson format.
+		optionSet, args = append(optionSet, fmt.Sprintf("jsonb_build_object('authenticationType', to_jsonb($%d::TEXT))", len(args)+1)), append(args, v.String())
+	}
+	if v := patch.Region; v != nil {
+		optionSet, args = append(optionSet, fmt.Sprintf("jsonb_build_object('region', to_jsonb($%d::TEXT))", len(args)+1)), append(args, *v)
+	}
 	if len(optionSet) != 0 {
 		set = append(set, fmt.Sprintf(`options = options || %s`, strings.Join(optionSet, "||")))
 	}
@@ -321,6 +338,8 @@ func (*Store) addDataSourceToInstanceImplV2(ctx context.Context, tx *Tx, instanc
 		SshUser:                 dataSource.SSHUser,
 		SshObfuscatedPassword:   dataSource.SSHObfuscatedPassword,
 		SshObfuscatedPrivateKey: dataSource.SSHObfuscatedPrivateKey,
+		AuthenticationType:      dataSource.AuthenticationType,
+		Region:                  dataSource.Region,
 	}
 	protoBytes, err := protojson.Marshal(&dataSourceOptions)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/jackc/pgtype"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/bytebase/bytebase/backend/common"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
@@ -70,7 +71,10 @@ type UpdateIssueMessage struct {
 	Assignee       *UserMessage
 	// PayloadUpsert upserts the presented top-level keys.
 	PayloadUpsert *storepb.IssuePayload
-	Subscribers   *[]*UserMessage
+	// ExpectedApproval is used for optimistic concurrency control of the approval payload.
+	// If set, the update fails with a conflict error unless the current approval payload of the issue equals it.
+	ExpectedApproval *storepb.IssuePayloadApproval
+	Subscribers      *[]*UserMessage
 
 	PipelineUID *int
 }
@@ -107,6 +111,8 @@ type FindIssueMessage struct {
 	Offset *int
 
 	Query *string
+	// PendingApprovalSLA finds the issues whose single approval template has an SLA and hasn't been rejected.
+	PendingApprovalSLA bool
 
 	PermissionFilter *FindIssueMessagePermissionFilter
 }
@@ -283,6 +289,25 @@ func (s *Store) UpdateIssueV2(ctx context.Context, uid int, patch *UpdateIssueMe
 	}
 	defer tx.Rollback()
 
+	if v := patch.ExpectedApproval; v != nil {
+		var approval []byte
+		if err := tx.QueryRowContext(ctx, `SELECT COALESCE(payload->'approval', '{}'::JSONB) FROM issue WHERE id = $1 FOR UPDATE`, uid).Scan(&approval); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("issue ID not found: %d", uid)}
+			}
+			return nil, err
+		}
+		current := &storepb.IssuePayloadApproval{}
+		if err := protojson.Unmarshal(approval, current); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal issue approval payload")
+		}
+		if !proto.Equal(current, v) {
+			// The cached issue is stale.
+			s.issueCache.Remove(uid)
+			return nil, &common.Error{Code: common.Conflict, Err: errors.Errorf("the approval of issue %d has been modified", uid)}
+		}
+	}
+
 	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`
 		UPDATE issue
 		SET `+strings.Join(set, ", ")+`
@@ -446,6 +471,15 @@ func (s *Store) ListIssueV2(ctx context.Context, find *FindIssueMessage) ([]*Iss
 		where = append(where, fmt.Sprintf("EXISTS (SELECT 1 FROM task WHERE task.pipeline_id = issue.pipeline_id AND task.type = ANY($%d))", len(args)+1))
 		args = append(args, *v)
 	}
+	if find.PendingApprovalSLA {
+		where = append(where,
+			"issue.payload->'approval'->>'approvalFindingDone' = 'true'",
+			"COALESCE(issue.payload->'approval'->>'approvalFindingError', '') = ''",
+			"issue.payload->'approval'->'approvalTemplates'->0 ? 'sla'",
+			"issue.payload->'approval'->'approvalTemplates'->1 IS NULL",
+			`NOT COALESCE(issue.payload->'approval'->'approvers', '[]'::JSONB) @> '[{"status": "REJECTED"}]'::JSONB`,
+		)
+	}
 	limitOffsetClause := ""
 	if v := find.Limit; v != nil {
 		limitOffsetClause = fmt.Sprintf(" LIMIT %d", *v)
@@ -25,6 +25,10 @@ const (
 	PlanCheckDatabaseStatementType PlanCheckRunType = "bb.plan-check.database.statement.type"
 	// PlanCheckDatabaseStatementSummaryReport is the plan check type for statement summary report.
 	PlanCheckDatabaseStatementSummaryReport PlanCheckRunType = "bb.plan-check.database.statement.summary.report"
+	// PlanCheckDatabaseStatementLockImpact is the plan check type for statement lock impact.
+	PlanCheckDatabaseStatementLockImpact PlanCheckRunType = "bb.plan-check.database.statement.lock-impact"
+	// PlanCheckDatabaseStatementDMLDryRun is the plan check type for statement DML dry run.
+	PlanCheckDatabaseStatementDMLDryRun PlanCheckRunType = "bb.plan-check.database.statement.dml-dry-run"
 	// PlanCheckDatabaseConnect is the plan check type for database connection.
 	PlanCheckDatabaseConnect PlanCheckRunType = "bb.plan-check.database.connect"
 	// PlanCheckDatabaseGhostSync is the plan check type for the gh-ost sync task.
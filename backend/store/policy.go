@@ -105,6 +105,80 @@ func (s *Store) GetSlowQueryPolicy(ctx context.Context, resourceType api.PolicyR
 	return api.UnmarshalSlowQueryPolicy(policy.Payload)
 }
 
+// GetSessionGuardPolicy will get the session guard policy for an environment or a project.
+// The empty policy without any limit is returned if the policy is not set or not enforced.
+func (s *Store) GetSessionGuardPolicy(ctx context.Context, resourceType api.PolicyResourceType, resourceID int) (*storepb.SessionGuardPolicy, error) {
+	pType := api.PolicyTypeSessionGuard
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &resourceType,
+		ResourceUID:  &resourceID,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil || !policy.Enforce {
+		return &storepb.SessionGuardPolicy{}, nil
+	}
+
+	p := new(storepb.SessionGuardPolicy)
+	if err := protojson.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal session guard policy")
+	}
+
+	return p, nil
+}
+
+// GetLockImpactPolicy will get the lock impact policy for an environment.
+// Nil is returned if the policy is not set or not enforced.
+func (s *Store) GetLockImpactPolicy(ctx context.Context, environmentID int) (*storepb.LockImpactPolicy, error) {
+	resourceType := api.PolicyResourceTypeEnvironment
+	pType := api.PolicyTypeLockImpact
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &resourceType,
+		ResourceUID:  &environmentID,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil || !policy.Enforce {
+		return nil, nil
+	}
+
+	p := new(storepb.LockImpactPolicy)
+	if err := protojson.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal lock impact policy")
+	}
+
+	return p, nil
+}
+
+// GetDMLDryRunPolicy will get the DML dry run policy for an environment.
+// Nil is returned if the policy is not set or not enforced, which means the DML dry run is not allowed.
+func (s *Store) GetDMLDryRunPolicy(ctx context.Context, environmentID int) (*storepb.DMLDryRunPolicy, error) {
+	resourceType := api.PolicyResourceTypeEnvironment
+	pType := api.PolicyTypeDMLDryRun
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &resourceType,
+		ResourceUID:  &environmentID,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil || !policy.Enforce {
+		return nil, nil
+	}
+
+	p := new(storepb.DMLDryRunPolicy)
+	if err := protojson.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal DML dry run policy")
+	}
+
+	return p, nil
+}
+
 // GetMaskingRulePolicy will get the masking rule policy.
 func (s *Store) GetMaskingRulePolicy(ctx context.Context) (*storepb.MaskingRulePolicy, error) {
 	pType := api.PolicyTypeMaskingRule
@@ -0,0 +1,251 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// SensitiveDataProposalState is the state of a sensitive data proposal.
+type SensitiveDataProposalState string
+
+const (
+	// SensitiveDataProposalStatePending is the proposal state for PENDING.
+	SensitiveDataProposalStatePending SensitiveDataProposalState = "PENDING"
+	// SensitiveDataProposalStateAccepted is the proposal state for ACCEPTED.
+	SensitiveDataProposalStateAccepted SensitiveDataProposalState = "ACCEPTED"
+	// SensitiveDataProposalStateRejected is the proposal state for REJECTED.
+	SensitiveDataProposalStateRejected SensitiveDataProposalState = "REJECTED"
+)
+
+// SensitiveDataProposalMessage is the message for a sensitive data proposal.
+type SensitiveDataProposalMessage struct {
+	DatabaseUID int
+	SchemaName  string
+	TableName   string
+	ColumnName  string
+	State       SensitiveDataProposalState
+	Payload     *storepb.SensitiveDataProposalPayload
+
+	// Output only fields.
+	UID       int
+	CreatedTs int64
+	UpdatedTs int64
+}
+
+// FindSensitiveDataProposalMessage is the message for finding sensitive data proposals.
+type FindSensitiveDataProposalMessage struct {
+	UID         *int
+	DatabaseUID *int
+	State       *SensitiveDataProposalState
+}
+
+// UpdateSensitiveDataProposalMessage is the message for updating a sensitive data proposal.
+type UpdateSensitiveDataProposalMessage struct {
+	UID       int
+	UpdaterID int
+
+	State   *SensitiveDataProposalState
+	Payload *storepb.SensitiveDataProposalPayload
+}
+
+// GetSensitiveDataProposal gets a sensitive data proposal.
+func (s *Store) GetSensitiveDataProposal(ctx context.Context, find *FindSensitiveDataProposalMessage) (*SensitiveDataProposalMessage, error) {
+	proposals, err := s.ListSensitiveDataProposals(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(proposals) == 0 {
+		return nil, nil
+	}
+	if len(proposals) > 1 {
+		return nil, errors.Errorf("expected 1 sensitive data proposal, got %d", len(proposals))
+	}
+	return proposals[0], nil
+}
+
+// ListSensitiveDataProposals lists sensitive data proposals.
+func (s *Store) ListSensitiveDataProposals(ctx context.Context, find *FindSensitiveDataProposalMessage) ([]*SensitiveDataProposalMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.DatabaseUID; v != nil {
+		where, args = append(where, fmt.Sprintf("database_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.State; v != nil {
+		where, args = append(where, fmt.Sprintf("state = $%d", len(args)+1)), append(args, *v)
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+		SELECT
+			id,
+			created_ts,
+			updated_ts,
+			database_id,
+			schema_name,
+			table_name,
+			column_name,
+			state,
+			payload
+		FROM sensitive_data_proposal
+		WHERE %s
+		ORDER BY schema_name, table_name, column_name`, strings.Join(where, " AND ")),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var proposals []*SensitiveDataProposalMessage
+	for rows.Next() {
+		var proposal SensitiveDataProposalMessage
+		var payload []byte
+		if err := rows.Scan(
+			&proposal.UID,
+			&proposal.CreatedTs,
+			&proposal.UpdatedTs,
+			&proposal.DatabaseUID,
+			&proposal.SchemaName,
+			&proposal.TableName,
+			&proposal.ColumnName,
+			&proposal.State,
+			&payload,
+		); err != nil {
+			return nil, err
+		}
+		proposal.Payload = &storepb.SensitiveDataProposalPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payload, proposal.Payload); err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, &proposal)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return proposals, nil
+}
+
+// UpsertSensitiveDataProposals upserts the pending sensitive data proposals of a database.
+// The proposals which have been accepted or rejected are left as they are, so that a re-scan doesn't bring back a reviewed proposal.
+func (s *Store) UpsertSensitiveDataProposals(ctx context.Context, upserts []*SensitiveDataProposalMessage, updaterID int) error {
+	if len(upserts) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO sensitive_data_proposal (
+			creator_id,
+			updater_id,
+			database_id,
+			schema_name,
+			table_name,
+			column_name,
+			payload
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT(database_id, schema_name, table_name, column_name) DO UPDATE SET
+			updater_id = EXCLUDED.updater_id,
+			payload = EXCLUDED.payload
+		WHERE sensitive_data_proposal.state = 'PENDING'
+	`
+	for _, upsert := range upserts {
+		payload, err := protojson.Marshal(upsert.Payload)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, query,
+			updaterID,
+			updaterID,
+			upsert.DatabaseUID,
+			upsert.SchemaName,
+			upsert.TableName,
+			upsert.ColumnName,
+			payload,
+		); err != nil {
+			return errors.Wrapf(err, "failed to upsert sensitive data proposal for column %q", upsert.ColumnName)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// UpdateSensitiveDataProposal updates a sensitive data proposal.
+func (s *Store) UpdateSensitiveDataProposal(ctx context.Context, patch *UpdateSensitiveDataProposalMessage) (*SensitiveDataProposalMessage, error) {
+	set, args := []string{"updater_id = $1"}, []any{patch.UpdaterID}
+	if v := patch.State; v != nil {
+		set, args = append(set, fmt.Sprintf("state = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := patch.Payload; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		set, args = append(set, fmt.Sprintf("payload = $%d", len(args)+1)), append(args, payload)
+	}
+	args = append(args, patch.UID)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var proposal SensitiveDataProposalMessage
+	var payload []byte
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf(`
+		UPDATE sensitive_data_proposal
+		SET %s
+		WHERE id = $%d
+		RETURNING id, created_ts, updated_ts, database_id, schema_name, table_name, column_name, state, payload
+	`, strings.Join(set, ", "), len(args)),
+		args...,
+	).Scan(
+		&proposal.UID,
+		&proposal.CreatedTs,
+		&proposal.UpdatedTs,
+		&proposal.DatabaseUID,
+		&proposal.SchemaName,
+		&proposal.TableName,
+		&proposal.ColumnName,
+		&proposal.State,
+		&payload,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("sensitive data proposal %d not found", patch.UID)
+		}
+		return nil, err
+	}
+	proposal.Payload = &storepb.SensitiveDataProposalPayload{}
+	if err := protojsonUnmarshaler.Unmarshal(payload, proposal.Payload); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &proposal, nil
+}
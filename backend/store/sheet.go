@@ -44,6 +44,9 @@ const (
 	SheetForSQL SheetType = "SQL"
 )
 
+// sheetEtagExpr computes the etag of a sheet from the fields that can be updated by the worksheet API.
+const sheetEtagExpr = "md5(concat_ws(':', sheet.name, sheet.visibility, md5(sheet.statement)))"
+
 // SheetMessage is the message for a sheet.
 type SheetMessage struct {
 	ProjectUID int
@@ -69,6 +72,8 @@ type SheetMessage struct {
 	UpdatedTime time.Time
 	Starred     bool
 	Pinned      bool
+	// Etag changes whenever the title, statement or visibility changes.
+	Etag string
 
 	// Internal fields
 	rowStatus RowStatus
@@ -125,6 +130,9 @@ type PatchSheetMessage struct {
 	ProjectUID  *int
 	DatabaseUID *int
 	Payload     *storepb.SheetPayload
+	// Etag is used for optimistic concurrency control.
+	// If set, the patch fails with a conflict error unless it matches the current etag of the sheet.
+	Etag *string
 }
 
 // GetSheetStatementByID gets the statement of a sheet by ID.
@@ -257,10 +265,11 @@ func (s *Store) ListSheets(ctx context.Context, find *FindSheetMessage, currentP
 			sheet.payload,
 			OCTET_LENGTH(sheet.statement),
 			COALESCE(sheet_organizer.starred, FALSE),
-			COALESCE(sheet_organizer.pinned, FALSE)
+			COALESCE(sheet_organizer.pinned, FALSE),
+			%s
 		FROM sheet
 		LEFT JOIN sheet_organizer ON sheet_organizer.sheet_id = sheet.id AND sheet_organizer.principal_id = %d
-		WHERE %s`, statementField, currentPrincipalID, strings.Join(where, " AND ")),
+		WHERE %s`, statementField, sheetEtagExpr, currentPrincipalID, strings.Join(where, " AND ")),
 		args...,
 	)
 	if err != nil {
@@ -290,6 +299,7 @@ func (s *Store) ListSheets(ctx context.Context, find *FindSheetMessage, currentP
 			&sheet.Size,
 			&sheet.Starred,
 			&sheet.Pinned,
+			&sheet.Etag,
 		); err != nil {
 			return nil, err
 		}
@@ -326,7 +336,7 @@ func (s *Store) CreateSheet(ctx context.Context, create *SheetMessage) (*SheetMe
 		return nil, err
 	}
 
-	query := `
+	query := fmt.Sprintf(`
 		INSERT INTO sheet (
 			creator_id,
 			updater_id,
@@ -340,8 +350,8 @@ func (s *Store) CreateSheet(ctx context.Context, create *SheetMessage) (*SheetMe
 			payload
 		)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-		RETURNING id, row_status, created_ts, updated_ts, OCTET_LENGTH(statement)
-	`
+		RETURNING id, row_status, created_ts, updated_ts, OCTET_LENGTH(statement), %s
+	`, sheetEtagExpr)
 
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -366,12 +376,18 @@ func (s *Store) CreateSheet(ctx context.Context, create *SheetMessage) (*SheetMe
 		&create.createdTs,
 		&create.updatedTs,
 		&create.Size,
+		&create.Etag,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, common.FormatDBErrorEmptyRowWithQuery(query)
 		}
 		return nil, err
 	}
+	if create.Source == SheetFromBytebase {
+		if err := createSheetRevisionImpl(ctx, tx, create.UID, create.CreatorID, create.Statement); err != nil {
+			return nil, err
+		}
+	}
 	if err := tx.Commit(); err != nil {
 		return nil, errors.Wrapf(err, "failed to commit transaction")
 	}
@@ -388,6 +404,7 @@ func (s *Store) PatchSheet(ctx context.Context, patch *PatchSheetMessage) (*Shee
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to begin transaction")
 	}
+	defer tx.Rollback()
 
 	sheet, err := patchSheetImpl(ctx, tx, patch)
 	if err != nil {
@@ -422,7 +439,21 @@ func (s *Store) DeleteSheet(ctx context.Context, sheetUID int) error {
 }
 
 // patchSheetImpl updates a sheet's name/statement/visibility/payload/database_id/project_id.
+// A new revision is created if the statement of a worksheet changes.
 func patchSheetImpl(ctx context.Context, tx *Tx, patch *PatchSheetMessage) (*SheetMessage, error) {
+	if v := patch.Etag; v != nil {
+		var etag string
+		if err := tx.QueryRowContext(ctx, fmt.Sprintf(`SELECT %s FROM sheet WHERE id = $1 FOR UPDATE`, sheetEtagExpr), patch.UID).Scan(&etag); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("sheet ID not found: %d", patch.UID)}
+			}
+			return nil, err
+		}
+		if etag != *v {
+			return nil, &common.Error{Code: common.Conflict, Err: errors.Errorf("sheet %d has been modified, etag %q does not match the current etag %q", patch.UID, *v, etag)}
+		}
+	}
+
 	set, args := []string{"updater_id = $1"}, []any{patch.UpdaterID}
 	if v := patch.Title; v != nil {
 		set, args = append(set, fmt.Sprintf("name = $%d", len(args)+1)), append(args, *v)
@@ -457,8 +488,8 @@ func patchSheetImpl(ctx context.Context, tx *Tx, patch *PatchSheetMessage) (*She
 		UPDATE sheet
 		SET `+strings.Join(set, ", ")+`
 		WHERE id = $%d
-		RETURNING id, row_status, creator_id, created_ts, updater_id, updated_ts, project_id, database_id, name, LEFT(statement, %d), visibility, source, type, payload, OCTET_LENGTH(statement)
-	`, len(args), common.MaxSheetSize),
+		RETURNING id, row_status, creator_id, created_ts, updater_id, updated_ts, project_id, database_id, name, LEFT(statement, %d), visibility, source, type, payload, OCTET_LENGTH(statement), %s
+	`, len(args), common.MaxSheetSize, sheetEtagExpr),
 		args...,
 	).Scan(
 		&sheet.UID,
@@ -476,12 +507,18 @@ func patchSheetImpl(ctx context.Context, tx *Tx, patch *PatchSheetMessage) (*She
 		&sheet.Type,
 		&payload,
 		&sheet.Size,
+		&sheet.Etag,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("sheet ID not found: %d", patch.UID)}
 		}
 		return nil, err
 	}
+	if v := patch.Statement; v != nil && sheet.Source == SheetFromBytebase {
+		if err := createSheetRevisionImpl(ctx, tx, sheet.UID, patch.UpdaterID, *v); err != nil {
+			return nil, err
+		}
+	}
 	sheetPayload := &storepb.SheetPayload{}
 	if err := protojsonUnmarshaler.Unmarshal(payload, sheetPayload); err != nil {
 		return nil, err
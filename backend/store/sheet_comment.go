@@ -0,0 +1,243 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+)
+
+// SheetCommentMessage is the message for a comment anchored to a line of a sheet revision.
+type SheetCommentMessage struct {
+	SheetUID int
+	Revision int
+	// Line is the 1-based line number in the statement of the revision.
+	Line    int
+	Content string
+
+	CreatorID int
+	UpdaterID int
+
+	// Output only fields
+	UID         int
+	CreatedTime time.Time
+	UpdatedTime time.Time
+}
+
+// FindSheetCommentMessage is the message for finding sheet comments.
+type FindSheetCommentMessage struct {
+	UID      *int
+	SheetUID *int
+	Revision *int
+}
+
+// UpdateSheetCommentMessage is the message for updating a sheet comment.
+type UpdateSheetCommentMessage struct {
+	UID       int
+	UpdaterID int
+
+	Content *string
+}
+
+// GetSheetComment gets a sheet comment.
+func (s *Store) GetSheetComment(ctx context.Context, find *FindSheetCommentMessage) (*SheetCommentMessage, error) {
+	comments, err := s.ListSheetComments(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(comments) == 0 {
+		return nil, nil
+	}
+	if len(comments) > 1 {
+		return nil, errors.Errorf("expected 1 sheet comment, got %d", len(comments))
+	}
+	return comments[0], nil
+}
+
+// ListSheetComments lists sheet comments ordered by revision, line and creation.
+func (s *Store) ListSheetComments(ctx context.Context, find *FindSheetCommentMessage) ([]*SheetCommentMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.SheetUID; v != nil {
+		where, args = append(where, fmt.Sprintf("sheet_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Revision; v != nil {
+		where, args = append(where, fmt.Sprintf("revision = $%d", len(args)+1)), append(args, *v)
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			updater_id,
+			updated_ts,
+			sheet_id,
+			revision,
+			line,
+			content
+		FROM sheet_comment
+		WHERE %s
+		ORDER BY revision, line, id`, strings.Join(where, " AND ")),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var comments []*SheetCommentMessage
+	for rows.Next() {
+		var comment SheetCommentMessage
+		var createdTs, updatedTs int64
+		if err := rows.Scan(
+			&comment.UID,
+			&comment.CreatorID,
+			&createdTs,
+			&comment.UpdaterID,
+			&updatedTs,
+			&comment.SheetUID,
+			&comment.Revision,
+			&comment.Line,
+			&comment.Content,
+		); err != nil {
+			return nil, err
+		}
+		comment.CreatedTime = time.Unix(createdTs, 0)
+		comment.UpdatedTime = time.Unix(updatedTs, 0)
+		comments = append(comments, &comment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+// CreateSheetComment creates a sheet comment.
+func (s *Store) CreateSheetComment(ctx context.Context, create *SheetCommentMessage) (*SheetCommentMessage, error) {
+	query := `
+		INSERT INTO sheet_comment (
+			creator_id,
+			updater_id,
+			sheet_id,
+			revision,
+			line,
+			content
+		)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_ts, updated_ts
+	`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	create.UpdaterID = create.CreatorID
+	var createdTs, updatedTs int64
+	if err := tx.QueryRowContext(ctx, query,
+		create.CreatorID,
+		create.UpdaterID,
+		create.SheetUID,
+		create.Revision,
+		create.Line,
+		create.Content,
+	).Scan(
+		&create.UID,
+		&createdTs,
+		&updatedTs,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, common.FormatDBErrorEmptyRowWithQuery(query)
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	create.CreatedTime = time.Unix(createdTs, 0)
+	create.UpdatedTime = time.Unix(updatedTs, 0)
+	return create, nil
+}
+
+// UpdateSheetComment updates a sheet comment.
+func (s *Store) UpdateSheetComment(ctx context.Context, patch *UpdateSheetCommentMessage) (*SheetCommentMessage, error) {
+	set, args := []string{"updater_id = $1"}, []any{patch.UpdaterID}
+	if v := patch.Content; v != nil {
+		set, args = append(set, fmt.Sprintf("content = $%d", len(args)+1)), append(args, *v)
+	}
+	args = append(args, patch.UID)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var comment SheetCommentMessage
+	var createdTs, updatedTs int64
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf(`
+		UPDATE sheet_comment
+		SET %s
+		WHERE id = $%d
+		RETURNING id, creator_id, created_ts, updater_id, updated_ts, sheet_id, revision, line, content
+	`, strings.Join(set, ", "), len(args)),
+		args...,
+	).Scan(
+		&comment.UID,
+		&comment.CreatorID,
+		&createdTs,
+		&comment.UpdaterID,
+		&updatedTs,
+		&comment.SheetUID,
+		&comment.Revision,
+		&comment.Line,
+		&comment.Content,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("sheet comment not found: %d", patch.UID)}
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	comment.CreatedTime = time.Unix(createdTs, 0)
+	comment.UpdatedTime = time.Unix(updatedTs, 0)
+	return &comment, nil
+}
+
+// DeleteSheetComment deletes a sheet comment.
+func (s *Store) DeleteSheetComment(ctx context.Context, uid int) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM sheet_comment WHERE id = $1`, uid); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,146 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+)
+
+// SheetRevisionMessage is the message for an immutable revision of a sheet statement.
+type SheetRevisionMessage struct {
+	SheetUID  int
+	Revision  int
+	CreatorID int
+	Statement string
+
+	// Output only fields
+	UID         int
+	Size        int64
+	CreatedTime time.Time
+}
+
+// FindSheetRevisionMessage is the message for finding sheet revisions.
+type FindSheetRevisionMessage struct {
+	SheetUID int
+	Revision *int
+
+	// LoadFull is used if we want to load the full statement.
+	LoadFull bool
+}
+
+// GetSheetRevision gets a sheet revision.
+func (s *Store) GetSheetRevision(ctx context.Context, find *FindSheetRevisionMessage) (*SheetRevisionMessage, error) {
+	revisions, err := s.ListSheetRevisions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 {
+		return nil, nil
+	}
+	if len(revisions) > 1 {
+		return nil, errors.Errorf("expected 1 sheet revision, got %d", len(revisions))
+	}
+	return revisions[0], nil
+}
+
+// ListSheetRevisions lists the revisions of a sheet, the latest revision comes first.
+func (s *Store) ListSheetRevisions(ctx context.Context, find *FindSheetRevisionMessage) ([]*SheetRevisionMessage, error) {
+	where, args := []string{"sheet_id = $1"}, []any{find.SheetUID}
+	if v := find.Revision; v != nil {
+		where, args = append(where, fmt.Sprintf("revision = $%d", len(args)+1)), append(args, *v)
+	}
+	statementField := fmt.Sprintf("LEFT(statement, %d)", common.MaxSheetSize)
+	if find.LoadFull {
+		statementField = "statement"
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			sheet_id,
+			revision,
+			%s,
+			OCTET_LENGTH(statement)
+		FROM sheet_revision
+		WHERE %s
+		ORDER BY revision DESC`, statementField, strings.Join(where, " AND ")),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*SheetRevisionMessage
+	for rows.Next() {
+		var revision SheetRevisionMessage
+		var createdTs int64
+		if err := rows.Scan(
+			&revision.UID,
+			&revision.CreatorID,
+			&createdTs,
+			&revision.SheetUID,
+			&revision.Revision,
+			&revision.Statement,
+			&revision.Size,
+		); err != nil {
+			return nil, err
+		}
+		revision.CreatedTime = time.Unix(createdTs, 0)
+		revisions = append(revisions, &revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// createSheetRevisionImpl appends a revision to the sheet if the statement differs from the latest revision.
+func createSheetRevisionImpl(ctx context.Context, tx *Tx, sheetUID int, creatorID int, statement string) error {
+	var latest sql.NullInt32
+	var latestStatement sql.NullString
+	if err := tx.QueryRowContext(ctx, `
+		SELECT revision, statement
+		FROM sheet_revision
+		WHERE sheet_id = $1
+		ORDER BY revision DESC
+		LIMIT 1
+	`, sheetUID).Scan(&latest, &latestStatement); err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if latestStatement.Valid && latestStatement.String == statement {
+		return nil
+	}
+
+	query := `
+		INSERT INTO sheet_revision (
+			creator_id,
+			sheet_id,
+			revision,
+			statement
+		)
+		VALUES ($1, $2, $3, $4)
+	`
+	if _, err := tx.ExecContext(ctx, query, creatorID, sheetUID, latest.Int32+1, statement); err != nil {
+		return errors.Wrapf(err, "failed to create revision for sheet %d", sheetUID)
+	}
+	return nil
+}
@@ -203,24 +203,9 @@ type UpsertSlowLogMessage struct {
 
 // UpsertSlowLog upserts slow query logs.
 func (s *Store) UpsertSlowLog(ctx context.Context, upsert *UpsertSlowLogMessage) error {
-	var databaseUID sql.NullInt32
-	if upsert.DatabaseName != "" {
-		instance, err := s.GetInstanceV2(ctx, &FindInstanceMessage{ResourceID: upsert.InstanceID})
-		if err != nil {
-			return err
-		}
-		database, err := s.GetDatabaseV2(ctx, &FindDatabaseMessage{
-			InstanceID:          upsert.InstanceID,
-			DatabaseName:        &upsert.DatabaseName,
-			IgnoreCaseSensitive: IgnoreDatabaseAndTableCaseSensitive(instance),
-		})
-		if err != nil {
-			return err
-		}
-		if database != nil {
-			databaseUID.Int32 = int32(database.UID)
-			databaseUID.Valid = true
-		}
+	databaseUID, err := s.getSlowLogDatabaseUID(ctx, upsert.InstanceID, upsert.DatabaseName)
+	if err != nil {
+		return err
 	}
 
 	logDate, err := strconv.Atoi(upsert.LogDate.UTC().Format("20060102"))
@@ -267,6 +252,144 @@ func (s *Store) UpsertSlowLog(ctx context.Context, upsert *UpsertSlowLogMessage)
 	return tx.Commit()
 }
 
+func (s *Store) getSlowLogDatabaseUID(ctx context.Context, instanceID *string, databaseName string) (sql.NullInt32, error) {
+	var databaseUID sql.NullInt32
+	if databaseName == "" {
+		return databaseUID, nil
+	}
+	instance, err := s.GetInstanceV2(ctx, &FindInstanceMessage{ResourceID: instanceID})
+	if err != nil {
+		return databaseUID, err
+	}
+	database, err := s.GetDatabaseV2(ctx, &FindDatabaseMessage{
+		InstanceID:          instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return databaseUID, err
+	}
+	if database != nil {
+		databaseUID.Int32 = int32(database.UID)
+		databaseUID.Valid = true
+	}
+	return databaseUID, nil
+}
+
+// GetSlowLogMessage is the message to get the slow query log of a database on a log date.
+type GetSlowLogMessage struct {
+	InstanceID   *string
+	DatabaseName string
+
+	InstanceUID int
+	LogDate     time.Time
+}
+
+// GetSlowLog gets the slow query statistics of a database on a log date, it returns nil if not found.
+func (s *Store) GetSlowLog(ctx context.Context, get *GetSlowLogMessage) (*storepb.SlowQueryStatistics, error) {
+	databaseUID, err := s.getSlowLogDatabaseUID(ctx, get.InstanceID, get.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	logDate, err := strconv.Atoi(get.LogDate.UTC().Format("20060102"))
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var logBytes []byte
+	if err := tx.QueryRowContext(ctx, `
+		SELECT
+			slow_query_statistics
+		FROM slow_query
+		WHERE instance_id = $1 AND database_id IS NOT DISTINCT FROM $2 AND log_date_ts = $3`,
+		get.InstanceUID,
+		databaseUID,
+		logDate,
+	).Scan(&logBytes); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	var slowLog storepb.SlowQueryStatistics
+	if err := protojson.Unmarshal(logBytes, &slowLog); err != nil {
+		return nil, err
+	}
+	return &slowLog, nil
+}
+
+// SlowLogMessage is the message for a stored slow query log.
+type SlowLogMessage struct {
+	UID     int
+	SlowLog *storepb.SlowQueryStatistics
+}
+
+// ListSlowLogsByEngine lists the slow query logs of the instances with the engine.
+func (s *Store) ListSlowLogsByEngine(ctx context.Context, engine storepb.Engine) ([]*SlowLogMessage, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT
+			slow_query.id,
+			slow_query.slow_query_statistics
+		FROM slow_query
+		LEFT JOIN instance ON instance.id = slow_query.instance_id
+		WHERE instance.engine = $1`,
+		engine.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var slowLogs []*SlowLogMessage
+	for rows.Next() {
+		var uid int
+		var logBytes []byte
+		if err := rows.Scan(&uid, &logBytes); err != nil {
+			return nil, err
+		}
+		var slowLog storepb.SlowQueryStatistics
+		if err := protojson.Unmarshal(logBytes, &slowLog); err != nil {
+			return nil, err
+		}
+		slowLogs = append(slowLogs, &SlowLogMessage{UID: uid, SlowLog: &slowLog})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return slowLogs, nil
+}
+
+// UpdateSlowLog updates the statistics of a stored slow query log.
+func (s *Store) UpdateSlowLog(ctx context.Context, uid int, slowLog *storepb.SlowQueryStatistics) error {
+	logBytes, err := protojson.Marshal(slowLog)
+	if err != nil {
+		return err
+	}
+	if _, err := s.db.db.ExecContext(ctx, `UPDATE slow_query SET slow_query_statistics = $1 WHERE id = $2`, logBytes, uid); err != nil {
+		return err
+	}
+	return nil
+}
+
 // DeleteOutdatedSlowLog deletes outdated slow query logs.
 func (s *Store) DeleteOutdatedSlowLog(ctx context.Context, instanceUID int, earliestDate time.Time) error {
 	tx, err := s.db.BeginTx(ctx, nil)
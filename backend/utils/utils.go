@@ -61,6 +61,17 @@ func DataSourceFromInstanceWithType(instance *store.InstanceMessage, dataSourceT
 	return nil
 }
 
+// DataSourcesFromInstanceWithType gets all the data sources of the type from an instance.
+func DataSourcesFromInstanceWithType(instance *store.InstanceMessage, dataSourceType api.DataSourceType) []*store.DataSourceMessage {
+	var dataSources []*store.DataSourceMessage
+	for _, dataSource := range instance.DataSources {
+		if dataSource.Type == dataSourceType {
+			dataSources = append(dataSources, dataSource)
+		}
+	}
+	return dataSources
+}
+
 // isMatchExpression checks whether a databases matches the query.
 // labels is a mapping from database label key to value.
 func isMatchExpression(labels map[string]string, expression *api.LabelSelectorRequirement) bool {
@@ -379,29 +390,63 @@ func EndMigration(ctx context.Context, storeInstance *store.Store, startedNs int
 
 // FindNextPendingStep finds the next pending step in the approval flow.
 func FindNextPendingStep(template *storepb.ApprovalTemplate, approvers []*storepb.IssuePayloadApproval_Approver) *storepb.ApprovalStep {
-	// We can do the finding like this for now because we are presuming that
-	// one step is approved by one approver.
-	// and the approver status is either
-	// APPROVED or REJECTED.
-	if len(approvers) >= len(template.Flow.Steps) {
-		return nil
+	// The approvers are appended in the order of the steps, and each step
+	// consumes as many approvers as its required approvals.
+	// The approver status is either APPROVED or REJECTED.
+	start := 0
+	for _, step := range template.Flow.Steps {
+		end := start + GetRequiredApprovals(step)
+		if len(approvers) < end {
+			return step
+		}
+		start = end
 	}
-	return template.Flow.Steps[len(approvers)]
+	return nil
 }
 
 // FindRejectedStep finds the rejected step in the approval flow.
 func FindRejectedStep(template *storepb.ApprovalTemplate, approvers []*storepb.IssuePayloadApproval_Approver) *storepb.ApprovalStep {
-	for i, approver := range approvers {
-		if i >= len(template.Flow.Steps) {
+	start := 0
+	for _, step := range template.Flow.Steps {
+		if start >= len(approvers) {
+			return nil
+		}
+		end := min(start+GetRequiredApprovals(step), len(approvers))
+		for _, approver := range approvers[start:end] {
+			if approver.Status == storepb.IssuePayloadApproval_Approver_REJECTED {
+				return step
+			}
+		}
+		start = end
+	}
+	return nil
+}
+
+// FindStepApprovers finds the approvers of the step in the approval flow.
+func FindStepApprovers(template *storepb.ApprovalTemplate, approvers []*storepb.IssuePayloadApproval_Approver, step *storepb.ApprovalStep) []*storepb.IssuePayloadApproval_Approver {
+	start := 0
+	for _, s := range template.Flow.Steps {
+		if start >= len(approvers) {
 			return nil
 		}
-		if approver.Status == storepb.IssuePayloadApproval_Approver_REJECTED {
-			return template.Flow.Steps[i]
+		end := min(start+GetRequiredApprovals(s), len(approvers))
+		if s == step {
+			return approvers[start:end]
 		}
+		start = end
 	}
 	return nil
 }
 
+// GetRequiredApprovals returns the number of approvals required by the step, which is the quorum of its only node.
+// It's one approval if the quorum is not set.
+func GetRequiredApprovals(step *storepb.ApprovalStep) int {
+	if len(step.Nodes) != 1 || step.Nodes[0].RequiredApprovals <= 1 {
+		return 1
+	}
+	return int(step.Nodes[0].RequiredApprovals)
+}
+
 // CheckApprovalApproved checks if the approval is approved.
 func CheckApprovalApproved(approval *storepb.IssuePayloadApproval) (bool, error) {
 	if approval == nil || !approval.ApprovalFindingDone {
@@ -432,3 +432,95 @@ func TestGetRenderedStatement(t *testing.T) {
 		assert.Equal(t, tc.expected, actual)
 	}
 }
+
+func TestFindApprovalSteps(t *testing.T) {
+	newStep := func(requiredApprovals int32) *storepb.ApprovalStep {
+		return &storepb.ApprovalStep{
+			Type: storepb.ApprovalStep_ANY,
+			Nodes: []*storepb.ApprovalNode{
+				{
+					Type: storepb.ApprovalNode_ANY_IN_GROUP,
+					Payload: &storepb.ApprovalNode_Users_{
+						Users: &storepb.ApprovalNode_Users{
+							Members: []string{"users/a@example.com", "users/b@example.com", "users/c@example.com"},
+						},
+					},
+					RequiredApprovals: requiredApprovals,
+				},
+			},
+		}
+	}
+	step1, step2, step3 := newStep(0), newStep(2), newStep(1)
+	template := &storepb.ApprovalTemplate{
+		Flow: &storepb.ApprovalFlow{
+			Steps: []*storepb.ApprovalStep{step1, step2, step3},
+		},
+	}
+	approved := func(principalID int32) *storepb.IssuePayloadApproval_Approver {
+		return &storepb.IssuePayloadApproval_Approver{Status: storepb.IssuePayloadApproval_Approver_APPROVED, PrincipalId: principalID}
+	}
+	rejected := func(principalID int32) *storepb.IssuePayloadApproval_Approver {
+		return &storepb.IssuePayloadApproval_Approver{Status: storepb.IssuePayloadApproval_Approver_REJECTED, PrincipalId: principalID}
+	}
+
+	tests := []struct {
+		approvers     []*storepb.IssuePayloadApproval_Approver
+		pendingStep   *storepb.ApprovalStep
+		rejectedStep  *storepb.ApprovalStep
+		stepApprovers int
+	}{
+		{
+			approvers:   nil,
+			pendingStep: step1,
+		},
+		{
+			approvers:   []*storepb.IssuePayloadApproval_Approver{approved(1)},
+			pendingStep: step2,
+		},
+		{
+			// The quorum of step 2 is not reached yet.
+			approvers:     []*storepb.IssuePayloadApproval_Approver{approved(1), approved(2)},
+			pendingStep:   step2,
+			stepApprovers: 1,
+		},
+		{
+			approvers:    []*storepb.IssuePayloadApproval_Approver{approved(1), approved(2), rejected(3)},
+			pendingStep:  nil,
+			rejectedStep: step2,
+		},
+		{
+			approvers:   []*storepb.IssuePayloadApproval_Approver{approved(1), approved(2), approved(3)},
+			pendingStep: step3,
+		},
+		{
+			approvers:   []*storepb.IssuePayloadApproval_Approver{approved(1), approved(2), approved(3), approved(1)},
+			pendingStep: nil,
+		},
+	}
+
+	a := require.New(t)
+	for i, test := range tests {
+		a.Equal(test.rejectedStep, FindRejectedStep(template, test.approvers), i)
+		if test.rejectedStep != nil {
+			continue
+		}
+		a.Equal(test.pendingStep, FindNextPendingStep(template, test.approvers), i)
+		if test.pendingStep != nil {
+			a.Len(FindStepApprovers(template, test.approvers, test.pendingStep), test.stepApprovers, i)
+		}
+	}
+}
+
+func TestGetRequiredApprovals(t *testing.T) {
+	tests := []struct {
+		step *storepb.ApprovalStep
+		want int
+	}{
+		{step: &storepb.ApprovalStep{Type: storepb.ApprovalStep_ANY}, want: 1},
+		{step: &storepb.ApprovalStep{Type: storepb.ApprovalStep_ANY, Nodes: []*storepb.ApprovalNode{{}}}, want: 1},
+		{step: &storepb.ApprovalStep{Type: storepb.ApprovalStep_ANY, Nodes: []*storepb.ApprovalNode{{RequiredApprovals: 2}}}, want: 2},
+	}
+	for i, test := range tests {
+		require.Equal(t, test.want, GetRequiredApprovals(test.step), i)
+	}
+}
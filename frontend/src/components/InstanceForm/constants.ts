@@ -31,6 +31,8 @@ export const defaultPortForEngine = (engine: Engine) => {
     return "9030";
   } else if (engine === Engine.DORIS) {
     return "9030";
+  } else if (engine === Engine.COCKROACHDB) {
+    return "26257";
   }
   return "3306";
 };
@@ -1,6 +1,8 @@
 /* eslint-disable */
 import Long from "long";
 import _m0 from "protobufjs/minimal";
+import { Duration } from "../google/protobuf/duration";
+import { Timestamp } from "../google/protobuf/timestamp";
 
 export const protobufPackage = "bytebase.store";
 
@@ -17,6 +19,7 @@ export interface IssuePayloadApproval {
    */
   approvalFindingDone: boolean;
   approvalFindingError: string;
+  slaState: IssuePayloadApproval_SLAState | undefined;
 }
 
 export interface IssuePayloadApproval_Approver {
@@ -71,11 +74,46 @@ export function issuePayloadApproval_Approver_StatusToJSON(object: IssuePayloadA
   }
 }
 
+/** SLAState is the SLA state of the pending approval step, maintained by the approval SLA runner. */
+export interface IssuePayloadApproval_SLAState {
+  /**
+   * The number of approvers when the pending step started waiting.
+   * The SLA timer restarts whenever the number of approvers changes.
+   */
+  approverCount: number;
+  pendingSince: Date | undefined;
+  lastRemindTime:
+    | Date
+    | undefined;
+  /** If the value is `true`, the users with the escalation role of the SLA can approve the pending step as well. */
+  escalated: boolean;
+}
+
 export interface ApprovalTemplate {
   flow: ApprovalFlow | undefined;
   title: string;
   description: string;
   creatorId: number;
+  sla: ApprovalSLA | undefined;
+}
+
+/**
+ * ApprovalSLA is the SLA of the pending approval steps.
+ * The durations are counted from the time the pending step started waiting, and zero disables the action.
+ */
+export interface ApprovalSLA {
+  /** The interval to remind the pending approvers via mail and webhooks. */
+  reminderInterval:
+    | Duration
+    | undefined;
+  /** The timeout to escalate the pending step to the escalation role. */
+  escalationTimeout:
+    | Duration
+    | undefined;
+  /** Format: roles/{role} */
+  escalationRole: string;
+  /** The timeout to reject the pending approval automatically. */
+  rejectionTimeout: Duration | undefined;
 }
 
 export interface ApprovalFlow {
@@ -138,7 +176,32 @@ export interface ApprovalNode {
     | undefined;
   /** Format: roles/{role} */
   role?: string | undefined;
-  externalNodeId?: string | undefined;
+  externalNodeId?:
+    | string
+    | undefined;
+  /** The named users who can approve the node. */
+  users?:
+    | ApprovalNode_Users
+    | undefined;
+  /**
+   * The CEL expression to resolve the approvers when the approval template is found for the issue.
+   * The expression returns the approver emails in a string or a list of strings.
+   * The variables are:
+   * - issue_creator: the email of the issue creator.
+   * - project_owners: the emails of the owners of the issue project.
+   * - database_labels: the labels of each database the issue targets.
+   * e.g. database_labels.filter(l, "owner" in l).map(l, l["owner"])
+   */
+  approverExpression?:
+    | string
+    | undefined;
+  /**
+   * The number of approvals required to approve the node, i.e. "N of M".
+   * Zero is treated as one.
+   */
+  requiredApprovals: number;
+  /** The approver emails resolved from the approver_expression at issue time. */
+  resolvedApprovers: string[];
 }
 
 /**
@@ -238,8 +301,19 @@ export function approvalNode_GroupValueToJSON(object: ApprovalNode_GroupValue):
   }
 }
 
+export interface ApprovalNode_Users {
+  /** Format: users/{email} */
+  members: string[];
+}
+
 function createBaseIssuePayloadApproval(): IssuePayloadApproval {
-  return { approvalTemplates: [], approvers: [], approvalFindingDone: false, approvalFindingError: "" };
+  return {
+    approvalTemplates: [],
+    approvers: [],
+    approvalFindingDone: false,
+    approvalFindingError: "",
+    slaState: undefined,
+  };
 }
 
 export const IssuePayloadApproval = {
@@ -256,6 +330,9 @@ export const IssuePayloadApproval = {
     if (message.approvalFindingError !== "") {
       writer.uint32(34).string(message.approvalFindingError);
     }
+    if (message.slaState !== undefined) {
+      IssuePayloadApproval_SLAState.encode(message.slaState, writer.uint32(42).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -294,6 +371,13 @@ export const IssuePayloadApproval = {
 
           message.approvalFindingError = reader.string();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.slaState = IssuePayloadApproval_SLAState.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -313,6 +397,7 @@ export const IssuePayloadApproval = {
         : [],
       approvalFindingDone: isSet(object.approvalFindingDone) ? globalThis.Boolean(object.approvalFindingDone) : false,
       approvalFindingError: isSet(object.approvalFindingError) ? globalThis.String(object.approvalFindingError) : "",
+      slaState: isSet(object.slaState) ? IssuePayloadApproval_SLAState.fromJSON(object.slaState) : undefined,
     };
   },
 
@@ -330,6 +415,9 @@ export const IssuePayloadApproval = {
     if (message.approvalFindingError !== "") {
       obj.approvalFindingError = message.approvalFindingError;
     }
+    if (message.slaState !== undefined) {
+      obj.slaState = IssuePayloadApproval_SLAState.toJSON(message.slaState);
+    }
     return obj;
   },
 
@@ -342,6 +430,9 @@ export const IssuePayloadApproval = {
     message.approvers = object.approvers?.map((e) => IssuePayloadApproval_Approver.fromPartial(e)) || [];
     message.approvalFindingDone = object.approvalFindingDone ?? false;
     message.approvalFindingError = object.approvalFindingError ?? "";
+    message.slaState = (object.slaState !== undefined && object.slaState !== null)
+      ? IssuePayloadApproval_SLAState.fromPartial(object.slaState)
+      : undefined;
     return message;
   },
 };
@@ -420,8 +511,112 @@ export const IssuePayloadApproval_Approver = {
   },
 };
 
+function createBaseIssuePayloadApproval_SLAState(): IssuePayloadApproval_SLAState {
+  return { approverCount: 0, pendingSince: undefined, lastRemindTime: undefined, escalated: false };
+}
+
+export const IssuePayloadApproval_SLAState = {
+  encode(message: IssuePayloadApproval_SLAState, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.approverCount !== 0) {
+      writer.uint32(8).int32(message.approverCount);
+    }
+    if (message.pendingSince !== undefined) {
+      Timestamp.encode(toTimestamp(message.pendingSince), writer.uint32(18).fork()).ldelim();
+    }
+    if (message.lastRemindTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastRemindTime), writer.uint32(26).fork()).ldelim();
+    }
+    if (message.escalated === true) {
+      writer.uint32(32).bool(message.escalated);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IssuePayloadApproval_SLAState {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssuePayloadApproval_SLAState();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.approverCount = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pendingSince = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.lastRemindTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.escalated = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IssuePayloadApproval_SLAState {
+    return {
+      approverCount: isSet(object.approverCount) ? globalThis.Number(object.approverCount) : 0,
+      pendingSince: isSet(object.pendingSince) ? fromJsonTimestamp(object.pendingSince) : undefined,
+      lastRemindTime: isSet(object.lastRemindTime) ? fromJsonTimestamp(object.lastRemindTime) : undefined,
+      escalated: isSet(object.escalated) ? globalThis.Boolean(object.escalated) : false,
+    };
+  },
+
+  toJSON(message: IssuePayloadApproval_SLAState): unknown {
+    const obj: any = {};
+    if (message.approverCount !== 0) {
+      obj.approverCount = Math.round(message.approverCount);
+    }
+    if (message.pendingSince !== undefined) {
+      obj.pendingSince = message.pendingSince.toISOString();
+    }
+    if (message.lastRemindTime !== undefined) {
+      obj.lastRemindTime = message.lastRemindTime.toISOString();
+    }
+    if (message.escalated === true) {
+      obj.escalated = message.escalated;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<IssuePayloadApproval_SLAState>): IssuePayloadApproval_SLAState {
+    return IssuePayloadApproval_SLAState.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IssuePayloadApproval_SLAState>): IssuePayloadApproval_SLAState {
+    const message = createBaseIssuePayloadApproval_SLAState();
+    message.approverCount = object.approverCount ?? 0;
+    message.pendingSince = object.pendingSince ?? undefined;
+    message.lastRemindTime = object.lastRemindTime ?? undefined;
+    message.escalated = object.escalated ?? false;
+    return message;
+  },
+};
+
 function createBaseApprovalTemplate(): ApprovalTemplate {
-  return { flow: undefined, title: "", description: "", creatorId: 0 };
+  return { flow: undefined, title: "", description: "", creatorId: 0, sla: undefined };
 }
 
 export const ApprovalTemplate = {
@@ -438,6 +633,9 @@ export const ApprovalTemplate = {
     if (message.creatorId !== 0) {
       writer.uint32(32).int32(message.creatorId);
     }
+    if (message.sla !== undefined) {
+      ApprovalSLA.encode(message.sla, writer.uint32(42).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -476,6 +674,13 @@ export const ApprovalTemplate = {
 
           message.creatorId = reader.int32();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.sla = ApprovalSLA.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -491,6 +696,7 @@ export const ApprovalTemplate = {
       title: isSet(object.title) ? globalThis.String(object.title) : "",
       description: isSet(object.description) ? globalThis.String(object.description) : "",
       creatorId: isSet(object.creatorId) ? globalThis.Number(object.creatorId) : 0,
+      sla: isSet(object.sla) ? ApprovalSLA.fromJSON(object.sla) : undefined,
     };
   },
 
@@ -508,6 +714,9 @@ export const ApprovalTemplate = {
     if (message.creatorId !== 0) {
       obj.creatorId = Math.round(message.creatorId);
     }
+    if (message.sla !== undefined) {
+      obj.sla = ApprovalSLA.toJSON(message.sla);
+    }
     return obj;
   },
 
@@ -522,6 +731,119 @@ export const ApprovalTemplate = {
     message.title = object.title ?? "";
     message.description = object.description ?? "";
     message.creatorId = object.creatorId ?? 0;
+    message.sla = (object.sla !== undefined && object.sla !== null)
+      ? ApprovalSLA.fromPartial(object.sla)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseApprovalSLA(): ApprovalSLA {
+  return { reminderInterval: undefined, escalationTimeout: undefined, escalationRole: "", rejectionTimeout: undefined };
+}
+
+export const ApprovalSLA = {
+  encode(message: ApprovalSLA, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.reminderInterval !== undefined) {
+      Duration.encode(message.reminderInterval, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.escalationTimeout !== undefined) {
+      Duration.encode(message.escalationTimeout, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.escalationRole !== "") {
+      writer.uint32(26).string(message.escalationRole);
+    }
+    if (message.rejectionTimeout !== undefined) {
+      Duration.encode(message.rejectionTimeout, writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ApprovalSLA {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseApprovalSLA();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.reminderInterval = Duration.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.escalationTimeout = Duration.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.escalationRole = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.rejectionTimeout = Duration.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ApprovalSLA {
+    return {
+      reminderInterval: isSet(object.reminderInterval) ? Duration.fromJSON(object.reminderInterval) : undefined,
+      escalationTimeout: isSet(object.escalationTimeout) ? Duration.fromJSON(object.escalationTimeout) : undefined,
+      escalationRole: isSet(object.escalationRole) ? globalThis.String(object.escalationRole) : "",
+      rejectionTimeout: isSet(object.rejectionTimeout) ? Duration.fromJSON(object.rejectionTimeout) : undefined,
+    };
+  },
+
+  toJSON(message: ApprovalSLA): unknown {
+    const obj: any = {};
+    if (message.reminderInterval !== undefined) {
+      obj.reminderInterval = Duration.toJSON(message.reminderInterval);
+    }
+    if (message.escalationTimeout !== undefined) {
+      obj.escalationTimeout = Duration.toJSON(message.escalationTimeout);
+    }
+    if (message.escalationRole !== "") {
+      obj.escalationRole = message.escalationRole;
+    }
+    if (message.rejectionTimeout !== undefined) {
+      obj.rejectionTimeout = Duration.toJSON(message.rejectionTimeout);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ApprovalSLA>): ApprovalSLA {
+    return ApprovalSLA.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ApprovalSLA>): ApprovalSLA {
+    const message = createBaseApprovalSLA();
+    message.reminderInterval = (object.reminderInterval !== undefined && object.reminderInterval !== null)
+      ? Duration.fromPartial(object.reminderInterval)
+      : undefined;
+    message.escalationTimeout = (object.escalationTimeout !== undefined && object.escalationTimeout !== null)
+      ? Duration.fromPartial(object.escalationTimeout)
+      : undefined;
+    message.escalationRole = object.escalationRole ?? "";
+    message.rejectionTimeout = (object.rejectionTimeout !== undefined && object.rejectionTimeout !== null)
+      ? Duration.fromPartial(object.rejectionTimeout)
+      : undefined;
     return message;
   },
 };
@@ -660,7 +982,16 @@ export const ApprovalStep = {
 };
 
 function createBaseApprovalNode(): ApprovalNode {
-  return { type: 0, groupValue: undefined, role: undefined, externalNodeId: undefined };
+  return {
+    type: 0,
+    groupValue: undefined,
+    role: undefined,
+    externalNodeId: undefined,
+    users: undefined,
+    approverExpression: undefined,
+    requiredApprovals: 0,
+    resolvedApprovers: [],
+  };
 }
 
 export const ApprovalNode = {
@@ -677,6 +1008,18 @@ export const ApprovalNode = {
     if (message.externalNodeId !== undefined) {
       writer.uint32(34).string(message.externalNodeId);
     }
+    if (message.users !== undefined) {
+      ApprovalNode_Users.encode(message.users, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.approverExpression !== undefined) {
+      writer.uint32(50).string(message.approverExpression);
+    }
+    if (message.requiredApprovals !== 0) {
+      writer.uint32(56).int32(message.requiredApprovals);
+    }
+    for (const v of message.resolvedApprovers) {
+      writer.uint32(66).string(v!);
+    }
     return writer;
   },
 
@@ -715,6 +1058,34 @@ export const ApprovalNode = {
 
           message.externalNodeId = reader.string();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.users = ApprovalNode_Users.decode(reader, reader.uint32());
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.approverExpression = reader.string();
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.requiredApprovals = reader.int32();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.resolvedApprovers.push(reader.string());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -730,6 +1101,12 @@ export const ApprovalNode = {
       groupValue: isSet(object.groupValue) ? approvalNode_GroupValueFromJSON(object.groupValue) : undefined,
       role: isSet(object.role) ? globalThis.String(object.role) : undefined,
       externalNodeId: isSet(object.externalNodeId) ? globalThis.String(object.externalNodeId) : undefined,
+      users: isSet(object.users) ? ApprovalNode_Users.fromJSON(object.users) : undefined,
+      approverExpression: isSet(object.approverExpression) ? globalThis.String(object.approverExpression) : undefined,
+      requiredApprovals: isSet(object.requiredApprovals) ? globalThis.Number(object.requiredApprovals) : 0,
+      resolvedApprovers: globalThis.Array.isArray(object?.resolvedApprovers)
+        ? object.resolvedApprovers.map((e: any) => globalThis.String(e))
+        : [],
     };
   },
 
@@ -747,6 +1124,18 @@ export const ApprovalNode = {
     if (message.externalNodeId !== undefined) {
       obj.externalNodeId = message.externalNodeId;
     }
+    if (message.users !== undefined) {
+      obj.users = ApprovalNode_Users.toJSON(message.users);
+    }
+    if (message.approverExpression !== undefined) {
+      obj.approverExpression = message.approverExpression;
+    }
+    if (message.requiredApprovals !== 0) {
+      obj.requiredApprovals = Math.round(message.requiredApprovals);
+    }
+    if (message.resolvedApprovers?.length) {
+      obj.resolvedApprovers = message.resolvedApprovers;
+    }
     return obj;
   },
 
@@ -759,6 +1148,71 @@ export const ApprovalNode = {
     message.groupValue = object.groupValue ?? undefined;
     message.role = object.role ?? undefined;
     message.externalNodeId = object.externalNodeId ?? undefined;
+    message.users = (object.users !== undefined && object.users !== null)
+      ? ApprovalNode_Users.fromPartial(object.users)
+      : undefined;
+    message.approverExpression = object.approverExpression ?? undefined;
+    message.requiredApprovals = object.requiredApprovals ?? 0;
+    message.resolvedApprovers = object.resolvedApprovers?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseApprovalNode_Users(): ApprovalNode_Users {
+  return { members: [] };
+}
+
+export const ApprovalNode_Users = {
+  encode(message: ApprovalNode_Users, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.members) {
+      writer.uint32(10).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ApprovalNode_Users {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseApprovalNode_Users();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.members.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ApprovalNode_Users {
+    return {
+      members: globalThis.Array.isArray(object?.members) ? object.members.map((e: any) => globalThis.String(e)) : [],
+    };
+  },
+
+  toJSON(message: ApprovalNode_Users): unknown {
+    const obj: any = {};
+    if (message.members?.length) {
+      obj.members = message.members;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ApprovalNode_Users>): ApprovalNode_Users {
+    return ApprovalNode_Users.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ApprovalNode_Users>): ApprovalNode_Users {
+    const message = createBaseApprovalNode_Users();
+    message.members = object.members?.map((e) => e) || [];
     return message;
   },
 };
@@ -771,6 +1225,32 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = numberToLong(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds.toNumber() || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new globalThis.Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof globalThis.Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new globalThis.Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function numberToLong(number: number) {
+  return Long.fromNumber(number);
+}
+
 if (_m0.util.Long !== Long) {
   _m0.util.Long = Long as any;
   _m0.configure();
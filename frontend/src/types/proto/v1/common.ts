@@ -62,6 +62,8 @@ export enum Engine {
   OCEANBASE_ORACLE = 17,
   STARROCKS = 18,
   DORIS = 19,
+  COCKROACHDB = 20,
+  DUCKDB = 21,
   UNRECOGNIZED = -1,
 }
 
@@ -127,6 +129,12 @@ export function engineFromJSON(object: any): Engine {
     case 19:
     case "DORIS":
       return Engine.DORIS;
+    case 20:
+    case "COCKROACHDB":
+      return Engine.COCKROACHDB;
+    case 21:
+    case "DUCKDB":
+      return Engine.DUCKDB;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -176,6 +184,10 @@ export function engineToJSON(object: Engine): string {
       return "STARROCKS";
     case Engine.DORIS:
       return "DORIS";
+    case Engine.COCKROACHDB:
+      return "COCKROACHDB";
+    case Engine.DUCKDB:
+      return "DUCKDB";
     case Engine.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
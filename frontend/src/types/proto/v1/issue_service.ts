@@ -272,6 +272,8 @@ export interface Issue {
    * - users/{email}
    */
   releasers: string[];
+  /** If the value is `true`, the pending approval step has been escalated to the escalation role of the approval template SLA. */
+  approvalEscalated: boolean;
 }
 
 export enum Issue_Type {
@@ -389,6 +391,26 @@ export interface ApprovalTemplate {
    * TODO: we should mark it as OUTPUT_ONLY, but currently the frontend will post the approval setting with creator.
    */
   creator: string;
+  sla: ApprovalSLA | undefined;
+}
+
+/**
+ * ApprovalSLA is the SLA of the pending approval steps.
+ * The durations are counted from the time the pending step started waiting, and zero disables the action.
+ */
+export interface ApprovalSLA {
+  /** The interval to remind the pending approvers via mail and webhooks. */
+  reminderInterval:
+    | Duration
+    | undefined;
+  /** The timeout to escalate the pending step to the escalation role. */
+  escalationTimeout:
+    | Duration
+    | undefined;
+  /** Format: roles/{role} */
+  escalationRole: string;
+  /** The timeout to reject the pending approval automatically. */
+  rejectionTimeout: Duration | undefined;
 }
 
 export interface ApprovalFlow {
@@ -451,7 +473,32 @@ export interface ApprovalNode {
     | undefined;
   /** Format: roles/{role} */
   role?: string | undefined;
-  externalNodeId?: string | undefined;
+  externalNodeId?:
+    | string
+    | undefined;
+  /** The named users who can approve the node. */
+  users?:
+    | ApprovalNode_Users
+    | undefined;
+  /**
+   * The CEL expression to resolve the approvers when the approval template is found for the issue.
+   * The expression returns the approver emails in a string or a list of strings.
+   * The variables are:
+   * - issue_creator: the email of the issue creator.
+   * - project_owners: the emails of the owners of the issue project.
+   * - database_labels: the labels of each database the issue targets.
+   * e.g. database_labels.filter(l, "owner" in l).map(l, l["owner"])
+   */
+  approverExpression?:
+    | string
+    | undefined;
+  /**
+   * The number of approvals required to approve the node, i.e. "N of M".
+   * Zero is treated as one.
+   */
+  requiredApprovals: number;
+  /** The approver emails resolved from the approver_expression at issue time. */
+  resolvedApprovers: string[];
 }
 
 /**
@@ -551,6 +598,11 @@ export function approvalNode_GroupValueToJSON(object: ApprovalNode_GroupValue):
   }
 }
 
+export interface ApprovalNode_Users {
+  /** Format: users/{email} */
+  members: string[];
+}
+
 export interface CreateIssueCommentRequest {
   /**
    * The issue name
@@ -1581,6 +1633,7 @@ function createBaseIssue(): Issue {
     rollout: "",
     grantRequest: undefined,
     releasers: [],
+    approvalEscalated: false,
   };
 }
 
@@ -1646,6 +1699,9 @@ export const Issue = {
     for (const v of message.releasers) {
       writer.uint32(162).string(v!);
     }
+    if (message.approvalEscalated === true) {
+      writer.uint32(168).bool(message.approvalEscalated);
+    }
     return writer;
   },
 
@@ -1796,6 +1852,13 @@ export const Issue = {
 
           message.releasers.push(reader.string());
           continue;
+        case 21:
+          if (tag !== 168) {
+            break;
+          }
+
+          message.approvalEscalated = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1835,6 +1898,7 @@ export const Issue = {
       releasers: globalThis.Array.isArray(object?.releasers)
         ? object.releasers.map((e: any) => globalThis.String(e))
         : [],
+      approvalEscalated: isSet(object.approvalEscalated) ? globalThis.Boolean(object.approvalEscalated) : false,
     };
   },
 
@@ -1900,6 +1964,9 @@ export const Issue = {
     if (message.releasers?.length) {
       obj.releasers = message.releasers;
     }
+    if (message.approvalEscalated === true) {
+      obj.approvalEscalated = message.approvalEscalated;
+    }
     return obj;
   },
 
@@ -1930,6 +1997,7 @@ export const Issue = {
       ? GrantRequest.fromPartial(object.grantRequest)
       : undefined;
     message.releasers = object.releasers?.map((e) => e) || [];
+    message.approvalEscalated = object.approvalEscalated ?? false;
     return message;
   },
 };
@@ -2117,7 +2185,7 @@ export const GrantRequest = {
 };
 
 function createBaseApprovalTemplate(): ApprovalTemplate {
-  return { flow: undefined, title: "", description: "", creator: "" };
+  return { flow: undefined, title: "", description: "", creator: "", sla: undefined };
 }
 
 export const ApprovalTemplate = {
@@ -2134,6 +2202,9 @@ export const ApprovalTemplate = {
     if (message.creator !== "") {
       writer.uint32(34).string(message.creator);
     }
+    if (message.sla !== undefined) {
+      ApprovalSLA.encode(message.sla, writer.uint32(42).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -2172,6 +2243,13 @@ export const ApprovalTemplate = {
 
           message.creator = reader.string();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.sla = ApprovalSLA.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2187,6 +2265,7 @@ export const ApprovalTemplate = {
       title: isSet(object.title) ? globalThis.String(object.title) : "",
       description: isSet(object.description) ? globalThis.String(object.description) : "",
       creator: isSet(object.creator) ? globalThis.String(object.creator) : "",
+      sla: isSet(object.sla) ? ApprovalSLA.fromJSON(object.sla) : undefined,
     };
   },
 
@@ -2204,6 +2283,9 @@ export const ApprovalTemplate = {
     if (message.creator !== "") {
       obj.creator = message.creator;
     }
+    if (message.sla !== undefined) {
+      obj.sla = ApprovalSLA.toJSON(message.sla);
+    }
     return obj;
   },
 
@@ -2218,6 +2300,119 @@ export const ApprovalTemplate = {
     message.title = object.title ?? "";
     message.description = object.description ?? "";
     message.creator = object.creator ?? "";
+    message.sla = (object.sla !== undefined && object.sla !== null)
+      ? ApprovalSLA.fromPartial(object.sla)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseApprovalSLA(): ApprovalSLA {
+  return { reminderInterval: undefined, escalationTimeout: undefined, escalationRole: "", rejectionTimeout: undefined };
+}
+
+export const ApprovalSLA = {
+  encode(message: ApprovalSLA, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.reminderInterval !== undefined) {
+      Duration.encode(message.reminderInterval, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.escalationTimeout !== undefined) {
+      Duration.encode(message.escalationTimeout, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.escalationRole !== "") {
+      writer.uint32(26).string(message.escalationRole);
+    }
+    if (message.rejectionTimeout !== undefined) {
+      Duration.encode(message.rejectionTimeout, writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ApprovalSLA {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseApprovalSLA();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.reminderInterval = Duration.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.escalationTimeout = Duration.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.escalationRole = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.rejectionTimeout = Duration.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ApprovalSLA {
+    return {
+      reminderInterval: isSet(object.reminderInterval) ? Duration.fromJSON(object.reminderInterval) : undefined,
+      escalationTimeout: isSet(object.escalationTimeout) ? Duration.fromJSON(object.escalationTimeout) : undefined,
+      escalationRole: isSet(object.escalationRole) ? globalThis.String(object.escalationRole) : "",
+      rejectionTimeout: isSet(object.rejectionTimeout) ? Duration.fromJSON(object.rejectionTimeout) : undefined,
+    };
+  },
+
+  toJSON(message: ApprovalSLA): unknown {
+    const obj: any = {};
+    if (message.reminderInterval !== undefined) {
+      obj.reminderInterval = Duration.toJSON(message.reminderInterval);
+    }
+    if (message.escalationTimeout !== undefined) {
+      obj.escalationTimeout = Duration.toJSON(message.escalationTimeout);
+    }
+    if (message.escalationRole !== "") {
+      obj.escalationRole = message.escalationRole;
+    }
+    if (message.rejectionTimeout !== undefined) {
+      obj.rejectionTimeout = Duration.toJSON(message.rejectionTimeout);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ApprovalSLA>): ApprovalSLA {
+    return ApprovalSLA.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ApprovalSLA>): ApprovalSLA {
+    const message = createBaseApprovalSLA();
+    message.reminderInterval = (object.reminderInterval !== undefined && object.reminderInterval !== null)
+      ? Duration.fromPartial(object.reminderInterval)
+      : undefined;
+    message.escalationTimeout = (object.escalationTimeout !== undefined && object.escalationTimeout !== null)
+      ? Duration.fromPartial(object.escalationTimeout)
+      : undefined;
+    message.escalationRole = object.escalationRole ?? "";
+    message.rejectionTimeout = (object.rejectionTimeout !== undefined && object.rejectionTimeout !== null)
+      ? Duration.fromPartial(object.rejectionTimeout)
+      : undefined;
     return message;
   },
 };
@@ -2356,7 +2551,16 @@ export const ApprovalStep = {
 };
 
 function createBaseApprovalNode(): ApprovalNode {
-  return { type: 0, groupValue: undefined, role: undefined, externalNodeId: undefined };
+  return {
+    type: 0,
+    groupValue: undefined,
+    role: undefined,
+    externalNodeId: undefined,
+    users: undefined,
+    approverExpression: undefined,
+    requiredApprovals: 0,
+    resolvedApprovers: [],
+  };
 }
 
 export const ApprovalNode = {
@@ -2373,6 +2577,18 @@ export const ApprovalNode = {
     if (message.externalNodeId !== undefined) {
       writer.uint32(34).string(message.externalNodeId);
     }
+    if (message.users !== undefined) {
+      ApprovalNode_Users.encode(message.users, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.approverExpression !== undefined) {
+      writer.uint32(50).string(message.approverExpression);
+    }
+    if (message.requiredApprovals !== 0) {
+      writer.uint32(56).int32(message.requiredApprovals);
+    }
+    for (const v of message.resolvedApprovers) {
+      writer.uint32(66).string(v!);
+    }
     return writer;
   },
 
@@ -2411,6 +2627,34 @@ export const ApprovalNode = {
 
           message.externalNodeId = reader.string();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.users = ApprovalNode_Users.decode(reader, reader.uint32());
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.approverExpression = reader.string();
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.requiredApprovals = reader.int32();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.resolvedApprovers.push(reader.string());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2426,6 +2670,12 @@ export const ApprovalNode = {
       groupValue: isSet(object.groupValue) ? approvalNode_GroupValueFromJSON(object.groupValue) : undefined,
       role: isSet(object.role) ? globalThis.String(object.role) : undefined,
       externalNodeId: isSet(object.externalNodeId) ? globalThis.String(object.externalNodeId) : undefined,
+      users: isSet(object.users) ? ApprovalNode_Users.fromJSON(object.users) : undefined,
+      approverExpression: isSet(object.approverExpression) ? globalThis.String(object.approverExpression) : undefined,
+      requiredApprovals: isSet(object.requiredApprovals) ? globalThis.Number(object.requiredApprovals) : 0,
+      resolvedApprovers: globalThis.Array.isArray(object?.resolvedApprovers)
+        ? object.resolvedApprovers.map((e: any) => globalThis.String(e))
+        : [],
     };
   },
 
@@ -2443,6 +2693,18 @@ export const ApprovalNode = {
     if (message.externalNodeId !== undefined) {
       obj.externalNodeId = message.externalNodeId;
     }
+    if (message.users !== undefined) {
+      obj.users = ApprovalNode_Users.toJSON(message.users);
+    }
+    if (message.approverExpression !== undefined) {
+      obj.approverExpression = message.approverExpression;
+    }
+    if (message.requiredApprovals !== 0) {
+      obj.requiredApprovals = Math.round(message.requiredApprovals);
+    }
+    if (message.resolvedApprovers?.length) {
+      obj.resolvedApprovers = message.resolvedApprovers;
+    }
     return obj;
   },
 
@@ -2455,6 +2717,71 @@ export const ApprovalNode = {
     message.groupValue = object.groupValue ?? undefined;
     message.role = object.role ?? undefined;
     message.externalNodeId = object.externalNodeId ?? undefined;
+    message.users = (object.users !== undefined && object.users !== null)
+      ? ApprovalNode_Users.fromPartial(object.users)
+      : undefined;
+    message.approverExpression = object.approverExpression ?? undefined;
+    message.requiredApprovals = object.requiredApprovals ?? 0;
+    message.resolvedApprovers = object.resolvedApprovers?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseApprovalNode_Users(): ApprovalNode_Users {
+  return { members: [] };
+}
+
+export const ApprovalNode_Users = {
+  encode(message: ApprovalNode_Users, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.members) {
+      writer.uint32(10).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ApprovalNode_Users {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseApprovalNode_Users();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.members.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ApprovalNode_Users {
+    return {
+      members: globalThis.Array.isArray(object?.members) ? object.members.map((e: any) => globalThis.String(e)) : [],
+    };
+  },
+
+  toJSON(message: ApprovalNode_Users): unknown {
+    const obj: any = {};
+    if (message.members?.length) {
+      obj.members = message.members;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ApprovalNode_Users>): ApprovalNode_Users {
+    return ApprovalNode_Users.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ApprovalNode_Users>): ApprovalNode_Users {
+    const message = createBaseApprovalNode_Users();
+    message.members = object.members?.map((e) => e) || [];
     return message;
   },
 };
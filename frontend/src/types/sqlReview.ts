@@ -161,7 +161,12 @@ export type RuleType =
   | "index.total-number-limit"
   | "index.primary-key-type-allowlist"
   | "index.create-concurrently"
-  | "index.pk-type-limit";
+  | "index.pk-type-limit"
+  | "custom.cel";
+
+// The custom rule type, whose condition is a CEL expression.
+// The custom rules are not in the templates, and cannot be edited in the UI yet.
+export const CUSTOM_RULE_TYPE: RuleType = "custom.cel";
 
 // The naming format rule payload.
 // Used by the backend.
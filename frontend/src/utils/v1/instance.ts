@@ -89,6 +89,7 @@ export const supportedEngineV1List = () => {
     Engine.REDSHIFT,
     Engine.MARIADB,
     Engine.RISINGWAVE,
+    Engine.COCKROACHDB,
   ];
   if (locale.value === "zh-CN") {
     engines.push(Engine.DM);
@@ -128,6 +129,7 @@ export const instanceV1HasBackupRestore = (
   if (engine === Engine.REDIS) return false;
   if (engine === Engine.SPANNER) return false;
   if (engine === Engine.REDSHIFT) return false;
+  if (engine === Engine.COCKROACHDB) return false;
   return true;
 };
 
@@ -172,6 +174,7 @@ export const instanceV1HasSSL = (
     Engine.DM,
     Engine.STARROCKS,
     Engine.DORIS,
+    Engine.COCKROACHDB,
   ].includes(engine);
 };
 
@@ -186,6 +189,7 @@ export const instanceV1HasSSH = (
     Engine.OCEANBASE,
     Engine.POSTGRES,
     Engine.REDIS,
+    Engine.COCKROACHDB,
   ].includes(engine);
 };
 
@@ -202,6 +206,8 @@ export const instanceV1HasCollationAndCharacterSet = (
     Engine.RISINGWAVE,
     Engine.STARROCKS,
     Engine.DORIS,
+    Engine.COCKROACHDB,
+    Engine.DUCKDB,
   ];
   return !excludedList.includes(engine);
 };
@@ -280,6 +286,10 @@ export const engineNameV1 = (type: Engine): string => {
       return "StarRocks";
     case Engine.DORIS:
       return "Doris";
+    case Engine.COCKROACHDB:
+      return "CockroachDB";
+    case Engine.DUCKDB:
+      return "DuckDB";
   }
   return "";
 };
@@ -43,6 +43,7 @@ export const wrapSQLIdentifier = (id: string, engine: Engine) => {
     [
       Engine.POSTGRES,
       Engine.SQLITE,
+      Engine.DUCKDB,
       Engine.SNOWFLAKE,
       Engine.ORACLE,
       Engine.OCEANBASE_ORACLE,
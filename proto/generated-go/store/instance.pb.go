@@ -22,6 +22,57 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ReadOnlyRoutingPolicy_Strategy int32
+
+const (
+	ReadOnlyRoutingPolicy_STRATEGY_UNSPECIFIED ReadOnlyRoutingPolicy_Strategy = 0
+	// Route the queries to the read-only data sources in turn.
+	ReadOnlyRoutingPolicy_ROUND_ROBIN ReadOnlyRoutingPolicy_Strategy = 1
+	// Route the queries to the read-only data source with the fewest connections in use.
+	ReadOnlyRoutingPolicy_LEAST_CONNECTIONS ReadOnlyRoutingPolicy_Strategy = 2
+)
+
+// Enum value maps for ReadOnlyRoutingPolicy_Strategy.
+var (
+	ReadOnlyRoutingPolicy_Strategy_name = map[int32]string{
+		0: "STRATEGY_UNSPECIFIED",
+		1: "ROUND_ROBIN",
+		2: "LEAST_CONNECTIONS",
+	}
+	ReadOnlyRoutingPolicy_Strategy_value = map[string]int32{
+		"STRATEGY_UNSPECIFIED": 0,
+		"ROUND_ROBIN":          1,
+		"LEAST_CONNECTIONS":    2,
+	}
+)
+
+func (x ReadOnlyRoutingPolicy_Strategy) Enum() *ReadOnlyRoutingPolicy_Strategy {
+	p := new(ReadOnlyRoutingPolicy_Strategy)
+	*p = x
+	return p
+}
+
+func (x ReadOnlyRoutingPolicy_Strategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReadOnlyRoutingPolicy_Strategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_instance_proto_enumTypes[0].Descriptor()
+}
+
+func (ReadOnlyRoutingPolicy_Strategy) Type() protoreflect.EnumType {
+	return &file_store_instance_proto_enumTypes[0]
+}
+
+func (x ReadOnlyRoutingPolicy_Strategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReadOnlyRoutingPolicy_Strategy.Descriptor instead.
+func (ReadOnlyRoutingPolicy_Strategy) EnumDescriptor() ([]byte, []int) {
+	return file_store_instance_proto_rawDescGZIP(), []int{1, 0}
+}
+
 // InstanceOptions is the option for instances.
 type InstanceOptions struct {
 	state         protoimpl.MessageState
@@ -36,6 +87,8 @@ type InstanceOptions struct {
 	// The maximum number of connections.
 	// The default is 10 if the value is unset or zero.
 	MaximumConnections int32 `protobuf:"varint,3,opt,name=maximum_connections,json=maximumConnections,proto3" json:"maximum_connections,omitempty"`
+	// The policy to route the queries to the read-only data sources.
+	ReadOnlyRoutingPolicy *ReadOnlyRoutingPolicy `protobuf:"bytes,4,opt,name=read_only_routing_policy,json=readOnlyRoutingPolicy,proto3" json:"read_only_routing_policy,omitempty"`
 }
 
 func (x *InstanceOptions) Reset() {
@@ -91,6 +144,94 @@ func (x *InstanceOptions) GetMaximumConnections() int32 {
 	return 0
 }
 
+func (x *InstanceOptions) GetReadOnlyRoutingPolicy() *ReadOnlyRoutingPolicy {
+	if x != nil {
+		return x.ReadOnlyRoutingPolicy
+	}
+	return nil
+}
+
+// ReadOnlyRoutingPolicy is the policy to route the queries of SQL editor and exports to the read-only data sources.
+// It's used if the query doesn't specify the data source.
+type ReadOnlyRoutingPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The default is ROUND_ROBIN.
+	Strategy ReadOnlyRoutingPolicy_Strategy `protobuf:"varint,1,opt,name=strategy,proto3,enum=bytebase.store.ReadOnlyRoutingPolicy_Strategy" json:"strategy,omitempty"`
+	// The read-only data sources whose replication lag exceeds max_replication_lag are skipped.
+	// The replication lag is not checked if it's unset or zero.
+	// It's supported for MySQL, MariaDB and PostgreSQL.
+	MaxReplicationLag *durationpb.Duration `protobuf:"bytes,2,opt,name=max_replication_lag,json=maxReplicationLag,proto3" json:"max_replication_lag,omitempty"`
+	// Route the queries to the admin data source if no read-only data source is available.
+	FallbackToAdmin bool `protobuf:"varint,3,opt,name=fallback_to_admin,json=fallbackToAdmin,proto3" json:"fallback_to_admin,omitempty"`
+	// Skip the read-only data sources whose replication lag is unknown, e.g. the user of the data source lacks the
+	// privilege to read the replication status.
+	// By default, they're not skipped as if they have no lag.
+	SkipUnknownReplicationLag bool `protobuf:"varint,4,opt,name=skip_unknown_replication_lag,json=skipUnknownReplicationLag,proto3" json:"skip_unknown_replication_lag,omitempty"`
+}
+
+func (x *ReadOnlyRoutingPolicy) Reset() {
+	*x = ReadOnlyRoutingPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_instance_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadOnlyRoutingPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadOnlyRoutingPolicy) ProtoMessage() {}
+
+func (x *ReadOnlyRoutingPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadOnlyRoutingPolicy.ProtoReflect.Descriptor instead.
+func (*ReadOnlyRoutingPolicy) Descriptor() ([]byte, []int) {
+	return file_store_instance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReadOnlyRoutingPolicy) GetStrategy() ReadOnlyRoutingPolicy_Strategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return ReadOnlyRoutingPolicy_STRATEGY_UNSPECIFIED
+}
+
+func (x *ReadOnlyRoutingPolicy) GetMaxReplicationLag() *durationpb.Duration {
+	if x != nil {
+		return x.MaxReplicationLag
+	}
+	return nil
+}
+
+func (x *ReadOnlyRoutingPolicy) GetFallbackToAdmin() bool {
+	if x != nil {
+		return x.FallbackToAdmin
+	}
+	return false
+}
+
+func (x *ReadOnlyRoutingPolicy) GetSkipUnknownReplicationLag() bool {
+	if x != nil {
+		return x.SkipUnknownReplicationLag
+	}
+	return false
+}
+
 // InstanceMetadata is the metadata for instances.
 type InstanceMetadata struct {
 	state         protoimpl.MessageState
@@ -106,7 +247,7 @@ type InstanceMetadata struct {
 func (x *InstanceMetadata) Reset() {
 	*x = InstanceMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_instance_proto_msgTypes[1]
+		mi := &file_store_instance_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -119,7 +260,7 @@ func (x *InstanceMetadata) String() string {
 func (*InstanceMetadata) ProtoMessage() {}
 
 func (x *InstanceMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_proto_msgTypes[1]
+	mi := &file_store_instance_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -132,7 +273,7 @@ func (x *InstanceMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceMetadata.ProtoReflect.Descriptor instead.
 func (*InstanceMetadata) Descriptor() ([]byte, []int) {
-	return file_store_instance_proto_rawDescGZIP(), []int{1}
+	return file_store_instance_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *InstanceMetadata) GetMysqlLowerCaseTableNames() int32 {
@@ -158,7 +299,7 @@ var file_store_instance_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb0, 0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x74,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x02, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x74,
 	0x61, 0x6e, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73,
 	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x54,
@@ -169,18 +310,47 @@ var file_store_instance_proto_rawDesc = []byte{
 	0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x13, 0x6d, 0x61, 0x78,
 	0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x10, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x3e, 0x0a, 0x1c, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x63,
-	0x61, 0x73, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x18, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x4c, 0x6f, 0x77, 0x65,
-	0x72, 0x43, 0x61, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12,
-	0x40, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x69, 0x6d,
-	0x65, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67,
-	0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5e, 0x0a, 0x18, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x15, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x6f, 0x75,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xe9, 0x02, 0x0a, 0x15, 0x52,
+	0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x4a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79,
+	0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x12, 0x49, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x66,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x54, 0x6f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x3f, 0x0a, 0x1c, 0x73, 0x6b, 0x69, 0x70, 0x5f,
+	0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x73,
+	0x6b, 0x69, 0x70, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x67, 0x22, 0x4c, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f,
+	0x0a, 0x0b, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x4c, 0x45, 0x41, 0x53, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x53, 0x10, 0x02, 0x22, 0x94, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x1c, 0x6d,
+	0x79, 0x73, 0x71, 0x6c, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x18, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x61, 0x73,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x14, 0x5a,
+	0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -195,21 +365,27 @@ func file_store_instance_proto_rawDescGZIP() []byte {
 	return file_store_instance_proto_rawDescData
 }
 
-var file_store_instance_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_instance_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_store_instance_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_store_instance_proto_goTypes = []interface{}{
-	(*InstanceOptions)(nil),       // 0: bytebase.store.InstanceOptions
-	(*InstanceMetadata)(nil),      // 1: bytebase.store.InstanceMetadata
-	(*durationpb.Duration)(nil),   // 2: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(ReadOnlyRoutingPolicy_Strategy)(0), // 0: bytebase.store.ReadOnlyRoutingPolicy.Strategy
+	(*InstanceOptions)(nil),             // 1: bytebase.store.InstanceOptions
+	(*ReadOnlyRoutingPolicy)(nil),       // 2: bytebase.store.ReadOnlyRoutingPolicy
+	(*InstanceMetadata)(nil),            // 3: bytebase.store.InstanceMetadata
+	(*durationpb.Duration)(nil),         // 4: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),       // 5: google.protobuf.Timestamp
 }
 var file_store_instance_proto_depIdxs = []int32{
-	2, // 0: bytebase.store.InstanceOptions.sync_interval:type_name -> google.protobuf.Duration
-	3, // 1: bytebase.store.InstanceMetadata.last_sync_time:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4, // 0: bytebase.store.InstanceOptions.sync_interval:type_name -> google.protobuf.Duration
+	2, // 1: bytebase.store.InstanceOptions.read_only_routing_policy:type_name -> bytebase.store.ReadOnlyRoutingPolicy
+	0, // 2: bytebase.store.ReadOnlyRoutingPolicy.strategy:type_name -> bytebase.store.ReadOnlyRoutingPolicy.Strategy
+	4, // 3: bytebase.store.ReadOnlyRoutingPolicy.max_replication_lag:type_name -> google.protobuf.Duration
+	5, // 4: bytebase.store.InstanceMetadata.last_sync_time:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_store_instance_proto_init() }
@@ -231,6 +407,18 @@ func file_store_instance_proto_init() {
 			}
 		}
 		file_store_instance_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadOnlyRoutingPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_instance_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InstanceMetadata); i {
 			case 0:
 				return &v.state
@@ -248,13 +436,14 @@ func file_store_instance_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_instance_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_store_instance_proto_goTypes,
 		DependencyIndexes: file_store_instance_proto_depIdxs,
+		EnumInfos:         file_store_instance_proto_enumTypes,
 		MessageInfos:      file_store_instance_proto_msgTypes,
 	}.Build()
 	File_store_instance_proto = out.File
@@ -35,7 +35,7 @@ const (
 	PlanConfig_ChangeDatabaseConfig_MIGRATE PlanConfig_ChangeDatabaseConfig_Type = 2
 	// Used for schema changes via state-based schema migration including CREATE DATABASE.
 	PlanConfig_ChangeDatabaseConfig_MIGRATE_SDL PlanConfig_ChangeDatabaseConfig_Type = 3
-	// Used for DDL changes using gh-ost.
+	// Used for DDL changes using gh-ost for MySQL, or the trigger based online schema migration for PostgreSQL.
 	PlanConfig_ChangeDatabaseConfig_MIGRATE_GHOST PlanConfig_ChangeDatabaseConfig_Type = 4
 	// Used when restoring from a backup (the restored database branched from the original backup).
 	PlanConfig_ChangeDatabaseConfig_BRANCH PlanConfig_ChangeDatabaseConfig_Type = 5
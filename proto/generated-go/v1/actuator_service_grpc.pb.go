@@ -25,6 +25,7 @@ const (
 	ActuatorService_DeleteCache_FullMethodName        = "/bytebase.v1.ActuatorService/DeleteCache"
 	ActuatorService_ListDebugLog_FullMethodName       = "/bytebase.v1.ActuatorService/ListDebugLog"
 	ActuatorService_GetResourcePackage_FullMethodName = "/bytebase.v1.ActuatorService/GetResourcePackage"
+	ActuatorService_GetDriverPoolStats_FullMethodName = "/bytebase.v1.ActuatorService/GetDriverPoolStats"
 )
 
 // ActuatorServiceClient is the client API for ActuatorService service.
@@ -36,6 +37,7 @@ type ActuatorServiceClient interface {
 	DeleteCache(ctx context.Context, in *DeleteCacheRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListDebugLog(ctx context.Context, in *ListDebugLogRequest, opts ...grpc.CallOption) (*ListDebugLogResponse, error)
 	GetResourcePackage(ctx context.Context, in *GetResourcePackageRequest, opts ...grpc.CallOption) (*ResourcePackage, error)
+	GetDriverPoolStats(ctx context.Context, in *GetDriverPoolStatsRequest, opts ...grpc.CallOption) (*DriverPoolStats, error)
 }
 
 type actuatorServiceClient struct {
@@ -91,6 +93,15 @@ func (c *actuatorServiceClient) GetResourcePackage(ctx context.Context, in *GetR
 	return out, nil
 }
 
+func (c *actuatorServiceClient) GetDriverPoolStats(ctx context.Context, in *GetDriverPoolStatsRequest, opts ...grpc.CallOption) (*DriverPoolStats, error) {
+	out := new(DriverPoolStats)
+	err := c.cc.Invoke(ctx, ActuatorService_GetDriverPoolStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ActuatorServiceServer is the server API for ActuatorService service.
 // All implementations must embed UnimplementedActuatorServiceServer
 // for forward compatibility
@@ -100,6 +111,7 @@ type ActuatorServiceServer interface {
 	DeleteCache(context.Context, *DeleteCacheRequest) (*emptypb.Empty, error)
 	ListDebugLog(context.Context, *ListDebugLogRequest) (*ListDebugLogResponse, error)
 	GetResourcePackage(context.Context, *GetResourcePackageRequest) (*ResourcePackage, error)
+	GetDriverPoolStats(context.Context, *GetDriverPoolStatsRequest) (*DriverPoolStats, error)
 	mustEmbedUnimplementedActuatorServiceServer()
 }
 
@@ -122,6 +134,9 @@ func (UnimplementedActuatorServiceServer) ListDebugLog(context.Context, *ListDeb
 func (UnimplementedActuatorServiceServer) GetResourcePackage(context.Context, *GetResourcePackageRequest) (*ResourcePackage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResourcePackage not implemented")
 }
+func (UnimplementedActuatorServiceServer) GetDriverPoolStats(context.Context, *GetDriverPoolStatsRequest) (*DriverPoolStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriverPoolStats not implemented")
+}
 func (UnimplementedActuatorServiceServer) mustEmbedUnimplementedActuatorServiceServer() {}
 
 // UnsafeActuatorServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -225,6 +240,24 @@ func _ActuatorService_GetResourcePackage_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ActuatorService_GetDriverPoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriverPoolStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActuatorServiceServer).GetDriverPoolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActuatorService_GetDriverPoolStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActuatorServiceServer).GetDriverPoolStats(ctx, req.(*GetDriverPoolStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ActuatorService_ServiceDesc is the grpc.ServiceDesc for ActuatorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -252,6 +285,10 @@ var ActuatorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetResourcePackage",
 			Handler:    _ActuatorService_GetResourcePackage_Handler,
 		},
+		{
+			MethodName: "GetDriverPoolStats",
+			Handler:    _ActuatorService_GetDriverPoolStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/actuator_service.proto",
@@ -20,27 +20,33 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	DatabaseService_GetDatabase_FullMethodName            = "/bytebase.v1.DatabaseService/GetDatabase"
-	DatabaseService_ListDatabases_FullMethodName          = "/bytebase.v1.DatabaseService/ListDatabases"
-	DatabaseService_SearchDatabases_FullMethodName        = "/bytebase.v1.DatabaseService/SearchDatabases"
-	DatabaseService_UpdateDatabase_FullMethodName         = "/bytebase.v1.DatabaseService/UpdateDatabase"
-	DatabaseService_BatchUpdateDatabases_FullMethodName   = "/bytebase.v1.DatabaseService/BatchUpdateDatabases"
-	DatabaseService_SyncDatabase_FullMethodName           = "/bytebase.v1.DatabaseService/SyncDatabase"
-	DatabaseService_GetDatabaseMetadata_FullMethodName    = "/bytebase.v1.DatabaseService/GetDatabaseMetadata"
-	DatabaseService_UpdateDatabaseMetadata_FullMethodName = "/bytebase.v1.DatabaseService/UpdateDatabaseMetadata"
-	DatabaseService_GetDatabaseSchema_FullMethodName      = "/bytebase.v1.DatabaseService/GetDatabaseSchema"
-	DatabaseService_DiffSchema_FullMethodName             = "/bytebase.v1.DatabaseService/DiffSchema"
-	DatabaseService_GetBackupSetting_FullMethodName       = "/bytebase.v1.DatabaseService/GetBackupSetting"
-	DatabaseService_UpdateBackupSetting_FullMethodName    = "/bytebase.v1.DatabaseService/UpdateBackupSetting"
-	DatabaseService_CreateBackup_FullMethodName           = "/bytebase.v1.DatabaseService/CreateBackup"
-	DatabaseService_ListBackups_FullMethodName            = "/bytebase.v1.DatabaseService/ListBackups"
-	DatabaseService_ListSlowQueries_FullMethodName        = "/bytebase.v1.DatabaseService/ListSlowQueries"
-	DatabaseService_ListSecrets_FullMethodName            = "/bytebase.v1.DatabaseService/ListSecrets"
-	DatabaseService_UpdateSecret_FullMethodName           = "/bytebase.v1.DatabaseService/UpdateSecret"
-	DatabaseService_DeleteSecret_FullMethodName           = "/bytebase.v1.DatabaseService/DeleteSecret"
-	DatabaseService_AdviseIndex_FullMethodName            = "/bytebase.v1.DatabaseService/AdviseIndex"
-	DatabaseService_ListChangeHistories_FullMethodName    = "/bytebase.v1.DatabaseService/ListChangeHistories"
-	DatabaseService_GetChangeHistory_FullMethodName       = "/bytebase.v1.DatabaseService/GetChangeHistory"
+	DatabaseService_GetDatabase_FullMethodName                 = "/bytebase.v1.DatabaseService/GetDatabase"
+	DatabaseService_ListDatabases_FullMethodName               = "/bytebase.v1.DatabaseService/ListDatabases"
+	DatabaseService_SearchDatabases_FullMethodName             = "/bytebase.v1.DatabaseService/SearchDatabases"
+	DatabaseService_UpdateDatabase_FullMethodName              = "/bytebase.v1.DatabaseService/UpdateDatabase"
+	DatabaseService_BatchUpdateDatabases_FullMethodName        = "/bytebase.v1.DatabaseService/BatchUpdateDatabases"
+	DatabaseService_SyncDatabase_FullMethodName                = "/bytebase.v1.DatabaseService/SyncDatabase"
+	DatabaseService_GetDatabaseMetadata_FullMethodName         = "/bytebase.v1.DatabaseService/GetDatabaseMetadata"
+	DatabaseService_UpdateDatabaseMetadata_FullMethodName      = "/bytebase.v1.DatabaseService/UpdateDatabaseMetadata"
+	DatabaseService_GetDatabaseSchema_FullMethodName           = "/bytebase.v1.DatabaseService/GetDatabaseSchema"
+	DatabaseService_DiffSchema_FullMethodName                  = "/bytebase.v1.DatabaseService/DiffSchema"
+	DatabaseService_GetBackupSetting_FullMethodName            = "/bytebase.v1.DatabaseService/GetBackupSetting"
+	DatabaseService_UpdateBackupSetting_FullMethodName         = "/bytebase.v1.DatabaseService/UpdateBackupSetting"
+	DatabaseService_CreateBackup_FullMethodName                = "/bytebase.v1.DatabaseService/CreateBackup"
+	DatabaseService_ListBackups_FullMethodName                 = "/bytebase.v1.DatabaseService/ListBackups"
+	DatabaseService_ListSlowQueries_FullMethodName             = "/bytebase.v1.DatabaseService/ListSlowQueries"
+	DatabaseService_ListTopQueries_FullMethodName              = "/bytebase.v1.DatabaseService/ListTopQueries"
+	DatabaseService_ListSecrets_FullMethodName                 = "/bytebase.v1.DatabaseService/ListSecrets"
+	DatabaseService_UpdateSecret_FullMethodName                = "/bytebase.v1.DatabaseService/UpdateSecret"
+	DatabaseService_DeleteSecret_FullMethodName                = "/bytebase.v1.DatabaseService/DeleteSecret"
+	DatabaseService_AdviseIndex_FullMethodName                 = "/bytebase.v1.DatabaseService/AdviseIndex"
+	DatabaseService_ListChangeHistories_FullMethodName         = "/bytebase.v1.DatabaseService/ListChangeHistories"
+	DatabaseService_GetChangeHistory_FullMethodName            = "/bytebase.v1.DatabaseService/GetChangeHistory"
+	DatabaseService_DiscoverSensitiveData_FullMethodName       = "/bytebase.v1.DatabaseService/DiscoverSensitiveData"
+	DatabaseService_ListSensitiveDataProposals_FullMethodName  = "/bytebase.v1.DatabaseService/ListSensitiveDataProposals"
+	DatabaseService_AcceptSensitiveDataProposal_FullMethodName = "/bytebase.v1.DatabaseService/AcceptSensitiveDataProposal"
+	DatabaseService_RejectSensitiveDataProposal_FullMethodName = "/bytebase.v1.DatabaseService/RejectSensitiveDataProposal"
+	DatabaseService_ReconcileSchemaDrift_FullMethodName        = "/bytebase.v1.DatabaseService/ReconcileSchemaDrift"
 )
 
 // DatabaseServiceClient is the client API for DatabaseService service.
@@ -63,12 +69,21 @@ type DatabaseServiceClient interface {
 	CreateBackup(ctx context.Context, in *CreateBackupRequest, opts ...grpc.CallOption) (*Backup, error)
 	ListBackups(ctx context.Context, in *ListBackupsRequest, opts ...grpc.CallOption) (*ListBackupsResponse, error)
 	ListSlowQueries(ctx context.Context, in *ListSlowQueriesRequest, opts ...grpc.CallOption) (*ListSlowQueriesResponse, error)
+	// ListTopQueries lists the top queries executed in the SQL editor on the database, grouped by the query fingerprint.
+	ListTopQueries(ctx context.Context, in *ListTopQueriesRequest, opts ...grpc.CallOption) (*ListTopQueriesResponse, error)
 	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
 	UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...grpc.CallOption) (*Secret, error)
 	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AdviseIndex(ctx context.Context, in *AdviseIndexRequest, opts ...grpc.CallOption) (*AdviseIndexResponse, error)
 	ListChangeHistories(ctx context.Context, in *ListChangeHistoriesRequest, opts ...grpc.CallOption) (*ListChangeHistoriesResponse, error)
 	GetChangeHistory(ctx context.Context, in *GetChangeHistoryRequest, opts ...grpc.CallOption) (*ChangeHistory, error)
+	DiscoverSensitiveData(ctx context.Context, in *DiscoverSensitiveDataRequest, opts ...grpc.CallOption) (*DiscoverSensitiveDataResponse, error)
+	ListSensitiveDataProposals(ctx context.Context, in *ListSensitiveDataProposalsRequest, opts ...grpc.CallOption) (*ListSensitiveDataProposalsResponse, error)
+	AcceptSensitiveDataProposal(ctx context.Context, in *AcceptSensitiveDataProposalRequest, opts ...grpc.CallOption) (*SensitiveDataProposal, error)
+	RejectSensitiveDataProposal(ctx context.Context, in *RejectSensitiveDataProposalRequest, opts ...grpc.CallOption) (*SensitiveDataProposal, error)
+	// ReconcileSchemaDrift creates an issue to reconcile the schema drift of a database.
+	// The issue goes through the plan checks and the approval flow as the other database change issues.
+	ReconcileSchemaDrift(ctx context.Context, in *ReconcileSchemaDriftRequest, opts ...grpc.CallOption) (*Issue, error)
 }
 
 type databaseServiceClient struct {
@@ -214,6 +229,15 @@ func (c *databaseServiceClient) ListSlowQueries(ctx context.Context, in *ListSlo
 	return out, nil
 }
 
+func (c *databaseServiceClient) ListTopQueries(ctx context.Context, in *ListTopQueriesRequest, opts ...grpc.CallOption) (*ListTopQueriesResponse, error) {
+	out := new(ListTopQueriesResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ListTopQueries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseServiceClient) ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error) {
 	out := new(ListSecretsResponse)
 	err := c.cc.Invoke(ctx, DatabaseService_ListSecrets_FullMethodName, in, out, opts...)
@@ -268,6 +292,51 @@ func (c *databaseServiceClient) GetChangeHistory(ctx context.Context, in *GetCha
 	return out, nil
 }
 
+func (c *databaseServiceClient) DiscoverSensitiveData(ctx context.Context, in *DiscoverSensitiveDataRequest, opts ...grpc.CallOption) (*DiscoverSensitiveDataResponse, error) {
+	out := new(DiscoverSensitiveDataResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_DiscoverSensitiveData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ListSensitiveDataProposals(ctx context.Context, in *ListSensitiveDataProposalsRequest, opts ...grpc.CallOption) (*ListSensitiveDataProposalsResponse, error) {
+	out := new(ListSensitiveDataProposalsResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ListSensitiveDataProposals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) AcceptSensitiveDataProposal(ctx context.Context, in *AcceptSensitiveDataProposalRequest, opts ...grpc.CallOption) (*SensitiveDataProposal, error) {
+	out := new(SensitiveDataProposal)
+	err := c.cc.Invoke(ctx, DatabaseService_AcceptSensitiveDataProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) RejectSensitiveDataProposal(ctx context.Context, in *RejectSensitiveDataProposalRequest, opts ...grpc.CallOption) (*SensitiveDataProposal, error) {
+	out := new(SensitiveDataProposal)
+	err := c.cc.Invoke(ctx, DatabaseService_RejectSensitiveDataProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ReconcileSchemaDrift(ctx context.Context, in *ReconcileSchemaDriftRequest, opts ...grpc.CallOption) (*Issue, error) {
+	out := new(Issue)
+	err := c.cc.Invoke(ctx, DatabaseService_ReconcileSchemaDrift_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DatabaseServiceServer is the server API for DatabaseService service.
 // All implementations must embed UnimplementedDatabaseServiceServer
 // for forward compatibility
@@ -288,12 +357,21 @@ type DatabaseServiceServer interface {
 	CreateBackup(context.Context, *CreateBackupRequest) (*Backup, error)
 	ListBackups(context.Context, *ListBackupsRequest) (*ListBackupsResponse, error)
 	ListSlowQueries(context.Context, *ListSlowQueriesRequest) (*ListSlowQueriesResponse, error)
+	// ListTopQueries lists the top queries executed in the SQL editor on the database, grouped by the query fingerprint.
+	ListTopQueries(context.Context, *ListTopQueriesRequest) (*ListTopQueriesResponse, error)
 	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
 	UpdateSecret(context.Context, *UpdateSecretRequest) (*Secret, error)
 	DeleteSecret(context.Context, *DeleteSecretRequest) (*emptypb.Empty, error)
 	AdviseIndex(context.Context, *AdviseIndexRequest) (*AdviseIndexResponse, error)
 	ListChangeHistories(context.Context, *ListChangeHistoriesRequest) (*ListChangeHistoriesResponse, error)
 	GetChangeHistory(context.Context, *GetChangeHistoryRequest) (*ChangeHistory, error)
+	DiscoverSensitiveData(context.Context, *DiscoverSensitiveDataRequest) (*DiscoverSensitiveDataResponse, error)
+	ListSensitiveDataProposals(context.Context, *ListSensitiveDataProposalsRequest) (*ListSensitiveDataProposalsResponse, error)
+	AcceptSensitiveDataProposal(context.Context, *AcceptSensitiveDataProposalRequest) (*SensitiveDataProposal, error)
+	RejectSensitiveDataProposal(context.Context, *RejectSensitiveDataProposalRequest) (*SensitiveDataProposal, error)
+	// ReconcileSchemaDrift creates an issue to reconcile the schema drift of a database.
+	// The issue goes through the plan checks and the approval flow as the other database change issues.
+	ReconcileSchemaDrift(context.Context, *ReconcileSchemaDriftRequest) (*Issue, error)
 	mustEmbedUnimplementedDatabaseServiceServer()
 }
 
@@ -346,6 +424,9 @@ func (UnimplementedDatabaseServiceServer) ListBackups(context.Context, *ListBack
 func (UnimplementedDatabaseServiceServer) ListSlowQueries(context.Context, *ListSlowQueriesRequest) (*ListSlowQueriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSlowQueries not implemented")
 }
+func (UnimplementedDatabaseServiceServer) ListTopQueries(context.Context, *ListTopQueriesRequest) (*ListTopQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopQueries not implemented")
+}
 func (UnimplementedDatabaseServiceServer) ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSecrets not implemented")
 }
@@ -364,6 +445,21 @@ func (UnimplementedDatabaseServiceServer) ListChangeHistories(context.Context, *
 func (UnimplementedDatabaseServiceServer) GetChangeHistory(context.Context, *GetChangeHistoryRequest) (*ChangeHistory, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChangeHistory not implemented")
 }
+func (UnimplementedDatabaseServiceServer) DiscoverSensitiveData(context.Context, *DiscoverSensitiveDataRequest) (*DiscoverSensitiveDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscoverSensitiveData not implemented")
+}
+func (UnimplementedDatabaseServiceServer) ListSensitiveDataProposals(context.Context, *ListSensitiveDataProposalsRequest) (*ListSensitiveDataProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSensitiveDataProposals not implemented")
+}
+func (UnimplementedDatabaseServiceServer) AcceptSensitiveDataProposal(context.Context, *AcceptSensitiveDataProposalRequest) (*SensitiveDataProposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSensitiveDataProposal not implemented")
+}
+func (UnimplementedDatabaseServiceServer) RejectSensitiveDataProposal(context.Context, *RejectSensitiveDataProposalRequest) (*SensitiveDataProposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectSensitiveDataProposal not implemented")
+}
+func (UnimplementedDatabaseServiceServer) ReconcileSchemaDrift(context.Context, *ReconcileSchemaDriftRequest) (*Issue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileSchemaDrift not implemented")
+}
 func (UnimplementedDatabaseServiceServer) mustEmbedUnimplementedDatabaseServiceServer() {}
 
 // UnsafeDatabaseServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -647,6 +743,24 @@ func _DatabaseService_ListSlowQueries_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_ListTopQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ListTopQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ListTopQueries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ListTopQueries(ctx, req.(*ListTopQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseService_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSecretsRequest)
 	if err := dec(in); err != nil {
@@ -755,6 +869,96 @@ func _DatabaseService_GetChangeHistory_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_DiscoverSensitiveData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverSensitiveDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).DiscoverSensitiveData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_DiscoverSensitiveData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).DiscoverSensitiveData(ctx, req.(*DiscoverSensitiveDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ListSensitiveDataProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSensitiveDataProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ListSensitiveDataProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ListSensitiveDataProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ListSensitiveDataProposals(ctx, req.(*ListSensitiveDataProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_AcceptSensitiveDataProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptSensitiveDataProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).AcceptSensitiveDataProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_AcceptSensitiveDataProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).AcceptSensitiveDataProposal(ctx, req.(*AcceptSensitiveDataProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_RejectSensitiveDataProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectSensitiveDataProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).RejectSensitiveDataProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_RejectSensitiveDataProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).RejectSensitiveDataProposal(ctx, req.(*RejectSensitiveDataProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ReconcileSchemaDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileSchemaDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ReconcileSchemaDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ReconcileSchemaDrift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ReconcileSchemaDrift(ctx, req.(*ReconcileSchemaDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DatabaseService_ServiceDesc is the grpc.ServiceDesc for DatabaseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -822,6 +1026,10 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSlowQueries",
 			Handler:    _DatabaseService_ListSlowQueries_Handler,
 		},
+		{
+			MethodName: "ListTopQueries",
+			Handler:    _DatabaseService_ListTopQueries_Handler,
+		},
 		{
 			MethodName: "ListSecrets",
 			Handler:    _DatabaseService_ListSecrets_Handler,
@@ -846,6 +1054,26 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChangeHistory",
 			Handler:    _DatabaseService_GetChangeHistory_Handler,
 		},
+		{
+			MethodName: "DiscoverSensitiveData",
+			Handler:    _DatabaseService_DiscoverSensitiveData_Handler,
+		},
+		{
+			MethodName: "ListSensitiveDataProposals",
+			Handler:    _DatabaseService_ListSensitiveDataProposals_Handler,
+		},
+		{
+			MethodName: "AcceptSensitiveDataProposal",
+			Handler:    _DatabaseService_AcceptSensitiveDataProposal_Handler,
+		},
+		{
+			MethodName: "RejectSensitiveDataProposal",
+			Handler:    _DatabaseService_RejectSensitiveDataProposal_Handler,
+		},
+		{
+			MethodName: "ReconcileSchemaDrift",
+			Handler:    _DatabaseService_ReconcileSchemaDrift_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/database_service.proto",
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -20,6 +21,9 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	SQLService_Query_FullMethodName              = "/bytebase.v1.SQLService/Query"
+	SQLService_ListQuerySessions_FullMethodName  = "/bytebase.v1.SQLService/ListQuerySessions"
+	SQLService_GetQuerySession_FullMethodName    = "/bytebase.v1.SQLService/GetQuerySession"
+	SQLService_CancelQuerySession_FullMethodName = "/bytebase.v1.SQLService/CancelQuerySession"
 	SQLService_Export_FullMethodName             = "/bytebase.v1.SQLService/Export"
 	SQLService_AdminExecute_FullMethodName       = "/bytebase.v1.SQLService/AdminExecute"
 	SQLService_DifferPreview_FullMethodName      = "/bytebase.v1.SQLService/DifferPreview"
@@ -34,6 +38,11 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SQLServiceClient interface {
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// ListQuerySessions lists the running queries and the background queries of the current user.
+	ListQuerySessions(ctx context.Context, in *ListQuerySessionsRequest, opts ...grpc.CallOption) (*ListQuerySessionsResponse, error)
+	GetQuerySession(ctx context.Context, in *GetQuerySessionRequest, opts ...grpc.CallOption) (*QuerySession, error)
+	// CancelQuerySession cancels the running query by the engine native cancellation.
+	CancelQuerySession(ctx context.Context, in *CancelQuerySessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
 	AdminExecute(ctx context.Context, opts ...grpc.CallOption) (SQLService_AdminExecuteClient, error)
 	DifferPreview(ctx context.Context, in *DifferPreviewRequest, opts ...grpc.CallOption) (*DifferPreviewResponse, error)
@@ -60,6 +69,33 @@ func (c *sQLServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...
 	return out, nil
 }
 
+func (c *sQLServiceClient) ListQuerySessions(ctx context.Context, in *ListQuerySessionsRequest, opts ...grpc.CallOption) (*ListQuerySessionsResponse, error) {
+	out := new(ListQuerySessionsResponse)
+	err := c.cc.Invoke(ctx, SQLService_ListQuerySessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) GetQuerySession(ctx context.Context, in *GetQuerySessionRequest, opts ...grpc.CallOption) (*QuerySession, error) {
+	out := new(QuerySession)
+	err := c.cc.Invoke(ctx, SQLService_GetQuerySession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) CancelQuerySession(ctx context.Context, in *CancelQuerySessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SQLService_CancelQuerySession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sQLServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
 	out := new(ExportResponse)
 	err := c.cc.Invoke(ctx, SQLService_Export_FullMethodName, in, out, opts...)
@@ -150,6 +186,11 @@ func (c *sQLServiceClient) StringifyMetadata(ctx context.Context, in *StringifyM
 // for forward compatibility
 type SQLServiceServer interface {
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// ListQuerySessions lists the running queries and the background queries of the current user.
+	ListQuerySessions(context.Context, *ListQuerySessionsRequest) (*ListQuerySessionsResponse, error)
+	GetQuerySession(context.Context, *GetQuerySessionRequest) (*QuerySession, error)
+	// CancelQuerySession cancels the running query by the engine native cancellation.
+	CancelQuerySession(context.Context, *CancelQuerySessionRequest) (*emptypb.Empty, error)
 	Export(context.Context, *ExportRequest) (*ExportResponse, error)
 	AdminExecute(SQLService_AdminExecuteServer) error
 	DifferPreview(context.Context, *DifferPreviewRequest) (*DifferPreviewResponse, error)
@@ -167,6 +208,15 @@ type UnimplementedSQLServiceServer struct {
 func (UnimplementedSQLServiceServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (UnimplementedSQLServiceServer) ListQuerySessions(context.Context, *ListQuerySessionsRequest) (*ListQuerySessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuerySessions not implemented")
+}
+func (UnimplementedSQLServiceServer) GetQuerySession(context.Context, *GetQuerySessionRequest) (*QuerySession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuerySession not implemented")
+}
+func (UnimplementedSQLServiceServer) CancelQuerySession(context.Context, *CancelQuerySessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQuerySession not implemented")
+}
 func (UnimplementedSQLServiceServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
 }
@@ -219,6 +269,60 @@ func _SQLService_Query_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_ListQuerySessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuerySessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).ListQuerySessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_ListQuerySessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).ListQuerySessions(ctx, req.(*ListQuerySessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_GetQuerySession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuerySessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).GetQuerySession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_GetQuerySession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).GetQuerySession(ctx, req.(*GetQuerySessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_CancelQuerySession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelQuerySessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).CancelQuerySession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_CancelQuerySession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).CancelQuerySession(ctx, req.(*CancelQuerySessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SQLService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportRequest)
 	if err := dec(in); err != nil {
@@ -364,6 +468,18 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _SQLService_Query_Handler,
 		},
+		{
+			MethodName: "ListQuerySessions",
+			Handler:    _SQLService_ListQuerySessions_Handler,
+		},
+		{
+			MethodName: "GetQuerySession",
+			Handler:    _SQLService_GetQuerySession_Handler,
+		},
+		{
+			MethodName: "CancelQuerySession",
+			Handler:    _SQLService_CancelQuerySession_Handler,
+		},
 		{
 			MethodName: "Export",
 			Handler:    _SQLService_Export_Handler,
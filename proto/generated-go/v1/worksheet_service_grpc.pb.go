@@ -26,6 +26,14 @@ const (
 	WorksheetService_UpdateWorksheet_FullMethodName          = "/bytebase.v1.WorksheetService/UpdateWorksheet"
 	WorksheetService_UpdateWorksheetOrganizer_FullMethodName = "/bytebase.v1.WorksheetService/UpdateWorksheetOrganizer"
 	WorksheetService_DeleteWorksheet_FullMethodName          = "/bytebase.v1.WorksheetService/DeleteWorksheet"
+	WorksheetService_ListWorksheetRevisions_FullMethodName   = "/bytebase.v1.WorksheetService/ListWorksheetRevisions"
+	WorksheetService_GetWorksheetRevision_FullMethodName     = "/bytebase.v1.WorksheetService/GetWorksheetRevision"
+	WorksheetService_DiffWorksheetRevisions_FullMethodName   = "/bytebase.v1.WorksheetService/DiffWorksheetRevisions"
+	WorksheetService_RestoreWorksheetRevision_FullMethodName = "/bytebase.v1.WorksheetService/RestoreWorksheetRevision"
+	WorksheetService_CreateWorksheetComment_FullMethodName   = "/bytebase.v1.WorksheetService/CreateWorksheetComment"
+	WorksheetService_ListWorksheetComments_FullMethodName    = "/bytebase.v1.WorksheetService/ListWorksheetComments"
+	WorksheetService_UpdateWorksheetComment_FullMethodName   = "/bytebase.v1.WorksheetService/UpdateWorksheetComment"
+	WorksheetService_DeleteWorksheetComment_FullMethodName   = "/bytebase.v1.WorksheetService/DeleteWorksheetComment"
 )
 
 // WorksheetServiceClient is the client API for WorksheetService service.
@@ -38,6 +46,14 @@ type WorksheetServiceClient interface {
 	UpdateWorksheet(ctx context.Context, in *UpdateWorksheetRequest, opts ...grpc.CallOption) (*Worksheet, error)
 	UpdateWorksheetOrganizer(ctx context.Context, in *UpdateWorksheetOrganizerRequest, opts ...grpc.CallOption) (*WorksheetOrganizer, error)
 	DeleteWorksheet(ctx context.Context, in *DeleteWorksheetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListWorksheetRevisions(ctx context.Context, in *ListWorksheetRevisionsRequest, opts ...grpc.CallOption) (*ListWorksheetRevisionsResponse, error)
+	GetWorksheetRevision(ctx context.Context, in *GetWorksheetRevisionRequest, opts ...grpc.CallOption) (*WorksheetRevision, error)
+	DiffWorksheetRevisions(ctx context.Context, in *DiffWorksheetRevisionsRequest, opts ...grpc.CallOption) (*DiffWorksheetRevisionsResponse, error)
+	RestoreWorksheetRevision(ctx context.Context, in *RestoreWorksheetRevisionRequest, opts ...grpc.CallOption) (*Worksheet, error)
+	CreateWorksheetComment(ctx context.Context, in *CreateWorksheetCommentRequest, opts ...grpc.CallOption) (*WorksheetComment, error)
+	ListWorksheetComments(ctx context.Context, in *ListWorksheetCommentsRequest, opts ...grpc.CallOption) (*ListWorksheetCommentsResponse, error)
+	UpdateWorksheetComment(ctx context.Context, in *UpdateWorksheetCommentRequest, opts ...grpc.CallOption) (*WorksheetComment, error)
+	DeleteWorksheetComment(ctx context.Context, in *DeleteWorksheetCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type worksheetServiceClient struct {
@@ -102,6 +118,78 @@ func (c *worksheetServiceClient) DeleteWorksheet(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *worksheetServiceClient) ListWorksheetRevisions(ctx context.Context, in *ListWorksheetRevisionsRequest, opts ...grpc.CallOption) (*ListWorksheetRevisionsResponse, error) {
+	out := new(ListWorksheetRevisionsResponse)
+	err := c.cc.Invoke(ctx, WorksheetService_ListWorksheetRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worksheetServiceClient) GetWorksheetRevision(ctx context.Context, in *GetWorksheetRevisionRequest, opts ...grpc.CallOption) (*WorksheetRevision, error) {
+	out := new(WorksheetRevision)
+	err := c.cc.Invoke(ctx, WorksheetService_GetWorksheetRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worksheetServiceClient) DiffWorksheetRevisions(ctx context.Context, in *DiffWorksheetRevisionsRequest, opts ...grpc.CallOption) (*DiffWorksheetRevisionsResponse, error) {
+	out := new(DiffWorksheetRevisionsResponse)
+	err := c.cc.Invoke(ctx, WorksheetService_DiffWorksheetRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worksheetServiceClient) RestoreWorksheetRevision(ctx context.Context, in *RestoreWorksheetRevisionRequest, opts ...grpc.CallOption) (*Worksheet, error) {
+	out := new(Worksheet)
+	err := c.cc.Invoke(ctx, WorksheetService_RestoreWorksheetRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worksheetServiceClient) CreateWorksheetComment(ctx context.Context, in *CreateWorksheetCommentRequest, opts ...grpc.CallOption) (*WorksheetComment, error) {
+	out := new(WorksheetComment)
+	err := c.cc.Invoke(ctx, WorksheetService_CreateWorksheetComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worksheetServiceClient) ListWorksheetComments(ctx context.Context, in *ListWorksheetCommentsRequest, opts ...grpc.CallOption) (*ListWorksheetCommentsResponse, error) {
+	out := new(ListWorksheetCommentsResponse)
+	err := c.cc.Invoke(ctx, WorksheetService_ListWorksheetComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worksheetServiceClient) UpdateWorksheetComment(ctx context.Context, in *UpdateWorksheetCommentRequest, opts ...grpc.CallOption) (*WorksheetComment, error) {
+	out := new(WorksheetComment)
+	err := c.cc.Invoke(ctx, WorksheetService_UpdateWorksheetComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worksheetServiceClient) DeleteWorksheetComment(ctx context.Context, in *DeleteWorksheetCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorksheetService_DeleteWorksheetComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorksheetServiceServer is the server API for WorksheetService service.
 // All implementations must embed UnimplementedWorksheetServiceServer
 // for forward compatibility
@@ -112,6 +200,14 @@ type WorksheetServiceServer interface {
 	UpdateWorksheet(context.Context, *UpdateWorksheetRequest) (*Worksheet, error)
 	UpdateWorksheetOrganizer(context.Context, *UpdateWorksheetOrganizerRequest) (*WorksheetOrganizer, error)
 	DeleteWorksheet(context.Context, *DeleteWorksheetRequest) (*emptypb.Empty, error)
+	ListWorksheetRevisions(context.Context, *ListWorksheetRevisionsRequest) (*ListWorksheetRevisionsResponse, error)
+	GetWorksheetRevision(context.Context, *GetWorksheetRevisionRequest) (*WorksheetRevision, error)
+	DiffWorksheetRevisions(context.Context, *DiffWorksheetRevisionsRequest) (*DiffWorksheetRevisionsResponse, error)
+	RestoreWorksheetRevision(context.Context, *RestoreWorksheetRevisionRequest) (*Worksheet, error)
+	CreateWorksheetComment(context.Context, *CreateWorksheetCommentRequest) (*WorksheetComment, error)
+	ListWorksheetComments(context.Context, *ListWorksheetCommentsRequest) (*ListWorksheetCommentsResponse, error)
+	UpdateWorksheetComment(context.Context, *UpdateWorksheetCommentRequest) (*WorksheetComment, error)
+	DeleteWorksheetComment(context.Context, *DeleteWorksheetCommentRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedWorksheetServiceServer()
 }
 
@@ -137,6 +233,30 @@ func (UnimplementedWorksheetServiceServer) UpdateWorksheetOrganizer(context.Cont
 func (UnimplementedWorksheetServiceServer) DeleteWorksheet(context.Context, *DeleteWorksheetRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorksheet not implemented")
 }
+func (UnimplementedWorksheetServiceServer) ListWorksheetRevisions(context.Context, *ListWorksheetRevisionsRequest) (*ListWorksheetRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorksheetRevisions not implemented")
+}
+func (UnimplementedWorksheetServiceServer) GetWorksheetRevision(context.Context, *GetWorksheetRevisionRequest) (*WorksheetRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorksheetRevision not implemented")
+}
+func (UnimplementedWorksheetServiceServer) DiffWorksheetRevisions(context.Context, *DiffWorksheetRevisionsRequest) (*DiffWorksheetRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffWorksheetRevisions not implemented")
+}
+func (UnimplementedWorksheetServiceServer) RestoreWorksheetRevision(context.Context, *RestoreWorksheetRevisionRequest) (*Worksheet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreWorksheetRevision not implemented")
+}
+func (UnimplementedWorksheetServiceServer) CreateWorksheetComment(context.Context, *CreateWorksheetCommentRequest) (*WorksheetComment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorksheetComment not implemented")
+}
+func (UnimplementedWorksheetServiceServer) ListWorksheetComments(context.Context, *ListWorksheetCommentsRequest) (*ListWorksheetCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorksheetComments not implemented")
+}
+func (UnimplementedWorksheetServiceServer) UpdateWorksheetComment(context.Context, *UpdateWorksheetCommentRequest) (*WorksheetComment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorksheetComment not implemented")
+}
+func (UnimplementedWorksheetServiceServer) DeleteWorksheetComment(context.Context, *DeleteWorksheetCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorksheetComment not implemented")
+}
 func (UnimplementedWorksheetServiceServer) mustEmbedUnimplementedWorksheetServiceServer() {}
 
 // UnsafeWorksheetServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -258,6 +378,150 @@ func _WorksheetService_DeleteWorksheet_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorksheetService_ListWorksheetRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorksheetRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorksheetServiceServer).ListWorksheetRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorksheetService_ListWorksheetRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorksheetServiceServer).ListWorksheetRevisions(ctx, req.(*ListWorksheetRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorksheetService_GetWorksheetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorksheetRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorksheetServiceServer).GetWorksheetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorksheetService_GetWorksheetRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorksheetServiceServer).GetWorksheetRevision(ctx, req.(*GetWorksheetRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorksheetService_DiffWorksheetRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffWorksheetRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorksheetServiceServer).DiffWorksheetRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorksheetService_DiffWorksheetRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorksheetServiceServer).DiffWorksheetRevisions(ctx, req.(*DiffWorksheetRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorksheetService_RestoreWorksheetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreWorksheetRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorksheetServiceServer).RestoreWorksheetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorksheetService_RestoreWorksheetRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorksheetServiceServer).RestoreWorksheetRevision(ctx, req.(*RestoreWorksheetRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorksheetService_CreateWorksheetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorksheetCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorksheetServiceServer).CreateWorksheetComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorksheetService_CreateWorksheetComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorksheetServiceServer).CreateWorksheetComment(ctx, req.(*CreateWorksheetCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorksheetService_ListWorksheetComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorksheetCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorksheetServiceServer).ListWorksheetComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorksheetService_ListWorksheetComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorksheetServiceServer).ListWorksheetComments(ctx, req.(*ListWorksheetCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorksheetService_UpdateWorksheetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorksheetCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorksheetServiceServer).UpdateWorksheetComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorksheetService_UpdateWorksheetComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorksheetServiceServer).UpdateWorksheetComment(ctx, req.(*UpdateWorksheetCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorksheetService_DeleteWorksheetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorksheetCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorksheetServiceServer).DeleteWorksheetComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorksheetService_DeleteWorksheetComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorksheetServiceServer).DeleteWorksheetComment(ctx, req.(*DeleteWorksheetCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorksheetService_ServiceDesc is the grpc.ServiceDesc for WorksheetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +553,38 @@ var WorksheetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWorksheet",
 			Handler:    _WorksheetService_DeleteWorksheet_Handler,
 		},
+		{
+			MethodName: "ListWorksheetRevisions",
+			Handler:    _WorksheetService_ListWorksheetRevisions_Handler,
+		},
+		{
+			MethodName: "GetWorksheetRevision",
+			Handler:    _WorksheetService_GetWorksheetRevision_Handler,
+		},
+		{
+			MethodName: "DiffWorksheetRevisions",
+			Handler:    _WorksheetService_DiffWorksheetRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreWorksheetRevision",
+			Handler:    _WorksheetService_RestoreWorksheetRevision_Handler,
+		},
+		{
+			MethodName: "CreateWorksheetComment",
+			Handler:    _WorksheetService_CreateWorksheetComment_Handler,
+		},
+		{
+			MethodName: "ListWorksheetComments",
+			Handler:    _WorksheetService_ListWorksheetComments_Handler,
+		},
+		{
+			MethodName: "UpdateWorksheetComment",
+			Handler:    _WorksheetService_UpdateWorksheetComment_Handler,
+		},
+		{
+			MethodName: "DeleteWorksheetComment",
+			Handler:    _WorksheetService_DeleteWorksheetComment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/worksheet_service.proto",